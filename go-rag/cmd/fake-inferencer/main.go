@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"go-rag/services/fakeinferencer"
+)
+
+// fake-inferencer serves the deterministic Inferencer on EMBEDDING_SERVICE_PORT
+// (default 50051) so the API can be run without the real inference service.
+func main() {
+	logrus.SetFormatter(&logrus.JSONFormatter{})
+
+	port := os.Getenv("EMBEDDING_SERVICE_PORT")
+	if port == "" {
+		port = "50051"
+	}
+
+	addr := fmt.Sprintf(":%s", port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to listen")
+	}
+
+	logrus.WithField("address", addr).Info("fake inferencer listening")
+	_, errCh := fakeinferencer.Serve(lis)
+	if err := <-errCh; err != nil {
+		logrus.WithError(err).Fatal("fake inferencer stopped")
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-rag/ent/ent/chunk"
 	"go-rag/ent/ent/document"
@@ -23,6 +24,16 @@ type Chunk struct {
 	Content string `json:"content,omitempty"`
 	// ContentHash holds the value of the "content_hash" field.
 	ContentHash string `json:"content_hash,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// StartOffset holds the value of the "start_offset" field.
+	StartOffset int `json:"start_offset,omitempty"`
+	// EndOffset holds the value of the "end_offset" field.
+	EndOffset int `json:"end_offset,omitempty"`
+	// StartLine holds the value of the "start_line" field.
+	StartLine int `json:"start_line,omitempty"`
+	// EndLine holds the value of the "end_line" field.
+	EndLine int `json:"end_line,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChunkQuery when eager-loading is set.
 	Edges           ChunkEdges `json:"edges"`
@@ -66,7 +77,9 @@ func (*Chunk) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chunk.FieldID, chunk.FieldIndex:
+		case chunk.FieldMetadata:
+			values[i] = new([]byte)
+		case chunk.FieldID, chunk.FieldIndex, chunk.FieldStartOffset, chunk.FieldEndOffset, chunk.FieldStartLine, chunk.FieldEndLine:
 			values[i] = new(sql.NullInt64)
 		case chunk.FieldContent, chunk.FieldContentHash:
 			values[i] = new(sql.NullString)
@@ -111,6 +124,38 @@ func (_m *Chunk) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ContentHash = value.String
 			}
+		case chunk.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case chunk.FieldStartOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_offset", values[i])
+			} else if value.Valid {
+				_m.StartOffset = int(value.Int64)
+			}
+		case chunk.FieldEndOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_offset", values[i])
+			} else if value.Valid {
+				_m.EndOffset = int(value.Int64)
+			}
+		case chunk.FieldStartLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_line", values[i])
+			} else if value.Valid {
+				_m.StartLine = int(value.Int64)
+			}
+		case chunk.FieldEndLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_line", values[i])
+			} else if value.Valid {
+				_m.EndLine = int(value.Int64)
+			}
 		case chunk.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field document_chunks", value)
@@ -172,6 +217,21 @@ func (_m *Chunk) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("content_hash=")
 	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("start_offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartOffset))
+	builder.WriteString(", ")
+	builder.WriteString("end_offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndOffset))
+	builder.WriteString(", ")
+	builder.WriteString("start_line=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartLine))
+	builder.WriteString(", ")
+	builder.WriteString("end_line=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndLine))
 	builder.WriteByte(')')
 	return builder.String()
 }
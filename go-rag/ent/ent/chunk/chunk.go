@@ -18,6 +18,16 @@ const (
 	FieldContent = "content"
 	// FieldContentHash holds the string denoting the content_hash field in the database.
 	FieldContentHash = "content_hash"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldStartOffset holds the string denoting the start_offset field in the database.
+	FieldStartOffset = "start_offset"
+	// FieldEndOffset holds the string denoting the end_offset field in the database.
+	FieldEndOffset = "end_offset"
+	// FieldStartLine holds the string denoting the start_line field in the database.
+	FieldStartLine = "start_line"
+	// FieldEndLine holds the string denoting the end_line field in the database.
+	FieldEndLine = "end_line"
 	// EdgeDocument holds the string denoting the document edge name in mutations.
 	EdgeDocument = "document"
 	// EdgeQueryResults holds the string denoting the query_results edge name in mutations.
@@ -44,6 +54,11 @@ var Columns = []string{
 	FieldIndex,
 	FieldContent,
 	FieldContentHash,
+	FieldMetadata,
+	FieldStartOffset,
+	FieldEndOffset,
+	FieldStartLine,
+	FieldEndLine,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "chunks"
@@ -96,6 +111,26 @@ func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
 }
 
+// ByStartOffset orders the results by the start_offset field.
+func ByStartOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartOffset, opts...).ToFunc()
+}
+
+// ByEndOffset orders the results by the end_offset field.
+func ByEndOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndOffset, opts...).ToFunc()
+}
+
+// ByStartLine orders the results by the start_line field.
+func ByStartLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartLine, opts...).ToFunc()
+}
+
+// ByEndLine orders the results by the end_line field.
+func ByEndLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndLine, opts...).ToFunc()
+}
+
 // ByDocumentField orders the results by document field.
 func ByDocumentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -69,6 +69,26 @@ func ContentHash(v string) predicate.Chunk {
 	return predicate.Chunk(sql.FieldEQ(FieldContentHash, v))
 }
 
+// StartOffset applies equality check predicate on the "start_offset" field. It's identical to StartOffsetEQ.
+func StartOffset(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldStartOffset, v))
+}
+
+// EndOffset applies equality check predicate on the "end_offset" field. It's identical to EndOffsetEQ.
+func EndOffset(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldEndOffset, v))
+}
+
+// StartLine applies equality check predicate on the "start_line" field. It's identical to StartLineEQ.
+func StartLine(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldStartLine, v))
+}
+
+// EndLine applies equality check predicate on the "end_line" field. It's identical to EndLineEQ.
+func EndLine(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldEndLine, v))
+}
+
 // IndexEQ applies the EQ predicate on the "index" field.
 func IndexEQ(v int) predicate.Chunk {
 	return predicate.Chunk(sql.FieldEQ(FieldIndex, v))
@@ -249,6 +269,216 @@ func ContentHashContainsFold(v string) predicate.Chunk {
 	return predicate.Chunk(sql.FieldContainsFold(FieldContentHash, v))
 }
 
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotNull(FieldMetadata))
+}
+
+// StartOffsetEQ applies the EQ predicate on the "start_offset" field.
+func StartOffsetEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldStartOffset, v))
+}
+
+// StartOffsetNEQ applies the NEQ predicate on the "start_offset" field.
+func StartOffsetNEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNEQ(FieldStartOffset, v))
+}
+
+// StartOffsetIn applies the In predicate on the "start_offset" field.
+func StartOffsetIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldIn(FieldStartOffset, vs...))
+}
+
+// StartOffsetNotIn applies the NotIn predicate on the "start_offset" field.
+func StartOffsetNotIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotIn(FieldStartOffset, vs...))
+}
+
+// StartOffsetGT applies the GT predicate on the "start_offset" field.
+func StartOffsetGT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGT(FieldStartOffset, v))
+}
+
+// StartOffsetGTE applies the GTE predicate on the "start_offset" field.
+func StartOffsetGTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGTE(FieldStartOffset, v))
+}
+
+// StartOffsetLT applies the LT predicate on the "start_offset" field.
+func StartOffsetLT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLT(FieldStartOffset, v))
+}
+
+// StartOffsetLTE applies the LTE predicate on the "start_offset" field.
+func StartOffsetLTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLTE(FieldStartOffset, v))
+}
+
+// StartOffsetIsNil applies the IsNil predicate on the "start_offset" field.
+func StartOffsetIsNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldIsNull(FieldStartOffset))
+}
+
+// StartOffsetNotNil applies the NotNil predicate on the "start_offset" field.
+func StartOffsetNotNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotNull(FieldStartOffset))
+}
+
+// EndOffsetEQ applies the EQ predicate on the "end_offset" field.
+func EndOffsetEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldEndOffset, v))
+}
+
+// EndOffsetNEQ applies the NEQ predicate on the "end_offset" field.
+func EndOffsetNEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNEQ(FieldEndOffset, v))
+}
+
+// EndOffsetIn applies the In predicate on the "end_offset" field.
+func EndOffsetIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldIn(FieldEndOffset, vs...))
+}
+
+// EndOffsetNotIn applies the NotIn predicate on the "end_offset" field.
+func EndOffsetNotIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotIn(FieldEndOffset, vs...))
+}
+
+// EndOffsetGT applies the GT predicate on the "end_offset" field.
+func EndOffsetGT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGT(FieldEndOffset, v))
+}
+
+// EndOffsetGTE applies the GTE predicate on the "end_offset" field.
+func EndOffsetGTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGTE(FieldEndOffset, v))
+}
+
+// EndOffsetLT applies the LT predicate on the "end_offset" field.
+func EndOffsetLT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLT(FieldEndOffset, v))
+}
+
+// EndOffsetLTE applies the LTE predicate on the "end_offset" field.
+func EndOffsetLTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLTE(FieldEndOffset, v))
+}
+
+// EndOffsetIsNil applies the IsNil predicate on the "end_offset" field.
+func EndOffsetIsNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldIsNull(FieldEndOffset))
+}
+
+// EndOffsetNotNil applies the NotNil predicate on the "end_offset" field.
+func EndOffsetNotNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotNull(FieldEndOffset))
+}
+
+// StartLineEQ applies the EQ predicate on the "start_line" field.
+func StartLineEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldStartLine, v))
+}
+
+// StartLineNEQ applies the NEQ predicate on the "start_line" field.
+func StartLineNEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNEQ(FieldStartLine, v))
+}
+
+// StartLineIn applies the In predicate on the "start_line" field.
+func StartLineIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldIn(FieldStartLine, vs...))
+}
+
+// StartLineNotIn applies the NotIn predicate on the "start_line" field.
+func StartLineNotIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotIn(FieldStartLine, vs...))
+}
+
+// StartLineGT applies the GT predicate on the "start_line" field.
+func StartLineGT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGT(FieldStartLine, v))
+}
+
+// StartLineGTE applies the GTE predicate on the "start_line" field.
+func StartLineGTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGTE(FieldStartLine, v))
+}
+
+// StartLineLT applies the LT predicate on the "start_line" field.
+func StartLineLT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLT(FieldStartLine, v))
+}
+
+// StartLineLTE applies the LTE predicate on the "start_line" field.
+func StartLineLTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLTE(FieldStartLine, v))
+}
+
+// StartLineIsNil applies the IsNil predicate on the "start_line" field.
+func StartLineIsNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldIsNull(FieldStartLine))
+}
+
+// StartLineNotNil applies the NotNil predicate on the "start_line" field.
+func StartLineNotNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotNull(FieldStartLine))
+}
+
+// EndLineEQ applies the EQ predicate on the "end_line" field.
+func EndLineEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldEQ(FieldEndLine, v))
+}
+
+// EndLineNEQ applies the NEQ predicate on the "end_line" field.
+func EndLineNEQ(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNEQ(FieldEndLine, v))
+}
+
+// EndLineIn applies the In predicate on the "end_line" field.
+func EndLineIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldIn(FieldEndLine, vs...))
+}
+
+// EndLineNotIn applies the NotIn predicate on the "end_line" field.
+func EndLineNotIn(vs ...int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotIn(FieldEndLine, vs...))
+}
+
+// EndLineGT applies the GT predicate on the "end_line" field.
+func EndLineGT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGT(FieldEndLine, v))
+}
+
+// EndLineGTE applies the GTE predicate on the "end_line" field.
+func EndLineGTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldGTE(FieldEndLine, v))
+}
+
+// EndLineLT applies the LT predicate on the "end_line" field.
+func EndLineLT(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLT(FieldEndLine, v))
+}
+
+// EndLineLTE applies the LTE predicate on the "end_line" field.
+func EndLineLTE(v int) predicate.Chunk {
+	return predicate.Chunk(sql.FieldLTE(FieldEndLine, v))
+}
+
+// EndLineIsNil applies the IsNil predicate on the "end_line" field.
+func EndLineIsNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldIsNull(FieldEndLine))
+}
+
+// EndLineNotNil applies the NotNil predicate on the "end_line" field.
+func EndLineNotNil() predicate.Chunk {
+	return predicate.Chunk(sql.FieldNotNull(FieldEndLine))
+}
+
 // HasDocument applies the HasEdge predicate on the "document" edge.
 func HasDocument() predicate.Chunk {
 	return predicate.Chunk(func(s *sql.Selector) {
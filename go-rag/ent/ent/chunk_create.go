@@ -47,6 +47,68 @@ func (_c *ChunkCreate) SetNillableContentHash(v *string) *ChunkCreate {
 	return _c
 }
 
+// SetMetadata sets the "metadata" field.
+func (_c *ChunkCreate) SetMetadata(v map[string]interface{}) *ChunkCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (_c *ChunkCreate) SetStartOffset(v int) *ChunkCreate {
+	_c.mutation.SetStartOffset(v)
+	return _c
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (_c *ChunkCreate) SetNillableStartOffset(v *int) *ChunkCreate {
+	if v != nil {
+		_c.SetStartOffset(*v)
+	}
+	return _c
+}
+
+// SetEndOffset sets the "end_offset" field.
+func (_c *ChunkCreate) SetEndOffset(v int) *ChunkCreate {
+	_c.mutation.SetEndOffset(v)
+	return _c
+}
+
+// SetNillableEndOffset sets the "end_offset" field if the given value is not nil.
+func (_c *ChunkCreate) SetNillableEndOffset(v *int) *ChunkCreate {
+	if v != nil {
+		_c.SetEndOffset(*v)
+	}
+	return _c
+}
+
+// SetStartLine sets the "start_line" field.
+func (_c *ChunkCreate) SetStartLine(v int) *ChunkCreate {
+	_c.mutation.SetStartLine(v)
+	return _c
+}
+
+// SetNillableStartLine sets the "start_line" field if the given value is not nil.
+func (_c *ChunkCreate) SetNillableStartLine(v *int) *ChunkCreate {
+	if v != nil {
+		_c.SetStartLine(*v)
+	}
+	return _c
+}
+
+// SetEndLine sets the "end_line" field.
+func (_c *ChunkCreate) SetEndLine(v int) *ChunkCreate {
+	_c.mutation.SetEndLine(v)
+	return _c
+}
+
+// SetNillableEndLine sets the "end_line" field if the given value is not nil.
+func (_c *ChunkCreate) SetNillableEndLine(v *int) *ChunkCreate {
+	if v != nil {
+		_c.SetEndLine(*v)
+	}
+	return _c
+}
+
 // SetDocumentID sets the "document" edge to the Document entity by ID.
 func (_c *ChunkCreate) SetDocumentID(id int) *ChunkCreate {
 	_c.mutation.SetDocumentID(id)
@@ -159,6 +221,26 @@ func (_c *ChunkCreate) createSpec() (*Chunk, *sqlgraph.CreateSpec) {
 		_spec.SetField(chunk.FieldContentHash, field.TypeString, value)
 		_node.ContentHash = value
 	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(chunk.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.StartOffset(); ok {
+		_spec.SetField(chunk.FieldStartOffset, field.TypeInt, value)
+		_node.StartOffset = value
+	}
+	if value, ok := _c.mutation.EndOffset(); ok {
+		_spec.SetField(chunk.FieldEndOffset, field.TypeInt, value)
+		_node.EndOffset = value
+	}
+	if value, ok := _c.mutation.StartLine(); ok {
+		_spec.SetField(chunk.FieldStartLine, field.TypeInt, value)
+		_node.StartLine = value
+	}
+	if value, ok := _c.mutation.EndLine(); ok {
+		_spec.SetField(chunk.FieldEndLine, field.TypeInt, value)
+		_node.EndLine = value
+	}
 	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
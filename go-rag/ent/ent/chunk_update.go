@@ -84,6 +84,126 @@ func (_u *ChunkUpdate) ClearContentHash() *ChunkUpdate {
 	return _u
 }
 
+// SetMetadata sets the "metadata" field.
+func (_u *ChunkUpdate) SetMetadata(v map[string]interface{}) *ChunkUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *ChunkUpdate) ClearMetadata() *ChunkUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (_u *ChunkUpdate) SetStartOffset(v int) *ChunkUpdate {
+	_u.mutation.ResetStartOffset()
+	_u.mutation.SetStartOffset(v)
+	return _u
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (_u *ChunkUpdate) SetNillableStartOffset(v *int) *ChunkUpdate {
+	if v != nil {
+		_u.SetStartOffset(*v)
+	}
+	return _u
+}
+
+// AddStartOffset adds value to the "start_offset" field.
+func (_u *ChunkUpdate) AddStartOffset(v int) *ChunkUpdate {
+	_u.mutation.AddStartOffset(v)
+	return _u
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (_u *ChunkUpdate) ClearStartOffset() *ChunkUpdate {
+	_u.mutation.ClearStartOffset()
+	return _u
+}
+
+// SetEndOffset sets the "end_offset" field.
+func (_u *ChunkUpdate) SetEndOffset(v int) *ChunkUpdate {
+	_u.mutation.ResetEndOffset()
+	_u.mutation.SetEndOffset(v)
+	return _u
+}
+
+// SetNillableEndOffset sets the "end_offset" field if the given value is not nil.
+func (_u *ChunkUpdate) SetNillableEndOffset(v *int) *ChunkUpdate {
+	if v != nil {
+		_u.SetEndOffset(*v)
+	}
+	return _u
+}
+
+// AddEndOffset adds value to the "end_offset" field.
+func (_u *ChunkUpdate) AddEndOffset(v int) *ChunkUpdate {
+	_u.mutation.AddEndOffset(v)
+	return _u
+}
+
+// ClearEndOffset clears the value of the "end_offset" field.
+func (_u *ChunkUpdate) ClearEndOffset() *ChunkUpdate {
+	_u.mutation.ClearEndOffset()
+	return _u
+}
+
+// SetStartLine sets the "start_line" field.
+func (_u *ChunkUpdate) SetStartLine(v int) *ChunkUpdate {
+	_u.mutation.ResetStartLine()
+	_u.mutation.SetStartLine(v)
+	return _u
+}
+
+// SetNillableStartLine sets the "start_line" field if the given value is not nil.
+func (_u *ChunkUpdate) SetNillableStartLine(v *int) *ChunkUpdate {
+	if v != nil {
+		_u.SetStartLine(*v)
+	}
+	return _u
+}
+
+// AddStartLine adds value to the "start_line" field.
+func (_u *ChunkUpdate) AddStartLine(v int) *ChunkUpdate {
+	_u.mutation.AddStartLine(v)
+	return _u
+}
+
+// ClearStartLine clears the value of the "start_line" field.
+func (_u *ChunkUpdate) ClearStartLine() *ChunkUpdate {
+	_u.mutation.ClearStartLine()
+	return _u
+}
+
+// SetEndLine sets the "end_line" field.
+func (_u *ChunkUpdate) SetEndLine(v int) *ChunkUpdate {
+	_u.mutation.ResetEndLine()
+	_u.mutation.SetEndLine(v)
+	return _u
+}
+
+// SetNillableEndLine sets the "end_line" field if the given value is not nil.
+func (_u *ChunkUpdate) SetNillableEndLine(v *int) *ChunkUpdate {
+	if v != nil {
+		_u.SetEndLine(*v)
+	}
+	return _u
+}
+
+// AddEndLine adds value to the "end_line" field.
+func (_u *ChunkUpdate) AddEndLine(v int) *ChunkUpdate {
+	_u.mutation.AddEndLine(v)
+	return _u
+}
+
+// ClearEndLine clears the value of the "end_line" field.
+func (_u *ChunkUpdate) ClearEndLine() *ChunkUpdate {
+	_u.mutation.ClearEndLine()
+	return _u
+}
+
 // SetDocumentID sets the "document" edge to the Document entity by ID.
 func (_u *ChunkUpdate) SetDocumentID(id int) *ChunkUpdate {
 	_u.mutation.SetDocumentID(id)
@@ -201,6 +321,48 @@ func (_u *ChunkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(chunk.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(chunk.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(chunk.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartOffset(); ok {
+		_spec.SetField(chunk.FieldStartOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartOffset(); ok {
+		_spec.AddField(chunk.FieldStartOffset, field.TypeInt, value)
+	}
+	if _u.mutation.StartOffsetCleared() {
+		_spec.ClearField(chunk.FieldStartOffset, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndOffset(); ok {
+		_spec.SetField(chunk.FieldEndOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndOffset(); ok {
+		_spec.AddField(chunk.FieldEndOffset, field.TypeInt, value)
+	}
+	if _u.mutation.EndOffsetCleared() {
+		_spec.ClearField(chunk.FieldEndOffset, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartLine(); ok {
+		_spec.SetField(chunk.FieldStartLine, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartLine(); ok {
+		_spec.AddField(chunk.FieldStartLine, field.TypeInt, value)
+	}
+	if _u.mutation.StartLineCleared() {
+		_spec.ClearField(chunk.FieldStartLine, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndLine(); ok {
+		_spec.SetField(chunk.FieldEndLine, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndLine(); ok {
+		_spec.AddField(chunk.FieldEndLine, field.TypeInt, value)
+	}
+	if _u.mutation.EndLineCleared() {
+		_spec.ClearField(chunk.FieldEndLine, field.TypeInt)
+	}
 	if _u.mutation.DocumentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -350,6 +512,126 @@ func (_u *ChunkUpdateOne) ClearContentHash() *ChunkUpdateOne {
 	return _u
 }
 
+// SetMetadata sets the "metadata" field.
+func (_u *ChunkUpdateOne) SetMetadata(v map[string]interface{}) *ChunkUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *ChunkUpdateOne) ClearMetadata() *ChunkUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (_u *ChunkUpdateOne) SetStartOffset(v int) *ChunkUpdateOne {
+	_u.mutation.ResetStartOffset()
+	_u.mutation.SetStartOffset(v)
+	return _u
+}
+
+// SetNillableStartOffset sets the "start_offset" field if the given value is not nil.
+func (_u *ChunkUpdateOne) SetNillableStartOffset(v *int) *ChunkUpdateOne {
+	if v != nil {
+		_u.SetStartOffset(*v)
+	}
+	return _u
+}
+
+// AddStartOffset adds value to the "start_offset" field.
+func (_u *ChunkUpdateOne) AddStartOffset(v int) *ChunkUpdateOne {
+	_u.mutation.AddStartOffset(v)
+	return _u
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (_u *ChunkUpdateOne) ClearStartOffset() *ChunkUpdateOne {
+	_u.mutation.ClearStartOffset()
+	return _u
+}
+
+// SetEndOffset sets the "end_offset" field.
+func (_u *ChunkUpdateOne) SetEndOffset(v int) *ChunkUpdateOne {
+	_u.mutation.ResetEndOffset()
+	_u.mutation.SetEndOffset(v)
+	return _u
+}
+
+// SetNillableEndOffset sets the "end_offset" field if the given value is not nil.
+func (_u *ChunkUpdateOne) SetNillableEndOffset(v *int) *ChunkUpdateOne {
+	if v != nil {
+		_u.SetEndOffset(*v)
+	}
+	return _u
+}
+
+// AddEndOffset adds value to the "end_offset" field.
+func (_u *ChunkUpdateOne) AddEndOffset(v int) *ChunkUpdateOne {
+	_u.mutation.AddEndOffset(v)
+	return _u
+}
+
+// ClearEndOffset clears the value of the "end_offset" field.
+func (_u *ChunkUpdateOne) ClearEndOffset() *ChunkUpdateOne {
+	_u.mutation.ClearEndOffset()
+	return _u
+}
+
+// SetStartLine sets the "start_line" field.
+func (_u *ChunkUpdateOne) SetStartLine(v int) *ChunkUpdateOne {
+	_u.mutation.ResetStartLine()
+	_u.mutation.SetStartLine(v)
+	return _u
+}
+
+// SetNillableStartLine sets the "start_line" field if the given value is not nil.
+func (_u *ChunkUpdateOne) SetNillableStartLine(v *int) *ChunkUpdateOne {
+	if v != nil {
+		_u.SetStartLine(*v)
+	}
+	return _u
+}
+
+// AddStartLine adds value to the "start_line" field.
+func (_u *ChunkUpdateOne) AddStartLine(v int) *ChunkUpdateOne {
+	_u.mutation.AddStartLine(v)
+	return _u
+}
+
+// ClearStartLine clears the value of the "start_line" field.
+func (_u *ChunkUpdateOne) ClearStartLine() *ChunkUpdateOne {
+	_u.mutation.ClearStartLine()
+	return _u
+}
+
+// SetEndLine sets the "end_line" field.
+func (_u *ChunkUpdateOne) SetEndLine(v int) *ChunkUpdateOne {
+	_u.mutation.ResetEndLine()
+	_u.mutation.SetEndLine(v)
+	return _u
+}
+
+// SetNillableEndLine sets the "end_line" field if the given value is not nil.
+func (_u *ChunkUpdateOne) SetNillableEndLine(v *int) *ChunkUpdateOne {
+	if v != nil {
+		_u.SetEndLine(*v)
+	}
+	return _u
+}
+
+// AddEndLine adds value to the "end_line" field.
+func (_u *ChunkUpdateOne) AddEndLine(v int) *ChunkUpdateOne {
+	_u.mutation.AddEndLine(v)
+	return _u
+}
+
+// ClearEndLine clears the value of the "end_line" field.
+func (_u *ChunkUpdateOne) ClearEndLine() *ChunkUpdateOne {
+	_u.mutation.ClearEndLine()
+	return _u
+}
+
 // SetDocumentID sets the "document" edge to the Document entity by ID.
 func (_u *ChunkUpdateOne) SetDocumentID(id int) *ChunkUpdateOne {
 	_u.mutation.SetDocumentID(id)
@@ -497,6 +779,48 @@ func (_u *ChunkUpdateOne) sqlSave(ctx context.Context) (_node *Chunk, err error)
 	if _u.mutation.ContentHashCleared() {
 		_spec.ClearField(chunk.FieldContentHash, field.TypeString)
 	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(chunk.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(chunk.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartOffset(); ok {
+		_spec.SetField(chunk.FieldStartOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartOffset(); ok {
+		_spec.AddField(chunk.FieldStartOffset, field.TypeInt, value)
+	}
+	if _u.mutation.StartOffsetCleared() {
+		_spec.ClearField(chunk.FieldStartOffset, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndOffset(); ok {
+		_spec.SetField(chunk.FieldEndOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndOffset(); ok {
+		_spec.AddField(chunk.FieldEndOffset, field.TypeInt, value)
+	}
+	if _u.mutation.EndOffsetCleared() {
+		_spec.ClearField(chunk.FieldEndOffset, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartLine(); ok {
+		_spec.SetField(chunk.FieldStartLine, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartLine(); ok {
+		_spec.AddField(chunk.FieldStartLine, field.TypeInt, value)
+	}
+	if _u.mutation.StartLineCleared() {
+		_spec.ClearField(chunk.FieldStartLine, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndLine(); ok {
+		_spec.SetField(chunk.FieldEndLine, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndLine(); ok {
+		_spec.AddField(chunk.FieldEndLine, field.TypeInt, value)
+	}
+	if _u.mutation.EndLineCleared() {
+		_spec.ClearField(chunk.FieldEndLine, field.TypeInt)
+	}
 	if _u.mutation.DocumentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"go-rag/ent/ent/document"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"strings"
 	"time"
 
@@ -20,30 +21,63 @@ type Document struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Source holds the value of the "source" field.
+	Source []byte `json:"-"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
 	// ContentHash holds the value of the "content_hash" field.
 	ContentHash string `json:"content_hash,omitempty"`
 	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
+	Status document.Status `json:"status,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// QueuedAt holds the value of the "queued_at" field.
+	QueuedAt time.Time `json:"queued_at,omitempty"`
+	// ChunkingAt holds the value of the "chunking_at" field.
+	ChunkingAt *time.Time `json:"chunking_at,omitempty"`
+	// EmbeddingAt holds the value of the "embedding_at" field.
+	EmbeddingAt *time.Time `json:"embedding_at,omitempty"`
+	// IndexingAt holds the value of the "indexing_at" field.
+	IndexingAt *time.Time `json:"indexing_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// FailedAt holds the value of the "failed_at" field.
+	FailedAt *time.Time `json:"failed_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CommitSha holds the value of the "commit_sha" field.
+	CommitSha string `json:"commit_sha,omitempty"`
+	// BlobSha holds the value of the "blob_sha" field.
+	BlobSha string `json:"blob_sha,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DocumentQuery when eager-loading is set.
-	Edges             DocumentEdges `json:"edges"`
-	project_documents *int
-	selectValues      sql.SelectValues
+	Edges                    DocumentEdges `json:"edges"`
+	project_documents        *int
+	project_source_documents *int
+	selectValues             sql.SelectValues
 }
 
 // DocumentEdges holds the relations/edges for other nodes in the graph.
 type DocumentEdges struct {
 	// Project holds the value of the project edge.
 	Project *Project `json:"project,omitempty"`
+	// ProjectSource holds the value of the project_source edge.
+	ProjectSource *ProjectSource `json:"project_source,omitempty"`
 	// Chunks holds the value of the chunks edge.
 	Chunks []*Chunk `json:"chunks,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*IngestJob `json:"jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -57,28 +91,52 @@ func (e DocumentEdges) ProjectOrErr() (*Project, error) {
 	return nil, &NotLoadedError{edge: "project"}
 }
 
+// ProjectSourceOrErr returns the ProjectSource value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentEdges) ProjectSourceOrErr() (*ProjectSource, error) {
+	if e.ProjectSource != nil {
+		return e.ProjectSource, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: projectsource.Label}
+	}
+	return nil, &NotLoadedError{edge: "project_source"}
+}
+
 // ChunksOrErr returns the Chunks value or an error if the edge
 // was not loaded in eager-loading.
 func (e DocumentEdges) ChunksOrErr() ([]*Chunk, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Chunks, nil
 	}
 	return nil, &NotLoadedError{edge: "chunks"}
 }
 
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e DocumentEdges) JobsOrErr() ([]*IngestJob, error) {
+	if e.loadedTypes[3] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Document) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case document.FieldID:
+		case document.FieldSource:
+			values[i] = new([]byte)
+		case document.FieldID, document.FieldAttempts:
 			values[i] = new(sql.NullInt64)
-		case document.FieldName, document.FieldContent, document.FieldContentHash, document.FieldStatus:
+		case document.FieldName, document.FieldPath, document.FieldContent, document.FieldMimeType, document.FieldContentHash, document.FieldStatus, document.FieldLastError, document.FieldCommitSha, document.FieldBlobSha:
 			values[i] = new(sql.NullString)
-		case document.FieldCreatedAt:
+		case document.FieldQueuedAt, document.FieldChunkingAt, document.FieldEmbeddingAt, document.FieldIndexingAt, document.FieldCompletedAt, document.FieldFailedAt, document.FieldCancelledAt, document.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case document.ForeignKeys[0]: // project_documents
 			values[i] = new(sql.NullInt64)
+		case document.ForeignKeys[1]: // project_source_documents
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -106,12 +164,30 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case document.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
 		case document.FieldContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case document.FieldSource:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value != nil {
+				_m.Source = *value
+			}
+		case document.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = value.String
+			}
 		case document.FieldContentHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
@@ -122,7 +198,79 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
-				_m.Status = value.String
+				_m.Status = document.Status(value.String)
+			}
+		case document.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case document.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case document.FieldQueuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field queued_at", values[i])
+			} else if value.Valid {
+				_m.QueuedAt = value.Time
+			}
+		case document.FieldChunkingAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field chunking_at", values[i])
+			} else if value.Valid {
+				_m.ChunkingAt = new(time.Time)
+				*_m.ChunkingAt = value.Time
+			}
+		case document.FieldEmbeddingAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_at", values[i])
+			} else if value.Valid {
+				_m.EmbeddingAt = new(time.Time)
+				*_m.EmbeddingAt = value.Time
+			}
+		case document.FieldIndexingAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field indexing_at", values[i])
+			} else if value.Valid {
+				_m.IndexingAt = new(time.Time)
+				*_m.IndexingAt = value.Time
+			}
+		case document.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case document.FieldFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_at", values[i])
+			} else if value.Valid {
+				_m.FailedAt = new(time.Time)
+				*_m.FailedAt = value.Time
+			}
+		case document.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case document.FieldCommitSha:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_sha", values[i])
+			} else if value.Valid {
+				_m.CommitSha = value.String
+			}
+		case document.FieldBlobSha:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_sha", values[i])
+			} else if value.Valid {
+				_m.BlobSha = value.String
 			}
 		case document.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -137,6 +285,13 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 				_m.project_documents = new(int)
 				*_m.project_documents = int(value.Int64)
 			}
+		case document.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_source_documents", value)
+			} else if value.Valid {
+				_m.project_source_documents = new(int)
+				*_m.project_source_documents = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -155,11 +310,21 @@ func (_m *Document) QueryProject() *ProjectQuery {
 	return NewDocumentClient(_m.config).QueryProject(_m)
 }
 
+// QueryProjectSource queries the "project_source" edge of the Document entity.
+func (_m *Document) QueryProjectSource() *ProjectSourceQuery {
+	return NewDocumentClient(_m.config).QueryProjectSource(_m)
+}
+
 // QueryChunks queries the "chunks" edge of the Document entity.
 func (_m *Document) QueryChunks() *ChunkQuery {
 	return NewDocumentClient(_m.config).QueryChunks(_m)
 }
 
+// QueryJobs queries the "jobs" edge of the Document entity.
+func (_m *Document) QueryJobs() *IngestJobQuery {
+	return NewDocumentClient(_m.config).QueryJobs(_m)
+}
+
 // Update returns a builder for updating this Document.
 // Note that you need to call Document.Unwrap() before calling this method if this Document
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,14 +351,67 @@ func (_m *Document) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("source=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(_m.MimeType)
+	builder.WriteString(", ")
 	builder.WriteString("content_hash=")
 	builder.WriteString(_m.ContentHash)
 	builder.WriteString(", ")
 	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("queued_at=")
+	builder.WriteString(_m.QueuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ChunkingAt; v != nil {
+		builder.WriteString("chunking_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EmbeddingAt; v != nil {
+		builder.WriteString("embedding_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.IndexingAt; v != nil {
+		builder.WriteString("indexing_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FailedAt; v != nil {
+		builder.WriteString("failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("commit_sha=")
+	builder.WriteString(_m.CommitSha)
+	builder.WriteString(", ")
+	builder.WriteString("blob_sha=")
+	builder.WriteString(_m.BlobSha)
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
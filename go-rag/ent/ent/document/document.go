@@ -3,6 +3,7 @@
 package document
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -16,18 +17,50 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
 	// FieldContentHash holds the string denoting the content_hash field in the database.
 	FieldContentHash = "content_hash"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldQueuedAt holds the string denoting the queued_at field in the database.
+	FieldQueuedAt = "queued_at"
+	// FieldChunkingAt holds the string denoting the chunking_at field in the database.
+	FieldChunkingAt = "chunking_at"
+	// FieldEmbeddingAt holds the string denoting the embedding_at field in the database.
+	FieldEmbeddingAt = "embedding_at"
+	// FieldIndexingAt holds the string denoting the indexing_at field in the database.
+	FieldIndexingAt = "indexing_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldFailedAt holds the string denoting the failed_at field in the database.
+	FieldFailedAt = "failed_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCommitSha holds the string denoting the commit_sha field in the database.
+	FieldCommitSha = "commit_sha"
+	// FieldBlobSha holds the string denoting the blob_sha field in the database.
+	FieldBlobSha = "blob_sha"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
+	// EdgeProjectSource holds the string denoting the project_source edge name in mutations.
+	EdgeProjectSource = "project_source"
 	// EdgeChunks holds the string denoting the chunks edge name in mutations.
 	EdgeChunks = "chunks"
+	// EdgeJobs holds the string denoting the jobs edge name in mutations.
+	EdgeJobs = "jobs"
 	// Table holds the table name of the document in the database.
 	Table = "documents"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -37,6 +70,13 @@ const (
 	ProjectInverseTable = "projects"
 	// ProjectColumn is the table column denoting the project relation/edge.
 	ProjectColumn = "project_documents"
+	// ProjectSourceTable is the table that holds the project_source relation/edge.
+	ProjectSourceTable = "documents"
+	// ProjectSourceInverseTable is the table name for the ProjectSource entity.
+	// It exists in this package in order to avoid circular dependency with the "projectsource" package.
+	ProjectSourceInverseTable = "project_sources"
+	// ProjectSourceColumn is the table column denoting the project_source relation/edge.
+	ProjectSourceColumn = "project_source_documents"
 	// ChunksTable is the table that holds the chunks relation/edge.
 	ChunksTable = "chunks"
 	// ChunksInverseTable is the table name for the Chunk entity.
@@ -44,15 +84,36 @@ const (
 	ChunksInverseTable = "chunks"
 	// ChunksColumn is the table column denoting the chunks relation/edge.
 	ChunksColumn = "document_chunks"
+	// JobsTable is the table that holds the jobs relation/edge.
+	JobsTable = "ingest_jobs"
+	// JobsInverseTable is the table name for the IngestJob entity.
+	// It exists in this package in order to avoid circular dependency with the "ingestjob" package.
+	JobsInverseTable = "ingest_jobs"
+	// JobsColumn is the table column denoting the jobs relation/edge.
+	JobsColumn = "document_jobs"
 )
 
 // Columns holds all SQL columns for document fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldPath,
 	FieldContent,
+	FieldSource,
+	FieldMimeType,
 	FieldContentHash,
 	FieldStatus,
+	FieldLastError,
+	FieldAttempts,
+	FieldQueuedAt,
+	FieldChunkingAt,
+	FieldEmbeddingAt,
+	FieldIndexingAt,
+	FieldCompletedAt,
+	FieldFailedAt,
+	FieldCancelledAt,
+	FieldCommitSha,
+	FieldBlobSha,
 	FieldCreatedAt,
 }
 
@@ -60,6 +121,7 @@ var Columns = []string{
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"project_documents",
+	"project_source_documents",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,12 +140,47 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// DefaultStatus holds the default value on creation for the "status" field.
-	DefaultStatus string
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultQueuedAt holds the default value on creation for the "queued_at" field.
+	DefaultQueuedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusQueued is the default value of the Status enum.
+const DefaultStatus = StatusQueued
+
+// Status values.
+const (
+	StatusQueued    Status = "queued"
+	StatusChunking  Status = "chunking"
+	StatusEmbedding Status = "embedding"
+	StatusIndexing  Status = "indexing"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusQueued, StatusChunking, StatusEmbedding, StatusIndexing, StatusCompleted, StatusFailed, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("document: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Document queries.
 type OrderOption func(*sql.Selector)
 
@@ -97,11 +194,21 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
 // ByContent orders the results by the content field.
 func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
 // ByContentHash orders the results by the content_hash field.
 func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
@@ -112,6 +219,61 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByQueuedAt orders the results by the queued_at field.
+func ByQueuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueuedAt, opts...).ToFunc()
+}
+
+// ByChunkingAt orders the results by the chunking_at field.
+func ByChunkingAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkingAt, opts...).ToFunc()
+}
+
+// ByEmbeddingAt orders the results by the embedding_at field.
+func ByEmbeddingAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingAt, opts...).ToFunc()
+}
+
+// ByIndexingAt orders the results by the indexing_at field.
+func ByIndexingAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIndexingAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByFailedAt orders the results by the failed_at field.
+func ByFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCommitSha orders the results by the commit_sha field.
+func ByCommitSha(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitSha, opts...).ToFunc()
+}
+
+// ByBlobSha orders the results by the blob_sha field.
+func ByBlobSha(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobSha, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -124,6 +286,13 @@ func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByProjectSourceField orders the results by project_source field.
+func ByProjectSourceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectSourceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByChunksCount orders the results by chunks count.
 func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -137,6 +306,20 @@ func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByJobsCount orders the results by jobs count.
+func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJobsStep(), opts...)
+	}
+}
+
+// ByJobs orders the results by jobs terms.
+func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -144,6 +327,13 @@ func newProjectStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
 	)
 }
+func newProjectSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectSourceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectSourceTable, ProjectSourceColumn),
+	)
+}
 func newChunksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -151,3 +341,10 @@ func newChunksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
 	)
 }
+func newJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+	)
+}
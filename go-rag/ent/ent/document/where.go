@@ -60,19 +60,84 @@ func Name(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldName, v))
 }
 
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldPath, v))
+}
+
 // Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
 func Content(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldContent, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSource, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldMimeType, v))
+}
+
 // ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
 func ContentHash(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldContentHash, v))
 }
 
-// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v string) predicate.Document {
-	return predicate.Document(sql.FieldEQ(FieldStatus, v))
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldLastError, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAttempts, v))
+}
+
+// QueuedAt applies equality check predicate on the "queued_at" field. It's identical to QueuedAtEQ.
+func QueuedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// ChunkingAt applies equality check predicate on the "chunking_at" field. It's identical to ChunkingAtEQ.
+func ChunkingAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldChunkingAt, v))
+}
+
+// EmbeddingAt applies equality check predicate on the "embedding_at" field. It's identical to EmbeddingAtEQ.
+func EmbeddingAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldEmbeddingAt, v))
+}
+
+// IndexingAt applies equality check predicate on the "indexing_at" field. It's identical to IndexingAtEQ.
+func IndexingAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldIndexingAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// FailedAt applies equality check predicate on the "failed_at" field. It's identical to FailedAtEQ.
+func FailedAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CommitSha applies equality check predicate on the "commit_sha" field. It's identical to CommitShaEQ.
+func CommitSha(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// BlobSha applies equality check predicate on the "blob_sha" field. It's identical to BlobShaEQ.
+func BlobSha(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobSha, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -145,6 +210,71 @@ func NameContainsFold(v string) predicate.Document {
 	return predicate.Document(sql.FieldContainsFold(FieldName, v))
 }
 
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldPath, v))
+}
+
 // ContentEQ applies the EQ predicate on the "content" field.
 func ContentEQ(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldContent, v))
@@ -210,6 +340,131 @@ func ContentContainsFold(v string) predicate.Document {
 	return predicate.Document(sql.FieldContainsFold(FieldContent, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...[]byte) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...[]byte) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v []byte) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldSource))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldMimeType, v))
+}
+
 // ContentHashEQ applies the EQ predicate on the "content_hash" field.
 func ContentHashEQ(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldContentHash, v))
@@ -286,68 +541,628 @@ func ContentHashContainsFold(v string) predicate.Document {
 }
 
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v string) predicate.Document {
+func StatusEQ(v Status) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v string) predicate.Document {
+func StatusNEQ(v Status) predicate.Document {
 	return predicate.Document(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...string) predicate.Document {
+func StatusIn(vs ...Status) predicate.Document {
 	return predicate.Document(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...string) predicate.Document {
+func StatusNotIn(vs ...Status) predicate.Document {
 	return predicate.Document(sql.FieldNotIn(FieldStatus, vs...))
 }
 
-// StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v string) predicate.Document {
-	return predicate.Document(sql.FieldGT(FieldStatus, v))
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldAttempts, v))
+}
+
+// QueuedAtEQ applies the EQ predicate on the "queued_at" field.
+func QueuedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtNEQ applies the NEQ predicate on the "queued_at" field.
+func QueuedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtIn applies the In predicate on the "queued_at" field.
+func QueuedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtNotIn applies the NotIn predicate on the "queued_at" field.
+func QueuedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtGT applies the GT predicate on the "queued_at" field.
+func QueuedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldQueuedAt, v))
+}
+
+// QueuedAtGTE applies the GTE predicate on the "queued_at" field.
+func QueuedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldQueuedAt, v))
+}
+
+// QueuedAtLT applies the LT predicate on the "queued_at" field.
+func QueuedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldQueuedAt, v))
+}
+
+// QueuedAtLTE applies the LTE predicate on the "queued_at" field.
+func QueuedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldQueuedAt, v))
+}
+
+// ChunkingAtEQ applies the EQ predicate on the "chunking_at" field.
+func ChunkingAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldChunkingAt, v))
+}
+
+// ChunkingAtNEQ applies the NEQ predicate on the "chunking_at" field.
+func ChunkingAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldChunkingAt, v))
+}
+
+// ChunkingAtIn applies the In predicate on the "chunking_at" field.
+func ChunkingAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldChunkingAt, vs...))
+}
+
+// ChunkingAtNotIn applies the NotIn predicate on the "chunking_at" field.
+func ChunkingAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldChunkingAt, vs...))
+}
+
+// ChunkingAtGT applies the GT predicate on the "chunking_at" field.
+func ChunkingAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldChunkingAt, v))
+}
+
+// ChunkingAtGTE applies the GTE predicate on the "chunking_at" field.
+func ChunkingAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldChunkingAt, v))
+}
+
+// ChunkingAtLT applies the LT predicate on the "chunking_at" field.
+func ChunkingAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldChunkingAt, v))
+}
+
+// ChunkingAtLTE applies the LTE predicate on the "chunking_at" field.
+func ChunkingAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldChunkingAt, v))
+}
+
+// ChunkingAtIsNil applies the IsNil predicate on the "chunking_at" field.
+func ChunkingAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldChunkingAt))
 }
 
-// StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v string) predicate.Document {
-	return predicate.Document(sql.FieldGTE(FieldStatus, v))
+// ChunkingAtNotNil applies the NotNil predicate on the "chunking_at" field.
+func ChunkingAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldChunkingAt))
 }
 
-// StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v string) predicate.Document {
-	return predicate.Document(sql.FieldLT(FieldStatus, v))
+// EmbeddingAtEQ applies the EQ predicate on the "embedding_at" field.
+func EmbeddingAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldEmbeddingAt, v))
 }
 
-// StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v string) predicate.Document {
-	return predicate.Document(sql.FieldLTE(FieldStatus, v))
+// EmbeddingAtNEQ applies the NEQ predicate on the "embedding_at" field.
+func EmbeddingAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldEmbeddingAt, v))
 }
 
-// StatusContains applies the Contains predicate on the "status" field.
-func StatusContains(v string) predicate.Document {
-	return predicate.Document(sql.FieldContains(FieldStatus, v))
+// EmbeddingAtIn applies the In predicate on the "embedding_at" field.
+func EmbeddingAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldEmbeddingAt, vs...))
 }
 
-// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
-func StatusHasPrefix(v string) predicate.Document {
-	return predicate.Document(sql.FieldHasPrefix(FieldStatus, v))
+// EmbeddingAtNotIn applies the NotIn predicate on the "embedding_at" field.
+func EmbeddingAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldEmbeddingAt, vs...))
 }
 
-// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
-func StatusHasSuffix(v string) predicate.Document {
-	return predicate.Document(sql.FieldHasSuffix(FieldStatus, v))
+// EmbeddingAtGT applies the GT predicate on the "embedding_at" field.
+func EmbeddingAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldEmbeddingAt, v))
 }
 
-// StatusEqualFold applies the EqualFold predicate on the "status" field.
-func StatusEqualFold(v string) predicate.Document {
-	return predicate.Document(sql.FieldEqualFold(FieldStatus, v))
+// EmbeddingAtGTE applies the GTE predicate on the "embedding_at" field.
+func EmbeddingAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldEmbeddingAt, v))
 }
 
-// StatusContainsFold applies the ContainsFold predicate on the "status" field.
-func StatusContainsFold(v string) predicate.Document {
-	return predicate.Document(sql.FieldContainsFold(FieldStatus, v))
+// EmbeddingAtLT applies the LT predicate on the "embedding_at" field.
+func EmbeddingAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldEmbeddingAt, v))
+}
+
+// EmbeddingAtLTE applies the LTE predicate on the "embedding_at" field.
+func EmbeddingAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldEmbeddingAt, v))
+}
+
+// EmbeddingAtIsNil applies the IsNil predicate on the "embedding_at" field.
+func EmbeddingAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldEmbeddingAt))
+}
+
+// EmbeddingAtNotNil applies the NotNil predicate on the "embedding_at" field.
+func EmbeddingAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldEmbeddingAt))
+}
+
+// IndexingAtEQ applies the EQ predicate on the "indexing_at" field.
+func IndexingAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldIndexingAt, v))
+}
+
+// IndexingAtNEQ applies the NEQ predicate on the "indexing_at" field.
+func IndexingAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldIndexingAt, v))
+}
+
+// IndexingAtIn applies the In predicate on the "indexing_at" field.
+func IndexingAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldIndexingAt, vs...))
+}
+
+// IndexingAtNotIn applies the NotIn predicate on the "indexing_at" field.
+func IndexingAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldIndexingAt, vs...))
+}
+
+// IndexingAtGT applies the GT predicate on the "indexing_at" field.
+func IndexingAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldIndexingAt, v))
+}
+
+// IndexingAtGTE applies the GTE predicate on the "indexing_at" field.
+func IndexingAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldIndexingAt, v))
+}
+
+// IndexingAtLT applies the LT predicate on the "indexing_at" field.
+func IndexingAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldIndexingAt, v))
+}
+
+// IndexingAtLTE applies the LTE predicate on the "indexing_at" field.
+func IndexingAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldIndexingAt, v))
+}
+
+// IndexingAtIsNil applies the IsNil predicate on the "indexing_at" field.
+func IndexingAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldIndexingAt))
+}
+
+// IndexingAtNotNil applies the NotNil predicate on the "indexing_at" field.
+func IndexingAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldIndexingAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// FailedAtEQ applies the EQ predicate on the "failed_at" field.
+func FailedAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// FailedAtNEQ applies the NEQ predicate on the "failed_at" field.
+func FailedAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldFailedAt, v))
+}
+
+// FailedAtIn applies the In predicate on the "failed_at" field.
+func FailedAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldFailedAt, vs...))
+}
+
+// FailedAtNotIn applies the NotIn predicate on the "failed_at" field.
+func FailedAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldFailedAt, vs...))
+}
+
+// FailedAtGT applies the GT predicate on the "failed_at" field.
+func FailedAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldFailedAt, v))
+}
+
+// FailedAtGTE applies the GTE predicate on the "failed_at" field.
+func FailedAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldFailedAt, v))
+}
+
+// FailedAtLT applies the LT predicate on the "failed_at" field.
+func FailedAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldFailedAt, v))
+}
+
+// FailedAtLTE applies the LTE predicate on the "failed_at" field.
+func FailedAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldFailedAt, v))
+}
+
+// FailedAtIsNil applies the IsNil predicate on the "failed_at" field.
+func FailedAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldFailedAt))
+}
+
+// FailedAtNotNil applies the NotNil predicate on the "failed_at" field.
+func FailedAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldFailedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CommitShaEQ applies the EQ predicate on the "commit_sha" field.
+func CommitShaEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldCommitSha, v))
+}
+
+// CommitShaNEQ applies the NEQ predicate on the "commit_sha" field.
+func CommitShaNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldCommitSha, v))
+}
+
+// CommitShaIn applies the In predicate on the "commit_sha" field.
+func CommitShaIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldCommitSha, vs...))
+}
+
+// CommitShaNotIn applies the NotIn predicate on the "commit_sha" field.
+func CommitShaNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldCommitSha, vs...))
+}
+
+// CommitShaGT applies the GT predicate on the "commit_sha" field.
+func CommitShaGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldCommitSha, v))
+}
+
+// CommitShaGTE applies the GTE predicate on the "commit_sha" field.
+func CommitShaGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldCommitSha, v))
+}
+
+// CommitShaLT applies the LT predicate on the "commit_sha" field.
+func CommitShaLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldCommitSha, v))
+}
+
+// CommitShaLTE applies the LTE predicate on the "commit_sha" field.
+func CommitShaLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldCommitSha, v))
+}
+
+// CommitShaContains applies the Contains predicate on the "commit_sha" field.
+func CommitShaContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldCommitSha, v))
+}
+
+// CommitShaHasPrefix applies the HasPrefix predicate on the "commit_sha" field.
+func CommitShaHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldCommitSha, v))
+}
+
+// CommitShaHasSuffix applies the HasSuffix predicate on the "commit_sha" field.
+func CommitShaHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldCommitSha, v))
+}
+
+// CommitShaIsNil applies the IsNil predicate on the "commit_sha" field.
+func CommitShaIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldCommitSha))
+}
+
+// CommitShaNotNil applies the NotNil predicate on the "commit_sha" field.
+func CommitShaNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldCommitSha))
+}
+
+// CommitShaEqualFold applies the EqualFold predicate on the "commit_sha" field.
+func CommitShaEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldCommitSha, v))
+}
+
+// CommitShaContainsFold applies the ContainsFold predicate on the "commit_sha" field.
+func CommitShaContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldCommitSha, v))
+}
+
+// BlobShaEQ applies the EQ predicate on the "blob_sha" field.
+func BlobShaEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobSha, v))
+}
+
+// BlobShaNEQ applies the NEQ predicate on the "blob_sha" field.
+func BlobShaNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldBlobSha, v))
+}
+
+// BlobShaIn applies the In predicate on the "blob_sha" field.
+func BlobShaIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldBlobSha, vs...))
+}
+
+// BlobShaNotIn applies the NotIn predicate on the "blob_sha" field.
+func BlobShaNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldBlobSha, vs...))
+}
+
+// BlobShaGT applies the GT predicate on the "blob_sha" field.
+func BlobShaGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldBlobSha, v))
+}
+
+// BlobShaGTE applies the GTE predicate on the "blob_sha" field.
+func BlobShaGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldBlobSha, v))
+}
+
+// BlobShaLT applies the LT predicate on the "blob_sha" field.
+func BlobShaLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldBlobSha, v))
+}
+
+// BlobShaLTE applies the LTE predicate on the "blob_sha" field.
+func BlobShaLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldBlobSha, v))
+}
+
+// BlobShaContains applies the Contains predicate on the "blob_sha" field.
+func BlobShaContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldBlobSha, v))
+}
+
+// BlobShaHasPrefix applies the HasPrefix predicate on the "blob_sha" field.
+func BlobShaHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldBlobSha, v))
+}
+
+// BlobShaHasSuffix applies the HasSuffix predicate on the "blob_sha" field.
+func BlobShaHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldBlobSha, v))
+}
+
+// BlobShaIsNil applies the IsNil predicate on the "blob_sha" field.
+func BlobShaIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldBlobSha))
+}
+
+// BlobShaNotNil applies the NotNil predicate on the "blob_sha" field.
+func BlobShaNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldBlobSha))
+}
+
+// BlobShaEqualFold applies the EqualFold predicate on the "blob_sha" field.
+func BlobShaEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldBlobSha, v))
+}
+
+// BlobShaContainsFold applies the ContainsFold predicate on the "blob_sha" field.
+func BlobShaContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldBlobSha, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -413,6 +1228,29 @@ func HasProjectWith(preds ...predicate.Project) predicate.Document {
 	})
 }
 
+// HasProjectSource applies the HasEdge predicate on the "project_source" edge.
+func HasProjectSource() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectSourceTable, ProjectSourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectSourceWith applies the HasEdge predicate on the "project_source" edge with a given conditions (other predicates).
+func HasProjectSourceWith(preds ...predicate.ProjectSource) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newProjectSourceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasChunks applies the HasEdge predicate on the "chunks" edge.
 func HasChunks() predicate.Document {
 	return predicate.Document(func(s *sql.Selector) {
@@ -436,6 +1274,29 @@ func HasChunksWith(preds ...predicate.Chunk) predicate.Document {
 	})
 }
 
+// HasJobs applies the HasEdge predicate on the "jobs" edge.
+func HasJobs() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobsWith applies the HasEdge predicate on the "jobs" edge with a given conditions (other predicates).
+func HasJobsWith(preds ...predicate.IngestJob) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Document) predicate.Document {
 	return predicate.Document(sql.AndPredicates(predicates...))
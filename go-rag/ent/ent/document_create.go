@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"go-rag/ent/ent/chunk"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,12 +30,38 @@ func (_c *DocumentCreate) SetName(v string) *DocumentCreate {
 	return _c
 }
 
+// SetPath sets the "path" field.
+func (_c *DocumentCreate) SetPath(v string) *DocumentCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
 // SetContent sets the "content" field.
 func (_c *DocumentCreate) SetContent(v string) *DocumentCreate {
 	_c.mutation.SetContent(v)
 	return _c
 }
 
+// SetSource sets the "source" field.
+func (_c *DocumentCreate) SetSource(v []byte) *DocumentCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *DocumentCreate) SetMimeType(v string) *DocumentCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableMimeType(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
 // SetContentHash sets the "content_hash" field.
 func (_c *DocumentCreate) SetContentHash(v string) *DocumentCreate {
 	_c.mutation.SetContentHash(v)
@@ -49,19 +77,173 @@ func (_c *DocumentCreate) SetNillableContentHash(v *string) *DocumentCreate {
 }
 
 // SetStatus sets the "status" field.
-func (_c *DocumentCreate) SetStatus(v string) *DocumentCreate {
+func (_c *DocumentCreate) SetStatus(v document.Status) *DocumentCreate {
 	_c.mutation.SetStatus(v)
 	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *DocumentCreate) SetNillableStatus(v *string) *DocumentCreate {
+func (_c *DocumentCreate) SetNillableStatus(v *document.Status) *DocumentCreate {
 	if v != nil {
 		_c.SetStatus(*v)
 	}
 	return _c
 }
 
+// SetLastError sets the "last_error" field.
+func (_c *DocumentCreate) SetLastError(v string) *DocumentCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableLastError(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DocumentCreate) SetAttempts(v int) *DocumentCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableAttempts(v *int) *DocumentCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (_c *DocumentCreate) SetQueuedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetQueuedAt(v)
+	return _c
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableQueuedAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetQueuedAt(*v)
+	}
+	return _c
+}
+
+// SetChunkingAt sets the "chunking_at" field.
+func (_c *DocumentCreate) SetChunkingAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetChunkingAt(v)
+	return _c
+}
+
+// SetNillableChunkingAt sets the "chunking_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableChunkingAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetChunkingAt(*v)
+	}
+	return _c
+}
+
+// SetEmbeddingAt sets the "embedding_at" field.
+func (_c *DocumentCreate) SetEmbeddingAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetEmbeddingAt(v)
+	return _c
+}
+
+// SetNillableEmbeddingAt sets the "embedding_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableEmbeddingAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetEmbeddingAt(*v)
+	}
+	return _c
+}
+
+// SetIndexingAt sets the "indexing_at" field.
+func (_c *DocumentCreate) SetIndexingAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetIndexingAt(v)
+	return _c
+}
+
+// SetNillableIndexingAt sets the "indexing_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableIndexingAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetIndexingAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *DocumentCreate) SetCompletedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableCompletedAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_c *DocumentCreate) SetFailedAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetFailedAt(v)
+	return _c
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableFailedAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetFailedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *DocumentCreate) SetCancelledAt(v time.Time) *DocumentCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableCancelledAt(v *time.Time) *DocumentCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_c *DocumentCreate) SetCommitSha(v string) *DocumentCreate {
+	_c.mutation.SetCommitSha(v)
+	return _c
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableCommitSha(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetCommitSha(*v)
+	}
+	return _c
+}
+
+// SetBlobSha sets the "blob_sha" field.
+func (_c *DocumentCreate) SetBlobSha(v string) *DocumentCreate {
+	_c.mutation.SetBlobSha(v)
+	return _c
+}
+
+// SetNillableBlobSha sets the "blob_sha" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableBlobSha(v *string) *DocumentCreate {
+	if v != nil {
+		_c.SetBlobSha(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DocumentCreate) SetCreatedAt(v time.Time) *DocumentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -95,6 +277,25 @@ func (_c *DocumentCreate) SetProject(v *Project) *DocumentCreate {
 	return _c.SetProjectID(v.ID)
 }
 
+// SetProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID.
+func (_c *DocumentCreate) SetProjectSourceID(id int) *DocumentCreate {
+	_c.mutation.SetProjectSourceID(id)
+	return _c
+}
+
+// SetNillableProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID if the given value is not nil.
+func (_c *DocumentCreate) SetNillableProjectSourceID(id *int) *DocumentCreate {
+	if id != nil {
+		_c = _c.SetProjectSourceID(*id)
+	}
+	return _c
+}
+
+// SetProjectSource sets the "project_source" edge to the ProjectSource entity.
+func (_c *DocumentCreate) SetProjectSource(v *ProjectSource) *DocumentCreate {
+	return _c.SetProjectSourceID(v.ID)
+}
+
 // AddChunkIDs adds the "chunks" edge to the Chunk entity by IDs.
 func (_c *DocumentCreate) AddChunkIDs(ids ...int) *DocumentCreate {
 	_c.mutation.AddChunkIDs(ids...)
@@ -110,6 +311,21 @@ func (_c *DocumentCreate) AddChunks(v ...*Chunk) *DocumentCreate {
 	return _c.AddChunkIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the IngestJob entity by IDs.
+func (_c *DocumentCreate) AddJobIDs(ids ...int) *DocumentCreate {
+	_c.mutation.AddJobIDs(ids...)
+	return _c
+}
+
+// AddJobs adds the "jobs" edges to the IngestJob entity.
+func (_c *DocumentCreate) AddJobs(v ...*IngestJob) *DocumentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJobIDs(ids...)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_c *DocumentCreate) Mutation() *DocumentMutation {
 	return _c.mutation
@@ -149,6 +365,14 @@ func (_c *DocumentCreate) defaults() {
 		v := document.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := document.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.QueuedAt(); !ok {
+		v := document.DefaultQueuedAt()
+		_c.mutation.SetQueuedAt(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := document.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -160,12 +384,31 @@ func (_c *DocumentCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Document.name"`)}
 	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Document.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := document.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Document.path": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Document.content"`)}
 	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Document.status"`)}
 	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := document.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Document.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Document.attempts"`)}
+	}
+	if _, ok := _c.mutation.QueuedAt(); !ok {
+		return &ValidationError{Name: "queued_at", err: errors.New(`ent: missing required field "Document.queued_at"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Document.created_at"`)}
 	}
@@ -199,18 +442,74 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(document.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
 	if value, ok := _c.mutation.Content(); ok {
 		_spec.SetField(document.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeBytes, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
 	if value, ok := _c.mutation.ContentHash(); ok {
 		_spec.SetField(document.FieldContentHash, field.TypeString, value)
 		_node.ContentHash = value
 	}
 	if value, ok := _c.mutation.Status(); ok {
-		_spec.SetField(document.FieldStatus, field.TypeString, value)
+		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(document.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(document.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.QueuedAt(); ok {
+		_spec.SetField(document.FieldQueuedAt, field.TypeTime, value)
+		_node.QueuedAt = value
+	}
+	if value, ok := _c.mutation.ChunkingAt(); ok {
+		_spec.SetField(document.FieldChunkingAt, field.TypeTime, value)
+		_node.ChunkingAt = &value
+	}
+	if value, ok := _c.mutation.EmbeddingAt(); ok {
+		_spec.SetField(document.FieldEmbeddingAt, field.TypeTime, value)
+		_node.EmbeddingAt = &value
+	}
+	if value, ok := _c.mutation.IndexingAt(); ok {
+		_spec.SetField(document.FieldIndexingAt, field.TypeTime, value)
+		_node.IndexingAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(document.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.FailedAt(); ok {
+		_spec.SetField(document.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(document.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CommitSha(); ok {
+		_spec.SetField(document.FieldCommitSha, field.TypeString, value)
+		_node.CommitSha = value
+	}
+	if value, ok := _c.mutation.BlobSha(); ok {
+		_spec.SetField(document.FieldBlobSha, field.TypeString, value)
+		_node.BlobSha = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(document.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -232,6 +531,23 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_node.project_documents = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ProjectSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.ProjectSourceTable,
+			Columns: []string{document.ProjectSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_source_documents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ChunksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -248,6 +564,22 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"go-rag/ent/ent/chunk"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"math"
 
 	"entgo.io/ent"
@@ -21,13 +23,15 @@ import (
 // DocumentQuery is the builder for querying Document entities.
 type DocumentQuery struct {
 	config
-	ctx         *QueryContext
-	order       []document.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Document
-	withProject *ProjectQuery
-	withChunks  *ChunkQuery
-	withFKs     bool
+	ctx               *QueryContext
+	order             []document.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Document
+	withProject       *ProjectQuery
+	withProjectSource *ProjectSourceQuery
+	withChunks        *ChunkQuery
+	withJobs          *IngestJobQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +90,28 @@ func (_q *DocumentQuery) QueryProject() *ProjectQuery {
 	return query
 }
 
+// QueryProjectSource chains the current query on the "project_source" edge.
+func (_q *DocumentQuery) QueryProjectSource() *ProjectSourceQuery {
+	query := (&ProjectSourceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(projectsource.Table, projectsource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, document.ProjectSourceTable, document.ProjectSourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryChunks chains the current query on the "chunks" edge.
 func (_q *DocumentQuery) QueryChunks() *ChunkQuery {
 	query := (&ChunkClient{config: _q.config}).Query()
@@ -108,6 +134,28 @@ func (_q *DocumentQuery) QueryChunks() *ChunkQuery {
 	return query
 }
 
+// QueryJobs chains the current query on the "jobs" edge.
+func (_q *DocumentQuery) QueryJobs() *IngestJobQuery {
+	query := (&IngestJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(ingestjob.Table, ingestjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, document.JobsTable, document.JobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Document entity from the query.
 // Returns a *NotFoundError when no Document was found.
 func (_q *DocumentQuery) First(ctx context.Context) (*Document, error) {
@@ -295,13 +343,15 @@ func (_q *DocumentQuery) Clone() *DocumentQuery {
 		return nil
 	}
 	return &DocumentQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]document.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Document{}, _q.predicates...),
-		withProject: _q.withProject.Clone(),
-		withChunks:  _q.withChunks.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]document.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Document{}, _q.predicates...),
+		withProject:       _q.withProject.Clone(),
+		withProjectSource: _q.withProjectSource.Clone(),
+		withChunks:        _q.withChunks.Clone(),
+		withJobs:          _q.withJobs.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -319,6 +369,17 @@ func (_q *DocumentQuery) WithProject(opts ...func(*ProjectQuery)) *DocumentQuery
 	return _q
 }
 
+// WithProjectSource tells the query-builder to eager-load the nodes that are connected to
+// the "project_source" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithProjectSource(opts ...func(*ProjectSourceQuery)) *DocumentQuery {
+	query := (&ProjectSourceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProjectSource = query
+	return _q
+}
+
 // WithChunks tells the query-builder to eager-load the nodes that are connected to
 // the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *DocumentQuery) WithChunks(opts ...func(*ChunkQuery)) *DocumentQuery {
@@ -330,6 +391,17 @@ func (_q *DocumentQuery) WithChunks(opts ...func(*ChunkQuery)) *DocumentQuery {
 	return _q
 }
 
+// WithJobs tells the query-builder to eager-load the nodes that are connected to
+// the "jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithJobs(opts ...func(*IngestJobQuery)) *DocumentQuery {
+	query := (&IngestJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +481,14 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 		nodes       = []*Document{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withProject != nil,
+			_q.withProjectSource != nil,
 			_q.withChunks != nil,
+			_q.withJobs != nil,
 		}
 	)
-	if _q.withProject != nil {
+	if _q.withProject != nil || _q.withProjectSource != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -444,6 +518,12 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 			return nil, err
 		}
 	}
+	if query := _q.withProjectSource; query != nil {
+		if err := _q.loadProjectSource(ctx, query, nodes, nil,
+			func(n *Document, e *ProjectSource) { n.Edges.ProjectSource = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withChunks; query != nil {
 		if err := _q.loadChunks(ctx, query, nodes,
 			func(n *Document) { n.Edges.Chunks = []*Chunk{} },
@@ -451,6 +531,13 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 			return nil, err
 		}
 	}
+	if query := _q.withJobs; query != nil {
+		if err := _q.loadJobs(ctx, query, nodes,
+			func(n *Document) { n.Edges.Jobs = []*IngestJob{} },
+			func(n *Document, e *IngestJob) { n.Edges.Jobs = append(n.Edges.Jobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -486,6 +573,38 @@ func (_q *DocumentQuery) loadProject(ctx context.Context, query *ProjectQuery, n
 	}
 	return nil
 }
+func (_q *DocumentQuery) loadProjectSource(ctx context.Context, query *ProjectSourceQuery, nodes []*Document, init func(*Document), assign func(*Document, *ProjectSource)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Document)
+	for i := range nodes {
+		if nodes[i].project_source_documents == nil {
+			continue
+		}
+		fk := *nodes[i].project_source_documents
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(projectsource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_source_documents" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *DocumentQuery) loadChunks(ctx context.Context, query *ChunkQuery, nodes []*Document, init func(*Document), assign func(*Document, *Chunk)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Document)
@@ -517,6 +636,37 @@ func (_q *DocumentQuery) loadChunks(ctx context.Context, query *ChunkQuery, node
 	}
 	return nil
 }
+func (_q *DocumentQuery) loadJobs(ctx context.Context, query *IngestJobQuery, nodes []*Document, init func(*Document), assign func(*Document, *IngestJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.IngestJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.JobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.document_jobs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "document_jobs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_jobs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DocumentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
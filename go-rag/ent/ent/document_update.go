@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"go-rag/ent/ent/chunk"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -44,6 +46,20 @@ func (_u *DocumentUpdate) SetNillableName(v *string) *DocumentUpdate {
 	return _u
 }
 
+// SetPath sets the "path" field.
+func (_u *DocumentUpdate) SetPath(v string) *DocumentUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillablePath(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
 // SetContent sets the "content" field.
 func (_u *DocumentUpdate) SetContent(v string) *DocumentUpdate {
 	_u.mutation.SetContent(v)
@@ -58,6 +74,38 @@ func (_u *DocumentUpdate) SetNillableContent(v *string) *DocumentUpdate {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *DocumentUpdate) SetSource(v []byte) *DocumentUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *DocumentUpdate) ClearSource() *DocumentUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *DocumentUpdate) SetMimeType(v string) *DocumentUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableMimeType(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *DocumentUpdate) ClearMimeType() *DocumentUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetContentHash sets the "content_hash" field.
 func (_u *DocumentUpdate) SetContentHash(v string) *DocumentUpdate {
 	_u.mutation.SetContentHash(v)
@@ -79,19 +127,234 @@ func (_u *DocumentUpdate) ClearContentHash() *DocumentUpdate {
 }
 
 // SetStatus sets the "status" field.
-func (_u *DocumentUpdate) SetStatus(v string) *DocumentUpdate {
+func (_u *DocumentUpdate) SetStatus(v document.Status) *DocumentUpdate {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *DocumentUpdate) SetNillableStatus(v *string) *DocumentUpdate {
+func (_u *DocumentUpdate) SetNillableStatus(v *document.Status) *DocumentUpdate {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
 	return _u
 }
 
+// SetLastError sets the "last_error" field.
+func (_u *DocumentUpdate) SetLastError(v string) *DocumentUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableLastError(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DocumentUpdate) ClearLastError() *DocumentUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DocumentUpdate) SetAttempts(v int) *DocumentUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableAttempts(v *int) *DocumentUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DocumentUpdate) AddAttempts(v int) *DocumentUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (_u *DocumentUpdate) SetQueuedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetQueuedAt(v)
+	return _u
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableQueuedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetQueuedAt(*v)
+	}
+	return _u
+}
+
+// SetChunkingAt sets the "chunking_at" field.
+func (_u *DocumentUpdate) SetChunkingAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetChunkingAt(v)
+	return _u
+}
+
+// SetNillableChunkingAt sets the "chunking_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableChunkingAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetChunkingAt(*v)
+	}
+	return _u
+}
+
+// ClearChunkingAt clears the value of the "chunking_at" field.
+func (_u *DocumentUpdate) ClearChunkingAt() *DocumentUpdate {
+	_u.mutation.ClearChunkingAt()
+	return _u
+}
+
+// SetEmbeddingAt sets the "embedding_at" field.
+func (_u *DocumentUpdate) SetEmbeddingAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetEmbeddingAt(v)
+	return _u
+}
+
+// SetNillableEmbeddingAt sets the "embedding_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableEmbeddingAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetEmbeddingAt(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingAt clears the value of the "embedding_at" field.
+func (_u *DocumentUpdate) ClearEmbeddingAt() *DocumentUpdate {
+	_u.mutation.ClearEmbeddingAt()
+	return _u
+}
+
+// SetIndexingAt sets the "indexing_at" field.
+func (_u *DocumentUpdate) SetIndexingAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetIndexingAt(v)
+	return _u
+}
+
+// SetNillableIndexingAt sets the "indexing_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableIndexingAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetIndexingAt(*v)
+	}
+	return _u
+}
+
+// ClearIndexingAt clears the value of the "indexing_at" field.
+func (_u *DocumentUpdate) ClearIndexingAt() *DocumentUpdate {
+	_u.mutation.ClearIndexingAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DocumentUpdate) SetCompletedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableCompletedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DocumentUpdate) ClearCompletedAt() *DocumentUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *DocumentUpdate) SetFailedAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableFailedAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (_u *DocumentUpdate) ClearFailedAt() *DocumentUpdate {
+	_u.mutation.ClearFailedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *DocumentUpdate) SetCancelledAt(v time.Time) *DocumentUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableCancelledAt(v *time.Time) *DocumentUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *DocumentUpdate) ClearCancelledAt() *DocumentUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_u *DocumentUpdate) SetCommitSha(v string) *DocumentUpdate {
+	_u.mutation.SetCommitSha(v)
+	return _u
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableCommitSha(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetCommitSha(*v)
+	}
+	return _u
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (_u *DocumentUpdate) ClearCommitSha() *DocumentUpdate {
+	_u.mutation.ClearCommitSha()
+	return _u
+}
+
+// SetBlobSha sets the "blob_sha" field.
+func (_u *DocumentUpdate) SetBlobSha(v string) *DocumentUpdate {
+	_u.mutation.SetBlobSha(v)
+	return _u
+}
+
+// SetNillableBlobSha sets the "blob_sha" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableBlobSha(v *string) *DocumentUpdate {
+	if v != nil {
+		_u.SetBlobSha(*v)
+	}
+	return _u
+}
+
+// ClearBlobSha clears the value of the "blob_sha" field.
+func (_u *DocumentUpdate) ClearBlobSha() *DocumentUpdate {
+	_u.mutation.ClearBlobSha()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *DocumentUpdate) SetCreatedAt(v time.Time) *DocumentUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -125,6 +388,25 @@ func (_u *DocumentUpdate) SetProject(v *Project) *DocumentUpdate {
 	return _u.SetProjectID(v.ID)
 }
 
+// SetProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID.
+func (_u *DocumentUpdate) SetProjectSourceID(id int) *DocumentUpdate {
+	_u.mutation.SetProjectSourceID(id)
+	return _u
+}
+
+// SetNillableProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableProjectSourceID(id *int) *DocumentUpdate {
+	if id != nil {
+		_u = _u.SetProjectSourceID(*id)
+	}
+	return _u
+}
+
+// SetProjectSource sets the "project_source" edge to the ProjectSource entity.
+func (_u *DocumentUpdate) SetProjectSource(v *ProjectSource) *DocumentUpdate {
+	return _u.SetProjectSourceID(v.ID)
+}
+
 // AddChunkIDs adds the "chunks" edge to the Chunk entity by IDs.
 func (_u *DocumentUpdate) AddChunkIDs(ids ...int) *DocumentUpdate {
 	_u.mutation.AddChunkIDs(ids...)
@@ -140,6 +422,21 @@ func (_u *DocumentUpdate) AddChunks(v ...*Chunk) *DocumentUpdate {
 	return _u.AddChunkIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the IngestJob entity by IDs.
+func (_u *DocumentUpdate) AddJobIDs(ids ...int) *DocumentUpdate {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the IngestJob entity.
+func (_u *DocumentUpdate) AddJobs(v ...*IngestJob) *DocumentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdate) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -151,6 +448,12 @@ func (_u *DocumentUpdate) ClearProject() *DocumentUpdate {
 	return _u
 }
 
+// ClearProjectSource clears the "project_source" edge to the ProjectSource entity.
+func (_u *DocumentUpdate) ClearProjectSource() *DocumentUpdate {
+	_u.mutation.ClearProjectSource()
+	return _u
+}
+
 // ClearChunks clears all "chunks" edges to the Chunk entity.
 func (_u *DocumentUpdate) ClearChunks() *DocumentUpdate {
 	_u.mutation.ClearChunks()
@@ -172,6 +475,27 @@ func (_u *DocumentUpdate) RemoveChunks(v ...*Chunk) *DocumentUpdate {
 	return _u.RemoveChunkIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the IngestJob entity.
+func (_u *DocumentUpdate) ClearJobs() *DocumentUpdate {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to IngestJob entities by IDs.
+func (_u *DocumentUpdate) RemoveJobIDs(ids ...int) *DocumentUpdate {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to IngestJob entities.
+func (_u *DocumentUpdate) RemoveJobs(v ...*IngestJob) *DocumentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DocumentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -199,7 +523,25 @@ func (_u *DocumentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := document.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Document.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := document.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Document.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -211,9 +553,24 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(document.FieldPath, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(document.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeBytes, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(document.FieldSource, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(document.FieldMimeType, field.TypeString)
+	}
 	if value, ok := _u.mutation.ContentHash(); ok {
 		_spec.SetField(document.FieldContentHash, field.TypeString, value)
 	}
@@ -221,7 +578,70 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.ClearField(document.FieldContentHash, field.TypeString)
 	}
 	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(document.FieldStatus, field.TypeString, value)
+		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(document.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(document.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(document.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(document.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QueuedAt(); ok {
+		_spec.SetField(document.FieldQueuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChunkingAt(); ok {
+		_spec.SetField(document.FieldChunkingAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChunkingAtCleared() {
+		_spec.ClearField(document.FieldChunkingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmbeddingAt(); ok {
+		_spec.SetField(document.FieldEmbeddingAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmbeddingAtCleared() {
+		_spec.ClearField(document.FieldEmbeddingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IndexingAt(); ok {
+		_spec.SetField(document.FieldIndexingAt, field.TypeTime, value)
+	}
+	if _u.mutation.IndexingAtCleared() {
+		_spec.ClearField(document.FieldIndexingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(document.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(document.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(document.FieldFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FailedAtCleared() {
+		_spec.ClearField(document.FieldFailedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(document.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(document.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CommitSha(); ok {
+		_spec.SetField(document.FieldCommitSha, field.TypeString, value)
+	}
+	if _u.mutation.CommitShaCleared() {
+		_spec.ClearField(document.FieldCommitSha, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlobSha(); ok {
+		_spec.SetField(document.FieldBlobSha, field.TypeString, value)
+	}
+	if _u.mutation.BlobShaCleared() {
+		_spec.ClearField(document.FieldBlobSha, field.TypeString)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(document.FieldCreatedAt, field.TypeTime, value)
@@ -255,6 +675,35 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ProjectSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.ProjectSourceTable,
+			Columns: []string{document.ProjectSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.ProjectSourceTable,
+			Columns: []string{document.ProjectSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ChunksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -300,6 +749,51 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{document.Label}
@@ -334,6 +828,20 @@ func (_u *DocumentUpdateOne) SetNillableName(v *string) *DocumentUpdateOne {
 	return _u
 }
 
+// SetPath sets the "path" field.
+func (_u *DocumentUpdateOne) SetPath(v string) *DocumentUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillablePath(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
 // SetContent sets the "content" field.
 func (_u *DocumentUpdateOne) SetContent(v string) *DocumentUpdateOne {
 	_u.mutation.SetContent(v)
@@ -348,6 +856,38 @@ func (_u *DocumentUpdateOne) SetNillableContent(v *string) *DocumentUpdateOne {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *DocumentUpdateOne) SetSource(v []byte) *DocumentUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *DocumentUpdateOne) ClearSource() *DocumentUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *DocumentUpdateOne) SetMimeType(v string) *DocumentUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableMimeType(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *DocumentUpdateOne) ClearMimeType() *DocumentUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetContentHash sets the "content_hash" field.
 func (_u *DocumentUpdateOne) SetContentHash(v string) *DocumentUpdateOne {
 	_u.mutation.SetContentHash(v)
@@ -369,19 +909,234 @@ func (_u *DocumentUpdateOne) ClearContentHash() *DocumentUpdateOne {
 }
 
 // SetStatus sets the "status" field.
-func (_u *DocumentUpdateOne) SetStatus(v string) *DocumentUpdateOne {
+func (_u *DocumentUpdateOne) SetStatus(v document.Status) *DocumentUpdateOne {
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *DocumentUpdateOne) SetNillableStatus(v *string) *DocumentUpdateOne {
+func (_u *DocumentUpdateOne) SetNillableStatus(v *document.Status) *DocumentUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
 	return _u
 }
 
+// SetLastError sets the "last_error" field.
+func (_u *DocumentUpdateOne) SetLastError(v string) *DocumentUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableLastError(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DocumentUpdateOne) ClearLastError() *DocumentUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DocumentUpdateOne) SetAttempts(v int) *DocumentUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableAttempts(v *int) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DocumentUpdateOne) AddAttempts(v int) *DocumentUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (_u *DocumentUpdateOne) SetQueuedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetQueuedAt(v)
+	return _u
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableQueuedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetQueuedAt(*v)
+	}
+	return _u
+}
+
+// SetChunkingAt sets the "chunking_at" field.
+func (_u *DocumentUpdateOne) SetChunkingAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetChunkingAt(v)
+	return _u
+}
+
+// SetNillableChunkingAt sets the "chunking_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableChunkingAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetChunkingAt(*v)
+	}
+	return _u
+}
+
+// ClearChunkingAt clears the value of the "chunking_at" field.
+func (_u *DocumentUpdateOne) ClearChunkingAt() *DocumentUpdateOne {
+	_u.mutation.ClearChunkingAt()
+	return _u
+}
+
+// SetEmbeddingAt sets the "embedding_at" field.
+func (_u *DocumentUpdateOne) SetEmbeddingAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetEmbeddingAt(v)
+	return _u
+}
+
+// SetNillableEmbeddingAt sets the "embedding_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableEmbeddingAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingAt(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingAt clears the value of the "embedding_at" field.
+func (_u *DocumentUpdateOne) ClearEmbeddingAt() *DocumentUpdateOne {
+	_u.mutation.ClearEmbeddingAt()
+	return _u
+}
+
+// SetIndexingAt sets the "indexing_at" field.
+func (_u *DocumentUpdateOne) SetIndexingAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetIndexingAt(v)
+	return _u
+}
+
+// SetNillableIndexingAt sets the "indexing_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableIndexingAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetIndexingAt(*v)
+	}
+	return _u
+}
+
+// ClearIndexingAt clears the value of the "indexing_at" field.
+func (_u *DocumentUpdateOne) ClearIndexingAt() *DocumentUpdateOne {
+	_u.mutation.ClearIndexingAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DocumentUpdateOne) SetCompletedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableCompletedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DocumentUpdateOne) ClearCompletedAt() *DocumentUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *DocumentUpdateOne) SetFailedAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableFailedAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// ClearFailedAt clears the value of the "failed_at" field.
+func (_u *DocumentUpdateOne) ClearFailedAt() *DocumentUpdateOne {
+	_u.mutation.ClearFailedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *DocumentUpdateOne) SetCancelledAt(v time.Time) *DocumentUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableCancelledAt(v *time.Time) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *DocumentUpdateOne) ClearCancelledAt() *DocumentUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCommitSha sets the "commit_sha" field.
+func (_u *DocumentUpdateOne) SetCommitSha(v string) *DocumentUpdateOne {
+	_u.mutation.SetCommitSha(v)
+	return _u
+}
+
+// SetNillableCommitSha sets the "commit_sha" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableCommitSha(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetCommitSha(*v)
+	}
+	return _u
+}
+
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (_u *DocumentUpdateOne) ClearCommitSha() *DocumentUpdateOne {
+	_u.mutation.ClearCommitSha()
+	return _u
+}
+
+// SetBlobSha sets the "blob_sha" field.
+func (_u *DocumentUpdateOne) SetBlobSha(v string) *DocumentUpdateOne {
+	_u.mutation.SetBlobSha(v)
+	return _u
+}
+
+// SetNillableBlobSha sets the "blob_sha" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableBlobSha(v *string) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetBlobSha(*v)
+	}
+	return _u
+}
+
+// ClearBlobSha clears the value of the "blob_sha" field.
+func (_u *DocumentUpdateOne) ClearBlobSha() *DocumentUpdateOne {
+	_u.mutation.ClearBlobSha()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *DocumentUpdateOne) SetCreatedAt(v time.Time) *DocumentUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -415,6 +1170,25 @@ func (_u *DocumentUpdateOne) SetProject(v *Project) *DocumentUpdateOne {
 	return _u.SetProjectID(v.ID)
 }
 
+// SetProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID.
+func (_u *DocumentUpdateOne) SetProjectSourceID(id int) *DocumentUpdateOne {
+	_u.mutation.SetProjectSourceID(id)
+	return _u
+}
+
+// SetNillableProjectSourceID sets the "project_source" edge to the ProjectSource entity by ID if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableProjectSourceID(id *int) *DocumentUpdateOne {
+	if id != nil {
+		_u = _u.SetProjectSourceID(*id)
+	}
+	return _u
+}
+
+// SetProjectSource sets the "project_source" edge to the ProjectSource entity.
+func (_u *DocumentUpdateOne) SetProjectSource(v *ProjectSource) *DocumentUpdateOne {
+	return _u.SetProjectSourceID(v.ID)
+}
+
 // AddChunkIDs adds the "chunks" edge to the Chunk entity by IDs.
 func (_u *DocumentUpdateOne) AddChunkIDs(ids ...int) *DocumentUpdateOne {
 	_u.mutation.AddChunkIDs(ids...)
@@ -430,6 +1204,21 @@ func (_u *DocumentUpdateOne) AddChunks(v ...*Chunk) *DocumentUpdateOne {
 	return _u.AddChunkIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the IngestJob entity by IDs.
+func (_u *DocumentUpdateOne) AddJobIDs(ids ...int) *DocumentUpdateOne {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the IngestJob entity.
+func (_u *DocumentUpdateOne) AddJobs(v ...*IngestJob) *DocumentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdateOne) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -441,6 +1230,12 @@ func (_u *DocumentUpdateOne) ClearProject() *DocumentUpdateOne {
 	return _u
 }
 
+// ClearProjectSource clears the "project_source" edge to the ProjectSource entity.
+func (_u *DocumentUpdateOne) ClearProjectSource() *DocumentUpdateOne {
+	_u.mutation.ClearProjectSource()
+	return _u
+}
+
 // ClearChunks clears all "chunks" edges to the Chunk entity.
 func (_u *DocumentUpdateOne) ClearChunks() *DocumentUpdateOne {
 	_u.mutation.ClearChunks()
@@ -462,6 +1257,27 @@ func (_u *DocumentUpdateOne) RemoveChunks(v ...*Chunk) *DocumentUpdateOne {
 	return _u.RemoveChunkIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the IngestJob entity.
+func (_u *DocumentUpdateOne) ClearJobs() *DocumentUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to IngestJob entities by IDs.
+func (_u *DocumentUpdateOne) RemoveJobIDs(ids ...int) *DocumentUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to IngestJob entities.
+func (_u *DocumentUpdateOne) RemoveJobs(v ...*IngestJob) *DocumentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
 // Where appends a list predicates to the DocumentUpdate builder.
 func (_u *DocumentUpdateOne) Where(ps ...predicate.Document) *DocumentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -502,7 +1318,25 @@ func (_u *DocumentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := document.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Document.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := document.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Document.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -531,9 +1365,24 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(document.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(document.FieldPath, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(document.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(document.FieldSource, field.TypeBytes, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(document.FieldSource, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(document.FieldMimeType, field.TypeString)
+	}
 	if value, ok := _u.mutation.ContentHash(); ok {
 		_spec.SetField(document.FieldContentHash, field.TypeString, value)
 	}
@@ -541,7 +1390,70 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		_spec.ClearField(document.FieldContentHash, field.TypeString)
 	}
 	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(document.FieldStatus, field.TypeString, value)
+		_spec.SetField(document.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(document.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(document.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(document.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(document.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QueuedAt(); ok {
+		_spec.SetField(document.FieldQueuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChunkingAt(); ok {
+		_spec.SetField(document.FieldChunkingAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChunkingAtCleared() {
+		_spec.ClearField(document.FieldChunkingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmbeddingAt(); ok {
+		_spec.SetField(document.FieldEmbeddingAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmbeddingAtCleared() {
+		_spec.ClearField(document.FieldEmbeddingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IndexingAt(); ok {
+		_spec.SetField(document.FieldIndexingAt, field.TypeTime, value)
+	}
+	if _u.mutation.IndexingAtCleared() {
+		_spec.ClearField(document.FieldIndexingAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(document.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(document.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(document.FieldFailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FailedAtCleared() {
+		_spec.ClearField(document.FieldFailedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(document.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(document.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CommitSha(); ok {
+		_spec.SetField(document.FieldCommitSha, field.TypeString, value)
+	}
+	if _u.mutation.CommitShaCleared() {
+		_spec.ClearField(document.FieldCommitSha, field.TypeString)
+	}
+	if value, ok := _u.mutation.BlobSha(); ok {
+		_spec.SetField(document.FieldBlobSha, field.TypeString, value)
+	}
+	if _u.mutation.BlobShaCleared() {
+		_spec.ClearField(document.FieldBlobSha, field.TypeString)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(document.FieldCreatedAt, field.TypeTime, value)
@@ -575,6 +1487,35 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ProjectSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.ProjectSourceTable,
+			Columns: []string{document.ProjectSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.ProjectSourceTable,
+			Columns: []string{document.ProjectSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ChunksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -620,6 +1561,51 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   document.JobsTable,
+			Columns: []string{document.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ingestjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Document{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,13 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"go-rag/ent/ent/chunk"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"go-rag/ent/ent/queryresult"
 	"go-rag/ent/ent/securityquestion"
 	"go-rag/ent/ent/session"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
+	"go-rag/ent/ent/webhook"
+	"go-rag/ent/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -81,13 +87,19 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			chunk.Table:            chunk.ValidColumn,
+			conversation.Table:     conversation.ValidColumn,
 			document.Table:         document.ValidColumn,
+			importjob.Table:        importjob.ValidColumn,
+			ingestjob.Table:        ingestjob.ValidColumn,
 			project.Table:          project.ValidColumn,
+			projectsource.Table:    projectsource.ValidColumn,
 			queryresult.Table:      queryresult.ValidColumn,
 			securityquestion.Table: securityquestion.ValidColumn,
 			session.Table:          session.ValidColumn,
 			user.Table:             user.ValidColumn,
 			userprompt.Table:       userprompt.ValidColumn,
+			webhook.Table:          webhook.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
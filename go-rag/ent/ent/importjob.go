@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/project"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportJob is the model entity for the ImportJob schema.
+type ImportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArchiveName holds the value of the "archive_name" field.
+	ArchiveName string `json:"archive_name,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// Include holds the value of the "include" field.
+	Include []string `json:"include,omitempty"`
+	// Exclude holds the value of the "exclude" field.
+	Exclude []string `json:"exclude,omitempty"`
+	// TotalFiles holds the value of the "total_files" field.
+	TotalFiles int `json:"total_files,omitempty"`
+	// ProcessedFiles holds the value of the "processed_files" field.
+	ProcessedFiles int `json:"processed_files,omitempty"`
+	// FailedFiles holds the value of the "failed_files" field.
+	FailedFiles int `json:"failed_files,omitempty"`
+	// SkippedFiles holds the value of the "skipped_files" field.
+	SkippedFiles int `json:"skipped_files,omitempty"`
+	// Errors holds the value of the "errors" field.
+	Errors []string `json:"errors,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportJobQuery when eager-loading is set.
+	Edges               ImportJobEdges `json:"edges"`
+	project_import_jobs *int
+	selectValues        sql.SelectValues
+}
+
+// ImportJobEdges holds the relations/edges for other nodes in the graph.
+type ImportJobEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportJobEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldInclude, importjob.FieldExclude, importjob.FieldErrors:
+			values[i] = new([]byte)
+		case importjob.FieldID, importjob.FieldTotalFiles, importjob.FieldProcessedFiles, importjob.FieldFailedFiles, importjob.FieldSkippedFiles:
+			values[i] = new(sql.NullInt64)
+		case importjob.FieldArchiveName, importjob.FieldStatus, importjob.FieldLastError:
+			values[i] = new(sql.NullString)
+		case importjob.FieldLockedUntil, importjob.FieldCreatedAt, importjob.FieldUpdatedAt, importjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case importjob.ForeignKeys[0]: // project_import_jobs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportJob fields.
+func (_m *ImportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case importjob.FieldArchiveName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_name", values[i])
+			} else if value.Valid {
+				_m.ArchiveName = value.String
+			}
+		case importjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case importjob.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
+		case importjob.FieldInclude:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field include", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Include); err != nil {
+					return fmt.Errorf("unmarshal field include: %w", err)
+				}
+			}
+		case importjob.FieldExclude:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exclude", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Exclude); err != nil {
+					return fmt.Errorf("unmarshal field exclude: %w", err)
+				}
+			}
+		case importjob.FieldTotalFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_files", values[i])
+			} else if value.Valid {
+				_m.TotalFiles = int(value.Int64)
+			}
+		case importjob.FieldProcessedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_files", values[i])
+			} else if value.Valid {
+				_m.ProcessedFiles = int(value.Int64)
+			}
+		case importjob.FieldFailedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_files", values[i])
+			} else if value.Valid {
+				_m.FailedFiles = int(value.Int64)
+			}
+		case importjob.FieldSkippedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_files", values[i])
+			} else if value.Valid {
+				_m.SkippedFiles = int(value.Int64)
+			}
+		case importjob.FieldErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Errors); err != nil {
+					return fmt.Errorf("unmarshal field errors: %w", err)
+				}
+			}
+		case importjob.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case importjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case importjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case importjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case importjob.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field project_import_jobs", value)
+			} else if value.Valid {
+				_m.project_import_jobs = new(int)
+				*_m.project_import_jobs = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ImportJob entity.
+func (_m *ImportJob) QueryProject() *ProjectQuery {
+	return NewImportJobClient(_m.config).QueryProject(_m)
+}
+
+// Update returns a builder for updating this ImportJob.
+// Note that you need to call ImportJob.Unwrap() before calling this method if this ImportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportJob) Update() *ImportJobUpdateOne {
+	return NewImportJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportJob) Unwrap() *ImportJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("archive_name=")
+	builder.WriteString(_m.ArchiveName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("include=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Include))
+	builder.WriteString(", ")
+	builder.WriteString("exclude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Exclude))
+	builder.WriteString(", ")
+	builder.WriteString("total_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalFiles))
+	builder.WriteString(", ")
+	builder.WriteString("processed_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProcessedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("failed_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("skipped_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkippedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Errors))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportJobs is a parsable slice of ImportJob.
+type ImportJobs []*ImportJob
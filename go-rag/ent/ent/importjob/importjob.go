@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importjob type in the database.
+	Label = "import_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldArchiveName holds the string denoting the archive_name field in the database.
+	FieldArchiveName = "archive_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldInclude holds the string denoting the include field in the database.
+	FieldInclude = "include"
+	// FieldExclude holds the string denoting the exclude field in the database.
+	FieldExclude = "exclude"
+	// FieldTotalFiles holds the string denoting the total_files field in the database.
+	FieldTotalFiles = "total_files"
+	// FieldProcessedFiles holds the string denoting the processed_files field in the database.
+	FieldProcessedFiles = "processed_files"
+	// FieldFailedFiles holds the string denoting the failed_files field in the database.
+	FieldFailedFiles = "failed_files"
+	// FieldSkippedFiles holds the string denoting the skipped_files field in the database.
+	FieldSkippedFiles = "skipped_files"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// Table holds the table name of the importjob in the database.
+	Table = "import_jobs"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "import_jobs"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_import_jobs"
+)
+
+// Columns holds all SQL columns for importjob fields.
+var Columns = []string{
+	FieldID,
+	FieldArchiveName,
+	FieldStatus,
+	FieldLockedUntil,
+	FieldInclude,
+	FieldExclude,
+	FieldTotalFiles,
+	FieldProcessedFiles,
+	FieldFailedFiles,
+	FieldSkippedFiles,
+	FieldErrors,
+	FieldLastError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldFinishedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "import_jobs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"project_import_jobs",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultTotalFiles holds the default value on creation for the "total_files" field.
+	DefaultTotalFiles int
+	// DefaultProcessedFiles holds the default value on creation for the "processed_files" field.
+	DefaultProcessedFiles int
+	// DefaultFailedFiles holds the default value on creation for the "failed_files" field.
+	DefaultFailedFiles int
+	// DefaultSkippedFiles holds the default value on creation for the "skipped_files" field.
+	DefaultSkippedFiles int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ImportJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByArchiveName orders the results by the archive_name field.
+func ByArchiveName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByTotalFiles orders the results by the total_files field.
+func ByTotalFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalFiles, opts...).ToFunc()
+}
+
+// ByProcessedFiles orders the results by the processed_files field.
+func ByProcessedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedFiles, opts...).ToFunc()
+}
+
+// ByFailedFiles orders the results by the failed_files field.
+func ByFailedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedFiles, opts...).ToFunc()
+}
+
+// BySkippedFiles orders the results by the skipped_files field.
+func BySkippedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkippedFiles, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importjob
+
+import (
+	"go-rag/ent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldID, id))
+}
+
+// ArchiveName applies equality check predicate on the "archive_name" field. It's identical to ArchiveNameEQ.
+func ArchiveName(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldArchiveName, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// TotalFiles applies equality check predicate on the "total_files" field. It's identical to TotalFilesEQ.
+func TotalFiles(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldTotalFiles, v))
+}
+
+// ProcessedFiles applies equality check predicate on the "processed_files" field. It's identical to ProcessedFilesEQ.
+func ProcessedFiles(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldProcessedFiles, v))
+}
+
+// FailedFiles applies equality check predicate on the "failed_files" field. It's identical to FailedFilesEQ.
+func FailedFiles(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFailedFiles, v))
+}
+
+// SkippedFiles applies equality check predicate on the "skipped_files" field. It's identical to SkippedFilesEQ.
+func SkippedFiles(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldSkippedFiles, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// ArchiveNameEQ applies the EQ predicate on the "archive_name" field.
+func ArchiveNameEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldArchiveName, v))
+}
+
+// ArchiveNameNEQ applies the NEQ predicate on the "archive_name" field.
+func ArchiveNameNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldArchiveName, v))
+}
+
+// ArchiveNameIn applies the In predicate on the "archive_name" field.
+func ArchiveNameIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldArchiveName, vs...))
+}
+
+// ArchiveNameNotIn applies the NotIn predicate on the "archive_name" field.
+func ArchiveNameNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldArchiveName, vs...))
+}
+
+// ArchiveNameGT applies the GT predicate on the "archive_name" field.
+func ArchiveNameGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldArchiveName, v))
+}
+
+// ArchiveNameGTE applies the GTE predicate on the "archive_name" field.
+func ArchiveNameGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldArchiveName, v))
+}
+
+// ArchiveNameLT applies the LT predicate on the "archive_name" field.
+func ArchiveNameLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldArchiveName, v))
+}
+
+// ArchiveNameLTE applies the LTE predicate on the "archive_name" field.
+func ArchiveNameLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldArchiveName, v))
+}
+
+// ArchiveNameContains applies the Contains predicate on the "archive_name" field.
+func ArchiveNameContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldArchiveName, v))
+}
+
+// ArchiveNameHasPrefix applies the HasPrefix predicate on the "archive_name" field.
+func ArchiveNameHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldArchiveName, v))
+}
+
+// ArchiveNameHasSuffix applies the HasSuffix predicate on the "archive_name" field.
+func ArchiveNameHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldArchiveName, v))
+}
+
+// ArchiveNameEqualFold applies the EqualFold predicate on the "archive_name" field.
+func ArchiveNameEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldArchiveName, v))
+}
+
+// ArchiveNameContainsFold applies the ContainsFold predicate on the "archive_name" field.
+func ArchiveNameContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldArchiveName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// IncludeIsNil applies the IsNil predicate on the "include" field.
+func IncludeIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldInclude))
+}
+
+// IncludeNotNil applies the NotNil predicate on the "include" field.
+func IncludeNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldInclude))
+}
+
+// ExcludeIsNil applies the IsNil predicate on the "exclude" field.
+func ExcludeIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldExclude))
+}
+
+// ExcludeNotNil applies the NotNil predicate on the "exclude" field.
+func ExcludeNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldExclude))
+}
+
+// TotalFilesEQ applies the EQ predicate on the "total_files" field.
+func TotalFilesEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldTotalFiles, v))
+}
+
+// TotalFilesNEQ applies the NEQ predicate on the "total_files" field.
+func TotalFilesNEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldTotalFiles, v))
+}
+
+// TotalFilesIn applies the In predicate on the "total_files" field.
+func TotalFilesIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldTotalFiles, vs...))
+}
+
+// TotalFilesNotIn applies the NotIn predicate on the "total_files" field.
+func TotalFilesNotIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldTotalFiles, vs...))
+}
+
+// TotalFilesGT applies the GT predicate on the "total_files" field.
+func TotalFilesGT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldTotalFiles, v))
+}
+
+// TotalFilesGTE applies the GTE predicate on the "total_files" field.
+func TotalFilesGTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldTotalFiles, v))
+}
+
+// TotalFilesLT applies the LT predicate on the "total_files" field.
+func TotalFilesLT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldTotalFiles, v))
+}
+
+// TotalFilesLTE applies the LTE predicate on the "total_files" field.
+func TotalFilesLTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldTotalFiles, v))
+}
+
+// ProcessedFilesEQ applies the EQ predicate on the "processed_files" field.
+func ProcessedFilesEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldProcessedFiles, v))
+}
+
+// ProcessedFilesNEQ applies the NEQ predicate on the "processed_files" field.
+func ProcessedFilesNEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldProcessedFiles, v))
+}
+
+// ProcessedFilesIn applies the In predicate on the "processed_files" field.
+func ProcessedFilesIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldProcessedFiles, vs...))
+}
+
+// ProcessedFilesNotIn applies the NotIn predicate on the "processed_files" field.
+func ProcessedFilesNotIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldProcessedFiles, vs...))
+}
+
+// ProcessedFilesGT applies the GT predicate on the "processed_files" field.
+func ProcessedFilesGT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldProcessedFiles, v))
+}
+
+// ProcessedFilesGTE applies the GTE predicate on the "processed_files" field.
+func ProcessedFilesGTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldProcessedFiles, v))
+}
+
+// ProcessedFilesLT applies the LT predicate on the "processed_files" field.
+func ProcessedFilesLT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldProcessedFiles, v))
+}
+
+// ProcessedFilesLTE applies the LTE predicate on the "processed_files" field.
+func ProcessedFilesLTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldProcessedFiles, v))
+}
+
+// FailedFilesEQ applies the EQ predicate on the "failed_files" field.
+func FailedFilesEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFailedFiles, v))
+}
+
+// FailedFilesNEQ applies the NEQ predicate on the "failed_files" field.
+func FailedFilesNEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldFailedFiles, v))
+}
+
+// FailedFilesIn applies the In predicate on the "failed_files" field.
+func FailedFilesIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldFailedFiles, vs...))
+}
+
+// FailedFilesNotIn applies the NotIn predicate on the "failed_files" field.
+func FailedFilesNotIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldFailedFiles, vs...))
+}
+
+// FailedFilesGT applies the GT predicate on the "failed_files" field.
+func FailedFilesGT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldFailedFiles, v))
+}
+
+// FailedFilesGTE applies the GTE predicate on the "failed_files" field.
+func FailedFilesGTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldFailedFiles, v))
+}
+
+// FailedFilesLT applies the LT predicate on the "failed_files" field.
+func FailedFilesLT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldFailedFiles, v))
+}
+
+// FailedFilesLTE applies the LTE predicate on the "failed_files" field.
+func FailedFilesLTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldFailedFiles, v))
+}
+
+// SkippedFilesEQ applies the EQ predicate on the "skipped_files" field.
+func SkippedFilesEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldSkippedFiles, v))
+}
+
+// SkippedFilesNEQ applies the NEQ predicate on the "skipped_files" field.
+func SkippedFilesNEQ(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldSkippedFiles, v))
+}
+
+// SkippedFilesIn applies the In predicate on the "skipped_files" field.
+func SkippedFilesIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldSkippedFiles, vs...))
+}
+
+// SkippedFilesNotIn applies the NotIn predicate on the "skipped_files" field.
+func SkippedFilesNotIn(vs ...int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldSkippedFiles, vs...))
+}
+
+// SkippedFilesGT applies the GT predicate on the "skipped_files" field.
+func SkippedFilesGT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldSkippedFiles, v))
+}
+
+// SkippedFilesGTE applies the GTE predicate on the "skipped_files" field.
+func SkippedFilesGTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldSkippedFiles, v))
+}
+
+// SkippedFilesLT applies the LT predicate on the "skipped_files" field.
+func SkippedFilesLT(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldSkippedFiles, v))
+}
+
+// SkippedFilesLTE applies the LTE predicate on the "skipped_files" field.
+func SkippedFilesLTE(v int) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldSkippedFiles, v))
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldErrors))
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldErrors))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ImportJob {
+	return predicate.ImportJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ImportJob {
+	return predicate.ImportJob(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/project"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobCreate is the builder for creating a ImportJob entity.
+type ImportJobCreate struct {
+	config
+	mutation *ImportJobMutation
+	hooks    []Hook
+}
+
+// SetArchiveName sets the "archive_name" field.
+func (_c *ImportJobCreate) SetArchiveName(v string) *ImportJobCreate {
+	_c.mutation.SetArchiveName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportJobCreate) SetStatus(v string) *ImportJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableStatus(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *ImportJobCreate) SetLockedUntil(v time.Time) *ImportJobCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableLockedUntil(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetInclude sets the "include" field.
+func (_c *ImportJobCreate) SetInclude(v []string) *ImportJobCreate {
+	_c.mutation.SetInclude(v)
+	return _c
+}
+
+// SetExclude sets the "exclude" field.
+func (_c *ImportJobCreate) SetExclude(v []string) *ImportJobCreate {
+	_c.mutation.SetExclude(v)
+	return _c
+}
+
+// SetTotalFiles sets the "total_files" field.
+func (_c *ImportJobCreate) SetTotalFiles(v int) *ImportJobCreate {
+	_c.mutation.SetTotalFiles(v)
+	return _c
+}
+
+// SetNillableTotalFiles sets the "total_files" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableTotalFiles(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetTotalFiles(*v)
+	}
+	return _c
+}
+
+// SetProcessedFiles sets the "processed_files" field.
+func (_c *ImportJobCreate) SetProcessedFiles(v int) *ImportJobCreate {
+	_c.mutation.SetProcessedFiles(v)
+	return _c
+}
+
+// SetNillableProcessedFiles sets the "processed_files" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableProcessedFiles(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetProcessedFiles(*v)
+	}
+	return _c
+}
+
+// SetFailedFiles sets the "failed_files" field.
+func (_c *ImportJobCreate) SetFailedFiles(v int) *ImportJobCreate {
+	_c.mutation.SetFailedFiles(v)
+	return _c
+}
+
+// SetNillableFailedFiles sets the "failed_files" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFailedFiles(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetFailedFiles(*v)
+	}
+	return _c
+}
+
+// SetSkippedFiles sets the "skipped_files" field.
+func (_c *ImportJobCreate) SetSkippedFiles(v int) *ImportJobCreate {
+	_c.mutation.SetSkippedFiles(v)
+	return _c
+}
+
+// SetNillableSkippedFiles sets the "skipped_files" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableSkippedFiles(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetSkippedFiles(*v)
+	}
+	return _c
+}
+
+// SetErrors sets the "errors" field.
+func (_c *ImportJobCreate) SetErrors(v []string) *ImportJobCreate {
+	_c.mutation.SetErrors(v)
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ImportJobCreate) SetLastError(v string) *ImportJobCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableLastError(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportJobCreate) SetCreatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCreatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportJobCreate) SetUpdatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableUpdatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ImportJobCreate) SetFinishedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFinishedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_c *ImportJobCreate) SetProjectID(id int) *ImportJobCreate {
+	_c.mutation.SetProjectID(id)
+	return _c
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableProjectID(id *int) *ImportJobCreate {
+	if id != nil {
+		_c = _c.SetProjectID(*id)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *ImportJobCreate) SetProject(v *Project) *ImportJobCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_c *ImportJobCreate) Mutation() *ImportJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportJob in the database.
+func (_c *ImportJobCreate) Save(ctx context.Context) (*ImportJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportJobCreate) SaveX(ctx context.Context) *ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := importjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TotalFiles(); !ok {
+		v := importjob.DefaultTotalFiles
+		_c.mutation.SetTotalFiles(v)
+	}
+	if _, ok := _c.mutation.ProcessedFiles(); !ok {
+		v := importjob.DefaultProcessedFiles
+		_c.mutation.SetProcessedFiles(v)
+	}
+	if _, ok := _c.mutation.FailedFiles(); !ok {
+		v := importjob.DefaultFailedFiles
+		_c.mutation.SetFailedFiles(v)
+	}
+	if _, ok := _c.mutation.SkippedFiles(); !ok {
+		v := importjob.DefaultSkippedFiles
+		_c.mutation.SetSkippedFiles(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := importjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := importjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportJobCreate) check() error {
+	if _, ok := _c.mutation.ArchiveName(); !ok {
+		return &ValidationError{Name: "archive_name", err: errors.New(`ent: missing required field "ImportJob.archive_name"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportJob.status"`)}
+	}
+	if _, ok := _c.mutation.TotalFiles(); !ok {
+		return &ValidationError{Name: "total_files", err: errors.New(`ent: missing required field "ImportJob.total_files"`)}
+	}
+	if _, ok := _c.mutation.ProcessedFiles(); !ok {
+		return &ValidationError{Name: "processed_files", err: errors.New(`ent: missing required field "ImportJob.processed_files"`)}
+	}
+	if _, ok := _c.mutation.FailedFiles(); !ok {
+		return &ValidationError{Name: "failed_files", err: errors.New(`ent: missing required field "ImportJob.failed_files"`)}
+	}
+	if _, ok := _c.mutation.SkippedFiles(); !ok {
+		return &ValidationError{Name: "skipped_files", err: errors.New(`ent: missing required field "ImportJob.skipped_files"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ImportJobCreate) sqlSave(ctx context.Context) (*ImportJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportJobCreate) createSpec() (*ImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importjob.Table, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ArchiveName(); ok {
+		_spec.SetField(importjob.FieldArchiveName, field.TypeString, value)
+		_node.ArchiveName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(importjob.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.Include(); ok {
+		_spec.SetField(importjob.FieldInclude, field.TypeJSON, value)
+		_node.Include = value
+	}
+	if value, ok := _c.mutation.Exclude(); ok {
+		_spec.SetField(importjob.FieldExclude, field.TypeJSON, value)
+		_node.Exclude = value
+	}
+	if value, ok := _c.mutation.TotalFiles(); ok {
+		_spec.SetField(importjob.FieldTotalFiles, field.TypeInt, value)
+		_node.TotalFiles = value
+	}
+	if value, ok := _c.mutation.ProcessedFiles(); ok {
+		_spec.SetField(importjob.FieldProcessedFiles, field.TypeInt, value)
+		_node.ProcessedFiles = value
+	}
+	if value, ok := _c.mutation.FailedFiles(); ok {
+		_spec.SetField(importjob.FieldFailedFiles, field.TypeInt, value)
+		_node.FailedFiles = value
+	}
+	if value, ok := _c.mutation.SkippedFiles(); ok {
+		_spec.SetField(importjob.FieldSkippedFiles, field.TypeInt, value)
+		_node.SkippedFiles = value
+	}
+	if value, ok := _c.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+		_node.Errors = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(importjob.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.ProjectTable,
+			Columns: []string{importjob.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_import_jobs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportJobCreateBulk is the builder for creating many ImportJob entities in bulk.
+type ImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ImportJobCreate
+}
+
+// Save creates the ImportJob entities in the database.
+func (_c *ImportJobCreateBulk) Save(ctx context.Context) ([]*ImportJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) SaveX(ctx context.Context) []*ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
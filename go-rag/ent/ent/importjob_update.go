@@ -0,0 +1,970 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/project"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobUpdate is the builder for updating ImportJob entities.
+type ImportJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdate) Where(ps ...predicate.ImportJob) *ImportJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetArchiveName sets the "archive_name" field.
+func (_u *ImportJobUpdate) SetArchiveName(v string) *ImportJobUpdate {
+	_u.mutation.SetArchiveName(v)
+	return _u
+}
+
+// SetNillableArchiveName sets the "archive_name" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableArchiveName(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetArchiveName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdate) SetStatus(v string) *ImportJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableStatus(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ImportJobUpdate) SetLockedUntil(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableLockedUntil(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ImportJobUpdate) ClearLockedUntil() *ImportJobUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetInclude sets the "include" field.
+func (_u *ImportJobUpdate) SetInclude(v []string) *ImportJobUpdate {
+	_u.mutation.SetInclude(v)
+	return _u
+}
+
+// AppendInclude appends value to the "include" field.
+func (_u *ImportJobUpdate) AppendInclude(v []string) *ImportJobUpdate {
+	_u.mutation.AppendInclude(v)
+	return _u
+}
+
+// ClearInclude clears the value of the "include" field.
+func (_u *ImportJobUpdate) ClearInclude() *ImportJobUpdate {
+	_u.mutation.ClearInclude()
+	return _u
+}
+
+// SetExclude sets the "exclude" field.
+func (_u *ImportJobUpdate) SetExclude(v []string) *ImportJobUpdate {
+	_u.mutation.SetExclude(v)
+	return _u
+}
+
+// AppendExclude appends value to the "exclude" field.
+func (_u *ImportJobUpdate) AppendExclude(v []string) *ImportJobUpdate {
+	_u.mutation.AppendExclude(v)
+	return _u
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (_u *ImportJobUpdate) ClearExclude() *ImportJobUpdate {
+	_u.mutation.ClearExclude()
+	return _u
+}
+
+// SetTotalFiles sets the "total_files" field.
+func (_u *ImportJobUpdate) SetTotalFiles(v int) *ImportJobUpdate {
+	_u.mutation.ResetTotalFiles()
+	_u.mutation.SetTotalFiles(v)
+	return _u
+}
+
+// SetNillableTotalFiles sets the "total_files" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableTotalFiles(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetTotalFiles(*v)
+	}
+	return _u
+}
+
+// AddTotalFiles adds value to the "total_files" field.
+func (_u *ImportJobUpdate) AddTotalFiles(v int) *ImportJobUpdate {
+	_u.mutation.AddTotalFiles(v)
+	return _u
+}
+
+// SetProcessedFiles sets the "processed_files" field.
+func (_u *ImportJobUpdate) SetProcessedFiles(v int) *ImportJobUpdate {
+	_u.mutation.ResetProcessedFiles()
+	_u.mutation.SetProcessedFiles(v)
+	return _u
+}
+
+// SetNillableProcessedFiles sets the "processed_files" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableProcessedFiles(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetProcessedFiles(*v)
+	}
+	return _u
+}
+
+// AddProcessedFiles adds value to the "processed_files" field.
+func (_u *ImportJobUpdate) AddProcessedFiles(v int) *ImportJobUpdate {
+	_u.mutation.AddProcessedFiles(v)
+	return _u
+}
+
+// SetFailedFiles sets the "failed_files" field.
+func (_u *ImportJobUpdate) SetFailedFiles(v int) *ImportJobUpdate {
+	_u.mutation.ResetFailedFiles()
+	_u.mutation.SetFailedFiles(v)
+	return _u
+}
+
+// SetNillableFailedFiles sets the "failed_files" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFailedFiles(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFailedFiles(*v)
+	}
+	return _u
+}
+
+// AddFailedFiles adds value to the "failed_files" field.
+func (_u *ImportJobUpdate) AddFailedFiles(v int) *ImportJobUpdate {
+	_u.mutation.AddFailedFiles(v)
+	return _u
+}
+
+// SetSkippedFiles sets the "skipped_files" field.
+func (_u *ImportJobUpdate) SetSkippedFiles(v int) *ImportJobUpdate {
+	_u.mutation.ResetSkippedFiles()
+	_u.mutation.SetSkippedFiles(v)
+	return _u
+}
+
+// SetNillableSkippedFiles sets the "skipped_files" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableSkippedFiles(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetSkippedFiles(*v)
+	}
+	return _u
+}
+
+// AddSkippedFiles adds value to the "skipped_files" field.
+func (_u *ImportJobUpdate) AddSkippedFiles(v int) *ImportJobUpdate {
+	_u.mutation.AddSkippedFiles(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *ImportJobUpdate) SetErrors(v []string) *ImportJobUpdate {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *ImportJobUpdate) AppendErrors(v []string) *ImportJobUpdate {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *ImportJobUpdate) ClearErrors() *ImportJobUpdate {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ImportJobUpdate) SetLastError(v string) *ImportJobUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableLastError(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ImportJobUpdate) ClearLastError() *ImportJobUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImportJobUpdate) SetCreatedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableCreatedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdate) SetUpdatedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportJobUpdate) SetFinishedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFinishedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportJobUpdate) ClearFinishedAt() *ImportJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *ImportJobUpdate) SetProjectID(id int) *ImportJobUpdate {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableProjectID(id *int) *ImportJobUpdate {
+	if id != nil {
+		_u = _u.SetProjectID(*id)
+	}
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ImportJobUpdate) SetProject(v *Project) *ImportJobUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdate) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ImportJobUpdate) ClearProject() *ImportJobUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ImportJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ArchiveName(); ok {
+		_spec.SetField(importjob.FieldArchiveName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(importjob.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(importjob.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Include(); ok {
+		_spec.SetField(importjob.FieldInclude, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldInclude, value)
+		})
+	}
+	if _u.mutation.IncludeCleared() {
+		_spec.ClearField(importjob.FieldInclude, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Exclude(); ok {
+		_spec.SetField(importjob.FieldExclude, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldExclude, value)
+		})
+	}
+	if _u.mutation.ExcludeCleared() {
+		_spec.ClearField(importjob.FieldExclude, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TotalFiles(); ok {
+		_spec.SetField(importjob.FieldTotalFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFiles(); ok {
+		_spec.AddField(importjob.FieldTotalFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedFiles(); ok {
+		_spec.SetField(importjob.FieldProcessedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedFiles(); ok {
+		_spec.AddField(importjob.FieldProcessedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedFiles(); ok {
+		_spec.SetField(importjob.FieldFailedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedFiles(); ok {
+		_spec.AddField(importjob.FieldFailedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SkippedFiles(); ok {
+		_spec.SetField(importjob.FieldSkippedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedFiles(); ok {
+		_spec.AddField(importjob.FieldSkippedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(importjob.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(importjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(importjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.ProjectTable,
+			Columns: []string{importjob.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.ProjectTable,
+			Columns: []string{importjob.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportJobUpdateOne is the builder for updating a single ImportJob entity.
+type ImportJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// SetArchiveName sets the "archive_name" field.
+func (_u *ImportJobUpdateOne) SetArchiveName(v string) *ImportJobUpdateOne {
+	_u.mutation.SetArchiveName(v)
+	return _u
+}
+
+// SetNillableArchiveName sets the "archive_name" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableArchiveName(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetArchiveName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdateOne) SetStatus(v string) *ImportJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableStatus(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ImportJobUpdateOne) SetLockedUntil(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableLockedUntil(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ImportJobUpdateOne) ClearLockedUntil() *ImportJobUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetInclude sets the "include" field.
+func (_u *ImportJobUpdateOne) SetInclude(v []string) *ImportJobUpdateOne {
+	_u.mutation.SetInclude(v)
+	return _u
+}
+
+// AppendInclude appends value to the "include" field.
+func (_u *ImportJobUpdateOne) AppendInclude(v []string) *ImportJobUpdateOne {
+	_u.mutation.AppendInclude(v)
+	return _u
+}
+
+// ClearInclude clears the value of the "include" field.
+func (_u *ImportJobUpdateOne) ClearInclude() *ImportJobUpdateOne {
+	_u.mutation.ClearInclude()
+	return _u
+}
+
+// SetExclude sets the "exclude" field.
+func (_u *ImportJobUpdateOne) SetExclude(v []string) *ImportJobUpdateOne {
+	_u.mutation.SetExclude(v)
+	return _u
+}
+
+// AppendExclude appends value to the "exclude" field.
+func (_u *ImportJobUpdateOne) AppendExclude(v []string) *ImportJobUpdateOne {
+	_u.mutation.AppendExclude(v)
+	return _u
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (_u *ImportJobUpdateOne) ClearExclude() *ImportJobUpdateOne {
+	_u.mutation.ClearExclude()
+	return _u
+}
+
+// SetTotalFiles sets the "total_files" field.
+func (_u *ImportJobUpdateOne) SetTotalFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetTotalFiles()
+	_u.mutation.SetTotalFiles(v)
+	return _u
+}
+
+// SetNillableTotalFiles sets the "total_files" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableTotalFiles(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetTotalFiles(*v)
+	}
+	return _u
+}
+
+// AddTotalFiles adds value to the "total_files" field.
+func (_u *ImportJobUpdateOne) AddTotalFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.AddTotalFiles(v)
+	return _u
+}
+
+// SetProcessedFiles sets the "processed_files" field.
+func (_u *ImportJobUpdateOne) SetProcessedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetProcessedFiles()
+	_u.mutation.SetProcessedFiles(v)
+	return _u
+}
+
+// SetNillableProcessedFiles sets the "processed_files" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableProcessedFiles(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetProcessedFiles(*v)
+	}
+	return _u
+}
+
+// AddProcessedFiles adds value to the "processed_files" field.
+func (_u *ImportJobUpdateOne) AddProcessedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.AddProcessedFiles(v)
+	return _u
+}
+
+// SetFailedFiles sets the "failed_files" field.
+func (_u *ImportJobUpdateOne) SetFailedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetFailedFiles()
+	_u.mutation.SetFailedFiles(v)
+	return _u
+}
+
+// SetNillableFailedFiles sets the "failed_files" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFailedFiles(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFailedFiles(*v)
+	}
+	return _u
+}
+
+// AddFailedFiles adds value to the "failed_files" field.
+func (_u *ImportJobUpdateOne) AddFailedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.AddFailedFiles(v)
+	return _u
+}
+
+// SetSkippedFiles sets the "skipped_files" field.
+func (_u *ImportJobUpdateOne) SetSkippedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetSkippedFiles()
+	_u.mutation.SetSkippedFiles(v)
+	return _u
+}
+
+// SetNillableSkippedFiles sets the "skipped_files" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableSkippedFiles(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetSkippedFiles(*v)
+	}
+	return _u
+}
+
+// AddSkippedFiles adds value to the "skipped_files" field.
+func (_u *ImportJobUpdateOne) AddSkippedFiles(v int) *ImportJobUpdateOne {
+	_u.mutation.AddSkippedFiles(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *ImportJobUpdateOne) SetErrors(v []string) *ImportJobUpdateOne {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *ImportJobUpdateOne) AppendErrors(v []string) *ImportJobUpdateOne {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *ImportJobUpdateOne) ClearErrors() *ImportJobUpdateOne {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ImportJobUpdateOne) SetLastError(v string) *ImportJobUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableLastError(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ImportJobUpdateOne) ClearLastError() *ImportJobUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ImportJobUpdateOne) SetCreatedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableCreatedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdateOne) SetUpdatedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportJobUpdateOne) SetFinishedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFinishedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportJobUpdateOne) ClearFinishedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *ImportJobUpdateOne) SetProjectID(id int) *ImportJobUpdateOne {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableProjectID(id *int) *ImportJobUpdateOne {
+	if id != nil {
+		_u = _u.SetProjectID(*id)
+	}
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ImportJobUpdateOne) SetProject(v *Project) *ImportJobUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdateOne) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ImportJobUpdateOne) ClearProject() *ImportJobUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdateOne) Where(ps ...predicate.ImportJob) *ImportJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportJobUpdateOne) Select(field string, fields ...string) *ImportJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportJob entity.
+func (_u *ImportJobUpdateOne) Save(ctx context.Context) (*ImportJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) SaveX(ctx context.Context) *ImportJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ImportJobUpdateOne) sqlSave(ctx context.Context) (_node *ImportJob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importjob.FieldID)
+		for _, f := range fields {
+			if !importjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ArchiveName(); ok {
+		_spec.SetField(importjob.FieldArchiveName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(importjob.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(importjob.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Include(); ok {
+		_spec.SetField(importjob.FieldInclude, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedInclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldInclude, value)
+		})
+	}
+	if _u.mutation.IncludeCleared() {
+		_spec.ClearField(importjob.FieldInclude, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Exclude(); ok {
+		_spec.SetField(importjob.FieldExclude, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclude(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldExclude, value)
+		})
+	}
+	if _u.mutation.ExcludeCleared() {
+		_spec.ClearField(importjob.FieldExclude, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TotalFiles(); ok {
+		_spec.SetField(importjob.FieldTotalFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFiles(); ok {
+		_spec.AddField(importjob.FieldTotalFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessedFiles(); ok {
+		_spec.SetField(importjob.FieldProcessedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessedFiles(); ok {
+		_spec.AddField(importjob.FieldProcessedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedFiles(); ok {
+		_spec.SetField(importjob.FieldFailedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedFiles(); ok {
+		_spec.AddField(importjob.FieldFailedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SkippedFiles(); ok {
+		_spec.SetField(importjob.FieldSkippedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedFiles(); ok {
+		_spec.AddField(importjob.FieldSkippedFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(importjob.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importjob.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(importjob.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(importjob.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(importjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.ProjectTable,
+			Columns: []string{importjob.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importjob.ProjectTable,
+			Columns: []string{importjob.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ImportJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,6 +14,11 @@ var (
 		{Name: "index", Type: field.TypeInt},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "content_hash", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "start_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "end_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "start_line", Type: field.TypeInt, Nullable: true},
+		{Name: "end_line", Type: field.TypeInt, Nullable: true},
 		{Name: "document_chunks", Type: field.TypeInt, Nullable: true},
 	}
 	// ChunksTable holds the schema information for the "chunks" table.
@@ -24,7 +29,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chunks_documents_chunks",
-				Columns:    []*schema.Column{ChunksColumns[4]},
+				Columns:    []*schema.Column{ChunksColumns[9]},
 				RefColumns: []*schema.Column{DocumentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -37,15 +42,59 @@ var (
 			},
 		},
 	}
+	// ConversationsColumns holds the columns for the "conversations" table.
+	ConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_conversations", Type: field.TypeInt, Nullable: true},
+		{Name: "user_conversations", Type: field.TypeUUID, Nullable: true},
+	}
+	// ConversationsTable holds the schema information for the "conversations" table.
+	ConversationsTable = &schema.Table{
+		Name:       "conversations",
+		Columns:    ConversationsColumns,
+		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversations_projects_conversations",
+				Columns:    []*schema.Column{ConversationsColumns[4]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "conversations_users_conversations",
+				Columns:    []*schema.Column{ConversationsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// DocumentsColumns holds the columns for the "documents" table.
 	DocumentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "source", Type: field.TypeBytes, Nullable: true},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
 		{Name: "content_hash", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeString, Default: "uploaded"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "chunking", "embedding", "indexing", "completed", "failed", "cancelled"}, Default: "queued"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "queued_at", Type: field.TypeTime},
+		{Name: "chunking_at", Type: field.TypeTime, Nullable: true},
+		{Name: "embedding_at", Type: field.TypeTime, Nullable: true},
+		{Name: "indexing_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "commit_sha", Type: field.TypeString, Nullable: true},
+		{Name: "blob_sha", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "project_documents", Type: field.TypeInt, Nullable: true},
+		{Name: "project_source_documents", Type: field.TypeInt, Nullable: true},
 	}
 	// DocumentsTable holds the schema information for the "documents" table.
 	DocumentsTable = &schema.Table{
@@ -55,16 +104,96 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "documents_projects_documents",
-				Columns:    []*schema.Column{DocumentsColumns[6]},
+				Columns:    []*schema.Column{DocumentsColumns[20]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "documents_project_sources_documents",
+				Columns:    []*schema.Column{DocumentsColumns[21]},
+				RefColumns: []*schema.Column{ProjectSourcesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "document_content_hash",
 				Unique:  false,
-				Columns: []*schema.Column{DocumentsColumns[3]},
+				Columns: []*schema.Column{DocumentsColumns[6]},
+			},
+			{
+				Name:    "document_path_project_documents",
+				Unique:  true,
+				Columns: []*schema.Column{DocumentsColumns[2], DocumentsColumns[20]},
+			},
+		},
+	}
+	// ImportJobsColumns holds the columns for the "import_jobs" table.
+	ImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "archive_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "include", Type: field.TypeJSON, Nullable: true},
+		{Name: "exclude", Type: field.TypeJSON, Nullable: true},
+		{Name: "total_files", Type: field.TypeInt, Default: 0},
+		{Name: "processed_files", Type: field.TypeInt, Default: 0},
+		{Name: "failed_files", Type: field.TypeInt, Default: 0},
+		{Name: "skipped_files", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_import_jobs", Type: field.TypeInt, Nullable: true},
+	}
+	// ImportJobsTable holds the schema information for the "import_jobs" table.
+	ImportJobsTable = &schema.Table{
+		Name:       "import_jobs",
+		Columns:    ImportJobsColumns,
+		PrimaryKey: []*schema.Column{ImportJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_jobs_projects_import_jobs",
+				Columns:    []*schema.Column{ImportJobsColumns[15]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// IngestJobsColumns holds the columns for the "ingest_jobs" table.
+	IngestJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString, Default: "process_document"},
+		{Name: "status", Type: field.TypeString, Default: "queued"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 5},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "document_jobs", Type: field.TypeInt, Nullable: true},
+	}
+	// IngestJobsTable holds the schema information for the "ingest_jobs" table.
+	IngestJobsTable = &schema.Table{
+		Name:       "ingest_jobs",
+		Columns:    IngestJobsColumns,
+		PrimaryKey: []*schema.Column{IngestJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ingest_jobs_documents_jobs",
+				Columns:    []*schema.Column{IngestJobsColumns[11]},
+				RefColumns: []*schema.Column{DocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ingestjob_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{IngestJobsColumns[2], IngestJobsColumns[5]},
 			},
 		},
 	}
@@ -74,6 +203,11 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "chunk_strategy", Type: field.TypeString, Default: "auto"},
+		{Name: "chunk_max_size", Type: field.TypeInt, Nullable: true},
+		{Name: "chunk_overlap", Type: field.TypeInt, Nullable: true},
+		{Name: "chunk_heading_level", Type: field.TypeInt, Nullable: true},
+		{Name: "chunk_include_outputs", Type: field.TypeBool, Default: false},
 		{Name: "user_projects", Type: field.TypeUUID, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
@@ -84,18 +218,49 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "projects_users_projects",
-				Columns:    []*schema.Column{ProjectsColumns[4]},
+				Columns:    []*schema.Column{ProjectsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ProjectSourcesColumns holds the columns for the "project_sources" table.
+	ProjectSourcesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Default: "git"},
+		{Name: "url", Type: field.TypeString},
+		{Name: "ref", Type: field.TypeString, Default: "HEAD"},
+		{Name: "last_commit", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "synced_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "project_sources", Type: field.TypeInt, Nullable: true},
+	}
+	// ProjectSourcesTable holds the schema information for the "project_sources" table.
+	ProjectSourcesTable = &schema.Table{
+		Name:       "project_sources",
+		Columns:    ProjectSourcesColumns,
+		PrimaryKey: []*schema.Column{ProjectSourcesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_sources_projects_sources",
+				Columns:    []*schema.Column{ProjectSourcesColumns[10]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// QueryResultsColumns holds the columns for the "query_results" table.
 	QueryResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "rank", Type: field.TypeInt},
 		{Name: "score", Type: field.TypeFloat64},
 		{Name: "content_snippet", Type: field.TypeString, Size: 2147483647},
+		{Name: "document_id", Type: field.TypeInt, Nullable: true},
+		{Name: "document_name", Type: field.TypeString, Nullable: true},
+		{Name: "chunk_index", Type: field.TypeInt, Nullable: true},
 		{Name: "user_prompt_results", Type: field.TypeInt, Nullable: true},
 	}
 	// QueryResultsTable holds the schema information for the "query_results" table.
@@ -106,7 +271,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "query_results_user_prompts_results",
-				Columns:    []*schema.Column{QueryResultsColumns[4]},
+				Columns:    []*schema.Column{QueryResultsColumns[7]},
 				RefColumns: []*schema.Column{UserPromptsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -184,7 +349,9 @@ var (
 	UserPromptsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "query_text", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "conversation_turns", Type: field.TypeInt, Nullable: true},
 		{Name: "project_queries", Type: field.TypeInt, Nullable: true},
 		{Name: "user_queries", Type: field.TypeUUID, Nullable: true},
 	}
@@ -194,20 +361,94 @@ var (
 		Columns:    UserPromptsColumns,
 		PrimaryKey: []*schema.Column{UserPromptsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_prompts_conversations_turns",
+				Columns:    []*schema.Column{UserPromptsColumns[4]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "user_prompts_projects_queries",
-				Columns:    []*schema.Column{UserPromptsColumns[3]},
+				Columns:    []*schema.Column{UserPromptsColumns[5]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "user_prompts_users_queries",
-				Columns:    []*schema.Column{UserPromptsColumns[4]},
+				Columns:    []*schema.Column{UserPromptsColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_webhooks", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_projects_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 8},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[18]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[9]},
+			},
+		},
+	}
 	// ChunkQueryResultsColumns holds the columns for the "chunk_query_results" table.
 	ChunkQueryResultsColumns = []*schema.Column{
 		{Name: "chunk_id", Type: field.TypeInt},
@@ -236,26 +477,41 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChunksTable,
+		ConversationsTable,
 		DocumentsTable,
+		ImportJobsTable,
+		IngestJobsTable,
 		ProjectsTable,
+		ProjectSourcesTable,
 		QueryResultsTable,
 		SecurityQuestionsTable,
 		SessionsTable,
 		UsersTable,
 		UserPromptsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		ChunkQueryResultsTable,
 	}
 )
 
 func init() {
 	ChunksTable.ForeignKeys[0].RefTable = DocumentsTable
+	ConversationsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ConversationsTable.ForeignKeys[1].RefTable = UsersTable
 	DocumentsTable.ForeignKeys[0].RefTable = ProjectsTable
+	DocumentsTable.ForeignKeys[1].RefTable = ProjectSourcesTable
+	ImportJobsTable.ForeignKeys[0].RefTable = ProjectsTable
+	IngestJobsTable.ForeignKeys[0].RefTable = DocumentsTable
 	ProjectsTable.ForeignKeys[0].RefTable = UsersTable
+	ProjectSourcesTable.ForeignKeys[0].RefTable = ProjectsTable
 	QueryResultsTable.ForeignKeys[0].RefTable = UserPromptsTable
 	SecurityQuestionsTable.ForeignKeys[0].RefTable = UsersTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
-	UserPromptsTable.ForeignKeys[0].RefTable = ProjectsTable
-	UserPromptsTable.ForeignKeys[1].RefTable = UsersTable
+	UserPromptsTable.ForeignKeys[0].RefTable = ConversationsTable
+	UserPromptsTable.ForeignKeys[1].RefTable = ProjectsTable
+	UserPromptsTable.ForeignKeys[2].RefTable = UsersTable
+	WebhooksTable.ForeignKeys[0].RefTable = ProjectsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	ChunkQueryResultsTable.ForeignKeys[0].RefTable = ChunksTable
 	ChunkQueryResultsTable.ForeignKeys[1].RefTable = QueryResultsTable
 }
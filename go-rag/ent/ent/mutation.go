@@ -7,14 +7,20 @@ import (
 	"errors"
 	"fmt"
 	"go-rag/ent/ent/chunk"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"go-rag/ent/ent/queryresult"
 	"go-rag/ent/ent/securityquestion"
 	"go-rag/ent/ent/session"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
+	"go-rag/ent/ent/webhook"
+	"go-rag/ent/ent/webhookdelivery"
 	"sync"
 	"time"
 
@@ -33,13 +39,19 @@ const (
 
 	// Node types.
 	TypeChunk            = "Chunk"
+	TypeConversation     = "Conversation"
 	TypeDocument         = "Document"
+	TypeImportJob        = "ImportJob"
+	TypeIngestJob        = "IngestJob"
 	TypeProject          = "Project"
+	TypeProjectSource    = "ProjectSource"
 	TypeQueryResult      = "QueryResult"
 	TypeSecurityQuestion = "SecurityQuestion"
 	TypeSession          = "Session"
 	TypeUser             = "User"
 	TypeUserPrompt       = "UserPrompt"
+	TypeWebhook          = "Webhook"
+	TypeWebhookDelivery  = "WebhookDelivery"
 )
 
 // ChunkMutation represents an operation that mutates the Chunk nodes in the graph.
@@ -52,6 +64,15 @@ type ChunkMutation struct {
 	addindex             *int
 	content              *string
 	content_hash         *string
+	metadata             *map[string]interface{}
+	start_offset         *int
+	addstart_offset      *int
+	end_offset           *int
+	addend_offset        *int
+	start_line           *int
+	addstart_line        *int
+	end_line             *int
+	addend_line          *int
 	clearedFields        map[string]struct{}
 	document             *int
 	cleareddocument      bool
@@ -302,6 +323,335 @@ func (m *ChunkMutation) ResetContentHash() {
 	delete(m.clearedFields, chunk.FieldContentHash)
 }
 
+// SetMetadata sets the "metadata" field.
+func (m *ChunkMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ChunkMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Chunk entity.
+// If the Chunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunkMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ChunkMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[chunk.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ChunkMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[chunk.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ChunkMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, chunk.FieldMetadata)
+}
+
+// SetStartOffset sets the "start_offset" field.
+func (m *ChunkMutation) SetStartOffset(i int) {
+	m.start_offset = &i
+	m.addstart_offset = nil
+}
+
+// StartOffset returns the value of the "start_offset" field in the mutation.
+func (m *ChunkMutation) StartOffset() (r int, exists bool) {
+	v := m.start_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartOffset returns the old "start_offset" field's value of the Chunk entity.
+// If the Chunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunkMutation) OldStartOffset(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartOffset: %w", err)
+	}
+	return oldValue.StartOffset, nil
+}
+
+// AddStartOffset adds i to the "start_offset" field.
+func (m *ChunkMutation) AddStartOffset(i int) {
+	if m.addstart_offset != nil {
+		*m.addstart_offset += i
+	} else {
+		m.addstart_offset = &i
+	}
+}
+
+// AddedStartOffset returns the value that was added to the "start_offset" field in this mutation.
+func (m *ChunkMutation) AddedStartOffset() (r int, exists bool) {
+	v := m.addstart_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartOffset clears the value of the "start_offset" field.
+func (m *ChunkMutation) ClearStartOffset() {
+	m.start_offset = nil
+	m.addstart_offset = nil
+	m.clearedFields[chunk.FieldStartOffset] = struct{}{}
+}
+
+// StartOffsetCleared returns if the "start_offset" field was cleared in this mutation.
+func (m *ChunkMutation) StartOffsetCleared() bool {
+	_, ok := m.clearedFields[chunk.FieldStartOffset]
+	return ok
+}
+
+// ResetStartOffset resets all changes to the "start_offset" field.
+func (m *ChunkMutation) ResetStartOffset() {
+	m.start_offset = nil
+	m.addstart_offset = nil
+	delete(m.clearedFields, chunk.FieldStartOffset)
+}
+
+// SetEndOffset sets the "end_offset" field.
+func (m *ChunkMutation) SetEndOffset(i int) {
+	m.end_offset = &i
+	m.addend_offset = nil
+}
+
+// EndOffset returns the value of the "end_offset" field in the mutation.
+func (m *ChunkMutation) EndOffset() (r int, exists bool) {
+	v := m.end_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndOffset returns the old "end_offset" field's value of the Chunk entity.
+// If the Chunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunkMutation) OldEndOffset(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndOffset: %w", err)
+	}
+	return oldValue.EndOffset, nil
+}
+
+// AddEndOffset adds i to the "end_offset" field.
+func (m *ChunkMutation) AddEndOffset(i int) {
+	if m.addend_offset != nil {
+		*m.addend_offset += i
+	} else {
+		m.addend_offset = &i
+	}
+}
+
+// AddedEndOffset returns the value that was added to the "end_offset" field in this mutation.
+func (m *ChunkMutation) AddedEndOffset() (r int, exists bool) {
+	v := m.addend_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndOffset clears the value of the "end_offset" field.
+func (m *ChunkMutation) ClearEndOffset() {
+	m.end_offset = nil
+	m.addend_offset = nil
+	m.clearedFields[chunk.FieldEndOffset] = struct{}{}
+}
+
+// EndOffsetCleared returns if the "end_offset" field was cleared in this mutation.
+func (m *ChunkMutation) EndOffsetCleared() bool {
+	_, ok := m.clearedFields[chunk.FieldEndOffset]
+	return ok
+}
+
+// ResetEndOffset resets all changes to the "end_offset" field.
+func (m *ChunkMutation) ResetEndOffset() {
+	m.end_offset = nil
+	m.addend_offset = nil
+	delete(m.clearedFields, chunk.FieldEndOffset)
+}
+
+// SetStartLine sets the "start_line" field.
+func (m *ChunkMutation) SetStartLine(i int) {
+	m.start_line = &i
+	m.addstart_line = nil
+}
+
+// StartLine returns the value of the "start_line" field in the mutation.
+func (m *ChunkMutation) StartLine() (r int, exists bool) {
+	v := m.start_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartLine returns the old "start_line" field's value of the Chunk entity.
+// If the Chunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunkMutation) OldStartLine(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartLine: %w", err)
+	}
+	return oldValue.StartLine, nil
+}
+
+// AddStartLine adds i to the "start_line" field.
+func (m *ChunkMutation) AddStartLine(i int) {
+	if m.addstart_line != nil {
+		*m.addstart_line += i
+	} else {
+		m.addstart_line = &i
+	}
+}
+
+// AddedStartLine returns the value that was added to the "start_line" field in this mutation.
+func (m *ChunkMutation) AddedStartLine() (r int, exists bool) {
+	v := m.addstart_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartLine clears the value of the "start_line" field.
+func (m *ChunkMutation) ClearStartLine() {
+	m.start_line = nil
+	m.addstart_line = nil
+	m.clearedFields[chunk.FieldStartLine] = struct{}{}
+}
+
+// StartLineCleared returns if the "start_line" field was cleared in this mutation.
+func (m *ChunkMutation) StartLineCleared() bool {
+	_, ok := m.clearedFields[chunk.FieldStartLine]
+	return ok
+}
+
+// ResetStartLine resets all changes to the "start_line" field.
+func (m *ChunkMutation) ResetStartLine() {
+	m.start_line = nil
+	m.addstart_line = nil
+	delete(m.clearedFields, chunk.FieldStartLine)
+}
+
+// SetEndLine sets the "end_line" field.
+func (m *ChunkMutation) SetEndLine(i int) {
+	m.end_line = &i
+	m.addend_line = nil
+}
+
+// EndLine returns the value of the "end_line" field in the mutation.
+func (m *ChunkMutation) EndLine() (r int, exists bool) {
+	v := m.end_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndLine returns the old "end_line" field's value of the Chunk entity.
+// If the Chunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunkMutation) OldEndLine(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndLine: %w", err)
+	}
+	return oldValue.EndLine, nil
+}
+
+// AddEndLine adds i to the "end_line" field.
+func (m *ChunkMutation) AddEndLine(i int) {
+	if m.addend_line != nil {
+		*m.addend_line += i
+	} else {
+		m.addend_line = &i
+	}
+}
+
+// AddedEndLine returns the value that was added to the "end_line" field in this mutation.
+func (m *ChunkMutation) AddedEndLine() (r int, exists bool) {
+	v := m.addend_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndLine clears the value of the "end_line" field.
+func (m *ChunkMutation) ClearEndLine() {
+	m.end_line = nil
+	m.addend_line = nil
+	m.clearedFields[chunk.FieldEndLine] = struct{}{}
+}
+
+// EndLineCleared returns if the "end_line" field was cleared in this mutation.
+func (m *ChunkMutation) EndLineCleared() bool {
+	_, ok := m.clearedFields[chunk.FieldEndLine]
+	return ok
+}
+
+// ResetEndLine resets all changes to the "end_line" field.
+func (m *ChunkMutation) ResetEndLine() {
+	m.end_line = nil
+	m.addend_line = nil
+	delete(m.clearedFields, chunk.FieldEndLine)
+}
+
 // SetDocumentID sets the "document" edge to the Document entity by id.
 func (m *ChunkMutation) SetDocumentID(id int) {
 	m.document = &id
@@ -429,7 +779,7 @@ func (m *ChunkMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChunkMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 8)
 	if m.index != nil {
 		fields = append(fields, chunk.FieldIndex)
 	}
@@ -439,6 +789,21 @@ func (m *ChunkMutation) Fields() []string {
 	if m.content_hash != nil {
 		fields = append(fields, chunk.FieldContentHash)
 	}
+	if m.metadata != nil {
+		fields = append(fields, chunk.FieldMetadata)
+	}
+	if m.start_offset != nil {
+		fields = append(fields, chunk.FieldStartOffset)
+	}
+	if m.end_offset != nil {
+		fields = append(fields, chunk.FieldEndOffset)
+	}
+	if m.start_line != nil {
+		fields = append(fields, chunk.FieldStartLine)
+	}
+	if m.end_line != nil {
+		fields = append(fields, chunk.FieldEndLine)
+	}
 	return fields
 }
 
@@ -453,6 +818,16 @@ func (m *ChunkMutation) Field(name string) (ent.Value, bool) {
 		return m.Content()
 	case chunk.FieldContentHash:
 		return m.ContentHash()
+	case chunk.FieldMetadata:
+		return m.Metadata()
+	case chunk.FieldStartOffset:
+		return m.StartOffset()
+	case chunk.FieldEndOffset:
+		return m.EndOffset()
+	case chunk.FieldStartLine:
+		return m.StartLine()
+	case chunk.FieldEndLine:
+		return m.EndLine()
 	}
 	return nil, false
 }
@@ -468,6 +843,16 @@ func (m *ChunkMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldContent(ctx)
 	case chunk.FieldContentHash:
 		return m.OldContentHash(ctx)
+	case chunk.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case chunk.FieldStartOffset:
+		return m.OldStartOffset(ctx)
+	case chunk.FieldEndOffset:
+		return m.OldEndOffset(ctx)
+	case chunk.FieldStartLine:
+		return m.OldStartLine(ctx)
+	case chunk.FieldEndLine:
+		return m.OldEndLine(ctx)
 	}
 	return nil, fmt.Errorf("unknown Chunk field %s", name)
 }
@@ -498,6 +883,41 @@ func (m *ChunkMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetContentHash(v)
 		return nil
+	case chunk.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case chunk.FieldStartOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartOffset(v)
+		return nil
+	case chunk.FieldEndOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndOffset(v)
+		return nil
+	case chunk.FieldStartLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartLine(v)
+		return nil
+	case chunk.FieldEndLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndLine(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Chunk field %s", name)
 }
@@ -509,6 +929,18 @@ func (m *ChunkMutation) AddedFields() []string {
 	if m.addindex != nil {
 		fields = append(fields, chunk.FieldIndex)
 	}
+	if m.addstart_offset != nil {
+		fields = append(fields, chunk.FieldStartOffset)
+	}
+	if m.addend_offset != nil {
+		fields = append(fields, chunk.FieldEndOffset)
+	}
+	if m.addstart_line != nil {
+		fields = append(fields, chunk.FieldStartLine)
+	}
+	if m.addend_line != nil {
+		fields = append(fields, chunk.FieldEndLine)
+	}
 	return fields
 }
 
@@ -519,6 +951,14 @@ func (m *ChunkMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case chunk.FieldIndex:
 		return m.AddedIndex()
+	case chunk.FieldStartOffset:
+		return m.AddedStartOffset()
+	case chunk.FieldEndOffset:
+		return m.AddedEndOffset()
+	case chunk.FieldStartLine:
+		return m.AddedStartLine()
+	case chunk.FieldEndLine:
+		return m.AddedEndLine()
 	}
 	return nil, false
 }
@@ -535,6 +975,34 @@ func (m *ChunkMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddIndex(v)
 		return nil
+	case chunk.FieldStartOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartOffset(v)
+		return nil
+	case chunk.FieldEndOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndOffset(v)
+		return nil
+	case chunk.FieldStartLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartLine(v)
+		return nil
+	case chunk.FieldEndLine:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndLine(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Chunk numeric field %s", name)
 }
@@ -546,6 +1014,21 @@ func (m *ChunkMutation) ClearedFields() []string {
 	if m.FieldCleared(chunk.FieldContentHash) {
 		fields = append(fields, chunk.FieldContentHash)
 	}
+	if m.FieldCleared(chunk.FieldMetadata) {
+		fields = append(fields, chunk.FieldMetadata)
+	}
+	if m.FieldCleared(chunk.FieldStartOffset) {
+		fields = append(fields, chunk.FieldStartOffset)
+	}
+	if m.FieldCleared(chunk.FieldEndOffset) {
+		fields = append(fields, chunk.FieldEndOffset)
+	}
+	if m.FieldCleared(chunk.FieldStartLine) {
+		fields = append(fields, chunk.FieldStartLine)
+	}
+	if m.FieldCleared(chunk.FieldEndLine) {
+		fields = append(fields, chunk.FieldEndLine)
+	}
 	return fields
 }
 
@@ -563,6 +1046,21 @@ func (m *ChunkMutation) ClearField(name string) error {
 	case chunk.FieldContentHash:
 		m.ClearContentHash()
 		return nil
+	case chunk.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case chunk.FieldStartOffset:
+		m.ClearStartOffset()
+		return nil
+	case chunk.FieldEndOffset:
+		m.ClearEndOffset()
+		return nil
+	case chunk.FieldStartLine:
+		m.ClearStartLine()
+		return nil
+	case chunk.FieldEndLine:
+		m.ClearEndLine()
+		return nil
 	}
 	return fmt.Errorf("unknown Chunk nullable field %s", name)
 }
@@ -580,9 +1078,24 @@ func (m *ChunkMutation) ResetField(name string) error {
 	case chunk.FieldContentHash:
 		m.ResetContentHash()
 		return nil
-	}
-	return fmt.Errorf("unknown Chunk field %s", name)
-}
+	case chunk.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case chunk.FieldStartOffset:
+		m.ResetStartOffset()
+		return nil
+	case chunk.FieldEndOffset:
+		m.ResetEndOffset()
+		return nil
+	case chunk.FieldStartLine:
+		m.ResetStartLine()
+		return nil
+	case chunk.FieldEndLine:
+		m.ResetEndLine()
+		return nil
+	}
+	return fmt.Errorf("unknown Chunk field %s", name)
+}
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ChunkMutation) AddedEdges() []string {
@@ -686,39 +1199,39 @@ func (m *ChunkMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Chunk edge %s", name)
 }
 
-// DocumentMutation represents an operation that mutates the Document nodes in the graph.
-type DocumentMutation struct {
+// ConversationMutation represents an operation that mutates the Conversation nodes in the graph.
+type ConversationMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *int
-	name           *string
-	content        *string
-	content_hash   *string
-	status         *string
+	title          *string
 	created_at     *time.Time
+	updated_at     *time.Time
 	clearedFields  map[string]struct{}
+	owner          *uuid.UUID
+	clearedowner   bool
 	project        *int
 	clearedproject bool
-	chunks         map[int]struct{}
-	removedchunks  map[int]struct{}
-	clearedchunks  bool
+	turns          map[int]struct{}
+	removedturns   map[int]struct{}
+	clearedturns   bool
 	done           bool
-	oldValue       func(context.Context) (*Document, error)
-	predicates     []predicate.Document
+	oldValue       func(context.Context) (*Conversation, error)
+	predicates     []predicate.Conversation
 }
 
-var _ ent.Mutation = (*DocumentMutation)(nil)
+var _ ent.Mutation = (*ConversationMutation)(nil)
 
-// documentOption allows management of the mutation configuration using functional options.
-type documentOption func(*DocumentMutation)
+// conversationOption allows management of the mutation configuration using functional options.
+type conversationOption func(*ConversationMutation)
 
-// newDocumentMutation creates new mutation for the Document entity.
-func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
-	m := &DocumentMutation{
+// newConversationMutation creates new mutation for the Conversation entity.
+func newConversationMutation(c config, op Op, opts ...conversationOption) *ConversationMutation {
+	m := &ConversationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocument,
+		typ:           TypeConversation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -727,20 +1240,20 @@ func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutat
 	return m
 }
 
-// withDocumentID sets the ID field of the mutation.
-func withDocumentID(id int) documentOption {
-	return func(m *DocumentMutation) {
+// withConversationID sets the ID field of the mutation.
+func withConversationID(id int) conversationOption {
+	return func(m *ConversationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Document
+			value *Conversation
 		)
-		m.oldValue = func(ctx context.Context) (*Document, error) {
+		m.oldValue = func(ctx context.Context) (*Conversation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Document.Get(ctx, id)
+					value, err = m.Client().Conversation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -749,10 +1262,10 @@ func withDocumentID(id int) documentOption {
 	}
 }
 
-// withDocument sets the old Document of the mutation.
-func withDocument(node *Document) documentOption {
-	return func(m *DocumentMutation) {
-		m.oldValue = func(context.Context) (*Document, error) {
+// withConversation sets the old Conversation of the mutation.
+func withConversation(node *Conversation) conversationOption {
+	return func(m *ConversationMutation) {
+		m.oldValue = func(context.Context) (*Conversation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -761,7 +1274,7 @@ func withDocument(node *Document) documentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentMutation) Client() *Client {
+func (m ConversationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -769,7 +1282,7 @@ func (m DocumentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentMutation) Tx() (*Tx, error) {
+func (m ConversationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -780,7 +1293,7 @@ func (m DocumentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentMutation) ID() (id int, exists bool) {
+func (m *ConversationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -791,7 +1304,7 @@ func (m *DocumentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ConversationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -800,222 +1313,189 @@ func (m *DocumentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Conversation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *DocumentMutation) SetName(s string) {
-	m.name = &s
+// SetTitle sets the "title" field.
+func (m *ConversationMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DocumentMutation) Name() (r string, exists bool) {
-	v := m.name
+// Title returns the value of the "title" field in the mutation.
+func (m *ConversationMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ConversationMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *DocumentMutation) ResetName() {
-	m.name = nil
-}
-
-// SetContent sets the "content" field.
-func (m *DocumentMutation) SetContent(s string) {
-	m.content = &s
+	return oldValue.Title, nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *DocumentMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTitle clears the value of the "title" field.
+func (m *ConversationMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[conversation.FieldTitle] = struct{}{}
 }
 
-// OldContent returns the old "content" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
-	}
-	return oldValue.Content, nil
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ConversationMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldTitle]
+	return ok
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *DocumentMutation) ResetContent() {
-	m.content = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *ConversationMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, conversation.FieldTitle)
 }
 
-// SetContentHash sets the "content_hash" field.
-func (m *DocumentMutation) SetContentHash(s string) {
-	m.content_hash = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ConversationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ContentHash returns the value of the "content_hash" field in the mutation.
-func (m *DocumentMutation) ContentHash() (r string, exists bool) {
-	v := m.content_hash
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConversationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentHash returns the old "content_hash" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldContentHash(ctx context.Context) (v string, err error) {
+func (m *ConversationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentHash requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ContentHash, nil
-}
-
-// ClearContentHash clears the value of the "content_hash" field.
-func (m *DocumentMutation) ClearContentHash() {
-	m.content_hash = nil
-	m.clearedFields[document.FieldContentHash] = struct{}{}
-}
-
-// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
-func (m *DocumentMutation) ContentHashCleared() bool {
-	_, ok := m.clearedFields[document.FieldContentHash]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetContentHash resets all changes to the "content_hash" field.
-func (m *DocumentMutation) ResetContentHash() {
-	m.content_hash = nil
-	delete(m.clearedFields, document.FieldContentHash)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConversationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DocumentMutation) SetStatus(s string) {
-	m.status = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConversationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DocumentMutation) Status() (r string, exists bool) {
-	v := m.status
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConversationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ConversationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DocumentMutation) ResetStatus() {
-	m.status = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConversationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DocumentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ConversationMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ConversationMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ConversationMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ConversationMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ConversationMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocumentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ConversationMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
 // SetProjectID sets the "project" edge to the Project entity by id.
-func (m *DocumentMutation) SetProjectID(id int) {
+func (m *ConversationMutation) SetProjectID(id int) {
 	m.project = &id
 }
 
 // ClearProject clears the "project" edge to the Project entity.
-func (m *DocumentMutation) ClearProject() {
+func (m *ConversationMutation) ClearProject() {
 	m.clearedproject = true
 }
 
 // ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *DocumentMutation) ProjectCleared() bool {
+func (m *ConversationMutation) ProjectCleared() bool {
 	return m.clearedproject
 }
 
 // ProjectID returns the "project" edge ID in the mutation.
-func (m *DocumentMutation) ProjectID() (id int, exists bool) {
+func (m *ConversationMutation) ProjectID() (id int, exists bool) {
 	if m.project != nil {
 		return *m.project, true
 	}
@@ -1025,7 +1505,7 @@ func (m *DocumentMutation) ProjectID() (id int, exists bool) {
 // ProjectIDs returns the "project" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProjectID instead. It exists only for internal usage by the builders.
-func (m *DocumentMutation) ProjectIDs() (ids []int) {
+func (m *ConversationMutation) ProjectIDs() (ids []int) {
 	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1033,74 +1513,74 @@ func (m *DocumentMutation) ProjectIDs() (ids []int) {
 }
 
 // ResetProject resets all changes to the "project" edge.
-func (m *DocumentMutation) ResetProject() {
+func (m *ConversationMutation) ResetProject() {
 	m.project = nil
 	m.clearedproject = false
 }
 
-// AddChunkIDs adds the "chunks" edge to the Chunk entity by ids.
-func (m *DocumentMutation) AddChunkIDs(ids ...int) {
-	if m.chunks == nil {
-		m.chunks = make(map[int]struct{})
+// AddTurnIDs adds the "turns" edge to the UserPrompt entity by ids.
+func (m *ConversationMutation) AddTurnIDs(ids ...int) {
+	if m.turns == nil {
+		m.turns = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.chunks[ids[i]] = struct{}{}
+		m.turns[ids[i]] = struct{}{}
 	}
 }
 
-// ClearChunks clears the "chunks" edge to the Chunk entity.
-func (m *DocumentMutation) ClearChunks() {
-	m.clearedchunks = true
+// ClearTurns clears the "turns" edge to the UserPrompt entity.
+func (m *ConversationMutation) ClearTurns() {
+	m.clearedturns = true
 }
 
-// ChunksCleared reports if the "chunks" edge to the Chunk entity was cleared.
-func (m *DocumentMutation) ChunksCleared() bool {
-	return m.clearedchunks
+// TurnsCleared reports if the "turns" edge to the UserPrompt entity was cleared.
+func (m *ConversationMutation) TurnsCleared() bool {
+	return m.clearedturns
 }
 
-// RemoveChunkIDs removes the "chunks" edge to the Chunk entity by IDs.
-func (m *DocumentMutation) RemoveChunkIDs(ids ...int) {
-	if m.removedchunks == nil {
-		m.removedchunks = make(map[int]struct{})
+// RemoveTurnIDs removes the "turns" edge to the UserPrompt entity by IDs.
+func (m *ConversationMutation) RemoveTurnIDs(ids ...int) {
+	if m.removedturns == nil {
+		m.removedturns = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.chunks, ids[i])
-		m.removedchunks[ids[i]] = struct{}{}
+		delete(m.turns, ids[i])
+		m.removedturns[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedChunks returns the removed IDs of the "chunks" edge to the Chunk entity.
-func (m *DocumentMutation) RemovedChunksIDs() (ids []int) {
-	for id := range m.removedchunks {
+// RemovedTurns returns the removed IDs of the "turns" edge to the UserPrompt entity.
+func (m *ConversationMutation) RemovedTurnsIDs() (ids []int) {
+	for id := range m.removedturns {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ChunksIDs returns the "chunks" edge IDs in the mutation.
-func (m *DocumentMutation) ChunksIDs() (ids []int) {
-	for id := range m.chunks {
+// TurnsIDs returns the "turns" edge IDs in the mutation.
+func (m *ConversationMutation) TurnsIDs() (ids []int) {
+	for id := range m.turns {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetChunks resets all changes to the "chunks" edge.
-func (m *DocumentMutation) ResetChunks() {
-	m.chunks = nil
-	m.clearedchunks = false
-	m.removedchunks = nil
+// ResetTurns resets all changes to the "turns" edge.
+func (m *ConversationMutation) ResetTurns() {
+	m.turns = nil
+	m.clearedturns = false
+	m.removedturns = nil
 }
 
-// Where appends a list predicates to the DocumentMutation builder.
-func (m *DocumentMutation) Where(ps ...predicate.Document) {
+// Where appends a list predicates to the ConversationMutation builder.
+func (m *ConversationMutation) Where(ps ...predicate.Conversation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConversationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Document, len(ps))
+func (m *ConversationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Conversation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1108,39 +1588,33 @@ func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DocumentMutation) Op() Op {
+func (m *ConversationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DocumentMutation) SetOp(op Op) {
+func (m *ConversationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Document).
-func (m *DocumentMutation) Type() string {
+// Type returns the node type of this mutation (Conversation).
+func (m *ConversationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocumentMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, document.FieldName)
-	}
-	if m.content != nil {
-		fields = append(fields, document.FieldContent)
-	}
-	if m.content_hash != nil {
-		fields = append(fields, document.FieldContentHash)
-	}
-	if m.status != nil {
-		fields = append(fields, document.FieldStatus)
+func (m *ConversationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.title != nil {
+		fields = append(fields, conversation.FieldTitle)
 	}
 	if m.created_at != nil {
-		fields = append(fields, document.FieldCreatedAt)
+		fields = append(fields, conversation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, conversation.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1148,18 +1622,14 @@ func (m *DocumentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+func (m *ConversationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case document.FieldName:
-		return m.Name()
-	case document.FieldContent:
-		return m.Content()
-	case document.FieldContentHash:
-		return m.ContentHash()
-	case document.FieldStatus:
-		return m.Status()
-	case document.FieldCreatedAt:
+	case conversation.FieldTitle:
+		return m.Title()
+	case conversation.FieldCreatedAt:
 		return m.CreatedAt()
+	case conversation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1167,162 +1637,145 @@ func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConversationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case document.FieldName:
-		return m.OldName(ctx)
-	case document.FieldContent:
-		return m.OldContent(ctx)
-	case document.FieldContentHash:
-		return m.OldContentHash(ctx)
-	case document.FieldStatus:
-		return m.OldStatus(ctx)
-	case document.FieldCreatedAt:
+	case conversation.FieldTitle:
+		return m.OldTitle(ctx)
+	case conversation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case conversation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Document field %s", name)
+	return nil, fmt.Errorf("unknown Conversation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+func (m *ConversationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case document.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case document.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case document.FieldContentHash:
+	case conversation.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentHash(v)
+		m.SetTitle(v)
 		return nil
-	case document.FieldStatus:
-		v, ok := value.(string)
+	case conversation.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetCreatedAt(v)
 		return nil
-	case document.FieldCreatedAt:
+	case conversation.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown Conversation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentMutation) AddedFields() []string {
+func (m *ConversationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConversationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+func (m *ConversationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Document numeric field %s", name)
+	return fmt.Errorf("unknown Conversation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentMutation) ClearedFields() []string {
+func (m *ConversationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(document.FieldContentHash) {
-		fields = append(fields, document.FieldContentHash)
+	if m.FieldCleared(conversation.FieldTitle) {
+		fields = append(fields, conversation.FieldTitle)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentMutation) FieldCleared(name string) bool {
+func (m *ConversationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentMutation) ClearField(name string) error {
+func (m *ConversationMutation) ClearField(name string) error {
 	switch name {
-	case document.FieldContentHash:
-		m.ClearContentHash()
+	case conversation.FieldTitle:
+		m.ClearTitle()
 		return nil
 	}
-	return fmt.Errorf("unknown Document nullable field %s", name)
+	return fmt.Errorf("unknown Conversation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentMutation) ResetField(name string) error {
+func (m *ConversationMutation) ResetField(name string) error {
 	switch name {
-	case document.FieldName:
-		m.ResetName()
-		return nil
-	case document.FieldContent:
-		m.ResetContent()
-		return nil
-	case document.FieldContentHash:
-		m.ResetContentHash()
-		return nil
-	case document.FieldStatus:
-		m.ResetStatus()
+	case conversation.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case document.FieldCreatedAt:
+	case conversation.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case conversation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown Conversation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ConversationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.owner != nil {
+		edges = append(edges, conversation.EdgeOwner)
+	}
 	if m.project != nil {
-		edges = append(edges, document.EdgeProject)
+		edges = append(edges, conversation.EdgeProject)
 	}
-	if m.chunks != nil {
-		edges = append(edges, document.EdgeChunks)
+	if m.turns != nil {
+		edges = append(edges, conversation.EdgeTurns)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+func (m *ConversationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case document.EdgeProject:
+	case conversation.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case conversation.EdgeProject:
 		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
-	case document.EdgeChunks:
-		ids := make([]ent.Value, 0, len(m.chunks))
-		for id := range m.chunks {
+	case conversation.EdgeTurns:
+		ids := make([]ent.Value, 0, len(m.turns))
+		for id := range m.turns {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1331,21 +1784,21 @@ func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchunks != nil {
-		edges = append(edges, document.EdgeChunks)
+func (m *ConversationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedturns != nil {
+		edges = append(edges, conversation.EdgeTurns)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConversationMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case document.EdgeChunks:
-		ids := make([]ent.Value, 0, len(m.removedchunks))
-		for id := range m.removedchunks {
+	case conversation.EdgeTurns:
+		ids := make([]ent.Value, 0, len(m.removedturns))
+		for id := range m.removedturns {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1354,88 +1807,118 @@ func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocumentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ConversationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedowner {
+		edges = append(edges, conversation.EdgeOwner)
+	}
 	if m.clearedproject {
-		edges = append(edges, document.EdgeProject)
+		edges = append(edges, conversation.EdgeProject)
 	}
-	if m.clearedchunks {
-		edges = append(edges, document.EdgeChunks)
+	if m.clearedturns {
+		edges = append(edges, conversation.EdgeTurns)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentMutation) EdgeCleared(name string) bool {
+func (m *ConversationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case document.EdgeProject:
+	case conversation.EdgeOwner:
+		return m.clearedowner
+	case conversation.EdgeProject:
 		return m.clearedproject
-	case document.EdgeChunks:
-		return m.clearedchunks
+	case conversation.EdgeTurns:
+		return m.clearedturns
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentMutation) ClearEdge(name string) error {
+func (m *ConversationMutation) ClearEdge(name string) error {
 	switch name {
-	case document.EdgeProject:
+	case conversation.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case conversation.EdgeProject:
 		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Document unique edge %s", name)
+	return fmt.Errorf("unknown Conversation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentMutation) ResetEdge(name string) error {
+func (m *ConversationMutation) ResetEdge(name string) error {
 	switch name {
-	case document.EdgeProject:
+	case conversation.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case conversation.EdgeProject:
 		m.ResetProject()
 		return nil
-	case document.EdgeChunks:
-		m.ResetChunks()
+	case conversation.EdgeTurns:
+		m.ResetTurns()
 		return nil
 	}
-	return fmt.Errorf("unknown Document edge %s", name)
+	return fmt.Errorf("unknown Conversation edge %s", name)
 }
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	description      *string
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	owner            *uuid.UUID
-	clearedowner     bool
-	documents        map[int]struct{}
-	removeddocuments map[int]struct{}
-	cleareddocuments bool
-	queries          map[int]struct{}
-	removedqueries   map[int]struct{}
-	clearedqueries   bool
-	done             bool
-	oldValue         func(context.Context) (*Project, error)
-	predicates       []predicate.Project
+	op                    Op
+	typ                   string
+	id                    *int
+	name                  *string
+	_path                 *string
+	content               *string
+	source                *[]byte
+	mime_type             *string
+	content_hash          *string
+	status                *document.Status
+	last_error            *string
+	attempts              *int
+	addattempts           *int
+	queued_at             *time.Time
+	chunking_at           *time.Time
+	embedding_at          *time.Time
+	indexing_at           *time.Time
+	completed_at          *time.Time
+	failed_at             *time.Time
+	cancelled_at          *time.Time
+	commit_sha            *string
+	blob_sha              *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	project               *int
+	clearedproject        bool
+	project_source        *int
+	clearedproject_source bool
+	chunks                map[int]struct{}
+	removedchunks         map[int]struct{}
+	clearedchunks         bool
+	jobs                  map[int]struct{}
+	removedjobs           map[int]struct{}
+	clearedjobs           bool
+	done                  bool
+	oldValue              func(context.Context) (*Document, error)
+	predicates            []predicate.Document
 }
 
-var _ ent.Mutation = (*ProjectMutation)(nil)
+var _ ent.Mutation = (*DocumentMutation)(nil)
 
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
 
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProject,
+		typ:           TypeDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1444,20 +1927,20 @@ func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation
 	return m
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id int) projectOption {
-	return func(m *ProjectMutation) {
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id int) documentOption {
+	return func(m *DocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Project
+			value *Document
 		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
+		m.oldValue = func(ctx context.Context) (*Document, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Project.Get(ctx, id)
+					value, err = m.Client().Document.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1466,10 +1949,10 @@ func withProjectID(id int) projectOption {
 	}
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1478,7 +1961,7 @@ func withProject(node *Project) projectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
+func (m DocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1486,7 +1969,7 @@ func (m ProjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
+func (m DocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1497,7 +1980,7 @@ func (m ProjectMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id int, exists bool) {
+func (m *DocumentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1508,7 +1991,7 @@ func (m *ProjectMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DocumentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1517,19 +2000,19 @@ func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ProjectMutation) SetName(s string) {
+func (m *DocumentMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ProjectMutation) Name() (r string, exists bool) {
+func (m *DocumentMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1537,10 +2020,10 @@ func (m *ProjectMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1555,873 +2038,934 @@ func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ProjectMutation) ResetName() {
+func (m *DocumentMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ProjectMutation) SetDescription(s string) {
-	m.description = &s
+// SetPath sets the "path" field.
+func (m *DocumentMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProjectMutation) Description() (r string, exists bool) {
-	v := m.description
+// Path returns the value of the "path" field in the mutation.
+func (m *DocumentMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProjectMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[project.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[project.FieldDescription]
-	return ok
+	return oldValue.Path, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ProjectMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, project.FieldDescription)
+// ResetPath resets all changes to the "path" field.
+func (m *DocumentMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetContent sets the "content" field.
+func (m *DocumentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Content returns the value of the "content" field in the mutation.
+func (m *DocumentMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Content, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProjectMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetContent resets all changes to the "content" field.
+func (m *DocumentMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *ProjectMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *ProjectMutation) ClearOwner() {
-	m.clearedowner = true
-}
-
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *ProjectMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetSource sets the "source" field.
+func (m *DocumentMutation) SetSource(b []byte) {
+	m.source = &b
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *ProjectMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// Source returns the value of the "source" field in the mutation.
+func (m *DocumentMutation) Source() (r []byte, exists bool) {
+	v := m.source
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *ProjectMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldSource returns the old "source" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldSource(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *ProjectMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ClearSource clears the value of the "source" field.
+func (m *DocumentMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[document.FieldSource] = struct{}{}
 }
 
-// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
-func (m *ProjectMutation) AddDocumentIDs(ids ...int) {
-	if m.documents == nil {
-		m.documents = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.documents[ids[i]] = struct{}{}
-	}
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *DocumentMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[document.FieldSource]
+	return ok
 }
 
-// ClearDocuments clears the "documents" edge to the Document entity.
-func (m *ProjectMutation) ClearDocuments() {
-	m.cleareddocuments = true
+// ResetSource resets all changes to the "source" field.
+func (m *DocumentMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, document.FieldSource)
 }
 
-// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
-func (m *ProjectMutation) DocumentsCleared() bool {
-	return m.cleareddocuments
+// SetMimeType sets the "mime_type" field.
+func (m *DocumentMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
-func (m *ProjectMutation) RemoveDocumentIDs(ids ...int) {
-	if m.removeddocuments == nil {
-		m.removeddocuments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.documents, ids[i])
-		m.removeddocuments[ids[i]] = struct{}{}
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *DocumentMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
-func (m *ProjectMutation) RemovedDocumentsIDs() (ids []int) {
-	for id := range m.removeddocuments {
-		ids = append(ids, id)
+// OldMimeType returns the old "mime_type" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
 }
 
-// DocumentsIDs returns the "documents" edge IDs in the mutation.
-func (m *ProjectMutation) DocumentsIDs() (ids []int) {
-	for id := range m.documents {
-		ids = append(ids, id)
-	}
-	return
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *DocumentMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[document.FieldMimeType] = struct{}{}
 }
 
-// ResetDocuments resets all changes to the "documents" edge.
-func (m *ProjectMutation) ResetDocuments() {
-	m.documents = nil
-	m.cleareddocuments = false
-	m.removeddocuments = nil
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *DocumentMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[document.FieldMimeType]
+	return ok
 }
 
-// AddQueryIDs adds the "queries" edge to the UserPrompt entity by ids.
-func (m *ProjectMutation) AddQueryIDs(ids ...int) {
-	if m.queries == nil {
-		m.queries = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.queries[ids[i]] = struct{}{}
-	}
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *DocumentMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, document.FieldMimeType)
 }
 
-// ClearQueries clears the "queries" edge to the UserPrompt entity.
-func (m *ProjectMutation) ClearQueries() {
-	m.clearedqueries = true
+// SetContentHash sets the "content_hash" field.
+func (m *DocumentMutation) SetContentHash(s string) {
+	m.content_hash = &s
 }
 
-// QueriesCleared reports if the "queries" edge to the UserPrompt entity was cleared.
-func (m *ProjectMutation) QueriesCleared() bool {
-	return m.clearedqueries
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *DocumentMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveQueryIDs removes the "queries" edge to the UserPrompt entity by IDs.
-func (m *ProjectMutation) RemoveQueryIDs(ids ...int) {
-	if m.removedqueries == nil {
-		m.removedqueries = make(map[int]struct{})
+// OldContentHash returns the old "content_hash" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.queries, ids[i])
-		m.removedqueries[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
 }
 
-// RemovedQueries returns the removed IDs of the "queries" edge to the UserPrompt entity.
-func (m *ProjectMutation) RemovedQueriesIDs() (ids []int) {
-	for id := range m.removedqueries {
-		ids = append(ids, id)
-	}
-	return
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *DocumentMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[document.FieldContentHash] = struct{}{}
 }
 
-// QueriesIDs returns the "queries" edge IDs in the mutation.
-func (m *ProjectMutation) QueriesIDs() (ids []int) {
-	for id := range m.queries {
-		ids = append(ids, id)
-	}
-	return
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *DocumentMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[document.FieldContentHash]
+	return ok
 }
 
-// ResetQueries resets all changes to the "queries" edge.
-func (m *ProjectMutation) ResetQueries() {
-	m.queries = nil
-	m.clearedqueries = false
-	m.removedqueries = nil
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *DocumentMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, document.FieldContentHash)
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
-	m.predicates = append(m.predicates, ps...)
+// SetStatus sets the "status" field.
+func (m *DocumentMutation) SetStatus(d document.Status) {
+	m.status = &d
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Status returns the value of the "status" field in the mutation.
+func (m *DocumentMutation) Status() (r document.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
-	return m.op
+// OldStatus returns the old "status" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldStatus(ctx context.Context) (v document.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
-	m.op = op
+// ResetStatus resets all changes to the "status" field.
+func (m *DocumentMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
-	return m.typ
+// SetLastError sets the "last_error" field.
+func (m *DocumentMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, project.FieldName)
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *DocumentMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
 	}
-	if m.description != nil {
-		fields = append(fields, project.FieldDescription)
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, project.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case project.FieldName:
-		return m.Name()
-	case project.FieldDescription:
-		return m.Description()
-	case project.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ClearLastError clears the value of the "last_error" field.
+func (m *DocumentMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[document.FieldLastError] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case project.FieldName:
-		return m.OldName(ctx)
-	case project.FieldDescription:
-		return m.OldDescription(ctx)
-	case project.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *DocumentMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[document.FieldLastError]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case project.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case project.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case project.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Project field %s", name)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *DocumentMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, document.FieldLastError)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProjectMutation) AddedFields() []string {
-	return nil
+// SetAttempts sets the "attempts" field.
+func (m *DocumentMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *DocumentMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldAttempts returns the old "attempts" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ProjectMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(project.FieldDescription) {
-		fields = append(fields, project.FieldDescription)
+// AddAttempts adds i to the "attempts" field.
+func (m *DocumentMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *DocumentMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
-	switch name {
-	case project.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *DocumentMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
-	switch name {
-	case project.FieldName:
-		m.ResetName()
-		return nil
-	case project.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case project.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// SetQueuedAt sets the "queued_at" field.
+func (m *DocumentMutation) SetQueuedAt(t time.Time) {
+	m.queued_at = &t
+}
+
+// QueuedAt returns the value of the "queued_at" field in the mutation.
+func (m *DocumentMutation) QueuedAt() (r time.Time, exists bool) {
+	v := m.queued_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, project.EdgeOwner)
+// OldQueuedAt returns the old "queued_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldQueuedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueuedAt is only allowed on UpdateOne operations")
 	}
-	if m.documents != nil {
-		edges = append(edges, project.EdgeDocuments)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueuedAt requires an ID field in the mutation")
 	}
-	if m.queries != nil {
-		edges = append(edges, project.EdgeQueries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueuedAt: %w", err)
 	}
-	return edges
+	return oldValue.QueuedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case project.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.documents))
-		for id := range m.documents {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeQueries:
-		ids := make([]ent.Value, 0, len(m.queries))
-		for id := range m.queries {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetQueuedAt resets all changes to the "queued_at" field.
+func (m *DocumentMutation) ResetQueuedAt() {
+	m.queued_at = nil
+}
+
+// SetChunkingAt sets the "chunking_at" field.
+func (m *DocumentMutation) SetChunkingAt(t time.Time) {
+	m.chunking_at = &t
+}
+
+// ChunkingAt returns the value of the "chunking_at" field in the mutation.
+func (m *DocumentMutation) ChunkingAt() (r time.Time, exists bool) {
+	v := m.chunking_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removeddocuments != nil {
-		edges = append(edges, project.EdgeDocuments)
+// OldChunkingAt returns the old "chunking_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldChunkingAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkingAt is only allowed on UpdateOne operations")
 	}
-	if m.removedqueries != nil {
-		edges = append(edges, project.EdgeQueries)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkingAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkingAt: %w", err)
+	}
+	return oldValue.ChunkingAt, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.removeddocuments))
-		for id := range m.removeddocuments {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeQueries:
-		ids := make([]ent.Value, 0, len(m.removedqueries))
-		for id := range m.removedqueries {
-			ids = append(ids, id)
-		}
-		return ids
+// ClearChunkingAt clears the value of the "chunking_at" field.
+func (m *DocumentMutation) ClearChunkingAt() {
+	m.chunking_at = nil
+	m.clearedFields[document.FieldChunkingAt] = struct{}{}
+}
+
+// ChunkingAtCleared returns if the "chunking_at" field was cleared in this mutation.
+func (m *DocumentMutation) ChunkingAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldChunkingAt]
+	return ok
+}
+
+// ResetChunkingAt resets all changes to the "chunking_at" field.
+func (m *DocumentMutation) ResetChunkingAt() {
+	m.chunking_at = nil
+	delete(m.clearedFields, document.FieldChunkingAt)
+}
+
+// SetEmbeddingAt sets the "embedding_at" field.
+func (m *DocumentMutation) SetEmbeddingAt(t time.Time) {
+	m.embedding_at = &t
+}
+
+// EmbeddingAt returns the value of the "embedding_at" field in the mutation.
+func (m *DocumentMutation) EmbeddingAt() (r time.Time, exists bool) {
+	v := m.embedding_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, project.EdgeOwner)
+// OldEmbeddingAt returns the old "embedding_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldEmbeddingAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingAt is only allowed on UpdateOne operations")
 	}
-	if m.cleareddocuments {
-		edges = append(edges, project.EdgeDocuments)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingAt requires an ID field in the mutation")
 	}
-	if m.clearedqueries {
-		edges = append(edges, project.EdgeQueries)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingAt: %w", err)
 	}
-	return edges
+	return oldValue.EmbeddingAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
-	switch name {
-	case project.EdgeOwner:
-		return m.clearedowner
-	case project.EdgeDocuments:
-		return m.cleareddocuments
-	case project.EdgeQueries:
-		return m.clearedqueries
-	}
-	return false
+// ClearEmbeddingAt clears the value of the "embedding_at" field.
+func (m *DocumentMutation) ClearEmbeddingAt() {
+	m.embedding_at = nil
+	m.clearedFields[document.FieldEmbeddingAt] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
-	switch name {
-	case project.EdgeOwner:
-		m.ClearOwner()
-		return nil
+// EmbeddingAtCleared returns if the "embedding_at" field was cleared in this mutation.
+func (m *DocumentMutation) EmbeddingAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldEmbeddingAt]
+	return ok
+}
+
+// ResetEmbeddingAt resets all changes to the "embedding_at" field.
+func (m *DocumentMutation) ResetEmbeddingAt() {
+	m.embedding_at = nil
+	delete(m.clearedFields, document.FieldEmbeddingAt)
+}
+
+// SetIndexingAt sets the "indexing_at" field.
+func (m *DocumentMutation) SetIndexingAt(t time.Time) {
+	m.indexing_at = &t
+}
+
+// IndexingAt returns the value of the "indexing_at" field in the mutation.
+func (m *DocumentMutation) IndexingAt() (r time.Time, exists bool) {
+	v := m.indexing_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
-	switch name {
-	case project.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case project.EdgeDocuments:
-		m.ResetDocuments()
-		return nil
-	case project.EdgeQueries:
-		m.ResetQueries()
-		return nil
+// OldIndexingAt returns the old "indexing_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldIndexingAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndexingAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndexingAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndexingAt: %w", err)
+	}
+	return oldValue.IndexingAt, nil
 }
 
-// QueryResultMutation represents an operation that mutates the QueryResult nodes in the graph.
-type QueryResultMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	rank            *int
-	addrank         *int
-	score           *float64
-	addscore        *float64
-	content_snippet *string
-	clearedFields   map[string]struct{}
-	query           *int
-	clearedquery    bool
-	chunks          map[int]struct{}
-	removedchunks   map[int]struct{}
-	clearedchunks   bool
-	done            bool
-	oldValue        func(context.Context) (*QueryResult, error)
-	predicates      []predicate.QueryResult
+// ClearIndexingAt clears the value of the "indexing_at" field.
+func (m *DocumentMutation) ClearIndexingAt() {
+	m.indexing_at = nil
+	m.clearedFields[document.FieldIndexingAt] = struct{}{}
 }
 
-var _ ent.Mutation = (*QueryResultMutation)(nil)
-
-// queryresultOption allows management of the mutation configuration using functional options.
-type queryresultOption func(*QueryResultMutation)
+// IndexingAtCleared returns if the "indexing_at" field was cleared in this mutation.
+func (m *DocumentMutation) IndexingAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldIndexingAt]
+	return ok
+}
 
-// newQueryResultMutation creates new mutation for the QueryResult entity.
-func newQueryResultMutation(c config, op Op, opts ...queryresultOption) *QueryResultMutation {
-	m := &QueryResultMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeQueryResult,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ResetIndexingAt resets all changes to the "indexing_at" field.
+func (m *DocumentMutation) ResetIndexingAt() {
+	m.indexing_at = nil
+	delete(m.clearedFields, document.FieldIndexingAt)
 }
 
-// withQueryResultID sets the ID field of the mutation.
-func withQueryResultID(id int) queryresultOption {
-	return func(m *QueryResultMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *QueryResult
-		)
-		m.oldValue = func(ctx context.Context) (*QueryResult, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().QueryResult.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// SetCompletedAt sets the "completed_at" field.
+func (m *DocumentMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// withQueryResult sets the old QueryResult of the mutation.
-func withQueryResult(node *QueryResult) queryresultOption {
-	return func(m *QueryResultMutation) {
-		m.oldValue = func(context.Context) (*QueryResult, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *DocumentMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QueryResultMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldCompletedAt returns the old "completed_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m QueryResultMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *DocumentMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[document.FieldCompletedAt] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *QueryResultMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *DocumentMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldCompletedAt]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *QueryResultMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QueryResult.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *DocumentMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, document.FieldCompletedAt)
 }
 
-// SetRank sets the "rank" field.
-func (m *QueryResultMutation) SetRank(i int) {
-	m.rank = &i
-	m.addrank = nil
+// SetFailedAt sets the "failed_at" field.
+func (m *DocumentMutation) SetFailedAt(t time.Time) {
+	m.failed_at = &t
 }
 
-// Rank returns the value of the "rank" field in the mutation.
-func (m *QueryResultMutation) Rank() (r int, exists bool) {
-	v := m.rank
+// FailedAt returns the value of the "failed_at" field in the mutation.
+func (m *DocumentMutation) FailedAt() (r time.Time, exists bool) {
+	v := m.failed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRank returns the old "rank" field's value of the QueryResult entity.
-// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// OldFailedAt returns the old "failed_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QueryResultMutation) OldRank(ctx context.Context) (v int, err error) {
+func (m *DocumentMutation) OldFailedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRank requires an ID field in the mutation")
+		return v, errors.New("OldFailedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedAt: %w", err)
 	}
-	return oldValue.Rank, nil
+	return oldValue.FailedAt, nil
 }
 
-// AddRank adds i to the "rank" field.
-func (m *QueryResultMutation) AddRank(i int) {
-	if m.addrank != nil {
-		*m.addrank += i
-	} else {
-		m.addrank = &i
-	}
+// ClearFailedAt clears the value of the "failed_at" field.
+func (m *DocumentMutation) ClearFailedAt() {
+	m.failed_at = nil
+	m.clearedFields[document.FieldFailedAt] = struct{}{}
 }
 
-// AddedRank returns the value that was added to the "rank" field in this mutation.
-func (m *QueryResultMutation) AddedRank() (r int, exists bool) {
-	v := m.addrank
-	if v == nil {
-		return
-	}
-	return *v, true
+// FailedAtCleared returns if the "failed_at" field was cleared in this mutation.
+func (m *DocumentMutation) FailedAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldFailedAt]
+	return ok
 }
 
-// ResetRank resets all changes to the "rank" field.
-func (m *QueryResultMutation) ResetRank() {
-	m.rank = nil
-	m.addrank = nil
+// ResetFailedAt resets all changes to the "failed_at" field.
+func (m *DocumentMutation) ResetFailedAt() {
+	m.failed_at = nil
+	delete(m.clearedFields, document.FieldFailedAt)
 }
 
-// SetScore sets the "score" field.
-func (m *QueryResultMutation) SetScore(f float64) {
-	m.score = &f
-	m.addscore = nil
+// SetCancelledAt sets the "cancelled_at" field.
+func (m *DocumentMutation) SetCancelledAt(t time.Time) {
+	m.cancelled_at = &t
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *QueryResultMutation) Score() (r float64, exists bool) {
-	v := m.score
+// CancelledAt returns the value of the "cancelled_at" field in the mutation.
+func (m *DocumentMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the QueryResult entity.
-// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// OldCancelledAt returns the old "cancelled_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QueryResultMutation) OldScore(ctx context.Context) (v float64, err error) {
+func (m *DocumentMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldCancelledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldCancelledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
 	}
-	return oldValue.Score, nil
+	return oldValue.CancelledAt, nil
 }
 
-// AddScore adds f to the "score" field.
-func (m *QueryResultMutation) AddScore(f float64) {
-	if m.addscore != nil {
-		*m.addscore += f
-	} else {
-		m.addscore = &f
-	}
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (m *DocumentMutation) ClearCancelledAt() {
+	m.cancelled_at = nil
+	m.clearedFields[document.FieldCancelledAt] = struct{}{}
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *QueryResultMutation) AddedScore() (r float64, exists bool) {
-	v := m.addscore
-	if v == nil {
-		return
-	}
-	return *v, true
+// CancelledAtCleared returns if the "cancelled_at" field was cleared in this mutation.
+func (m *DocumentMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[document.FieldCancelledAt]
+	return ok
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *QueryResultMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
+// ResetCancelledAt resets all changes to the "cancelled_at" field.
+func (m *DocumentMutation) ResetCancelledAt() {
+	m.cancelled_at = nil
+	delete(m.clearedFields, document.FieldCancelledAt)
 }
 
-// SetContentSnippet sets the "content_snippet" field.
-func (m *QueryResultMutation) SetContentSnippet(s string) {
-	m.content_snippet = &s
+// SetCommitSha sets the "commit_sha" field.
+func (m *DocumentMutation) SetCommitSha(s string) {
+	m.commit_sha = &s
 }
 
-// ContentSnippet returns the value of the "content_snippet" field in the mutation.
-func (m *QueryResultMutation) ContentSnippet() (r string, exists bool) {
-	v := m.content_snippet
+// CommitSha returns the value of the "commit_sha" field in the mutation.
+func (m *DocumentMutation) CommitSha() (r string, exists bool) {
+	v := m.commit_sha
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentSnippet returns the old "content_snippet" field's value of the QueryResult entity.
-// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// OldCommitSha returns the old "commit_sha" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QueryResultMutation) OldContentSnippet(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldCommitSha(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentSnippet is only allowed on UpdateOne operations")
+		return v, errors.New("OldCommitSha is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentSnippet requires an ID field in the mutation")
+		return v, errors.New("OldCommitSha requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentSnippet: %w", err)
+		return v, fmt.Errorf("querying old value for OldCommitSha: %w", err)
 	}
-	return oldValue.ContentSnippet, nil
+	return oldValue.CommitSha, nil
 }
 
-// ResetContentSnippet resets all changes to the "content_snippet" field.
-func (m *QueryResultMutation) ResetContentSnippet() {
-	m.content_snippet = nil
+// ClearCommitSha clears the value of the "commit_sha" field.
+func (m *DocumentMutation) ClearCommitSha() {
+	m.commit_sha = nil
+	m.clearedFields[document.FieldCommitSha] = struct{}{}
 }
 
-// SetQueryID sets the "query" edge to the UserPrompt entity by id.
-func (m *QueryResultMutation) SetQueryID(id int) {
-	m.query = &id
+// CommitShaCleared returns if the "commit_sha" field was cleared in this mutation.
+func (m *DocumentMutation) CommitShaCleared() bool {
+	_, ok := m.clearedFields[document.FieldCommitSha]
+	return ok
 }
 
-// ClearQuery clears the "query" edge to the UserPrompt entity.
-func (m *QueryResultMutation) ClearQuery() {
-	m.clearedquery = true
+// ResetCommitSha resets all changes to the "commit_sha" field.
+func (m *DocumentMutation) ResetCommitSha() {
+	m.commit_sha = nil
+	delete(m.clearedFields, document.FieldCommitSha)
 }
 
-// QueryCleared reports if the "query" edge to the UserPrompt entity was cleared.
-func (m *QueryResultMutation) QueryCleared() bool {
-	return m.clearedquery
+// SetBlobSha sets the "blob_sha" field.
+func (m *DocumentMutation) SetBlobSha(s string) {
+	m.blob_sha = &s
 }
 
-// QueryID returns the "query" edge ID in the mutation.
-func (m *QueryResultMutation) QueryID() (id int, exists bool) {
-	if m.query != nil {
-		return *m.query, true
+// BlobSha returns the value of the "blob_sha" field in the mutation.
+func (m *DocumentMutation) BlobSha() (r string, exists bool) {
+	v := m.blob_sha
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// QueryIDs returns the "query" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QueryID instead. It exists only for internal usage by the builders.
-func (m *QueryResultMutation) QueryIDs() (ids []int) {
-	if id := m.query; id != nil {
-		ids = append(ids, *id)
+// OldBlobSha returns the old "blob_sha" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldBlobSha(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlobSha is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlobSha requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlobSha: %w", err)
+	}
+	return oldValue.BlobSha, nil
 }
 
-// ResetQuery resets all changes to the "query" edge.
-func (m *QueryResultMutation) ResetQuery() {
-	m.query = nil
-	m.clearedquery = false
+// ClearBlobSha clears the value of the "blob_sha" field.
+func (m *DocumentMutation) ClearBlobSha() {
+	m.blob_sha = nil
+	m.clearedFields[document.FieldBlobSha] = struct{}{}
 }
 
-// AddChunkIDs adds the "chunks" edge to the Chunk entity by ids.
-func (m *QueryResultMutation) AddChunkIDs(ids ...int) {
-	if m.chunks == nil {
-		m.chunks = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.chunks[ids[i]] = struct{}{}
-	}
+// BlobShaCleared returns if the "blob_sha" field was cleared in this mutation.
+func (m *DocumentMutation) BlobShaCleared() bool {
+	_, ok := m.clearedFields[document.FieldBlobSha]
+	return ok
 }
 
-// ClearChunks clears the "chunks" edge to the Chunk entity.
-func (m *QueryResultMutation) ClearChunks() {
-	m.clearedchunks = true
+// ResetBlobSha resets all changes to the "blob_sha" field.
+func (m *DocumentMutation) ResetBlobSha() {
+	m.blob_sha = nil
+	delete(m.clearedFields, document.FieldBlobSha)
 }
 
-// ChunksCleared reports if the "chunks" edge to the Chunk entity was cleared.
-func (m *QueryResultMutation) ChunksCleared() bool {
-	return m.clearedchunks
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *DocumentMutation) SetProjectID(id int) {
+	m.project = &id
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *DocumentMutation) ClearProject() {
+	m.clearedproject = true
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *DocumentMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *DocumentMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
+	}
+	return
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *DocumentMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// SetProjectSourceID sets the "project_source" edge to the ProjectSource entity by id.
+func (m *DocumentMutation) SetProjectSourceID(id int) {
+	m.project_source = &id
+}
+
+// ClearProjectSource clears the "project_source" edge to the ProjectSource entity.
+func (m *DocumentMutation) ClearProjectSource() {
+	m.clearedproject_source = true
+}
+
+// ProjectSourceCleared reports if the "project_source" edge to the ProjectSource entity was cleared.
+func (m *DocumentMutation) ProjectSourceCleared() bool {
+	return m.clearedproject_source
+}
+
+// ProjectSourceID returns the "project_source" edge ID in the mutation.
+func (m *DocumentMutation) ProjectSourceID() (id int, exists bool) {
+	if m.project_source != nil {
+		return *m.project_source, true
+	}
+	return
+}
+
+// ProjectSourceIDs returns the "project_source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectSourceID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) ProjectSourceIDs() (ids []int) {
+	if id := m.project_source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProjectSource resets all changes to the "project_source" edge.
+func (m *DocumentMutation) ResetProjectSource() {
+	m.project_source = nil
+	m.clearedproject_source = false
+}
+
+// AddChunkIDs adds the "chunks" edge to the Chunk entity by ids.
+func (m *DocumentMutation) AddChunkIDs(ids ...int) {
+	if m.chunks == nil {
+		m.chunks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.chunks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChunks clears the "chunks" edge to the Chunk entity.
+func (m *DocumentMutation) ClearChunks() {
+	m.clearedchunks = true
+}
+
+// ChunksCleared reports if the "chunks" edge to the Chunk entity was cleared.
+func (m *DocumentMutation) ChunksCleared() bool {
+	return m.clearedchunks
 }
 
 // RemoveChunkIDs removes the "chunks" edge to the Chunk entity by IDs.
-func (m *QueryResultMutation) RemoveChunkIDs(ids ...int) {
+func (m *DocumentMutation) RemoveChunkIDs(ids ...int) {
 	if m.removedchunks == nil {
 		m.removedchunks = make(map[int]struct{})
 	}
@@ -2432,7 +2976,7 @@ func (m *QueryResultMutation) RemoveChunkIDs(ids ...int) {
 }
 
 // RemovedChunks returns the removed IDs of the "chunks" edge to the Chunk entity.
-func (m *QueryResultMutation) RemovedChunksIDs() (ids []int) {
+func (m *DocumentMutation) RemovedChunksIDs() (ids []int) {
 	for id := range m.removedchunks {
 		ids = append(ids, id)
 	}
@@ -2440,7 +2984,7 @@ func (m *QueryResultMutation) RemovedChunksIDs() (ids []int) {
 }
 
 // ChunksIDs returns the "chunks" edge IDs in the mutation.
-func (m *QueryResultMutation) ChunksIDs() (ids []int) {
+func (m *DocumentMutation) ChunksIDs() (ids []int) {
 	for id := range m.chunks {
 		ids = append(ids, id)
 	}
@@ -2448,21 +2992,75 @@ func (m *QueryResultMutation) ChunksIDs() (ids []int) {
 }
 
 // ResetChunks resets all changes to the "chunks" edge.
-func (m *QueryResultMutation) ResetChunks() {
+func (m *DocumentMutation) ResetChunks() {
 	m.chunks = nil
 	m.clearedchunks = false
 	m.removedchunks = nil
 }
 
-// Where appends a list predicates to the QueryResultMutation builder.
-func (m *QueryResultMutation) Where(ps ...predicate.QueryResult) {
+// AddJobIDs adds the "jobs" edge to the IngestJob entity by ids.
+func (m *DocumentMutation) AddJobIDs(ids ...int) {
+	if m.jobs == nil {
+		m.jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the IngestJob entity.
+func (m *DocumentMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the IngestJob entity was cleared.
+func (m *DocumentMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the IngestJob entity by IDs.
+func (m *DocumentMutation) RemoveJobIDs(ids ...int) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the IngestJob entity.
+func (m *DocumentMutation) RemovedJobsIDs() (ids []int) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *DocumentMutation) JobsIDs() (ids []int) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *DocumentMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QueryResultMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QueryResultMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QueryResult, len(ps))
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2470,33 +3068,81 @@ func (m *QueryResultMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QueryResultMutation) Op() Op {
+func (m *DocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QueryResultMutation) SetOp(op Op) {
+func (m *DocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QueryResult).
-func (m *QueryResultMutation) Type() string {
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QueryResultMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.rank != nil {
-		fields = append(fields, queryresult.FieldRank)
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.name != nil {
+		fields = append(fields, document.FieldName)
 	}
-	if m.score != nil {
-		fields = append(fields, queryresult.FieldScore)
+	if m._path != nil {
+		fields = append(fields, document.FieldPath)
 	}
-	if m.content_snippet != nil {
-		fields = append(fields, queryresult.FieldContentSnippet)
+	if m.content != nil {
+		fields = append(fields, document.FieldContent)
+	}
+	if m.source != nil {
+		fields = append(fields, document.FieldSource)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, document.FieldMimeType)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, document.FieldContentHash)
+	}
+	if m.status != nil {
+		fields = append(fields, document.FieldStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, document.FieldLastError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, document.FieldAttempts)
+	}
+	if m.queued_at != nil {
+		fields = append(fields, document.FieldQueuedAt)
+	}
+	if m.chunking_at != nil {
+		fields = append(fields, document.FieldChunkingAt)
+	}
+	if m.embedding_at != nil {
+		fields = append(fields, document.FieldEmbeddingAt)
+	}
+	if m.indexing_at != nil {
+		fields = append(fields, document.FieldIndexingAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, document.FieldCompletedAt)
+	}
+	if m.failed_at != nil {
+		fields = append(fields, document.FieldFailedAt)
+	}
+	if m.cancelled_at != nil {
+		fields = append(fields, document.FieldCancelledAt)
+	}
+	if m.commit_sha != nil {
+		fields = append(fields, document.FieldCommitSha)
+	}
+	if m.blob_sha != nil {
+		fields = append(fields, document.FieldBlobSha)
+	}
+	if m.created_at != nil {
+		fields = append(fields, document.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2504,14 +3150,46 @@ func (m *QueryResultMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QueryResultMutation) Field(name string) (ent.Value, bool) {
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case queryresult.FieldRank:
-		return m.Rank()
-	case queryresult.FieldScore:
-		return m.Score()
-	case queryresult.FieldContentSnippet:
-		return m.ContentSnippet()
+	case document.FieldName:
+		return m.Name()
+	case document.FieldPath:
+		return m.Path()
+	case document.FieldContent:
+		return m.Content()
+	case document.FieldSource:
+		return m.Source()
+	case document.FieldMimeType:
+		return m.MimeType()
+	case document.FieldContentHash:
+		return m.ContentHash()
+	case document.FieldStatus:
+		return m.Status()
+	case document.FieldLastError:
+		return m.LastError()
+	case document.FieldAttempts:
+		return m.Attempts()
+	case document.FieldQueuedAt:
+		return m.QueuedAt()
+	case document.FieldChunkingAt:
+		return m.ChunkingAt()
+	case document.FieldEmbeddingAt:
+		return m.EmbeddingAt()
+	case document.FieldIndexingAt:
+		return m.IndexingAt()
+	case document.FieldCompletedAt:
+		return m.CompletedAt()
+	case document.FieldFailedAt:
+		return m.FailedAt()
+	case document.FieldCancelledAt:
+		return m.CancelledAt()
+	case document.FieldCommitSha:
+		return m.CommitSha()
+	case document.FieldBlobSha:
+		return m.BlobSha()
+	case document.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2519,57 +3197,198 @@ func (m *QueryResultMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QueryResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case queryresult.FieldRank:
-		return m.OldRank(ctx)
-	case queryresult.FieldScore:
-		return m.OldScore(ctx)
-	case queryresult.FieldContentSnippet:
-		return m.OldContentSnippet(ctx)
+	case document.FieldName:
+		return m.OldName(ctx)
+	case document.FieldPath:
+		return m.OldPath(ctx)
+	case document.FieldContent:
+		return m.OldContent(ctx)
+	case document.FieldSource:
+		return m.OldSource(ctx)
+	case document.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case document.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case document.FieldStatus:
+		return m.OldStatus(ctx)
+	case document.FieldLastError:
+		return m.OldLastError(ctx)
+	case document.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case document.FieldQueuedAt:
+		return m.OldQueuedAt(ctx)
+	case document.FieldChunkingAt:
+		return m.OldChunkingAt(ctx)
+	case document.FieldEmbeddingAt:
+		return m.OldEmbeddingAt(ctx)
+	case document.FieldIndexingAt:
+		return m.OldIndexingAt(ctx)
+	case document.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case document.FieldFailedAt:
+		return m.OldFailedAt(ctx)
+	case document.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
+	case document.FieldCommitSha:
+		return m.OldCommitSha(ctx)
+	case document.FieldBlobSha:
+		return m.OldBlobSha(ctx)
+	case document.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QueryResult field %s", name)
+	return nil, fmt.Errorf("unknown Document field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QueryResultMutation) SetField(name string, value ent.Value) error {
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case queryresult.FieldRank:
-		v, ok := value.(int)
+	case document.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRank(v)
+		m.SetName(v)
 		return nil
-	case queryresult.FieldScore:
-		v, ok := value.(float64)
+	case document.FieldPath:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetPath(v)
 		return nil
-	case queryresult.FieldContentSnippet:
+	case document.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentSnippet(v)
+		m.SetContent(v)
+		return nil
+	case document.FieldSource:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case document.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case document.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case document.FieldStatus:
+		v, ok := value.(document.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case document.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case document.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case document.FieldQueuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuedAt(v)
+		return nil
+	case document.FieldChunkingAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkingAt(v)
+		return nil
+	case document.FieldEmbeddingAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingAt(v)
+		return nil
+	case document.FieldIndexingAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexingAt(v)
+		return nil
+	case document.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case document.FieldFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAt(v)
+		return nil
+	case document.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
+	case document.FieldCommitSha:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitSha(v)
+		return nil
+	case document.FieldBlobSha:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlobSha(v)
+		return nil
+	case document.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QueryResult field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *QueryResultMutation) AddedFields() []string {
-	var fields []string
-	if m.addrank != nil {
-		fields = append(fields, queryresult.FieldRank)
-	}
-	if m.addscore != nil {
-		fields = append(fields, queryresult.FieldScore)
+	return fmt.Errorf("unknown Document field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, document.FieldAttempts)
 	}
 	return fields
 }
@@ -2577,12 +3396,10 @@ func (m *QueryResultMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QueryResultMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case queryresult.FieldRank:
-		return m.AddedRank()
-	case queryresult.FieldScore:
-		return m.AddedScore()
+	case document.FieldAttempts:
+		return m.AddedAttempts()
 	}
 	return nil, false
 }
@@ -2590,193 +3407,370 @@ func (m *QueryResultMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QueryResultMutation) AddField(name string, value ent.Value) error {
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case queryresult.FieldRank:
+	case document.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRank(v)
-		return nil
-	case queryresult.FieldScore:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddScore(v)
+		m.AddAttempts(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QueryResult numeric field %s", name)
+	return fmt.Errorf("unknown Document numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QueryResultMutation) ClearedFields() []string {
-	return nil
+func (m *DocumentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(document.FieldSource) {
+		fields = append(fields, document.FieldSource)
+	}
+	if m.FieldCleared(document.FieldMimeType) {
+		fields = append(fields, document.FieldMimeType)
+	}
+	if m.FieldCleared(document.FieldContentHash) {
+		fields = append(fields, document.FieldContentHash)
+	}
+	if m.FieldCleared(document.FieldLastError) {
+		fields = append(fields, document.FieldLastError)
+	}
+	if m.FieldCleared(document.FieldChunkingAt) {
+		fields = append(fields, document.FieldChunkingAt)
+	}
+	if m.FieldCleared(document.FieldEmbeddingAt) {
+		fields = append(fields, document.FieldEmbeddingAt)
+	}
+	if m.FieldCleared(document.FieldIndexingAt) {
+		fields = append(fields, document.FieldIndexingAt)
+	}
+	if m.FieldCleared(document.FieldCompletedAt) {
+		fields = append(fields, document.FieldCompletedAt)
+	}
+	if m.FieldCleared(document.FieldFailedAt) {
+		fields = append(fields, document.FieldFailedAt)
+	}
+	if m.FieldCleared(document.FieldCancelledAt) {
+		fields = append(fields, document.FieldCancelledAt)
+	}
+	if m.FieldCleared(document.FieldCommitSha) {
+		fields = append(fields, document.FieldCommitSha)
+	}
+	if m.FieldCleared(document.FieldBlobSha) {
+		fields = append(fields, document.FieldBlobSha)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QueryResultMutation) FieldCleared(name string) bool {
+func (m *DocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QueryResultMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown QueryResult nullable field %s", name)
+func (m *DocumentMutation) ClearField(name string) error {
+	switch name {
+	case document.FieldSource:
+		m.ClearSource()
+		return nil
+	case document.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case document.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case document.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case document.FieldChunkingAt:
+		m.ClearChunkingAt()
+		return nil
+	case document.FieldEmbeddingAt:
+		m.ClearEmbeddingAt()
+		return nil
+	case document.FieldIndexingAt:
+		m.ClearIndexingAt()
+		return nil
+	case document.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case document.FieldFailedAt:
+		m.ClearFailedAt()
+		return nil
+	case document.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	case document.FieldCommitSha:
+		m.ClearCommitSha()
+		return nil
+	case document.FieldBlobSha:
+		m.ClearBlobSha()
+		return nil
+	}
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QueryResultMutation) ResetField(name string) error {
+func (m *DocumentMutation) ResetField(name string) error {
 	switch name {
-	case queryresult.FieldRank:
-		m.ResetRank()
+	case document.FieldName:
+		m.ResetName()
 		return nil
-	case queryresult.FieldScore:
-		m.ResetScore()
+	case document.FieldPath:
+		m.ResetPath()
 		return nil
-	case queryresult.FieldContentSnippet:
-		m.ResetContentSnippet()
+	case document.FieldContent:
+		m.ResetContent()
+		return nil
+	case document.FieldSource:
+		m.ResetSource()
+		return nil
+	case document.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case document.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case document.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case document.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case document.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case document.FieldQueuedAt:
+		m.ResetQueuedAt()
+		return nil
+	case document.FieldChunkingAt:
+		m.ResetChunkingAt()
+		return nil
+	case document.FieldEmbeddingAt:
+		m.ResetEmbeddingAt()
+		return nil
+	case document.FieldIndexingAt:
+		m.ResetIndexingAt()
+		return nil
+	case document.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case document.FieldFailedAt:
+		m.ResetFailedAt()
+		return nil
+	case document.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
+	case document.FieldCommitSha:
+		m.ResetCommitSha()
+		return nil
+	case document.FieldBlobSha:
+		m.ResetBlobSha()
+		return nil
+	case document.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QueryResult field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QueryResultMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.query != nil {
-		edges = append(edges, queryresult.EdgeQuery)
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.project != nil {
+		edges = append(edges, document.EdgeProject)
+	}
+	if m.project_source != nil {
+		edges = append(edges, document.EdgeProjectSource)
 	}
 	if m.chunks != nil {
-		edges = append(edges, queryresult.EdgeChunks)
+		edges = append(edges, document.EdgeChunks)
+	}
+	if m.jobs != nil {
+		edges = append(edges, document.EdgeJobs)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QueryResultMutation) AddedIDs(name string) []ent.Value {
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case queryresult.EdgeQuery:
-		if id := m.query; id != nil {
+	case document.EdgeProject:
+		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
-	case queryresult.EdgeChunks:
+	case document.EdgeProjectSource:
+		if id := m.project_source; id != nil {
+			return []ent.Value{*id}
+		}
+	case document.EdgeChunks:
 		ids := make([]ent.Value, 0, len(m.chunks))
 		for id := range m.chunks {
 			ids = append(ids, id)
 		}
 		return ids
+	case document.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QueryResultMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.removedchunks != nil {
-		edges = append(edges, queryresult.EdgeChunks)
+		edges = append(edges, document.EdgeChunks)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, document.EdgeJobs)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QueryResultMutation) RemovedIDs(name string) []ent.Value {
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case queryresult.EdgeChunks:
+	case document.EdgeChunks:
 		ids := make([]ent.Value, 0, len(m.removedchunks))
 		for id := range m.removedchunks {
 			ids = append(ids, id)
 		}
 		return ids
+	case document.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QueryResultMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquery {
-		edges = append(edges, queryresult.EdgeQuery)
+func (m *DocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedproject {
+		edges = append(edges, document.EdgeProject)
+	}
+	if m.clearedproject_source {
+		edges = append(edges, document.EdgeProjectSource)
 	}
 	if m.clearedchunks {
-		edges = append(edges, queryresult.EdgeChunks)
+		edges = append(edges, document.EdgeChunks)
+	}
+	if m.clearedjobs {
+		edges = append(edges, document.EdgeJobs)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QueryResultMutation) EdgeCleared(name string) bool {
+func (m *DocumentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case queryresult.EdgeQuery:
-		return m.clearedquery
-	case queryresult.EdgeChunks:
+	case document.EdgeProject:
+		return m.clearedproject
+	case document.EdgeProjectSource:
+		return m.clearedproject_source
+	case document.EdgeChunks:
 		return m.clearedchunks
+	case document.EdgeJobs:
+		return m.clearedjobs
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QueryResultMutation) ClearEdge(name string) error {
+func (m *DocumentMutation) ClearEdge(name string) error {
 	switch name {
-	case queryresult.EdgeQuery:
-		m.ClearQuery()
-		return nil
+	case document.EdgeProject:
+		m.ClearProject()
+		return nil
+	case document.EdgeProjectSource:
+		m.ClearProjectSource()
+		return nil
 	}
-	return fmt.Errorf("unknown QueryResult unique edge %s", name)
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QueryResultMutation) ResetEdge(name string) error {
+func (m *DocumentMutation) ResetEdge(name string) error {
 	switch name {
-	case queryresult.EdgeQuery:
-		m.ResetQuery()
+	case document.EdgeProject:
+		m.ResetProject()
 		return nil
-	case queryresult.EdgeChunks:
+	case document.EdgeProjectSource:
+		m.ResetProjectSource()
+		return nil
+	case document.EdgeChunks:
 		m.ResetChunks()
 		return nil
+	case document.EdgeJobs:
+		m.ResetJobs()
+		return nil
 	}
-	return fmt.Errorf("unknown QueryResult edge %s", name)
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// SecurityQuestionMutation represents an operation that mutates the SecurityQuestion nodes in the graph.
-type SecurityQuestionMutation struct {
+// ImportJobMutation represents an operation that mutates the ImportJob nodes in the graph.
+type ImportJobMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	question      *string
-	answer        *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*SecurityQuestion, error)
-	predicates    []predicate.SecurityQuestion
-}
-
-var _ ent.Mutation = (*SecurityQuestionMutation)(nil)
-
-// securityquestionOption allows management of the mutation configuration using functional options.
-type securityquestionOption func(*SecurityQuestionMutation)
-
-// newSecurityQuestionMutation creates new mutation for the SecurityQuestion entity.
-func newSecurityQuestionMutation(c config, op Op, opts ...securityquestionOption) *SecurityQuestionMutation {
-	m := &SecurityQuestionMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	archive_name       *string
+	status             *string
+	locked_until       *time.Time
+	include            *[]string
+	appendinclude      []string
+	exclude            *[]string
+	appendexclude      []string
+	total_files        *int
+	addtotal_files     *int
+	processed_files    *int
+	addprocessed_files *int
+	failed_files       *int
+	addfailed_files    *int
+	skipped_files      *int
+	addskipped_files   *int
+	errors             *[]string
+	appenderrors       []string
+	last_error         *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	finished_at        *time.Time
+	clearedFields      map[string]struct{}
+	project            *int
+	clearedproject     bool
+	done               bool
+	oldValue           func(context.Context) (*ImportJob, error)
+	predicates         []predicate.ImportJob
+}
+
+var _ ent.Mutation = (*ImportJobMutation)(nil)
+
+// importjobOption allows management of the mutation configuration using functional options.
+type importjobOption func(*ImportJobMutation)
+
+// newImportJobMutation creates new mutation for the ImportJob entity.
+func newImportJobMutation(c config, op Op, opts ...importjobOption) *ImportJobMutation {
+	m := &ImportJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSecurityQuestion,
+		typ:           TypeImportJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2785,20 +3779,20 @@ func newSecurityQuestionMutation(c config, op Op, opts ...securityquestionOption
 	return m
 }
 
-// withSecurityQuestionID sets the ID field of the mutation.
-func withSecurityQuestionID(id uuid.UUID) securityquestionOption {
-	return func(m *SecurityQuestionMutation) {
+// withImportJobID sets the ID field of the mutation.
+func withImportJobID(id int) importjobOption {
+	return func(m *ImportJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SecurityQuestion
+			value *ImportJob
 		)
-		m.oldValue = func(ctx context.Context) (*SecurityQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*ImportJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SecurityQuestion.Get(ctx, id)
+					value, err = m.Client().ImportJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2807,10 +3801,10 @@ func withSecurityQuestionID(id uuid.UUID) securityquestionOption {
 	}
 }
 
-// withSecurityQuestion sets the old SecurityQuestion of the mutation.
-func withSecurityQuestion(node *SecurityQuestion) securityquestionOption {
-	return func(m *SecurityQuestionMutation) {
-		m.oldValue = func(context.Context) (*SecurityQuestion, error) {
+// withImportJob sets the old ImportJob of the mutation.
+func withImportJob(node *ImportJob) importjobOption {
+	return func(m *ImportJobMutation) {
+		m.oldValue = func(context.Context) (*ImportJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2819,7 +3813,7 @@ func withSecurityQuestion(node *SecurityQuestion) securityquestionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SecurityQuestionMutation) Client() *Client {
+func (m ImportJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2827,7 +3821,7 @@ func (m SecurityQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SecurityQuestionMutation) Tx() (*Tx, error) {
+func (m ImportJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2836,15 +3830,9 @@ func (m SecurityQuestionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SecurityQuestion entities.
-func (m *SecurityQuestionMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SecurityQuestionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ImportJobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2855,1192 +3843,9535 @@ func (m *SecurityQuestionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SecurityQuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ImportJobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SecurityQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ImportJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQuestion sets the "question" field.
-func (m *SecurityQuestionMutation) SetQuestion(s string) {
-	m.question = &s
+// SetArchiveName sets the "archive_name" field.
+func (m *ImportJobMutation) SetArchiveName(s string) {
+	m.archive_name = &s
 }
 
-// Question returns the value of the "question" field in the mutation.
-func (m *SecurityQuestionMutation) Question() (r string, exists bool) {
-	v := m.question
+// ArchiveName returns the value of the "archive_name" field in the mutation.
+func (m *ImportJobMutation) ArchiveName() (r string, exists bool) {
+	v := m.archive_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestion returns the old "question" field's value of the SecurityQuestion entity.
-// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldArchiveName returns the old "archive_name" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecurityQuestionMutation) OldQuestion(ctx context.Context) (v string, err error) {
+func (m *ImportJobMutation) OldArchiveName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+		return v, errors.New("OldArchiveName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestion requires an ID field in the mutation")
+		return v, errors.New("OldArchiveName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+		return v, fmt.Errorf("querying old value for OldArchiveName: %w", err)
 	}
-	return oldValue.Question, nil
+	return oldValue.ArchiveName, nil
 }
 
-// ResetQuestion resets all changes to the "question" field.
-func (m *SecurityQuestionMutation) ResetQuestion() {
-	m.question = nil
+// ResetArchiveName resets all changes to the "archive_name" field.
+func (m *ImportJobMutation) ResetArchiveName() {
+	m.archive_name = nil
 }
 
-// SetAnswer sets the "answer" field.
-func (m *SecurityQuestionMutation) SetAnswer(s string) {
-	m.answer = &s
+// SetStatus sets the "status" field.
+func (m *ImportJobMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *SecurityQuestionMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportJobMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the SecurityQuestion entity.
-// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecurityQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+func (m *ImportJobMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Answer, nil
+	return oldValue.Status, nil
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *SecurityQuestionMutation) ResetAnswer() {
-	m.answer = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SecurityQuestionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLockedUntil sets the "locked_until" field.
+func (m *ImportJobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SecurityQuestionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *ImportJobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SecurityQuestion entity.
-// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldLockedUntil returns the old "locked_until" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecurityQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ImportJobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LockedUntil, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SecurityQuestionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *ImportJobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[importjob.FieldLockedUntil] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SecurityQuestionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *ImportJobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldLockedUntil]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SecurityQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *ImportJobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, importjob.FieldLockedUntil)
+}
+
+// SetInclude sets the "include" field.
+func (m *ImportJobMutation) SetInclude(s []string) {
+	m.include = &s
+	m.appendinclude = nil
+}
+
+// Include returns the value of the "include" field in the mutation.
+func (m *ImportJobMutation) Include() (r []string, exists bool) {
+	v := m.include
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the SecurityQuestion entity.
-// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldInclude returns the old "include" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecurityQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ImportJobMutation) OldInclude(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInclude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldInclude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInclude: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Include, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SecurityQuestionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AppendInclude adds s to the "include" field.
+func (m *ImportJobMutation) AppendInclude(s []string) {
+	m.appendinclude = append(m.appendinclude, s...)
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *SecurityQuestionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// AppendedInclude returns the list of values that were appended to the "include" field in this mutation.
+func (m *ImportJobMutation) AppendedInclude() ([]string, bool) {
+	if len(m.appendinclude) == 0 {
+		return nil, false
+	}
+	return m.appendinclude, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SecurityQuestionMutation) ClearUser() {
-	m.cleareduser = true
+// ClearInclude clears the value of the "include" field.
+func (m *ImportJobMutation) ClearInclude() {
+	m.include = nil
+	m.appendinclude = nil
+	m.clearedFields[importjob.FieldInclude] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SecurityQuestionMutation) UserCleared() bool {
-	return m.cleareduser
+// IncludeCleared returns if the "include" field was cleared in this mutation.
+func (m *ImportJobMutation) IncludeCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldInclude]
+	return ok
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *SecurityQuestionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// ResetInclude resets all changes to the "include" field.
+func (m *ImportJobMutation) ResetInclude() {
+	m.include = nil
+	m.appendinclude = nil
+	delete(m.clearedFields, importjob.FieldInclude)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SecurityQuestionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetExclude sets the "exclude" field.
+func (m *ImportJobMutation) SetExclude(s []string) {
+	m.exclude = &s
+	m.appendexclude = nil
+}
+
+// Exclude returns the value of the "exclude" field in the mutation.
+func (m *ImportJobMutation) Exclude() (r []string, exists bool) {
+	v := m.exclude
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SecurityQuestionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldExclude returns the old "exclude" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldExclude(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExclude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExclude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExclude: %w", err)
+	}
+	return oldValue.Exclude, nil
 }
 
-// Where appends a list predicates to the SecurityQuestionMutation builder.
-func (m *SecurityQuestionMutation) Where(ps ...predicate.SecurityQuestion) {
-	m.predicates = append(m.predicates, ps...)
+// AppendExclude adds s to the "exclude" field.
+func (m *ImportJobMutation) AppendExclude(s []string) {
+	m.appendexclude = append(m.appendexclude, s...)
 }
 
-// WhereP appends storage-level predicates to the SecurityQuestionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SecurityQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SecurityQuestion, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AppendedExclude returns the list of values that were appended to the "exclude" field in this mutation.
+func (m *ImportJobMutation) AppendedExclude() ([]string, bool) {
+	if len(m.appendexclude) == 0 {
+		return nil, false
 	}
-	m.Where(p...)
+	return m.appendexclude, true
 }
 
-// Op returns the operation name.
-func (m *SecurityQuestionMutation) Op() Op {
-	return m.op
+// ClearExclude clears the value of the "exclude" field.
+func (m *ImportJobMutation) ClearExclude() {
+	m.exclude = nil
+	m.appendexclude = nil
+	m.clearedFields[importjob.FieldExclude] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SecurityQuestionMutation) SetOp(op Op) {
-	m.op = op
+// ExcludeCleared returns if the "exclude" field was cleared in this mutation.
+func (m *ImportJobMutation) ExcludeCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldExclude]
+	return ok
 }
 
-// Type returns the node type of this mutation (SecurityQuestion).
-func (m *SecurityQuestionMutation) Type() string {
-	return m.typ
+// ResetExclude resets all changes to the "exclude" field.
+func (m *ImportJobMutation) ResetExclude() {
+	m.exclude = nil
+	m.appendexclude = nil
+	delete(m.clearedFields, importjob.FieldExclude)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SecurityQuestionMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.question != nil {
-		fields = append(fields, securityquestion.FieldQuestion)
+// SetTotalFiles sets the "total_files" field.
+func (m *ImportJobMutation) SetTotalFiles(i int) {
+	m.total_files = &i
+	m.addtotal_files = nil
+}
+
+// TotalFiles returns the value of the "total_files" field in the mutation.
+func (m *ImportJobMutation) TotalFiles() (r int, exists bool) {
+	v := m.total_files
+	if v == nil {
+		return
 	}
-	if m.answer != nil {
-		fields = append(fields, securityquestion.FieldAnswer)
+	return *v, true
+}
+
+// OldTotalFiles returns the old "total_files" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldTotalFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalFiles is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, securityquestion.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalFiles requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, securityquestion.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalFiles: %w", err)
 	}
-	return fields
+	return oldValue.TotalFiles, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SecurityQuestionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case securityquestion.FieldQuestion:
-		return m.Question()
-	case securityquestion.FieldAnswer:
-		return m.Answer()
-	case securityquestion.FieldCreatedAt:
-		return m.CreatedAt()
-	case securityquestion.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddTotalFiles adds i to the "total_files" field.
+func (m *ImportJobMutation) AddTotalFiles(i int) {
+	if m.addtotal_files != nil {
+		*m.addtotal_files += i
+	} else {
+		m.addtotal_files = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *SecurityQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case securityquestion.FieldQuestion:
-		return m.OldQuestion(ctx)
-	case securityquestion.FieldAnswer:
-		return m.OldAnswer(ctx)
-	case securityquestion.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case securityquestion.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedTotalFiles returns the value that was added to the "total_files" field in this mutation.
+func (m *ImportJobMutation) AddedTotalFiles() (r int, exists bool) {
+	v := m.addtotal_files
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown SecurityQuestion field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SecurityQuestionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case securityquestion.FieldQuestion:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuestion(v)
-		return nil
-	case securityquestion.FieldAnswer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAnswer(v)
-		return nil
-	case securityquestion.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case securityquestion.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ResetTotalFiles resets all changes to the "total_files" field.
+func (m *ImportJobMutation) ResetTotalFiles() {
+	m.total_files = nil
+	m.addtotal_files = nil
+}
+
+// SetProcessedFiles sets the "processed_files" field.
+func (m *ImportJobMutation) SetProcessedFiles(i int) {
+	m.processed_files = &i
+	m.addprocessed_files = nil
+}
+
+// ProcessedFiles returns the value of the "processed_files" field in the mutation.
+func (m *ImportJobMutation) ProcessedFiles() (r int, exists bool) {
+	v := m.processed_files
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown SecurityQuestion field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SecurityQuestionMutation) AddedFields() []string {
-	return nil
+// OldProcessedFiles returns the old "processed_files" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldProcessedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedFiles: %w", err)
+	}
+	return oldValue.ProcessedFiles, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SecurityQuestionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// AddProcessedFiles adds i to the "processed_files" field.
+func (m *ImportJobMutation) AddProcessedFiles(i int) {
+	if m.addprocessed_files != nil {
+		*m.addprocessed_files += i
+	} else {
+		m.addprocessed_files = &i
+	}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *SecurityQuestionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddedProcessedFiles returns the value that was added to the "processed_files" field in this mutation.
+func (m *ImportJobMutation) AddedProcessedFiles() (r int, exists bool) {
+	v := m.addprocessed_files
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown SecurityQuestion numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SecurityQuestionMutation) ClearedFields() []string {
-	return nil
+// ResetProcessedFiles resets all changes to the "processed_files" field.
+func (m *ImportJobMutation) ResetProcessedFiles() {
+	m.processed_files = nil
+	m.addprocessed_files = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *SecurityQuestionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetFailedFiles sets the "failed_files" field.
+func (m *ImportJobMutation) SetFailedFiles(i int) {
+	m.failed_files = &i
+	m.addfailed_files = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *SecurityQuestionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SecurityQuestion nullable field %s", name)
+// FailedFiles returns the value of the "failed_files" field in the mutation.
+func (m *ImportJobMutation) FailedFiles() (r int, exists bool) {
+	v := m.failed_files
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *SecurityQuestionMutation) ResetField(name string) error {
-	switch name {
-	case securityquestion.FieldQuestion:
-		m.ResetQuestion()
-		return nil
-	case securityquestion.FieldAnswer:
-		m.ResetAnswer()
-		return nil
-	case securityquestion.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case securityquestion.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldFailedFiles returns the old "failed_files" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFailedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedFiles is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown SecurityQuestion field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedFiles: %w", err)
+	}
+	return oldValue.FailedFiles, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SecurityQuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, securityquestion.EdgeUser)
+// AddFailedFiles adds i to the "failed_files" field.
+func (m *ImportJobMutation) AddFailedFiles(i int) {
+	if m.addfailed_files != nil {
+		*m.addfailed_files += i
+	} else {
+		m.addfailed_files = &i
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *SecurityQuestionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case securityquestion.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedFailedFiles returns the value that was added to the "failed_files" field in this mutation.
+func (m *ImportJobMutation) AddedFailedFiles() (r int, exists bool) {
+	v := m.addfailed_files
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SecurityQuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetFailedFiles resets all changes to the "failed_files" field.
+func (m *ImportJobMutation) ResetFailedFiles() {
+	m.failed_files = nil
+	m.addfailed_files = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *SecurityQuestionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetSkippedFiles sets the "skipped_files" field.
+func (m *ImportJobMutation) SetSkippedFiles(i int) {
+	m.skipped_files = &i
+	m.addskipped_files = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SecurityQuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, securityquestion.EdgeUser)
+// SkippedFiles returns the value of the "skipped_files" field in the mutation.
+func (m *ImportJobMutation) SkippedFiles() (r int, exists bool) {
+	v := m.skipped_files
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *SecurityQuestionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case securityquestion.EdgeUser:
-		return m.cleareduser
+// OldSkippedFiles returns the old "skipped_files" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldSkippedFiles(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkippedFiles is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkippedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkippedFiles: %w", err)
+	}
+	return oldValue.SkippedFiles, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *SecurityQuestionMutation) ClearEdge(name string) error {
-	switch name {
-	case securityquestion.EdgeUser:
-		m.ClearUser()
-		return nil
+// AddSkippedFiles adds i to the "skipped_files" field.
+func (m *ImportJobMutation) AddSkippedFiles(i int) {
+	if m.addskipped_files != nil {
+		*m.addskipped_files += i
+	} else {
+		m.addskipped_files = &i
 	}
-	return fmt.Errorf("unknown SecurityQuestion unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SecurityQuestionMutation) ResetEdge(name string) error {
-	switch name {
-	case securityquestion.EdgeUser:
-		m.ResetUser()
-		return nil
+// AddedSkippedFiles returns the value that was added to the "skipped_files" field in this mutation.
+func (m *ImportJobMutation) AddedSkippedFiles() (r int, exists bool) {
+	v := m.addskipped_files
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown SecurityQuestion edge %s", name)
+	return *v, true
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int
-	session_id     *uuid.UUID
-	session_type   *session.SessionType
-	access_token   *string
-	refresh_token  *string
-	device_name    *string
-	last_sync_time *time.Time
-	created_at     *time.Time
-	expires_at     *time.Time
-	revoked_at     *time.Time
-	ip_address     *string
-	user_agent     *string
-	metadata       *map[string]interface{}
-	clearedFields  map[string]struct{}
-	user           *uuid.UUID
-	cleareduser    bool
-	done           bool
-	oldValue       func(context.Context) (*Session, error)
-	predicates     []predicate.Session
+// ResetSkippedFiles resets all changes to the "skipped_files" field.
+func (m *ImportJobMutation) ResetSkippedFiles() {
+	m.skipped_files = nil
+	m.addskipped_files = nil
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+// SetErrors sets the "errors" field.
+func (m *ImportJobMutation) SetErrors(s []string) {
+	m.errors = &s
+	m.appenderrors = nil
+}
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// Errors returns the value of the "errors" field in the mutation.
+func (m *ImportJobMutation) Errors() (r []string, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSession,
-		clearedFields: make(map[string]struct{}),
+// OldErrors returns the old "errors" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldErrors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id int) sessionOption {
-	return func(m *SessionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Session
-		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Session.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// AppendErrors adds s to the "errors" field.
+func (m *ImportJobMutation) AppendErrors(s []string) {
+	m.appenderrors = append(m.appenderrors, s...)
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AppendedErrors returns the list of values that were appended to the "errors" field in this mutation.
+func (m *ImportJobMutation) AppendedErrors() ([]string, bool) {
+	if len(m.appenderrors) == 0 {
+		return nil, false
 	}
+	return m.appenderrors, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearErrors clears the value of the "errors" field.
+func (m *ImportJobMutation) ClearErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	m.clearedFields[importjob.FieldErrors] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *ImportJobMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldErrors]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetErrors resets all changes to the "errors" field.
+func (m *ImportJobMutation) ResetErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	delete(m.clearedFields, importjob.FieldErrors)
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *SessionMutation) SetSessionID(u uuid.UUID) {
-	m.session_id = &u
+// SetLastError sets the "last_error" field.
+func (m *ImportJobMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *SessionMutation) SessionID() (r uuid.UUID, exists bool) {
-	v := m.session_id
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ImportJobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ImportJobMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.SessionID, nil
+	return oldValue.LastError, nil
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *SessionMutation) ResetSessionID() {
-	m.session_id = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *ImportJobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[importjob.FieldLastError] = struct{}{}
 }
 
-// SetSessionsUserids sets the "sessions_userids" field.
-func (m *SessionMutation) SetSessionsUserids(u uuid.UUID) {
-	m.user = &u
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ImportJobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldLastError]
+	return ok
 }
 
-// SessionsUserids returns the value of the "sessions_userids" field in the mutation.
-func (m *SessionMutation) SessionsUserids() (r uuid.UUID, exists bool) {
-	v := m.user
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ImportJobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, importjob.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImportJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImportJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionsUserids returns the old "sessions_userids" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldSessionsUserids(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ImportJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionsUserids is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionsUserids requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionsUserids: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SessionsUserids, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSessionsUserids resets all changes to the "sessions_userids" field.
-func (m *SessionMutation) ResetSessionsUserids() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImportJobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetSessionType sets the "session_type" field.
-func (m *SessionMutation) SetSessionType(st session.SessionType) {
-	m.session_type = &st
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ImportJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// SessionType returns the value of the "session_type" field in the mutation.
-func (m *SessionMutation) SessionType() (r session.SessionType, exists bool) {
-	v := m.session_type
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ImportJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionType returns the old "session_type" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldSessionType(ctx context.Context) (v session.SessionType, err error) {
+func (m *ImportJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionType requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.SessionType, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSessionType resets all changes to the "session_type" field.
-func (m *SessionMutation) ResetSessionType() {
-	m.session_type = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ImportJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetAccessToken sets the "access_token" field.
-func (m *SessionMutation) SetAccessToken(s string) {
-	m.access_token = &s
+// SetFinishedAt sets the "finished_at" field.
+func (m *ImportJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *SessionMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ImportJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessToken returns the old "access_token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+func (m *ImportJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.AccessToken, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *SessionMutation) ResetAccessToken() {
-	m.access_token = nil
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ImportJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[importjob.FieldFinishedAt] = struct{}{}
 }
 
-// SetRefreshToken sets the "refresh_token" field.
-func (m *SessionMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ImportJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldFinishedAt]
+	return ok
 }
 
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *SessionMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ImportJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, importjob.FieldFinishedAt)
 }
 
-// OldRefreshToken returns the old "refresh_token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldRefreshToken(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *ImportJobMutation) SetProjectID(id int) {
+	m.project = &id
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *ImportJobMutation) ClearProject() {
+	m.clearedproject = true
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *ImportJobMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *ImportJobMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	return
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ImportJobMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *ImportJobMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the ImportJobMutation builder.
+func (m *ImportJobMutation) Where(ps ...predicate.ImportJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.RefreshToken, nil
+	m.Where(p...)
 }
 
-// ClearRefreshToken clears the value of the "refresh_token" field.
-func (m *SessionMutation) ClearRefreshToken() {
-	m.refresh_token = nil
-	m.clearedFields[session.FieldRefreshToken] = struct{}{}
+// Op returns the operation name.
+func (m *ImportJobMutation) Op() Op {
+	return m.op
 }
 
-// RefreshTokenCleared returns if the "refresh_token" field was cleared in this mutation.
-func (m *SessionMutation) RefreshTokenCleared() bool {
-	_, ok := m.clearedFields[session.FieldRefreshToken]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *ImportJobMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *SessionMutation) ResetRefreshToken() {
-	m.refresh_token = nil
-	delete(m.clearedFields, session.FieldRefreshToken)
+// Type returns the node type of this mutation (ImportJob).
+func (m *ImportJobMutation) Type() string {
+	return m.typ
 }
 
-// SetDeviceName sets the "device_name" field.
-func (m *SessionMutation) SetDeviceName(s string) {
-	m.device_name = &s
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportJobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.archive_name != nil {
+		fields = append(fields, importjob.FieldArchiveName)
+	}
+	if m.status != nil {
+		fields = append(fields, importjob.FieldStatus)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, importjob.FieldLockedUntil)
+	}
+	if m.include != nil {
+		fields = append(fields, importjob.FieldInclude)
+	}
+	if m.exclude != nil {
+		fields = append(fields, importjob.FieldExclude)
+	}
+	if m.total_files != nil {
+		fields = append(fields, importjob.FieldTotalFiles)
+	}
+	if m.processed_files != nil {
+		fields = append(fields, importjob.FieldProcessedFiles)
+	}
+	if m.failed_files != nil {
+		fields = append(fields, importjob.FieldFailedFiles)
+	}
+	if m.skipped_files != nil {
+		fields = append(fields, importjob.FieldSkippedFiles)
+	}
+	if m.errors != nil {
+		fields = append(fields, importjob.FieldErrors)
+	}
+	if m.last_error != nil {
+		fields = append(fields, importjob.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, importjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, importjob.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldArchiveName:
+		return m.ArchiveName()
+	case importjob.FieldStatus:
+		return m.Status()
+	case importjob.FieldLockedUntil:
+		return m.LockedUntil()
+	case importjob.FieldInclude:
+		return m.Include()
+	case importjob.FieldExclude:
+		return m.Exclude()
+	case importjob.FieldTotalFiles:
+		return m.TotalFiles()
+	case importjob.FieldProcessedFiles:
+		return m.ProcessedFiles()
+	case importjob.FieldFailedFiles:
+		return m.FailedFiles()
+	case importjob.FieldSkippedFiles:
+		return m.SkippedFiles()
+	case importjob.FieldErrors:
+		return m.Errors()
+	case importjob.FieldLastError:
+		return m.LastError()
+	case importjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case importjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case importjob.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importjob.FieldArchiveName:
+		return m.OldArchiveName(ctx)
+	case importjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case importjob.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case importjob.FieldInclude:
+		return m.OldInclude(ctx)
+	case importjob.FieldExclude:
+		return m.OldExclude(ctx)
+	case importjob.FieldTotalFiles:
+		return m.OldTotalFiles(ctx)
+	case importjob.FieldProcessedFiles:
+		return m.OldProcessedFiles(ctx)
+	case importjob.FieldFailedFiles:
+		return m.OldFailedFiles(ctx)
+	case importjob.FieldSkippedFiles:
+		return m.OldSkippedFiles(ctx)
+	case importjob.FieldErrors:
+		return m.OldErrors(ctx)
+	case importjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case importjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case importjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case importjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldArchiveName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchiveName(v)
+		return nil
+	case importjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importjob.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case importjob.FieldInclude:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInclude(v)
+		return nil
+	case importjob.FieldExclude:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExclude(v)
+		return nil
+	case importjob.FieldTotalFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFiles(v)
+		return nil
+	case importjob.FieldProcessedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedFiles(v)
+		return nil
+	case importjob.FieldFailedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedFiles(v)
+		return nil
+	case importjob.FieldSkippedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedFiles(v)
+		return nil
+	case importjob.FieldErrors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case importjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case importjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case importjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case importjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_files != nil {
+		fields = append(fields, importjob.FieldTotalFiles)
+	}
+	if m.addprocessed_files != nil {
+		fields = append(fields, importjob.FieldProcessedFiles)
+	}
+	if m.addfailed_files != nil {
+		fields = append(fields, importjob.FieldFailedFiles)
+	}
+	if m.addskipped_files != nil {
+		fields = append(fields, importjob.FieldSkippedFiles)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldTotalFiles:
+		return m.AddedTotalFiles()
+	case importjob.FieldProcessedFiles:
+		return m.AddedProcessedFiles()
+	case importjob.FieldFailedFiles:
+		return m.AddedFailedFiles()
+	case importjob.FieldSkippedFiles:
+		return m.AddedSkippedFiles()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldTotalFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFiles(v)
+		return nil
+	case importjob.FieldProcessedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessedFiles(v)
+		return nil
+	case importjob.FieldFailedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedFiles(v)
+		return nil
+	case importjob.FieldSkippedFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedFiles(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importjob.FieldLockedUntil) {
+		fields = append(fields, importjob.FieldLockedUntil)
+	}
+	if m.FieldCleared(importjob.FieldInclude) {
+		fields = append(fields, importjob.FieldInclude)
+	}
+	if m.FieldCleared(importjob.FieldExclude) {
+		fields = append(fields, importjob.FieldExclude)
+	}
+	if m.FieldCleared(importjob.FieldErrors) {
+		fields = append(fields, importjob.FieldErrors)
+	}
+	if m.FieldCleared(importjob.FieldLastError) {
+		fields = append(fields, importjob.FieldLastError)
+	}
+	if m.FieldCleared(importjob.FieldFinishedAt) {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportJobMutation) ClearField(name string) error {
+	switch name {
+	case importjob.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case importjob.FieldInclude:
+		m.ClearInclude()
+		return nil
+	case importjob.FieldExclude:
+		m.ClearExclude()
+		return nil
+	case importjob.FieldErrors:
+		m.ClearErrors()
+		return nil
+	case importjob.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportJobMutation) ResetField(name string) error {
+	switch name {
+	case importjob.FieldArchiveName:
+		m.ResetArchiveName()
+		return nil
+	case importjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importjob.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case importjob.FieldInclude:
+		m.ResetInclude()
+		return nil
+	case importjob.FieldExclude:
+		m.ResetExclude()
+		return nil
+	case importjob.FieldTotalFiles:
+		m.ResetTotalFiles()
+		return nil
+	case importjob.FieldProcessedFiles:
+		m.ResetProcessedFiles()
+		return nil
+	case importjob.FieldFailedFiles:
+		m.ResetFailedFiles()
+		return nil
+	case importjob.FieldSkippedFiles:
+		m.ResetSkippedFiles()
+		return nil
+	case importjob.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case importjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case importjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case importjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, importjob.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, importjob.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importjob.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportJobMutation) ClearEdge(name string) error {
+	switch name {
+	case importjob.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportJobMutation) ResetEdge(name string) error {
+	switch name {
+	case importjob.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob edge %s", name)
+}
+
+// IngestJobMutation represents an operation that mutates the IngestJob nodes in the graph.
+type IngestJobMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	kind            *string
+	status          *string
+	attempts        *int
+	addattempts     *int
+	max_attempts    *int
+	addmax_attempts *int
+	run_at          *time.Time
+	locked_until    *time.Time
+	last_error      *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	finished_at     *time.Time
+	clearedFields   map[string]struct{}
+	document        *int
+	cleareddocument bool
+	done            bool
+	oldValue        func(context.Context) (*IngestJob, error)
+	predicates      []predicate.IngestJob
+}
+
+var _ ent.Mutation = (*IngestJobMutation)(nil)
+
+// ingestjobOption allows management of the mutation configuration using functional options.
+type ingestjobOption func(*IngestJobMutation)
+
+// newIngestJobMutation creates new mutation for the IngestJob entity.
+func newIngestJobMutation(c config, op Op, opts ...ingestjobOption) *IngestJobMutation {
+	m := &IngestJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIngestJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIngestJobID sets the ID field of the mutation.
+func withIngestJobID(id int) ingestjobOption {
+	return func(m *IngestJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IngestJob
+		)
+		m.oldValue = func(ctx context.Context) (*IngestJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IngestJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIngestJob sets the old IngestJob of the mutation.
+func withIngestJob(node *IngestJob) ingestjobOption {
+	return func(m *IngestJobMutation) {
+		m.oldValue = func(context.Context) (*IngestJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IngestJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IngestJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IngestJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IngestJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IngestJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *IngestJobMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *IngestJobMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *IngestJobMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *IngestJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IngestJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IngestJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *IngestJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *IngestJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *IngestJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *IngestJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *IngestJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *IngestJobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *IngestJobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *IngestJobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *IngestJobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *IngestJobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetRunAt sets the "run_at" field.
+func (m *IngestJobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
+}
+
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *IngestJobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAt returns the old "run_at" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
+}
+
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *IngestJobMutation) ResetRunAt() {
+	m.run_at = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *IngestJobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *IngestJobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *IngestJobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[ingestjob.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *IngestJobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[ingestjob.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *IngestJobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, ingestjob.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *IngestJobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *IngestJobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *IngestJobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[ingestjob.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *IngestJobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[ingestjob.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *IngestJobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, ingestjob.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IngestJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IngestJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IngestJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IngestJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IngestJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IngestJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *IngestJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *IngestJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the IngestJob entity.
+// If the IngestJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *IngestJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[ingestjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *IngestJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[ingestjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *IngestJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, ingestjob.FieldFinishedAt)
+}
+
+// SetDocumentID sets the "document" edge to the Document entity by id.
+func (m *IngestJobMutation) SetDocumentID(id int) {
+	m.document = &id
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (m *IngestJobMutation) ClearDocument() {
+	m.cleareddocument = true
+}
+
+// DocumentCleared reports if the "document" edge to the Document entity was cleared.
+func (m *IngestJobMutation) DocumentCleared() bool {
+	return m.cleareddocument
+}
+
+// DocumentID returns the "document" edge ID in the mutation.
+func (m *IngestJobMutation) DocumentID() (id int, exists bool) {
+	if m.document != nil {
+		return *m.document, true
+	}
+	return
+}
+
+// DocumentIDs returns the "document" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DocumentID instead. It exists only for internal usage by the builders.
+func (m *IngestJobMutation) DocumentIDs() (ids []int) {
+	if id := m.document; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDocument resets all changes to the "document" edge.
+func (m *IngestJobMutation) ResetDocument() {
+	m.document = nil
+	m.cleareddocument = false
+}
+
+// Where appends a list predicates to the IngestJobMutation builder.
+func (m *IngestJobMutation) Where(ps ...predicate.IngestJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IngestJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IngestJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IngestJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IngestJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IngestJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IngestJob).
+func (m *IngestJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IngestJobMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.kind != nil {
+		fields = append(fields, ingestjob.FieldKind)
+	}
+	if m.status != nil {
+		fields = append(fields, ingestjob.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, ingestjob.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, ingestjob.FieldMaxAttempts)
+	}
+	if m.run_at != nil {
+		fields = append(fields, ingestjob.FieldRunAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, ingestjob.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, ingestjob.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ingestjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ingestjob.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, ingestjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IngestJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ingestjob.FieldKind:
+		return m.Kind()
+	case ingestjob.FieldStatus:
+		return m.Status()
+	case ingestjob.FieldAttempts:
+		return m.Attempts()
+	case ingestjob.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case ingestjob.FieldRunAt:
+		return m.RunAt()
+	case ingestjob.FieldLockedUntil:
+		return m.LockedUntil()
+	case ingestjob.FieldLastError:
+		return m.LastError()
+	case ingestjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case ingestjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case ingestjob.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IngestJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ingestjob.FieldKind:
+		return m.OldKind(ctx)
+	case ingestjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case ingestjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case ingestjob.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case ingestjob.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case ingestjob.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case ingestjob.FieldLastError:
+		return m.OldLastError(ctx)
+	case ingestjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ingestjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case ingestjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IngestJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ingestjob.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ingestjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case ingestjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case ingestjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case ingestjob.FieldRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case ingestjob.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case ingestjob.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case ingestjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ingestjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case ingestjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IngestJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, ingestjob.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, ingestjob.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IngestJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ingestjob.FieldAttempts:
+		return m.AddedAttempts()
+	case ingestjob.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ingestjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case ingestjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IngestJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ingestjob.FieldLockedUntil) {
+		fields = append(fields, ingestjob.FieldLockedUntil)
+	}
+	if m.FieldCleared(ingestjob.FieldLastError) {
+		fields = append(fields, ingestjob.FieldLastError)
+	}
+	if m.FieldCleared(ingestjob.FieldFinishedAt) {
+		fields = append(fields, ingestjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IngestJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IngestJobMutation) ClearField(name string) error {
+	switch name {
+	case ingestjob.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case ingestjob.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case ingestjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IngestJobMutation) ResetField(name string) error {
+	switch name {
+	case ingestjob.FieldKind:
+		m.ResetKind()
+		return nil
+	case ingestjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case ingestjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case ingestjob.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case ingestjob.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case ingestjob.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case ingestjob.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case ingestjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ingestjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case ingestjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IngestJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.document != nil {
+		edges = append(edges, ingestjob.EdgeDocument)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IngestJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ingestjob.EdgeDocument:
+		if id := m.document; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IngestJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IngestJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IngestJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddocument {
+		edges = append(edges, ingestjob.EdgeDocument)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IngestJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ingestjob.EdgeDocument:
+		return m.cleareddocument
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IngestJobMutation) ClearEdge(name string) error {
+	switch name {
+	case ingestjob.EdgeDocument:
+		m.ClearDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IngestJobMutation) ResetEdge(name string) error {
+	switch name {
+	case ingestjob.EdgeDocument:
+		m.ResetDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestJob edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	name                   *string
+	description            *string
+	created_at             *time.Time
+	chunk_strategy         *string
+	chunk_max_size         *int
+	addchunk_max_size      *int
+	chunk_overlap          *int
+	addchunk_overlap       *int
+	chunk_heading_level    *int
+	addchunk_heading_level *int
+	chunk_include_outputs  *bool
+	clearedFields          map[string]struct{}
+	owner                  *uuid.UUID
+	clearedowner           bool
+	documents              map[int]struct{}
+	removeddocuments       map[int]struct{}
+	cleareddocuments       bool
+	queries                map[int]struct{}
+	removedqueries         map[int]struct{}
+	clearedqueries         bool
+	conversations          map[int]struct{}
+	removedconversations   map[int]struct{}
+	clearedconversations   bool
+	import_jobs            map[int]struct{}
+	removedimport_jobs     map[int]struct{}
+	clearedimport_jobs     bool
+	sources                map[int]struct{}
+	removedsources         map[int]struct{}
+	clearedsources         bool
+	webhooks               map[int]struct{}
+	removedwebhooks        map[int]struct{}
+	clearedwebhooks        bool
+	done                   bool
+	oldValue               func(context.Context) (*Project, error)
+	predicates             []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[project.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[project.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, project.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetChunkStrategy sets the "chunk_strategy" field.
+func (m *ProjectMutation) SetChunkStrategy(s string) {
+	m.chunk_strategy = &s
+}
+
+// ChunkStrategy returns the value of the "chunk_strategy" field in the mutation.
+func (m *ProjectMutation) ChunkStrategy() (r string, exists bool) {
+	v := m.chunk_strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkStrategy returns the old "chunk_strategy" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldChunkStrategy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkStrategy: %w", err)
+	}
+	return oldValue.ChunkStrategy, nil
+}
+
+// ResetChunkStrategy resets all changes to the "chunk_strategy" field.
+func (m *ProjectMutation) ResetChunkStrategy() {
+	m.chunk_strategy = nil
+}
+
+// SetChunkMaxSize sets the "chunk_max_size" field.
+func (m *ProjectMutation) SetChunkMaxSize(i int) {
+	m.chunk_max_size = &i
+	m.addchunk_max_size = nil
+}
+
+// ChunkMaxSize returns the value of the "chunk_max_size" field in the mutation.
+func (m *ProjectMutation) ChunkMaxSize() (r int, exists bool) {
+	v := m.chunk_max_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkMaxSize returns the old "chunk_max_size" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldChunkMaxSize(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkMaxSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkMaxSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkMaxSize: %w", err)
+	}
+	return oldValue.ChunkMaxSize, nil
+}
+
+// AddChunkMaxSize adds i to the "chunk_max_size" field.
+func (m *ProjectMutation) AddChunkMaxSize(i int) {
+	if m.addchunk_max_size != nil {
+		*m.addchunk_max_size += i
+	} else {
+		m.addchunk_max_size = &i
+	}
+}
+
+// AddedChunkMaxSize returns the value that was added to the "chunk_max_size" field in this mutation.
+func (m *ProjectMutation) AddedChunkMaxSize() (r int, exists bool) {
+	v := m.addchunk_max_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChunkMaxSize clears the value of the "chunk_max_size" field.
+func (m *ProjectMutation) ClearChunkMaxSize() {
+	m.chunk_max_size = nil
+	m.addchunk_max_size = nil
+	m.clearedFields[project.FieldChunkMaxSize] = struct{}{}
+}
+
+// ChunkMaxSizeCleared returns if the "chunk_max_size" field was cleared in this mutation.
+func (m *ProjectMutation) ChunkMaxSizeCleared() bool {
+	_, ok := m.clearedFields[project.FieldChunkMaxSize]
+	return ok
+}
+
+// ResetChunkMaxSize resets all changes to the "chunk_max_size" field.
+func (m *ProjectMutation) ResetChunkMaxSize() {
+	m.chunk_max_size = nil
+	m.addchunk_max_size = nil
+	delete(m.clearedFields, project.FieldChunkMaxSize)
+}
+
+// SetChunkOverlap sets the "chunk_overlap" field.
+func (m *ProjectMutation) SetChunkOverlap(i int) {
+	m.chunk_overlap = &i
+	m.addchunk_overlap = nil
+}
+
+// ChunkOverlap returns the value of the "chunk_overlap" field in the mutation.
+func (m *ProjectMutation) ChunkOverlap() (r int, exists bool) {
+	v := m.chunk_overlap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkOverlap returns the old "chunk_overlap" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldChunkOverlap(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkOverlap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkOverlap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkOverlap: %w", err)
+	}
+	return oldValue.ChunkOverlap, nil
+}
+
+// AddChunkOverlap adds i to the "chunk_overlap" field.
+func (m *ProjectMutation) AddChunkOverlap(i int) {
+	if m.addchunk_overlap != nil {
+		*m.addchunk_overlap += i
+	} else {
+		m.addchunk_overlap = &i
+	}
+}
+
+// AddedChunkOverlap returns the value that was added to the "chunk_overlap" field in this mutation.
+func (m *ProjectMutation) AddedChunkOverlap() (r int, exists bool) {
+	v := m.addchunk_overlap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChunkOverlap clears the value of the "chunk_overlap" field.
+func (m *ProjectMutation) ClearChunkOverlap() {
+	m.chunk_overlap = nil
+	m.addchunk_overlap = nil
+	m.clearedFields[project.FieldChunkOverlap] = struct{}{}
+}
+
+// ChunkOverlapCleared returns if the "chunk_overlap" field was cleared in this mutation.
+func (m *ProjectMutation) ChunkOverlapCleared() bool {
+	_, ok := m.clearedFields[project.FieldChunkOverlap]
+	return ok
+}
+
+// ResetChunkOverlap resets all changes to the "chunk_overlap" field.
+func (m *ProjectMutation) ResetChunkOverlap() {
+	m.chunk_overlap = nil
+	m.addchunk_overlap = nil
+	delete(m.clearedFields, project.FieldChunkOverlap)
+}
+
+// SetChunkHeadingLevel sets the "chunk_heading_level" field.
+func (m *ProjectMutation) SetChunkHeadingLevel(i int) {
+	m.chunk_heading_level = &i
+	m.addchunk_heading_level = nil
+}
+
+// ChunkHeadingLevel returns the value of the "chunk_heading_level" field in the mutation.
+func (m *ProjectMutation) ChunkHeadingLevel() (r int, exists bool) {
+	v := m.chunk_heading_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkHeadingLevel returns the old "chunk_heading_level" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldChunkHeadingLevel(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkHeadingLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkHeadingLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkHeadingLevel: %w", err)
+	}
+	return oldValue.ChunkHeadingLevel, nil
+}
+
+// AddChunkHeadingLevel adds i to the "chunk_heading_level" field.
+func (m *ProjectMutation) AddChunkHeadingLevel(i int) {
+	if m.addchunk_heading_level != nil {
+		*m.addchunk_heading_level += i
+	} else {
+		m.addchunk_heading_level = &i
+	}
+}
+
+// AddedChunkHeadingLevel returns the value that was added to the "chunk_heading_level" field in this mutation.
+func (m *ProjectMutation) AddedChunkHeadingLevel() (r int, exists bool) {
+	v := m.addchunk_heading_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChunkHeadingLevel clears the value of the "chunk_heading_level" field.
+func (m *ProjectMutation) ClearChunkHeadingLevel() {
+	m.chunk_heading_level = nil
+	m.addchunk_heading_level = nil
+	m.clearedFields[project.FieldChunkHeadingLevel] = struct{}{}
+}
+
+// ChunkHeadingLevelCleared returns if the "chunk_heading_level" field was cleared in this mutation.
+func (m *ProjectMutation) ChunkHeadingLevelCleared() bool {
+	_, ok := m.clearedFields[project.FieldChunkHeadingLevel]
+	return ok
+}
+
+// ResetChunkHeadingLevel resets all changes to the "chunk_heading_level" field.
+func (m *ProjectMutation) ResetChunkHeadingLevel() {
+	m.chunk_heading_level = nil
+	m.addchunk_heading_level = nil
+	delete(m.clearedFields, project.FieldChunkHeadingLevel)
+}
+
+// SetChunkIncludeOutputs sets the "chunk_include_outputs" field.
+func (m *ProjectMutation) SetChunkIncludeOutputs(b bool) {
+	m.chunk_include_outputs = &b
+}
+
+// ChunkIncludeOutputs returns the value of the "chunk_include_outputs" field in the mutation.
+func (m *ProjectMutation) ChunkIncludeOutputs() (r bool, exists bool) {
+	v := m.chunk_include_outputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkIncludeOutputs returns the old "chunk_include_outputs" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldChunkIncludeOutputs(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkIncludeOutputs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkIncludeOutputs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkIncludeOutputs: %w", err)
+	}
+	return oldValue.ChunkIncludeOutputs, nil
+}
+
+// ResetChunkIncludeOutputs resets all changes to the "chunk_include_outputs" field.
+func (m *ProjectMutation) ResetChunkIncludeOutputs() {
+	m.chunk_include_outputs = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ProjectMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ProjectMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ProjectMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ProjectMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ProjectMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ProjectMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *ProjectMutation) AddDocumentIDs(ids ...int) {
+	if m.documents == nil {
+		m.documents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *ProjectMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *ProjectMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *ProjectMutation) RemoveDocumentIDs(ids ...int) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *ProjectMutation) RemovedDocumentsIDs() (ids []int) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *ProjectMutation) DocumentsIDs() (ids []int) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *ProjectMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// AddQueryIDs adds the "queries" edge to the UserPrompt entity by ids.
+func (m *ProjectMutation) AddQueryIDs(ids ...int) {
+	if m.queries == nil {
+		m.queries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.queries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQueries clears the "queries" edge to the UserPrompt entity.
+func (m *ProjectMutation) ClearQueries() {
+	m.clearedqueries = true
+}
+
+// QueriesCleared reports if the "queries" edge to the UserPrompt entity was cleared.
+func (m *ProjectMutation) QueriesCleared() bool {
+	return m.clearedqueries
+}
+
+// RemoveQueryIDs removes the "queries" edge to the UserPrompt entity by IDs.
+func (m *ProjectMutation) RemoveQueryIDs(ids ...int) {
+	if m.removedqueries == nil {
+		m.removedqueries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.queries, ids[i])
+		m.removedqueries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQueries returns the removed IDs of the "queries" edge to the UserPrompt entity.
+func (m *ProjectMutation) RemovedQueriesIDs() (ids []int) {
+	for id := range m.removedqueries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QueriesIDs returns the "queries" edge IDs in the mutation.
+func (m *ProjectMutation) QueriesIDs() (ids []int) {
+	for id := range m.queries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQueries resets all changes to the "queries" edge.
+func (m *ProjectMutation) ResetQueries() {
+	m.queries = nil
+	m.clearedqueries = false
+	m.removedqueries = nil
+}
+
+// AddConversationIDs adds the "conversations" edge to the Conversation entity by ids.
+func (m *ProjectMutation) AddConversationIDs(ids ...int) {
+	if m.conversations == nil {
+		m.conversations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.conversations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConversations clears the "conversations" edge to the Conversation entity.
+func (m *ProjectMutation) ClearConversations() {
+	m.clearedconversations = true
+}
+
+// ConversationsCleared reports if the "conversations" edge to the Conversation entity was cleared.
+func (m *ProjectMutation) ConversationsCleared() bool {
+	return m.clearedconversations
+}
+
+// RemoveConversationIDs removes the "conversations" edge to the Conversation entity by IDs.
+func (m *ProjectMutation) RemoveConversationIDs(ids ...int) {
+	if m.removedconversations == nil {
+		m.removedconversations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.conversations, ids[i])
+		m.removedconversations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConversations returns the removed IDs of the "conversations" edge to the Conversation entity.
+func (m *ProjectMutation) RemovedConversationsIDs() (ids []int) {
+	for id := range m.removedconversations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConversationsIDs returns the "conversations" edge IDs in the mutation.
+func (m *ProjectMutation) ConversationsIDs() (ids []int) {
+	for id := range m.conversations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConversations resets all changes to the "conversations" edge.
+func (m *ProjectMutation) ResetConversations() {
+	m.conversations = nil
+	m.clearedconversations = false
+	m.removedconversations = nil
+}
+
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by ids.
+func (m *ProjectMutation) AddImportJobIDs(ids ...int) {
+	if m.import_jobs == nil {
+		m.import_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportJobs clears the "import_jobs" edge to the ImportJob entity.
+func (m *ProjectMutation) ClearImportJobs() {
+	m.clearedimport_jobs = true
+}
+
+// ImportJobsCleared reports if the "import_jobs" edge to the ImportJob entity was cleared.
+func (m *ProjectMutation) ImportJobsCleared() bool {
+	return m.clearedimport_jobs
+}
+
+// RemoveImportJobIDs removes the "import_jobs" edge to the ImportJob entity by IDs.
+func (m *ProjectMutation) RemoveImportJobIDs(ids ...int) {
+	if m.removedimport_jobs == nil {
+		m.removedimport_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_jobs, ids[i])
+		m.removedimport_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportJobs returns the removed IDs of the "import_jobs" edge to the ImportJob entity.
+func (m *ProjectMutation) RemovedImportJobsIDs() (ids []int) {
+	for id := range m.removedimport_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportJobsIDs returns the "import_jobs" edge IDs in the mutation.
+func (m *ProjectMutation) ImportJobsIDs() (ids []int) {
+	for id := range m.import_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportJobs resets all changes to the "import_jobs" edge.
+func (m *ProjectMutation) ResetImportJobs() {
+	m.import_jobs = nil
+	m.clearedimport_jobs = false
+	m.removedimport_jobs = nil
+}
+
+// AddSourceIDs adds the "sources" edge to the ProjectSource entity by ids.
+func (m *ProjectMutation) AddSourceIDs(ids ...int) {
+	if m.sources == nil {
+		m.sources = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sources[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSources clears the "sources" edge to the ProjectSource entity.
+func (m *ProjectMutation) ClearSources() {
+	m.clearedsources = true
+}
+
+// SourcesCleared reports if the "sources" edge to the ProjectSource entity was cleared.
+func (m *ProjectMutation) SourcesCleared() bool {
+	return m.clearedsources
+}
+
+// RemoveSourceIDs removes the "sources" edge to the ProjectSource entity by IDs.
+func (m *ProjectMutation) RemoveSourceIDs(ids ...int) {
+	if m.removedsources == nil {
+		m.removedsources = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sources, ids[i])
+		m.removedsources[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSources returns the removed IDs of the "sources" edge to the ProjectSource entity.
+func (m *ProjectMutation) RemovedSourcesIDs() (ids []int) {
+	for id := range m.removedsources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SourcesIDs returns the "sources" edge IDs in the mutation.
+func (m *ProjectMutation) SourcesIDs() (ids []int) {
+	for id := range m.sources {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSources resets all changes to the "sources" edge.
+func (m *ProjectMutation) ResetSources() {
+	m.sources = nil
+	m.clearedsources = false
+	m.removedsources = nil
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by ids.
+func (m *ProjectMutation) AddWebhookIDs(ids ...int) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhooks clears the "webhooks" edge to the Webhook entity.
+func (m *ProjectMutation) ClearWebhooks() {
+	m.clearedwebhooks = true
+}
+
+// WebhooksCleared reports if the "webhooks" edge to the Webhook entity was cleared.
+func (m *ProjectMutation) WebhooksCleared() bool {
+	return m.clearedwebhooks
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to the Webhook entity by IDs.
+func (m *ProjectMutation) RemoveWebhookIDs(ids ...int) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.webhooks, ids[i])
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed IDs of the "webhooks" edge to the Webhook entity.
+func (m *ProjectMutation) RemovedWebhooksIDs() (ids []int) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the "webhooks" edge IDs in the mutation.
+func (m *ProjectMutation) WebhooksIDs() (ids []int) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks resets all changes to the "webhooks" edge.
+func (m *ProjectMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.clearedwebhooks = false
+	m.removedwebhooks = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
+	}
+	if m.chunk_strategy != nil {
+		fields = append(fields, project.FieldChunkStrategy)
+	}
+	if m.chunk_max_size != nil {
+		fields = append(fields, project.FieldChunkMaxSize)
+	}
+	if m.chunk_overlap != nil {
+		fields = append(fields, project.FieldChunkOverlap)
+	}
+	if m.chunk_heading_level != nil {
+		fields = append(fields, project.FieldChunkHeadingLevel)
+	}
+	if m.chunk_include_outputs != nil {
+		fields = append(fields, project.FieldChunkIncludeOutputs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldName:
+		return m.Name()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldChunkStrategy:
+		return m.ChunkStrategy()
+	case project.FieldChunkMaxSize:
+		return m.ChunkMaxSize()
+	case project.FieldChunkOverlap:
+		return m.ChunkOverlap()
+	case project.FieldChunkHeadingLevel:
+		return m.ChunkHeadingLevel()
+	case project.FieldChunkIncludeOutputs:
+		return m.ChunkIncludeOutputs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldChunkStrategy:
+		return m.OldChunkStrategy(ctx)
+	case project.FieldChunkMaxSize:
+		return m.OldChunkMaxSize(ctx)
+	case project.FieldChunkOverlap:
+		return m.OldChunkOverlap(ctx)
+	case project.FieldChunkHeadingLevel:
+		return m.OldChunkHeadingLevel(ctx)
+	case project.FieldChunkIncludeOutputs:
+		return m.OldChunkIncludeOutputs(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldChunkStrategy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkStrategy(v)
+		return nil
+	case project.FieldChunkMaxSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkMaxSize(v)
+		return nil
+	case project.FieldChunkOverlap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkOverlap(v)
+		return nil
+	case project.FieldChunkHeadingLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkHeadingLevel(v)
+		return nil
+	case project.FieldChunkIncludeOutputs:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkIncludeOutputs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	var fields []string
+	if m.addchunk_max_size != nil {
+		fields = append(fields, project.FieldChunkMaxSize)
+	}
+	if m.addchunk_overlap != nil {
+		fields = append(fields, project.FieldChunkOverlap)
+	}
+	if m.addchunk_heading_level != nil {
+		fields = append(fields, project.FieldChunkHeadingLevel)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldChunkMaxSize:
+		return m.AddedChunkMaxSize()
+	case project.FieldChunkOverlap:
+		return m.AddedChunkOverlap()
+	case project.FieldChunkHeadingLevel:
+		return m.AddedChunkHeadingLevel()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldChunkMaxSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkMaxSize(v)
+		return nil
+	case project.FieldChunkOverlap:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkOverlap(v)
+		return nil
+	case project.FieldChunkHeadingLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkHeadingLevel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDescription) {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.FieldCleared(project.FieldChunkMaxSize) {
+		fields = append(fields, project.FieldChunkMaxSize)
+	}
+	if m.FieldCleared(project.FieldChunkOverlap) {
+		fields = append(fields, project.FieldChunkOverlap)
+	}
+	if m.FieldCleared(project.FieldChunkHeadingLevel) {
+		fields = append(fields, project.FieldChunkHeadingLevel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case project.FieldChunkMaxSize:
+		m.ClearChunkMaxSize()
+		return nil
+	case project.FieldChunkOverlap:
+		m.ClearChunkOverlap()
+		return nil
+	case project.FieldChunkHeadingLevel:
+		m.ClearChunkHeadingLevel()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldChunkStrategy:
+		m.ResetChunkStrategy()
+		return nil
+	case project.FieldChunkMaxSize:
+		m.ResetChunkMaxSize()
+		return nil
+	case project.FieldChunkOverlap:
+		m.ResetChunkOverlap()
+		return nil
+	case project.FieldChunkHeadingLevel:
+		m.ResetChunkHeadingLevel()
+		return nil
+	case project.FieldChunkIncludeOutputs:
+		m.ResetChunkIncludeOutputs()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.owner != nil {
+		edges = append(edges, project.EdgeOwner)
+	}
+	if m.documents != nil {
+		edges = append(edges, project.EdgeDocuments)
+	}
+	if m.queries != nil {
+		edges = append(edges, project.EdgeQueries)
+	}
+	if m.conversations != nil {
+		edges = append(edges, project.EdgeConversations)
+	}
+	if m.import_jobs != nil {
+		edges = append(edges, project.EdgeImportJobs)
+	}
+	if m.sources != nil {
+		edges = append(edges, project.EdgeSources)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, project.EdgeWebhooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case project.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeQueries:
+		ids := make([]ent.Value, 0, len(m.queries))
+		for id := range m.queries {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeConversations:
+		ids := make([]ent.Value, 0, len(m.conversations))
+		for id := range m.conversations {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeImportJobs:
+		ids := make([]ent.Value, 0, len(m.import_jobs))
+		for id := range m.import_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeSources:
+		ids := make([]ent.Value, 0, len(m.sources))
+		for id := range m.sources {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removeddocuments != nil {
+		edges = append(edges, project.EdgeDocuments)
+	}
+	if m.removedqueries != nil {
+		edges = append(edges, project.EdgeQueries)
+	}
+	if m.removedconversations != nil {
+		edges = append(edges, project.EdgeConversations)
+	}
+	if m.removedimport_jobs != nil {
+		edges = append(edges, project.EdgeImportJobs)
+	}
+	if m.removedsources != nil {
+		edges = append(edges, project.EdgeSources)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, project.EdgeWebhooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeQueries:
+		ids := make([]ent.Value, 0, len(m.removedqueries))
+		for id := range m.removedqueries {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeConversations:
+		ids := make([]ent.Value, 0, len(m.removedconversations))
+		for id := range m.removedconversations {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeImportJobs:
+		ids := make([]ent.Value, 0, len(m.removedimport_jobs))
+		for id := range m.removedimport_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeSources:
+		ids := make([]ent.Value, 0, len(m.removedsources))
+		for id := range m.removedsources {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedowner {
+		edges = append(edges, project.EdgeOwner)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, project.EdgeDocuments)
+	}
+	if m.clearedqueries {
+		edges = append(edges, project.EdgeQueries)
+	}
+	if m.clearedconversations {
+		edges = append(edges, project.EdgeConversations)
+	}
+	if m.clearedimport_jobs {
+		edges = append(edges, project.EdgeImportJobs)
+	}
+	if m.clearedsources {
+		edges = append(edges, project.EdgeSources)
+	}
+	if m.clearedwebhooks {
+		edges = append(edges, project.EdgeWebhooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeOwner:
+		return m.clearedowner
+	case project.EdgeDocuments:
+		return m.cleareddocuments
+	case project.EdgeQueries:
+		return m.clearedqueries
+	case project.EdgeConversations:
+		return m.clearedconversations
+	case project.EdgeImportJobs:
+		return m.clearedimport_jobs
+	case project.EdgeSources:
+		return m.clearedsources
+	case project.EdgeWebhooks:
+		return m.clearedwebhooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	case project.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case project.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case project.EdgeQueries:
+		m.ResetQueries()
+		return nil
+	case project.EdgeConversations:
+		m.ResetConversations()
+		return nil
+	case project.EdgeImportJobs:
+		m.ResetImportJobs()
+		return nil
+	case project.EdgeSources:
+		m.ResetSources()
+		return nil
+	case project.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// ProjectSourceMutation represents an operation that mutates the ProjectSource nodes in the graph.
+type ProjectSourceMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	_type            *string
+	url              *string
+	ref              *string
+	last_commit      *string
+	status           *string
+	locked_until     *time.Time
+	last_error       *string
+	synced_at        *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	project          *int
+	clearedproject   bool
+	documents        map[int]struct{}
+	removeddocuments map[int]struct{}
+	cleareddocuments bool
+	done             bool
+	oldValue         func(context.Context) (*ProjectSource, error)
+	predicates       []predicate.ProjectSource
+}
+
+var _ ent.Mutation = (*ProjectSourceMutation)(nil)
+
+// projectsourceOption allows management of the mutation configuration using functional options.
+type projectsourceOption func(*ProjectSourceMutation)
+
+// newProjectSourceMutation creates new mutation for the ProjectSource entity.
+func newProjectSourceMutation(c config, op Op, opts ...projectsourceOption) *ProjectSourceMutation {
+	m := &ProjectSourceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjectSource,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectSourceID sets the ID field of the mutation.
+func withProjectSourceID(id int) projectsourceOption {
+	return func(m *ProjectSourceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProjectSource
+		)
+		m.oldValue = func(ctx context.Context) (*ProjectSource, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProjectSource.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjectSource sets the old ProjectSource of the mutation.
+func withProjectSource(node *ProjectSource) projectsourceOption {
+	return func(m *ProjectSourceMutation) {
+		m.oldValue = func(context.Context) (*ProjectSource, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectSourceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectSourceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectSourceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectSourceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProjectSource.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ProjectSourceMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProjectSourceMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProjectSourceMutation) ResetType() {
+	m._type = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ProjectSourceMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ProjectSourceMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ProjectSourceMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetRef sets the "ref" field.
+func (m *ProjectSourceMutation) SetRef(s string) {
+	m.ref = &s
+}
+
+// Ref returns the value of the "ref" field in the mutation.
+func (m *ProjectSourceMutation) Ref() (r string, exists bool) {
+	v := m.ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRef returns the old "ref" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRef: %w", err)
+	}
+	return oldValue.Ref, nil
+}
+
+// ResetRef resets all changes to the "ref" field.
+func (m *ProjectSourceMutation) ResetRef() {
+	m.ref = nil
+}
+
+// SetLastCommit sets the "last_commit" field.
+func (m *ProjectSourceMutation) SetLastCommit(s string) {
+	m.last_commit = &s
+}
+
+// LastCommit returns the value of the "last_commit" field in the mutation.
+func (m *ProjectSourceMutation) LastCommit() (r string, exists bool) {
+	v := m.last_commit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastCommit returns the old "last_commit" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldLastCommit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastCommit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastCommit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastCommit: %w", err)
+	}
+	return oldValue.LastCommit, nil
+}
+
+// ClearLastCommit clears the value of the "last_commit" field.
+func (m *ProjectSourceMutation) ClearLastCommit() {
+	m.last_commit = nil
+	m.clearedFields[projectsource.FieldLastCommit] = struct{}{}
+}
+
+// LastCommitCleared returns if the "last_commit" field was cleared in this mutation.
+func (m *ProjectSourceMutation) LastCommitCleared() bool {
+	_, ok := m.clearedFields[projectsource.FieldLastCommit]
+	return ok
+}
+
+// ResetLastCommit resets all changes to the "last_commit" field.
+func (m *ProjectSourceMutation) ResetLastCommit() {
+	m.last_commit = nil
+	delete(m.clearedFields, projectsource.FieldLastCommit)
+}
+
+// SetStatus sets the "status" field.
+func (m *ProjectSourceMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProjectSourceMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProjectSourceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *ProjectSourceMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *ProjectSourceMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *ProjectSourceMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[projectsource.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *ProjectSourceMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[projectsource.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *ProjectSourceMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, projectsource.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *ProjectSourceMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ProjectSourceMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *ProjectSourceMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[projectsource.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ProjectSourceMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[projectsource.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ProjectSourceMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, projectsource.FieldLastError)
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (m *ProjectSourceMutation) SetSyncedAt(t time.Time) {
+	m.synced_at = &t
+}
+
+// SyncedAt returns the value of the "synced_at" field in the mutation.
+func (m *ProjectSourceMutation) SyncedAt() (r time.Time, exists bool) {
+	v := m.synced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSyncedAt returns the old "synced_at" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldSyncedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSyncedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSyncedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSyncedAt: %w", err)
+	}
+	return oldValue.SyncedAt, nil
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (m *ProjectSourceMutation) ClearSyncedAt() {
+	m.synced_at = nil
+	m.clearedFields[projectsource.FieldSyncedAt] = struct{}{}
+}
+
+// SyncedAtCleared returns if the "synced_at" field was cleared in this mutation.
+func (m *ProjectSourceMutation) SyncedAtCleared() bool {
+	_, ok := m.clearedFields[projectsource.FieldSyncedAt]
+	return ok
+}
+
+// ResetSyncedAt resets all changes to the "synced_at" field.
+func (m *ProjectSourceMutation) ResetSyncedAt() {
+	m.synced_at = nil
+	delete(m.clearedFields, projectsource.FieldSyncedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectSourceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectSourceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProjectSource entity.
+// If the ProjectSource object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSourceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectSourceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *ProjectSourceMutation) SetProjectID(id int) {
+	m.project = &id
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *ProjectSourceMutation) ClearProject() {
+	m.clearedproject = true
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *ProjectSourceMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *ProjectSourceMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
+	}
+	return
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ProjectSourceMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *ProjectSourceMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *ProjectSourceMutation) AddDocumentIDs(ids ...int) {
+	if m.documents == nil {
+		m.documents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *ProjectSourceMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *ProjectSourceMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *ProjectSourceMutation) RemoveDocumentIDs(ids ...int) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *ProjectSourceMutation) RemovedDocumentsIDs() (ids []int) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *ProjectSourceMutation) DocumentsIDs() (ids []int) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *ProjectSourceMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// Where appends a list predicates to the ProjectSourceMutation builder.
+func (m *ProjectSourceMutation) Where(ps ...predicate.ProjectSource) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectSourceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectSourceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProjectSource, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectSourceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectSourceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProjectSource).
+func (m *ProjectSourceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectSourceMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, projectsource.FieldType)
+	}
+	if m.url != nil {
+		fields = append(fields, projectsource.FieldURL)
+	}
+	if m.ref != nil {
+		fields = append(fields, projectsource.FieldRef)
+	}
+	if m.last_commit != nil {
+		fields = append(fields, projectsource.FieldLastCommit)
+	}
+	if m.status != nil {
+		fields = append(fields, projectsource.FieldStatus)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, projectsource.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, projectsource.FieldLastError)
+	}
+	if m.synced_at != nil {
+		fields = append(fields, projectsource.FieldSyncedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, projectsource.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectSourceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projectsource.FieldType:
+		return m.GetType()
+	case projectsource.FieldURL:
+		return m.URL()
+	case projectsource.FieldRef:
+		return m.Ref()
+	case projectsource.FieldLastCommit:
+		return m.LastCommit()
+	case projectsource.FieldStatus:
+		return m.Status()
+	case projectsource.FieldLockedUntil:
+		return m.LockedUntil()
+	case projectsource.FieldLastError:
+		return m.LastError()
+	case projectsource.FieldSyncedAt:
+		return m.SyncedAt()
+	case projectsource.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectSourceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case projectsource.FieldType:
+		return m.OldType(ctx)
+	case projectsource.FieldURL:
+		return m.OldURL(ctx)
+	case projectsource.FieldRef:
+		return m.OldRef(ctx)
+	case projectsource.FieldLastCommit:
+		return m.OldLastCommit(ctx)
+	case projectsource.FieldStatus:
+		return m.OldStatus(ctx)
+	case projectsource.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case projectsource.FieldLastError:
+		return m.OldLastError(ctx)
+	case projectsource.FieldSyncedAt:
+		return m.OldSyncedAt(ctx)
+	case projectsource.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProjectSource field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectSourceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case projectsource.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case projectsource.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case projectsource.FieldRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRef(v)
+		return nil
+	case projectsource.FieldLastCommit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCommit(v)
+		return nil
+	case projectsource.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case projectsource.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case projectsource.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case projectsource.FieldSyncedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSyncedAt(v)
+		return nil
+	case projectsource.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSource field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectSourceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectSourceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectSourceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProjectSource numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectSourceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(projectsource.FieldLastCommit) {
+		fields = append(fields, projectsource.FieldLastCommit)
+	}
+	if m.FieldCleared(projectsource.FieldLockedUntil) {
+		fields = append(fields, projectsource.FieldLockedUntil)
+	}
+	if m.FieldCleared(projectsource.FieldLastError) {
+		fields = append(fields, projectsource.FieldLastError)
+	}
+	if m.FieldCleared(projectsource.FieldSyncedAt) {
+		fields = append(fields, projectsource.FieldSyncedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectSourceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectSourceMutation) ClearField(name string) error {
+	switch name {
+	case projectsource.FieldLastCommit:
+		m.ClearLastCommit()
+		return nil
+	case projectsource.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case projectsource.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case projectsource.FieldSyncedAt:
+		m.ClearSyncedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSource nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectSourceMutation) ResetField(name string) error {
+	switch name {
+	case projectsource.FieldType:
+		m.ResetType()
+		return nil
+	case projectsource.FieldURL:
+		m.ResetURL()
+		return nil
+	case projectsource.FieldRef:
+		m.ResetRef()
+		return nil
+	case projectsource.FieldLastCommit:
+		m.ResetLastCommit()
+		return nil
+	case projectsource.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case projectsource.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case projectsource.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case projectsource.FieldSyncedAt:
+		m.ResetSyncedAt()
+		return nil
+	case projectsource.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSource field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectSourceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.project != nil {
+		edges = append(edges, projectsource.EdgeProject)
+	}
+	if m.documents != nil {
+		edges = append(edges, projectsource.EdgeDocuments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectSourceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case projectsource.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case projectsource.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectSourceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddocuments != nil {
+		edges = append(edges, projectsource.EdgeDocuments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectSourceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case projectsource.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectSourceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproject {
+		edges = append(edges, projectsource.EdgeProject)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, projectsource.EdgeDocuments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectSourceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case projectsource.EdgeProject:
+		return m.clearedproject
+	case projectsource.EdgeDocuments:
+		return m.cleareddocuments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectSourceMutation) ClearEdge(name string) error {
+	switch name {
+	case projectsource.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSource unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectSourceMutation) ResetEdge(name string) error {
+	switch name {
+	case projectsource.EdgeProject:
+		m.ResetProject()
+		return nil
+	case projectsource.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSource edge %s", name)
+}
+
+// QueryResultMutation represents an operation that mutates the QueryResult nodes in the graph.
+type QueryResultMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	rank            *int
+	addrank         *int
+	score           *float64
+	addscore        *float64
+	content_snippet *string
+	document_id     *int
+	adddocument_id  *int
+	document_name   *string
+	chunk_index     *int
+	addchunk_index  *int
+	clearedFields   map[string]struct{}
+	query           *int
+	clearedquery    bool
+	chunks          map[int]struct{}
+	removedchunks   map[int]struct{}
+	clearedchunks   bool
+	done            bool
+	oldValue        func(context.Context) (*QueryResult, error)
+	predicates      []predicate.QueryResult
+}
+
+var _ ent.Mutation = (*QueryResultMutation)(nil)
+
+// queryresultOption allows management of the mutation configuration using functional options.
+type queryresultOption func(*QueryResultMutation)
+
+// newQueryResultMutation creates new mutation for the QueryResult entity.
+func newQueryResultMutation(c config, op Op, opts ...queryresultOption) *QueryResultMutation {
+	m := &QueryResultMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQueryResult,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQueryResultID sets the ID field of the mutation.
+func withQueryResultID(id int) queryresultOption {
+	return func(m *QueryResultMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QueryResult
+		)
+		m.oldValue = func(ctx context.Context) (*QueryResult, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QueryResult.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQueryResult sets the old QueryResult of the mutation.
+func withQueryResult(node *QueryResult) queryresultOption {
+	return func(m *QueryResultMutation) {
+		m.oldValue = func(context.Context) (*QueryResult, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QueryResultMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QueryResultMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QueryResultMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QueryResultMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QueryResult.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRank sets the "rank" field.
+func (m *QueryResultMutation) SetRank(i int) {
+	m.rank = &i
+	m.addrank = nil
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *QueryResultMutation) Rank() (r int, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldRank(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// AddRank adds i to the "rank" field.
+func (m *QueryResultMutation) AddRank(i int) {
+	if m.addrank != nil {
+		*m.addrank += i
+	} else {
+		m.addrank = &i
+	}
+}
+
+// AddedRank returns the value that was added to the "rank" field in this mutation.
+func (m *QueryResultMutation) AddedRank() (r int, exists bool) {
+	v := m.addrank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *QueryResultMutation) ResetRank() {
+	m.rank = nil
+	m.addrank = nil
+}
+
+// SetScore sets the "score" field.
+func (m *QueryResultMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *QueryResultMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *QueryResultMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *QueryResultMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *QueryResultMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetContentSnippet sets the "content_snippet" field.
+func (m *QueryResultMutation) SetContentSnippet(s string) {
+	m.content_snippet = &s
+}
+
+// ContentSnippet returns the value of the "content_snippet" field in the mutation.
+func (m *QueryResultMutation) ContentSnippet() (r string, exists bool) {
+	v := m.content_snippet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentSnippet returns the old "content_snippet" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldContentSnippet(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentSnippet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentSnippet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentSnippet: %w", err)
+	}
+	return oldValue.ContentSnippet, nil
+}
+
+// ResetContentSnippet resets all changes to the "content_snippet" field.
+func (m *QueryResultMutation) ResetContentSnippet() {
+	m.content_snippet = nil
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *QueryResultMutation) SetDocumentID(i int) {
+	m.document_id = &i
+	m.adddocument_id = nil
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *QueryResultMutation) DocumentID() (r int, exists bool) {
+	v := m.document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldDocumentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// AddDocumentID adds i to the "document_id" field.
+func (m *QueryResultMutation) AddDocumentID(i int) {
+	if m.adddocument_id != nil {
+		*m.adddocument_id += i
+	} else {
+		m.adddocument_id = &i
+	}
+}
+
+// AddedDocumentID returns the value that was added to the "document_id" field in this mutation.
+func (m *QueryResultMutation) AddedDocumentID() (r int, exists bool) {
+	v := m.adddocument_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (m *QueryResultMutation) ClearDocumentID() {
+	m.document_id = nil
+	m.adddocument_id = nil
+	m.clearedFields[queryresult.FieldDocumentID] = struct{}{}
+}
+
+// DocumentIDCleared returns if the "document_id" field was cleared in this mutation.
+func (m *QueryResultMutation) DocumentIDCleared() bool {
+	_, ok := m.clearedFields[queryresult.FieldDocumentID]
+	return ok
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *QueryResultMutation) ResetDocumentID() {
+	m.document_id = nil
+	m.adddocument_id = nil
+	delete(m.clearedFields, queryresult.FieldDocumentID)
+}
+
+// SetDocumentName sets the "document_name" field.
+func (m *QueryResultMutation) SetDocumentName(s string) {
+	m.document_name = &s
+}
+
+// DocumentName returns the value of the "document_name" field in the mutation.
+func (m *QueryResultMutation) DocumentName() (r string, exists bool) {
+	v := m.document_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentName returns the old "document_name" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldDocumentName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentName: %w", err)
+	}
+	return oldValue.DocumentName, nil
+}
+
+// ClearDocumentName clears the value of the "document_name" field.
+func (m *QueryResultMutation) ClearDocumentName() {
+	m.document_name = nil
+	m.clearedFields[queryresult.FieldDocumentName] = struct{}{}
+}
+
+// DocumentNameCleared returns if the "document_name" field was cleared in this mutation.
+func (m *QueryResultMutation) DocumentNameCleared() bool {
+	_, ok := m.clearedFields[queryresult.FieldDocumentName]
+	return ok
+}
+
+// ResetDocumentName resets all changes to the "document_name" field.
+func (m *QueryResultMutation) ResetDocumentName() {
+	m.document_name = nil
+	delete(m.clearedFields, queryresult.FieldDocumentName)
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (m *QueryResultMutation) SetChunkIndex(i int) {
+	m.chunk_index = &i
+	m.addchunk_index = nil
+}
+
+// ChunkIndex returns the value of the "chunk_index" field in the mutation.
+func (m *QueryResultMutation) ChunkIndex() (r int, exists bool) {
+	v := m.chunk_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkIndex returns the old "chunk_index" field's value of the QueryResult entity.
+// If the QueryResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueryResultMutation) OldChunkIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkIndex: %w", err)
+	}
+	return oldValue.ChunkIndex, nil
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (m *QueryResultMutation) AddChunkIndex(i int) {
+	if m.addchunk_index != nil {
+		*m.addchunk_index += i
+	} else {
+		m.addchunk_index = &i
+	}
+}
+
+// AddedChunkIndex returns the value that was added to the "chunk_index" field in this mutation.
+func (m *QueryResultMutation) AddedChunkIndex() (r int, exists bool) {
+	v := m.addchunk_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChunkIndex clears the value of the "chunk_index" field.
+func (m *QueryResultMutation) ClearChunkIndex() {
+	m.chunk_index = nil
+	m.addchunk_index = nil
+	m.clearedFields[queryresult.FieldChunkIndex] = struct{}{}
+}
+
+// ChunkIndexCleared returns if the "chunk_index" field was cleared in this mutation.
+func (m *QueryResultMutation) ChunkIndexCleared() bool {
+	_, ok := m.clearedFields[queryresult.FieldChunkIndex]
+	return ok
+}
+
+// ResetChunkIndex resets all changes to the "chunk_index" field.
+func (m *QueryResultMutation) ResetChunkIndex() {
+	m.chunk_index = nil
+	m.addchunk_index = nil
+	delete(m.clearedFields, queryresult.FieldChunkIndex)
+}
+
+// SetQueryID sets the "query" edge to the UserPrompt entity by id.
+func (m *QueryResultMutation) SetQueryID(id int) {
+	m.query = &id
+}
+
+// ClearQuery clears the "query" edge to the UserPrompt entity.
+func (m *QueryResultMutation) ClearQuery() {
+	m.clearedquery = true
+}
+
+// QueryCleared reports if the "query" edge to the UserPrompt entity was cleared.
+func (m *QueryResultMutation) QueryCleared() bool {
+	return m.clearedquery
+}
+
+// QueryID returns the "query" edge ID in the mutation.
+func (m *QueryResultMutation) QueryID() (id int, exists bool) {
+	if m.query != nil {
+		return *m.query, true
+	}
+	return
+}
+
+// QueryIDs returns the "query" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QueryID instead. It exists only for internal usage by the builders.
+func (m *QueryResultMutation) QueryIDs() (ids []int) {
+	if id := m.query; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuery resets all changes to the "query" edge.
+func (m *QueryResultMutation) ResetQuery() {
+	m.query = nil
+	m.clearedquery = false
+}
+
+// AddChunkIDs adds the "chunks" edge to the Chunk entity by ids.
+func (m *QueryResultMutation) AddChunkIDs(ids ...int) {
+	if m.chunks == nil {
+		m.chunks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.chunks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChunks clears the "chunks" edge to the Chunk entity.
+func (m *QueryResultMutation) ClearChunks() {
+	m.clearedchunks = true
+}
+
+// ChunksCleared reports if the "chunks" edge to the Chunk entity was cleared.
+func (m *QueryResultMutation) ChunksCleared() bool {
+	return m.clearedchunks
+}
+
+// RemoveChunkIDs removes the "chunks" edge to the Chunk entity by IDs.
+func (m *QueryResultMutation) RemoveChunkIDs(ids ...int) {
+	if m.removedchunks == nil {
+		m.removedchunks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.chunks, ids[i])
+		m.removedchunks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChunks returns the removed IDs of the "chunks" edge to the Chunk entity.
+func (m *QueryResultMutation) RemovedChunksIDs() (ids []int) {
+	for id := range m.removedchunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChunksIDs returns the "chunks" edge IDs in the mutation.
+func (m *QueryResultMutation) ChunksIDs() (ids []int) {
+	for id := range m.chunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChunks resets all changes to the "chunks" edge.
+func (m *QueryResultMutation) ResetChunks() {
+	m.chunks = nil
+	m.clearedchunks = false
+	m.removedchunks = nil
+}
+
+// Where appends a list predicates to the QueryResultMutation builder.
+func (m *QueryResultMutation) Where(ps ...predicate.QueryResult) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QueryResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QueryResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QueryResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QueryResultMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QueryResultMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QueryResult).
+func (m *QueryResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QueryResultMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.rank != nil {
+		fields = append(fields, queryresult.FieldRank)
+	}
+	if m.score != nil {
+		fields = append(fields, queryresult.FieldScore)
+	}
+	if m.content_snippet != nil {
+		fields = append(fields, queryresult.FieldContentSnippet)
+	}
+	if m.document_id != nil {
+		fields = append(fields, queryresult.FieldDocumentID)
+	}
+	if m.document_name != nil {
+		fields = append(fields, queryresult.FieldDocumentName)
+	}
+	if m.chunk_index != nil {
+		fields = append(fields, queryresult.FieldChunkIndex)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QueryResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case queryresult.FieldRank:
+		return m.Rank()
+	case queryresult.FieldScore:
+		return m.Score()
+	case queryresult.FieldContentSnippet:
+		return m.ContentSnippet()
+	case queryresult.FieldDocumentID:
+		return m.DocumentID()
+	case queryresult.FieldDocumentName:
+		return m.DocumentName()
+	case queryresult.FieldChunkIndex:
+		return m.ChunkIndex()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QueryResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case queryresult.FieldRank:
+		return m.OldRank(ctx)
+	case queryresult.FieldScore:
+		return m.OldScore(ctx)
+	case queryresult.FieldContentSnippet:
+		return m.OldContentSnippet(ctx)
+	case queryresult.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case queryresult.FieldDocumentName:
+		return m.OldDocumentName(ctx)
+	case queryresult.FieldChunkIndex:
+		return m.OldChunkIndex(ctx)
+	}
+	return nil, fmt.Errorf("unknown QueryResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueryResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case queryresult.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case queryresult.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case queryresult.FieldContentSnippet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentSnippet(v)
+		return nil
+	case queryresult.FieldDocumentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case queryresult.FieldDocumentName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentName(v)
+		return nil
+	case queryresult.FieldChunkIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QueryResultMutation) AddedFields() []string {
+	var fields []string
+	if m.addrank != nil {
+		fields = append(fields, queryresult.FieldRank)
+	}
+	if m.addscore != nil {
+		fields = append(fields, queryresult.FieldScore)
+	}
+	if m.adddocument_id != nil {
+		fields = append(fields, queryresult.FieldDocumentID)
+	}
+	if m.addchunk_index != nil {
+		fields = append(fields, queryresult.FieldChunkIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QueryResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case queryresult.FieldRank:
+		return m.AddedRank()
+	case queryresult.FieldScore:
+		return m.AddedScore()
+	case queryresult.FieldDocumentID:
+		return m.AddedDocumentID()
+	case queryresult.FieldChunkIndex:
+		return m.AddedChunkIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueryResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case queryresult.FieldRank:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRank(v)
+		return nil
+	case queryresult.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case queryresult.FieldDocumentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocumentID(v)
+		return nil
+	case queryresult.FieldChunkIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QueryResultMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(queryresult.FieldDocumentID) {
+		fields = append(fields, queryresult.FieldDocumentID)
+	}
+	if m.FieldCleared(queryresult.FieldDocumentName) {
+		fields = append(fields, queryresult.FieldDocumentName)
+	}
+	if m.FieldCleared(queryresult.FieldChunkIndex) {
+		fields = append(fields, queryresult.FieldChunkIndex)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QueryResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QueryResultMutation) ClearField(name string) error {
+	switch name {
+	case queryresult.FieldDocumentID:
+		m.ClearDocumentID()
+		return nil
+	case queryresult.FieldDocumentName:
+		m.ClearDocumentName()
+		return nil
+	case queryresult.FieldChunkIndex:
+		m.ClearChunkIndex()
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QueryResultMutation) ResetField(name string) error {
+	switch name {
+	case queryresult.FieldRank:
+		m.ResetRank()
+		return nil
+	case queryresult.FieldScore:
+		m.ResetScore()
+		return nil
+	case queryresult.FieldContentSnippet:
+		m.ResetContentSnippet()
+		return nil
+	case queryresult.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case queryresult.FieldDocumentName:
+		m.ResetDocumentName()
+		return nil
+	case queryresult.FieldChunkIndex:
+		m.ResetChunkIndex()
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QueryResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.query != nil {
+		edges = append(edges, queryresult.EdgeQuery)
+	}
+	if m.chunks != nil {
+		edges = append(edges, queryresult.EdgeChunks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QueryResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case queryresult.EdgeQuery:
+		if id := m.query; id != nil {
+			return []ent.Value{*id}
+		}
+	case queryresult.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.chunks))
+		for id := range m.chunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QueryResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchunks != nil {
+		edges = append(edges, queryresult.EdgeChunks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QueryResultMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case queryresult.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.removedchunks))
+		for id := range m.removedchunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QueryResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquery {
+		edges = append(edges, queryresult.EdgeQuery)
+	}
+	if m.clearedchunks {
+		edges = append(edges, queryresult.EdgeChunks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QueryResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case queryresult.EdgeQuery:
+		return m.clearedquery
+	case queryresult.EdgeChunks:
+		return m.clearedchunks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QueryResultMutation) ClearEdge(name string) error {
+	switch name {
+	case queryresult.EdgeQuery:
+		m.ClearQuery()
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QueryResultMutation) ResetEdge(name string) error {
+	switch name {
+	case queryresult.EdgeQuery:
+		m.ResetQuery()
+		return nil
+	case queryresult.EdgeChunks:
+		m.ResetChunks()
+		return nil
+	}
+	return fmt.Errorf("unknown QueryResult edge %s", name)
+}
+
+// SecurityQuestionMutation represents an operation that mutates the SecurityQuestion nodes in the graph.
+type SecurityQuestionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	question      *string
+	answer        *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*SecurityQuestion, error)
+	predicates    []predicate.SecurityQuestion
+}
+
+var _ ent.Mutation = (*SecurityQuestionMutation)(nil)
+
+// securityquestionOption allows management of the mutation configuration using functional options.
+type securityquestionOption func(*SecurityQuestionMutation)
+
+// newSecurityQuestionMutation creates new mutation for the SecurityQuestion entity.
+func newSecurityQuestionMutation(c config, op Op, opts ...securityquestionOption) *SecurityQuestionMutation {
+	m := &SecurityQuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecurityQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecurityQuestionID sets the ID field of the mutation.
+func withSecurityQuestionID(id uuid.UUID) securityquestionOption {
+	return func(m *SecurityQuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SecurityQuestion
+		)
+		m.oldValue = func(ctx context.Context) (*SecurityQuestion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SecurityQuestion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecurityQuestion sets the old SecurityQuestion of the mutation.
+func withSecurityQuestion(node *SecurityQuestion) securityquestionOption {
+	return func(m *SecurityQuestionMutation) {
+		m.oldValue = func(context.Context) (*SecurityQuestion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecurityQuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecurityQuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SecurityQuestion entities.
+func (m *SecurityQuestionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecurityQuestionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecurityQuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SecurityQuestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuestion sets the "question" field.
+func (m *SecurityQuestionMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *SecurityQuestionMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the SecurityQuestion entity.
+// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityQuestionMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *SecurityQuestionMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *SecurityQuestionMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *SecurityQuestionMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the SecurityQuestion entity.
+// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityQuestionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *SecurityQuestionMutation) ResetAnswer() {
+	m.answer = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SecurityQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SecurityQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SecurityQuestion entity.
+// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SecurityQuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SecurityQuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SecurityQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SecurityQuestion entity.
+// If the SecurityQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SecurityQuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SecurityQuestionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SecurityQuestionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SecurityQuestionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SecurityQuestionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SecurityQuestionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SecurityQuestionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SecurityQuestionMutation builder.
+func (m *SecurityQuestionMutation) Where(ps ...predicate.SecurityQuestion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecurityQuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecurityQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecurityQuestion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecurityQuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecurityQuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SecurityQuestion).
+func (m *SecurityQuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecurityQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.question != nil {
+		fields = append(fields, securityquestion.FieldQuestion)
+	}
+	if m.answer != nil {
+		fields = append(fields, securityquestion.FieldAnswer)
+	}
+	if m.created_at != nil {
+		fields = append(fields, securityquestion.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, securityquestion.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecurityQuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case securityquestion.FieldQuestion:
+		return m.Question()
+	case securityquestion.FieldAnswer:
+		return m.Answer()
+	case securityquestion.FieldCreatedAt:
+		return m.CreatedAt()
+	case securityquestion.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecurityQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case securityquestion.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case securityquestion.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case securityquestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case securityquestion.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SecurityQuestion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityQuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case securityquestion.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case securityquestion.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case securityquestion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case securityquestion.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityQuestion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecurityQuestionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecurityQuestionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityQuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SecurityQuestion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecurityQuestionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecurityQuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecurityQuestionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SecurityQuestion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecurityQuestionMutation) ResetField(name string) error {
+	switch name {
+	case securityquestion.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case securityquestion.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case securityquestion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case securityquestion.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityQuestion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecurityQuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, securityquestion.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecurityQuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case securityquestion.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecurityQuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecurityQuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecurityQuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, securityquestion.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecurityQuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case securityquestion.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecurityQuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case securityquestion.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityQuestion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecurityQuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case securityquestion.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityQuestion edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	session_id     *uuid.UUID
+	session_type   *session.SessionType
+	access_token   *string
+	refresh_token  *string
+	device_name    *string
+	last_sync_time *time.Time
+	created_at     *time.Time
+	expires_at     *time.Time
+	revoked_at     *time.Time
+	ip_address     *string
+	user_agent     *string
+	metadata       *map[string]interface{}
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*Session, error)
+	predicates     []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *SessionMutation) SetSessionID(u uuid.UUID) {
+	m.session_id = &u
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *SessionMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *SessionMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetSessionsUserids sets the "sessions_userids" field.
+func (m *SessionMutation) SetSessionsUserids(u uuid.UUID) {
+	m.user = &u
+}
+
+// SessionsUserids returns the value of the "sessions_userids" field in the mutation.
+func (m *SessionMutation) SessionsUserids() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionsUserids returns the old "sessions_userids" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldSessionsUserids(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionsUserids is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionsUserids requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionsUserids: %w", err)
+	}
+	return oldValue.SessionsUserids, nil
+}
+
+// ResetSessionsUserids resets all changes to the "sessions_userids" field.
+func (m *SessionMutation) ResetSessionsUserids() {
+	m.user = nil
+}
+
+// SetSessionType sets the "session_type" field.
+func (m *SessionMutation) SetSessionType(st session.SessionType) {
+	m.session_type = &st
+}
+
+// SessionType returns the value of the "session_type" field in the mutation.
+func (m *SessionMutation) SessionType() (r session.SessionType, exists bool) {
+	v := m.session_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionType returns the old "session_type" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldSessionType(ctx context.Context) (v session.SessionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionType: %w", err)
+	}
+	return oldValue.SessionType, nil
+}
+
+// ResetSessionType resets all changes to the "session_type" field.
+func (m *SessionMutation) ResetSessionType() {
+	m.session_type = nil
+}
+
+// SetAccessToken sets the "access_token" field.
+func (m *SessionMutation) SetAccessToken(s string) {
+	m.access_token = &s
+}
+
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *SessionMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessToken returns the old "access_token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
+}
+
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *SessionMutation) ResetAccessToken() {
+	m.access_token = nil
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (m *SessionMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
+}
+
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *SessionMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshToken returns the old "refresh_token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRefreshToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	}
+	return oldValue.RefreshToken, nil
+}
+
+// ClearRefreshToken clears the value of the "refresh_token" field.
+func (m *SessionMutation) ClearRefreshToken() {
+	m.refresh_token = nil
+	m.clearedFields[session.FieldRefreshToken] = struct{}{}
+}
+
+// RefreshTokenCleared returns if the "refresh_token" field was cleared in this mutation.
+func (m *SessionMutation) RefreshTokenCleared() bool {
+	_, ok := m.clearedFields[session.FieldRefreshToken]
+	return ok
+}
+
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *SessionMutation) ResetRefreshToken() {
+	m.refresh_token = nil
+	delete(m.clearedFields, session.FieldRefreshToken)
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *SessionMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *SessionMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceName returns the old "device_name" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeviceName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	}
+	return oldValue.DeviceName, nil
+}
+
+// ClearDeviceName clears the value of the "device_name" field.
+func (m *SessionMutation) ClearDeviceName() {
+	m.device_name = nil
+	m.clearedFields[session.FieldDeviceName] = struct{}{}
+}
+
+// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
+func (m *SessionMutation) DeviceNameCleared() bool {
+	_, ok := m.clearedFields[session.FieldDeviceName]
+	return ok
+}
+
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *SessionMutation) ResetDeviceName() {
+	m.device_name = nil
+	delete(m.clearedFields, session.FieldDeviceName)
+}
+
+// SetLastSyncTime sets the "last_sync_time" field.
+func (m *SessionMutation) SetLastSyncTime(t time.Time) {
+	m.last_sync_time = &t
+}
+
+// LastSyncTime returns the value of the "last_sync_time" field in the mutation.
+func (m *SessionMutation) LastSyncTime() (r time.Time, exists bool) {
+	v := m.last_sync_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSyncTime returns the old "last_sync_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastSyncTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSyncTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSyncTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSyncTime: %w", err)
+	}
+	return oldValue.LastSyncTime, nil
+}
+
+// ClearLastSyncTime clears the value of the "last_sync_time" field.
+func (m *SessionMutation) ClearLastSyncTime() {
+	m.last_sync_time = nil
+	m.clearedFields[session.FieldLastSyncTime] = struct{}{}
+}
+
+// LastSyncTimeCleared returns if the "last_sync_time" field was cleared in this mutation.
+func (m *SessionMutation) LastSyncTimeCleared() bool {
+	_, ok := m.clearedFields[session.FieldLastSyncTime]
+	return ok
+}
+
+// ResetLastSyncTime resets all changes to the "last_sync_time" field.
+func (m *SessionMutation) ResetLastSyncTime() {
+	m.last_sync_time = nil
+	delete(m.clearedFields, session.FieldLastSyncTime)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *SessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIPAddress(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *SessionMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[session.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *SessionMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[session.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SessionMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, session.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SessionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SessionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SessionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[session.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SessionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[session.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SessionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, session.FieldMetadata)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldSessionsUserids] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.session_id != nil {
+		fields = append(fields, session.FieldSessionID)
+	}
+	if m.user != nil {
+		fields = append(fields, session.FieldSessionsUserids)
+	}
+	if m.session_type != nil {
+		fields = append(fields, session.FieldSessionType)
+	}
+	if m.access_token != nil {
+		fields = append(fields, session.FieldAccessToken)
+	}
+	if m.refresh_token != nil {
+		fields = append(fields, session.FieldRefreshToken)
+	}
+	if m.device_name != nil {
+		fields = append(fields, session.FieldDeviceName)
+	}
+	if m.last_sync_time != nil {
+		fields = append(fields, session.FieldLastSyncTime)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.metadata != nil {
+		fields = append(fields, session.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldSessionID:
+		return m.SessionID()
+	case session.FieldSessionsUserids:
+		return m.SessionsUserids()
+	case session.FieldSessionType:
+		return m.SessionType()
+	case session.FieldAccessToken:
+		return m.AccessToken()
+	case session.FieldRefreshToken:
+		return m.RefreshToken()
+	case session.FieldDeviceName:
+		return m.DeviceName()
+	case session.FieldLastSyncTime:
+		return m.LastSyncTime()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldIPAddress:
+		return m.IPAddress()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case session.FieldSessionsUserids:
+		return m.OldSessionsUserids(ctx)
+	case session.FieldSessionType:
+		return m.OldSessionType(ctx)
+	case session.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case session.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case session.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case session.FieldLastSyncTime:
+		return m.OldLastSyncTime(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case session.FieldSessionsUserids:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionsUserids(v)
+		return nil
+	case session.FieldSessionType:
+		v, ok := value.(session.SessionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionType(v)
+		return nil
+	case session.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case session.FieldRefreshToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	case session.FieldDeviceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceName(v)
+		return nil
+	case session.FieldLastSyncTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSyncTime(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case session.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldRefreshToken) {
+		fields = append(fields, session.FieldRefreshToken)
+	}
+	if m.FieldCleared(session.FieldDeviceName) {
+		fields = append(fields, session.FieldDeviceName)
+	}
+	if m.FieldCleared(session.FieldLastSyncTime) {
+		fields = append(fields, session.FieldLastSyncTime)
+	}
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.FieldCleared(session.FieldIPAddress) {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.FieldCleared(session.FieldMetadata) {
+		fields = append(fields, session.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldRefreshToken:
+		m.ClearRefreshToken()
+		return nil
+	case session.FieldDeviceName:
+		m.ClearDeviceName()
+		return nil
+	case session.FieldLastSyncTime:
+		m.ClearLastSyncTime()
+		return nil
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case session.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case session.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case session.FieldSessionsUserids:
+		m.ResetSessionsUserids()
+		return nil
+	case session.FieldSessionType:
+		m.ResetSessionType()
+		return nil
+	case session.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case session.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	case session.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case session.FieldLastSyncTime:
+		m.ResetLastSyncTime()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case session.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	email                     *string
+	password_hash             *string
+	email_confirmed           *bool
+	created_at                *time.Time
+	clearedFields             map[string]struct{}
+	projects                  map[int]struct{}
+	removedprojects           map[int]struct{}
+	clearedprojects           bool
+	queries                   map[int]struct{}
+	removedqueries            map[int]struct{}
+	clearedqueries            bool
+	conversations             map[int]struct{}
+	removedconversations      map[int]struct{}
+	clearedconversations      bool
+	sessions                  map[int]struct{}
+	removedsessions           map[int]struct{}
+	clearedsessions           bool
+	security_questions        map[uuid.UUID]struct{}
+	removedsecurity_questions map[uuid.UUID]struct{}
+	clearedsecurity_questions bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetEmailConfirmed sets the "email_confirmed" field.
+func (m *UserMutation) SetEmailConfirmed(b bool) {
+	m.email_confirmed = &b
+}
+
+// EmailConfirmed returns the value of the "email_confirmed" field in the mutation.
+func (m *UserMutation) EmailConfirmed() (r bool, exists bool) {
+	v := m.email_confirmed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailConfirmed returns the old "email_confirmed" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailConfirmed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailConfirmed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailConfirmed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailConfirmed: %w", err)
+	}
+	return oldValue.EmailConfirmed, nil
+}
+
+// ResetEmailConfirmed resets all changes to the "email_confirmed" field.
+func (m *UserMutation) ResetEmailConfirmed() {
+	m.email_confirmed = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddProjectIDs adds the "projects" edge to the Project entity by ids.
+func (m *UserMutation) AddProjectIDs(ids ...int) {
+	if m.projects == nil {
+		m.projects = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.projects[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjects clears the "projects" edge to the Project entity.
+func (m *UserMutation) ClearProjects() {
+	m.clearedprojects = true
+}
+
+// ProjectsCleared reports if the "projects" edge to the Project entity was cleared.
+func (m *UserMutation) ProjectsCleared() bool {
+	return m.clearedprojects
+}
+
+// RemoveProjectIDs removes the "projects" edge to the Project entity by IDs.
+func (m *UserMutation) RemoveProjectIDs(ids ...int) {
+	if m.removedprojects == nil {
+		m.removedprojects = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.projects, ids[i])
+		m.removedprojects[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjects returns the removed IDs of the "projects" edge to the Project entity.
+func (m *UserMutation) RemovedProjectsIDs() (ids []int) {
+	for id := range m.removedprojects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectsIDs returns the "projects" edge IDs in the mutation.
+func (m *UserMutation) ProjectsIDs() (ids []int) {
+	for id := range m.projects {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjects resets all changes to the "projects" edge.
+func (m *UserMutation) ResetProjects() {
+	m.projects = nil
+	m.clearedprojects = false
+	m.removedprojects = nil
+}
+
+// AddQueryIDs adds the "queries" edge to the UserPrompt entity by ids.
+func (m *UserMutation) AddQueryIDs(ids ...int) {
+	if m.queries == nil {
+		m.queries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.queries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQueries clears the "queries" edge to the UserPrompt entity.
+func (m *UserMutation) ClearQueries() {
+	m.clearedqueries = true
+}
+
+// QueriesCleared reports if the "queries" edge to the UserPrompt entity was cleared.
+func (m *UserMutation) QueriesCleared() bool {
+	return m.clearedqueries
+}
+
+// RemoveQueryIDs removes the "queries" edge to the UserPrompt entity by IDs.
+func (m *UserMutation) RemoveQueryIDs(ids ...int) {
+	if m.removedqueries == nil {
+		m.removedqueries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.queries, ids[i])
+		m.removedqueries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQueries returns the removed IDs of the "queries" edge to the UserPrompt entity.
+func (m *UserMutation) RemovedQueriesIDs() (ids []int) {
+	for id := range m.removedqueries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QueriesIDs returns the "queries" edge IDs in the mutation.
+func (m *UserMutation) QueriesIDs() (ids []int) {
+	for id := range m.queries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQueries resets all changes to the "queries" edge.
+func (m *UserMutation) ResetQueries() {
+	m.queries = nil
+	m.clearedqueries = false
+	m.removedqueries = nil
+}
+
+// AddConversationIDs adds the "conversations" edge to the Conversation entity by ids.
+func (m *UserMutation) AddConversationIDs(ids ...int) {
+	if m.conversations == nil {
+		m.conversations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.conversations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConversations clears the "conversations" edge to the Conversation entity.
+func (m *UserMutation) ClearConversations() {
+	m.clearedconversations = true
+}
+
+// ConversationsCleared reports if the "conversations" edge to the Conversation entity was cleared.
+func (m *UserMutation) ConversationsCleared() bool {
+	return m.clearedconversations
+}
+
+// RemoveConversationIDs removes the "conversations" edge to the Conversation entity by IDs.
+func (m *UserMutation) RemoveConversationIDs(ids ...int) {
+	if m.removedconversations == nil {
+		m.removedconversations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.conversations, ids[i])
+		m.removedconversations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConversations returns the removed IDs of the "conversations" edge to the Conversation entity.
+func (m *UserMutation) RemovedConversationsIDs() (ids []int) {
+	for id := range m.removedconversations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConversationsIDs returns the "conversations" edge IDs in the mutation.
+func (m *UserMutation) ConversationsIDs() (ids []int) {
+	for id := range m.conversations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConversations resets all changes to the "conversations" edge.
+func (m *UserMutation) ResetConversations() {
+	m.conversations = nil
+	m.clearedconversations = false
+	m.removedconversations = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddSecurityQuestionIDs adds the "security_questions" edge to the SecurityQuestion entity by ids.
+func (m *UserMutation) AddSecurityQuestionIDs(ids ...uuid.UUID) {
+	if m.security_questions == nil {
+		m.security_questions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.security_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecurityQuestions clears the "security_questions" edge to the SecurityQuestion entity.
+func (m *UserMutation) ClearSecurityQuestions() {
+	m.clearedsecurity_questions = true
+}
+
+// SecurityQuestionsCleared reports if the "security_questions" edge to the SecurityQuestion entity was cleared.
+func (m *UserMutation) SecurityQuestionsCleared() bool {
+	return m.clearedsecurity_questions
+}
+
+// RemoveSecurityQuestionIDs removes the "security_questions" edge to the SecurityQuestion entity by IDs.
+func (m *UserMutation) RemoveSecurityQuestionIDs(ids ...uuid.UUID) {
+	if m.removedsecurity_questions == nil {
+		m.removedsecurity_questions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.security_questions, ids[i])
+		m.removedsecurity_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecurityQuestions returns the removed IDs of the "security_questions" edge to the SecurityQuestion entity.
+func (m *UserMutation) RemovedSecurityQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsecurity_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecurityQuestionsIDs returns the "security_questions" edge IDs in the mutation.
+func (m *UserMutation) SecurityQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.security_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecurityQuestions resets all changes to the "security_questions" edge.
+func (m *UserMutation) ResetSecurityQuestions() {
+	m.security_questions = nil
+	m.clearedsecurity_questions = false
+	m.removedsecurity_questions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.email_confirmed != nil {
+		fields = append(fields, user.FieldEmailConfirmed)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldEmailConfirmed:
+		return m.EmailConfirmed()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldEmailConfirmed:
+		return m.OldEmailConfirmed(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldEmailConfirmed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailConfirmed(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldEmailConfirmed:
+		m.ResetEmailConfirmed()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.projects != nil {
+		edges = append(edges, user.EdgeProjects)
+	}
+	if m.queries != nil {
+		edges = append(edges, user.EdgeQueries)
+	}
+	if m.conversations != nil {
+		edges = append(edges, user.EdgeConversations)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.security_questions != nil {
+		edges = append(edges, user.EdgeSecurityQuestions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.projects))
+		for id := range m.projects {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQueries:
+		ids := make([]ent.Value, 0, len(m.queries))
+		for id := range m.queries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConversations:
+		ids := make([]ent.Value, 0, len(m.conversations))
+		for id := range m.conversations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSecurityQuestions:
+		ids := make([]ent.Value, 0, len(m.security_questions))
+		for id := range m.security_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedprojects != nil {
+		edges = append(edges, user.EdgeProjects)
+	}
+	if m.removedqueries != nil {
+		edges = append(edges, user.EdgeQueries)
+	}
+	if m.removedconversations != nil {
+		edges = append(edges, user.EdgeConversations)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedsecurity_questions != nil {
+		edges = append(edges, user.EdgeSecurityQuestions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeProjects:
+		ids := make([]ent.Value, 0, len(m.removedprojects))
+		for id := range m.removedprojects {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQueries:
+		ids := make([]ent.Value, 0, len(m.removedqueries))
+		for id := range m.removedqueries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConversations:
+		ids := make([]ent.Value, 0, len(m.removedconversations))
+		for id := range m.removedconversations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSecurityQuestions:
+		ids := make([]ent.Value, 0, len(m.removedsecurity_questions))
+		for id := range m.removedsecurity_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedprojects {
+		edges = append(edges, user.EdgeProjects)
+	}
+	if m.clearedqueries {
+		edges = append(edges, user.EdgeQueries)
+	}
+	if m.clearedconversations {
+		edges = append(edges, user.EdgeConversations)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedsecurity_questions {
+		edges = append(edges, user.EdgeSecurityQuestions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeProjects:
+		return m.clearedprojects
+	case user.EdgeQueries:
+		return m.clearedqueries
+	case user.EdgeConversations:
+		return m.clearedconversations
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeSecurityQuestions:
+		return m.clearedsecurity_questions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeProjects:
+		m.ResetProjects()
+		return nil
+	case user.EdgeQueries:
+		m.ResetQueries()
+		return nil
+	case user.EdgeConversations:
+		m.ResetConversations()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeSecurityQuestions:
+		m.ResetSecurityQuestions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserPromptMutation represents an operation that mutates the UserPrompt nodes in the graph.
+type UserPromptMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	query_text          *string
+	answer              *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *uuid.UUID
+	cleareduser         bool
+	project             *int
+	clearedproject      bool
+	conversation        *int
+	clearedconversation bool
+	results             map[int]struct{}
+	removedresults      map[int]struct{}
+	clearedresults      bool
+	done                bool
+	oldValue            func(context.Context) (*UserPrompt, error)
+	predicates          []predicate.UserPrompt
+}
+
+var _ ent.Mutation = (*UserPromptMutation)(nil)
+
+// userpromptOption allows management of the mutation configuration using functional options.
+type userpromptOption func(*UserPromptMutation)
+
+// newUserPromptMutation creates new mutation for the UserPrompt entity.
+func newUserPromptMutation(c config, op Op, opts ...userpromptOption) *UserPromptMutation {
+	m := &UserPromptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserPrompt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserPromptID sets the ID field of the mutation.
+func withUserPromptID(id int) userpromptOption {
+	return func(m *UserPromptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserPrompt
+		)
+		m.oldValue = func(ctx context.Context) (*UserPrompt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserPrompt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserPrompt sets the old UserPrompt of the mutation.
+func withUserPrompt(node *UserPrompt) userpromptOption {
+	return func(m *UserPromptMutation) {
+		m.oldValue = func(context.Context) (*UserPrompt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserPromptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserPromptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserPromptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserPromptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserPrompt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQueryText sets the "query_text" field.
+func (m *UserPromptMutation) SetQueryText(s string) {
+	m.query_text = &s
+}
+
+// QueryText returns the value of the "query_text" field in the mutation.
+func (m *UserPromptMutation) QueryText() (r string, exists bool) {
+	v := m.query_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueryText returns the old "query_text" field's value of the UserPrompt entity.
+// If the UserPrompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPromptMutation) OldQueryText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueryText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueryText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueryText: %w", err)
+	}
+	return oldValue.QueryText, nil
+}
+
+// ResetQueryText resets all changes to the "query_text" field.
+func (m *UserPromptMutation) ResetQueryText() {
+	m.query_text = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *UserPromptMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *UserPromptMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the UserPrompt entity.
+// If the UserPrompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPromptMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *UserPromptMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[userprompt.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *UserPromptMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[userprompt.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *UserPromptMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, userprompt.FieldAnswer)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserPromptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserPromptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserPrompt entity.
+// If the UserPrompt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPromptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserPromptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UserPromptMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserPromptMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserPromptMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UserPromptMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserPromptMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserPromptMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *UserPromptMutation) SetProjectID(id int) {
+	m.project = &id
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *UserPromptMutation) ClearProject() {
+	m.clearedproject = true
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *UserPromptMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *UserPromptMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
+	}
+	return
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *UserPromptMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *UserPromptMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// SetConversationID sets the "conversation" edge to the Conversation entity by id.
+func (m *UserPromptMutation) SetConversationID(id int) {
+	m.conversation = &id
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (m *UserPromptMutation) ClearConversation() {
+	m.clearedconversation = true
+}
+
+// ConversationCleared reports if the "conversation" edge to the Conversation entity was cleared.
+func (m *UserPromptMutation) ConversationCleared() bool {
+	return m.clearedconversation
+}
+
+// ConversationID returns the "conversation" edge ID in the mutation.
+func (m *UserPromptMutation) ConversationID() (id int, exists bool) {
+	if m.conversation != nil {
+		return *m.conversation, true
+	}
+	return
+}
+
+// ConversationIDs returns the "conversation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConversationID instead. It exists only for internal usage by the builders.
+func (m *UserPromptMutation) ConversationIDs() (ids []int) {
+	if id := m.conversation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConversation resets all changes to the "conversation" edge.
+func (m *UserPromptMutation) ResetConversation() {
+	m.conversation = nil
+	m.clearedconversation = false
+}
+
+// AddResultIDs adds the "results" edge to the QueryResult entity by ids.
+func (m *UserPromptMutation) AddResultIDs(ids ...int) {
+	if m.results == nil {
+		m.results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResults clears the "results" edge to the QueryResult entity.
+func (m *UserPromptMutation) ClearResults() {
+	m.clearedresults = true
+}
+
+// ResultsCleared reports if the "results" edge to the QueryResult entity was cleared.
+func (m *UserPromptMutation) ResultsCleared() bool {
+	return m.clearedresults
+}
+
+// RemoveResultIDs removes the "results" edge to the QueryResult entity by IDs.
+func (m *UserPromptMutation) RemoveResultIDs(ids ...int) {
+	if m.removedresults == nil {
+		m.removedresults = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.results, ids[i])
+		m.removedresults[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResults returns the removed IDs of the "results" edge to the QueryResult entity.
+func (m *UserPromptMutation) RemovedResultsIDs() (ids []int) {
+	for id := range m.removedresults {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResultsIDs returns the "results" edge IDs in the mutation.
+func (m *UserPromptMutation) ResultsIDs() (ids []int) {
+	for id := range m.results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResults resets all changes to the "results" edge.
+func (m *UserPromptMutation) ResetResults() {
+	m.results = nil
+	m.clearedresults = false
+	m.removedresults = nil
+}
+
+// Where appends a list predicates to the UserPromptMutation builder.
+func (m *UserPromptMutation) Where(ps ...predicate.UserPrompt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserPromptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserPromptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserPrompt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserPromptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserPromptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserPrompt).
+func (m *UserPromptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserPromptMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.query_text != nil {
+		fields = append(fields, userprompt.FieldQueryText)
+	}
+	if m.answer != nil {
+		fields = append(fields, userprompt.FieldAnswer)
+	}
+	if m.created_at != nil {
+		fields = append(fields, userprompt.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserPromptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userprompt.FieldQueryText:
+		return m.QueryText()
+	case userprompt.FieldAnswer:
+		return m.Answer()
+	case userprompt.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserPromptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userprompt.FieldQueryText:
+		return m.OldQueryText(ctx)
+	case userprompt.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case userprompt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserPrompt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPromptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userprompt.FieldQueryText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueryText(v)
+		return nil
+	case userprompt.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case userprompt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserPrompt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserPromptMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserPromptMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPromptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UserPrompt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserPromptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userprompt.FieldAnswer) {
+		fields = append(fields, userprompt.FieldAnswer)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserPromptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserPromptMutation) ClearField(name string) error {
+	switch name {
+	case userprompt.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPrompt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserPromptMutation) ResetField(name string) error {
+	switch name {
+	case userprompt.FieldQueryText:
+		m.ResetQueryText()
+		return nil
+	case userprompt.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case userprompt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPrompt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserPromptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, userprompt.EdgeUser)
+	}
+	if m.project != nil {
+		edges = append(edges, userprompt.EdgeProject)
+	}
+	if m.conversation != nil {
+		edges = append(edges, userprompt.EdgeConversation)
+	}
+	if m.results != nil {
+		edges = append(edges, userprompt.EdgeResults)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserPromptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userprompt.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case userprompt.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case userprompt.EdgeConversation:
+		if id := m.conversation; id != nil {
+			return []ent.Value{*id}
+		}
+	case userprompt.EdgeResults:
+		ids := make([]ent.Value, 0, len(m.results))
+		for id := range m.results {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserPromptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedresults != nil {
+		edges = append(edges, userprompt.EdgeResults)
+	}
+	return edges
 }
 
-// DeviceName returns the value of the "device_name" field in the mutation.
-func (m *SessionMutation) DeviceName() (r string, exists bool) {
-	v := m.device_name
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserPromptMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case userprompt.EdgeResults:
+		ids := make([]ent.Value, 0, len(m.removedresults))
+		for id := range m.removedresults {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldDeviceName returns the old "device_name" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldDeviceName(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserPromptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, userprompt.EdgeUser)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	if m.clearedproject {
+		edges = append(edges, userprompt.EdgeProject)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	if m.clearedconversation {
+		edges = append(edges, userprompt.EdgeConversation)
 	}
-	return oldValue.DeviceName, nil
-}
-
-// ClearDeviceName clears the value of the "device_name" field.
-func (m *SessionMutation) ClearDeviceName() {
-	m.device_name = nil
-	m.clearedFields[session.FieldDeviceName] = struct{}{}
-}
-
-// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
-func (m *SessionMutation) DeviceNameCleared() bool {
-	_, ok := m.clearedFields[session.FieldDeviceName]
-	return ok
+	if m.clearedresults {
+		edges = append(edges, userprompt.EdgeResults)
+	}
+	return edges
 }
 
-// ResetDeviceName resets all changes to the "device_name" field.
-func (m *SessionMutation) ResetDeviceName() {
-	m.device_name = nil
-	delete(m.clearedFields, session.FieldDeviceName)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserPromptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userprompt.EdgeUser:
+		return m.cleareduser
+	case userprompt.EdgeProject:
+		return m.clearedproject
+	case userprompt.EdgeConversation:
+		return m.clearedconversation
+	case userprompt.EdgeResults:
+		return m.clearedresults
+	}
+	return false
 }
 
-// SetLastSyncTime sets the "last_sync_time" field.
-func (m *SessionMutation) SetLastSyncTime(t time.Time) {
-	m.last_sync_time = &t
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserPromptMutation) ClearEdge(name string) error {
+	switch name {
+	case userprompt.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userprompt.EdgeProject:
+		m.ClearProject()
+		return nil
+	case userprompt.EdgeConversation:
+		m.ClearConversation()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPrompt unique edge %s", name)
 }
 
-// LastSyncTime returns the value of the "last_sync_time" field in the mutation.
-func (m *SessionMutation) LastSyncTime() (r time.Time, exists bool) {
-	v := m.last_sync_time
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserPromptMutation) ResetEdge(name string) error {
+	switch name {
+	case userprompt.EdgeUser:
+		m.ResetUser()
+		return nil
+	case userprompt.EdgeProject:
+		m.ResetProject()
+		return nil
+	case userprompt.EdgeConversation:
+		m.ResetConversation()
+		return nil
+	case userprompt.EdgeResults:
+		m.ResetResults()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown UserPrompt edge %s", name)
 }
 
-// OldLastSyncTime returns the old "last_sync_time" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldLastSyncTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSyncTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSyncTime requires an ID field in the mutation")
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	active            *bool
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	project           *int
+	clearedproject    bool
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSyncTime: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.LastSyncTime, nil
+	return m
 }
 
-// ClearLastSyncTime clears the value of the "last_sync_time" field.
-func (m *SessionMutation) ClearLastSyncTime() {
-	m.last_sync_time = nil
-	m.clearedFields[session.FieldLastSyncTime] = struct{}{}
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// LastSyncTimeCleared returns if the "last_sync_time" field was cleared in this mutation.
-func (m *SessionMutation) LastSyncTimeCleared() bool {
-	_, ok := m.clearedFields[session.FieldLastSyncTime]
-	return ok
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetLastSyncTime resets all changes to the "last_sync_time" field.
-func (m *SessionMutation) ResetLastSyncTime() {
-	m.last_sync_time = nil
-	delete(m.clearedFields, session.FieldLastSyncTime)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SessionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SessionMutation) ResetCreatedAt() {
-	m.created_at = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *SessionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.URL, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *SessionMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetRevokedAt sets the "revoked_at" field.
-func (m *SessionMutation) SetRevokedAt(t time.Time) {
-	m.revoked_at = &t
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// RevokedAt returns the value of the "revoked_at" field in the mutation.
-func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
-	v := m.revoked_at
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
-	}
-	return oldValue.RevokedAt, nil
-}
-
-// ClearRevokedAt clears the value of the "revoked_at" field.
-func (m *SessionMutation) ClearRevokedAt() {
-	m.revoked_at = nil
-	m.clearedFields[session.FieldRevokedAt] = struct{}{}
-}
-
-// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
-func (m *SessionMutation) RevokedAtCleared() bool {
-	_, ok := m.clearedFields[session.FieldRevokedAt]
-	return ok
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
 }
 
-// ResetRevokedAt resets all changes to the "revoked_at" field.
-func (m *SessionMutation) ResetRevokedAt() {
-	m.revoked_at = nil
-	delete(m.clearedFields, session.FieldRevokedAt)
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *SessionMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *SessionMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldIPAddress(ctx context.Context) (v *string, err error) {
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldEvents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.Events, nil
 }
 
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *SessionMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[session.FieldIPAddress] = struct{}{}
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
 }
 
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *SessionMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[session.FieldIPAddress]
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
 	return ok
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *SessionMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, session.FieldIPAddress)
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, webhook.FieldEvents)
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *SessionMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// SetActive sets the "active" field.
+func (m *WebhookMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *SessionMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// Active returns the value of the "active" field in the mutation.
+func (m *WebhookMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldUserAgent(ctx context.Context) (v *string, err error) {
+func (m *WebhookMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.Active, nil
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (m *SessionMutation) ClearUserAgent() {
-	m.user_agent = nil
-	m.clearedFields[session.FieldUserAgent] = struct{}{}
+// ResetActive resets all changes to the "active" field.
+func (m *WebhookMutation) ResetActive() {
+	m.active = nil
 }
 
-// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
-func (m *SessionMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[session.FieldUserAgent]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *SessionMutation) ResetUserAgent() {
-	m.user_agent = nil
-	delete(m.clearedFields, session.FieldUserAgent)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SessionMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SessionMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SessionMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[session.FieldMetadata] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SessionMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[session.FieldMetadata]
-	return ok
+// SetProjectID sets the "project" edge to the Project entity by id.
+func (m *WebhookMutation) SetProjectID(id int) {
+	m.project = &id
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SessionMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, session.FieldMetadata)
+// ClearProject clears the "project" edge to the Project entity.
+func (m *WebhookMutation) ClearProject() {
+	m.clearedproject = true
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *SessionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *WebhookMutation) ProjectCleared() bool {
+	return m.clearedproject
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SessionMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[session.FieldSessionsUserids] = struct{}{}
+// ProjectID returns the "project" edge ID in the mutation.
+func (m *WebhookMutation) ProjectID() (id int, exists bool) {
+	if m.project != nil {
+		return *m.project, true
+	}
+	return
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SessionMutation) UserCleared() bool {
-	return m.cleareduser
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *SessionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetProject resets all changes to the "project" edge.
+func (m *WebhookMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SessionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SessionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4048,98 +13379,63 @@ func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SessionMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SessionMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.session_id != nil {
-		fields = append(fields, session.FieldSessionID)
-	}
-	if m.user != nil {
-		fields = append(fields, session.FieldSessionsUserids)
-	}
-	if m.session_type != nil {
-		fields = append(fields, session.FieldSessionType)
-	}
-	if m.access_token != nil {
-		fields = append(fields, session.FieldAccessToken)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.refresh_token != nil {
-		fields = append(fields, session.FieldRefreshToken)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.device_name != nil {
-		fields = append(fields, session.FieldDeviceName)
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
 	}
-	if m.last_sync_time != nil {
-		fields = append(fields, session.FieldLastSyncTime)
+	if m.active != nil {
+		fields = append(fields, webhook.FieldActive)
 	}
 	if m.created_at != nil {
-		fields = append(fields, session.FieldCreatedAt)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, session.FieldExpiresAt)
-	}
-	if m.revoked_at != nil {
-		fields = append(fields, session.FieldRevokedAt)
-	}
-	if m.ip_address != nil {
-		fields = append(fields, session.FieldIPAddress)
-	}
-	if m.user_agent != nil {
-		fields = append(fields, session.FieldUserAgent)
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
-	if m.metadata != nil {
-		fields = append(fields, session.FieldMetadata)
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case session.FieldSessionID:
-		return m.SessionID()
-	case session.FieldSessionsUserids:
-		return m.SessionsUserids()
-	case session.FieldSessionType:
-		return m.SessionType()
-	case session.FieldAccessToken:
-		return m.AccessToken()
-	case session.FieldRefreshToken:
-		return m.RefreshToken()
-	case session.FieldDeviceName:
-		return m.DeviceName()
-	case session.FieldLastSyncTime:
-		return m.LastSyncTime()
-	case session.FieldCreatedAt:
-		return m.CreatedAt()
-	case session.FieldExpiresAt:
-		return m.ExpiresAt()
-	case session.FieldRevokedAt:
-		return m.RevokedAt()
-	case session.FieldIPAddress:
-		return m.IPAddress()
-	case session.FieldUserAgent:
-		return m.UserAgent()
-	case session.FieldMetadata:
-		return m.Metadata()
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldActive:
+		return m.Active()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4147,384 +13443,299 @@ func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case session.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case session.FieldSessionsUserids:
-		return m.OldSessionsUserids(ctx)
-	case session.FieldSessionType:
-		return m.OldSessionType(ctx)
-	case session.FieldAccessToken:
-		return m.OldAccessToken(ctx)
-	case session.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	case session.FieldDeviceName:
-		return m.OldDeviceName(ctx)
-	case session.FieldLastSyncTime:
-		return m.OldLastSyncTime(ctx)
-	case session.FieldCreatedAt:
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldActive:
+		return m.OldActive(ctx)
+	case webhook.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case session.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case session.FieldRevokedAt:
-		return m.OldRevokedAt(ctx)
-	case session.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case session.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case session.FieldMetadata:
-		return m.OldMetadata(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldSessionID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionID(v)
-		return nil
-	case session.FieldSessionsUserids:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionsUserids(v)
-		return nil
-	case session.FieldSessionType:
-		v, ok := value.(session.SessionType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionType(v)
-		return nil
-	case session.FieldAccessToken:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessToken(v)
+		m.SetURL(v)
 		return nil
-	case session.FieldRefreshToken:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshToken(v)
+		m.SetSecret(v)
 		return nil
-	case session.FieldDeviceName:
-		v, ok := value.(string)
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceName(v)
+		m.SetEvents(v)
 		return nil
-	case session.FieldLastSyncTime:
-		v, ok := value.(time.Time)
+	case webhook.FieldActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSyncTime(v)
+		m.SetActive(v)
 		return nil
-	case session.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case session.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case session.FieldRevokedAt:
+	case webhook.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevokedAt(v)
-		return nil
-	case session.FieldIPAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIPAddress(v)
-		return nil
-	case session.FieldUserAgent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserAgent(v)
-		return nil
-	case session.FieldMetadata:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SessionMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SessionMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(session.FieldRefreshToken) {
-		fields = append(fields, session.FieldRefreshToken)
-	}
-	if m.FieldCleared(session.FieldDeviceName) {
-		fields = append(fields, session.FieldDeviceName)
-	}
-	if m.FieldCleared(session.FieldLastSyncTime) {
-		fields = append(fields, session.FieldLastSyncTime)
-	}
-	if m.FieldCleared(session.FieldRevokedAt) {
-		fields = append(fields, session.FieldRevokedAt)
-	}
-	if m.FieldCleared(session.FieldIPAddress) {
-		fields = append(fields, session.FieldIPAddress)
-	}
-	if m.FieldCleared(session.FieldUserAgent) {
-		fields = append(fields, session.FieldUserAgent)
-	}
-	if m.FieldCleared(session.FieldMetadata) {
-		fields = append(fields, session.FieldMetadata)
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
+func (m *WebhookMutation) ClearField(name string) error {
 	switch name {
-	case session.FieldRefreshToken:
-		m.ClearRefreshToken()
-		return nil
-	case session.FieldDeviceName:
-		m.ClearDeviceName()
-		return nil
-	case session.FieldLastSyncTime:
-		m.ClearLastSyncTime()
-		return nil
-	case session.FieldRevokedAt:
-		m.ClearRevokedAt()
-		return nil
-	case session.FieldIPAddress:
-		m.ClearIPAddress()
-		return nil
-	case session.FieldUserAgent:
-		m.ClearUserAgent()
-		return nil
-	case session.FieldMetadata:
-		m.ClearMetadata()
+	case webhook.FieldEvents:
+		m.ClearEvents()
 		return nil
 	}
-	return fmt.Errorf("unknown Session nullable field %s", name)
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case session.FieldSessionID:
-		m.ResetSessionID()
-		return nil
-	case session.FieldSessionsUserids:
-		m.ResetSessionsUserids()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case session.FieldSessionType:
-		m.ResetSessionType()
-		return nil
-	case session.FieldAccessToken:
-		m.ResetAccessToken()
-		return nil
-	case session.FieldRefreshToken:
-		m.ResetRefreshToken()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case session.FieldDeviceName:
-		m.ResetDeviceName()
+	case webhook.FieldEvents:
+		m.ResetEvents()
 		return nil
-	case session.FieldLastSyncTime:
-		m.ResetLastSyncTime()
+	case webhook.FieldActive:
+		m.ResetActive()
 		return nil
-	case session.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case session.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case session.FieldRevokedAt:
-		m.ResetRevokedAt()
-		return nil
-	case session.FieldIPAddress:
-		m.ResetIPAddress()
-		return nil
-	case session.FieldUserAgent:
-		m.ResetUserAgent()
-		return nil
-	case session.FieldMetadata:
-		m.ResetMetadata()
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, session.EdgeUser)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.project != nil {
+		edges = append(edges, webhook.EdgeProject)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case session.EdgeUser:
-		if id := m.user; id != nil {
+	case webhook.EdgeProject:
+		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, session.EdgeUser)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproject {
+		edges = append(edges, webhook.EdgeProject)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case session.EdgeUser:
-		return m.cleareduser
+	case webhook.EdgeProject:
+		return m.clearedproject
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case session.EdgeUser:
-		m.ClearUser()
+	case webhook.EdgeProject:
+		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Session unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case session.EdgeUser:
-		m.ResetUser()
+	case webhook.EdgeProject:
+		m.ResetProject()
 		return nil
-	}
-	return fmt.Errorf("unknown Session edge %s", name)
-}
-
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	email                     *string
-	password_hash             *string
-	email_confirmed           *bool
-	created_at                *time.Time
-	clearedFields             map[string]struct{}
-	projects                  map[int]struct{}
-	removedprojects           map[int]struct{}
-	clearedprojects           bool
-	queries                   map[int]struct{}
-	removedqueries            map[int]struct{}
-	clearedqueries            bool
-	sessions                  map[int]struct{}
-	removedsessions           map[int]struct{}
-	clearedsessions           bool
-	security_questions        map[uuid.UUID]struct{}
-	removedsecurity_questions map[uuid.UUID]struct{}
-	clearedsecurity_questions bool
-	done                      bool
-	oldValue                  func(context.Context) (*User, error)
-	predicates                []predicate.User
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	event              *string
+	event_id           *string
+	url                *string
+	payload            *string
+	secret             *string
+	status             *string
+	attempts           *int
+	addattempts        *int
+	max_attempts       *int
+	addmax_attempts    *int
+	next_attempt_at    *time.Time
+	locked_until       *time.Time
+	response_status    *int
+	addresponse_status *int
+	response_body      *string
+	duration_ms        *int
+	addduration_ms     *int
+	last_error         *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *int
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4533,20 +13744,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4555,10 +13766,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4567,7 +13778,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4575,7 +13786,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4584,15 +13795,9 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4603,887 +13808,679 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
-}
-
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
-	}
-	return oldValue.PasswordHash, nil
-}
-
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
-}
-
-// SetEmailConfirmed sets the "email_confirmed" field.
-func (m *UserMutation) SetEmailConfirmed(b bool) {
-	m.email_confirmed = &b
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// EmailConfirmed returns the value of the "email_confirmed" field in the mutation.
-func (m *UserMutation) EmailConfirmed() (r bool, exists bool) {
-	v := m.email_confirmed
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmailConfirmed returns the old "email_confirmed" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmailConfirmed(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailConfirmed is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailConfirmed requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailConfirmed: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.EmailConfirmed, nil
+	return oldValue.Event, nil
 }
 
-// ResetEmailConfirmed resets all changes to the "email_confirmed" field.
-func (m *UserMutation) ResetEmailConfirmed() {
-	m.email_confirmed = nil
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// AddProjectIDs adds the "projects" edge to the Project entity by ids.
-func (m *UserMutation) AddProjectIDs(ids ...int) {
-	if m.projects == nil {
-		m.projects = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.projects[ids[i]] = struct{}{}
-	}
-}
-
-// ClearProjects clears the "projects" edge to the Project entity.
-func (m *UserMutation) ClearProjects() {
-	m.clearedprojects = true
-}
-
-// ProjectsCleared reports if the "projects" edge to the Project entity was cleared.
-func (m *UserMutation) ProjectsCleared() bool {
-	return m.clearedprojects
-}
-
-// RemoveProjectIDs removes the "projects" edge to the Project entity by IDs.
-func (m *UserMutation) RemoveProjectIDs(ids ...int) {
-	if m.removedprojects == nil {
-		m.removedprojects = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.projects, ids[i])
-		m.removedprojects[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedProjects returns the removed IDs of the "projects" edge to the Project entity.
-func (m *UserMutation) RemovedProjectsIDs() (ids []int) {
-	for id := range m.removedprojects {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ProjectsIDs returns the "projects" edge IDs in the mutation.
-func (m *UserMutation) ProjectsIDs() (ids []int) {
-	for id := range m.projects {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetProjects resets all changes to the "projects" edge.
-func (m *UserMutation) ResetProjects() {
-	m.projects = nil
-	m.clearedprojects = false
-	m.removedprojects = nil
-}
-
-// AddQueryIDs adds the "queries" edge to the UserPrompt entity by ids.
-func (m *UserMutation) AddQueryIDs(ids ...int) {
-	if m.queries == nil {
-		m.queries = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.queries[ids[i]] = struct{}{}
-	}
-}
-
-// ClearQueries clears the "queries" edge to the UserPrompt entity.
-func (m *UserMutation) ClearQueries() {
-	m.clearedqueries = true
-}
-
-// QueriesCleared reports if the "queries" edge to the UserPrompt entity was cleared.
-func (m *UserMutation) QueriesCleared() bool {
-	return m.clearedqueries
-}
-
-// RemoveQueryIDs removes the "queries" edge to the UserPrompt entity by IDs.
-func (m *UserMutation) RemoveQueryIDs(ids ...int) {
-	if m.removedqueries == nil {
-		m.removedqueries = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.queries, ids[i])
-		m.removedqueries[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedQueries returns the removed IDs of the "queries" edge to the UserPrompt entity.
-func (m *UserMutation) RemovedQueriesIDs() (ids []int) {
-	for id := range m.removedqueries {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// QueriesIDs returns the "queries" edge IDs in the mutation.
-func (m *UserMutation) QueriesIDs() (ids []int) {
-	for id := range m.queries {
-		ids = append(ids, id)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return
-}
-
-// ResetQueries resets all changes to the "queries" edge.
-func (m *UserMutation) ResetQueries() {
-	m.queries = nil
-	m.clearedqueries = false
-	m.removedqueries = nil
+	return oldValue.EventID, nil
 }
 
-// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
-func (m *UserMutation) AddSessionIDs(ids ...int) {
-	if m.sessions == nil {
-		m.sessions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.sessions[ids[i]] = struct{}{}
-	}
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// ClearSessions clears the "sessions" edge to the Session entity.
-func (m *UserMutation) ClearSessions() {
-	m.clearedsessions = true
+// SetURL sets the "url" field.
+func (m *WebhookDeliveryMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
-func (m *UserMutation) SessionsCleared() bool {
-	return m.clearedsessions
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookDeliveryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
-func (m *UserMutation) RemoveSessionIDs(ids ...int) {
-	if m.removedsessions == nil {
-		m.removedsessions = make(map[int]struct{})
+// OldURL returns the old "url" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.sessions, ids[i])
-		m.removedsessions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
 }
 
-// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
-func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
-	for id := range m.removedsessions {
-		ids = append(ids, id)
-	}
-	return
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookDeliveryMutation) ResetURL() {
+	m.url = nil
 }
 
-// SessionsIDs returns the "sessions" edge IDs in the mutation.
-func (m *UserMutation) SessionsIDs() (ids []int) {
-	for id := range m.sessions {
-		ids = append(ids, id)
-	}
-	return
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ResetSessions resets all changes to the "sessions" edge.
-func (m *UserMutation) ResetSessions() {
-	m.sessions = nil
-	m.clearedsessions = false
-	m.removedsessions = nil
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddSecurityQuestionIDs adds the "security_questions" edge to the SecurityQuestion entity by ids.
-func (m *UserMutation) AddSecurityQuestionIDs(ids ...uuid.UUID) {
-	if m.security_questions == nil {
-		m.security_questions = make(map[uuid.UUID]struct{})
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.security_questions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
+	return oldValue.Payload, nil
 }
 
-// ClearSecurityQuestions clears the "security_questions" edge to the SecurityQuestion entity.
-func (m *UserMutation) ClearSecurityQuestions() {
-	m.clearedsecurity_questions = true
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SecurityQuestionsCleared reports if the "security_questions" edge to the SecurityQuestion entity was cleared.
-func (m *UserMutation) SecurityQuestionsCleared() bool {
-	return m.clearedsecurity_questions
+// SetSecret sets the "secret" field.
+func (m *WebhookDeliveryMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// RemoveSecurityQuestionIDs removes the "security_questions" edge to the SecurityQuestion entity by IDs.
-func (m *UserMutation) RemoveSecurityQuestionIDs(ids ...uuid.UUID) {
-	if m.removedsecurity_questions == nil {
-		m.removedsecurity_questions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.security_questions, ids[i])
-		m.removedsecurity_questions[ids[i]] = struct{}{}
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookDeliveryMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSecurityQuestions returns the removed IDs of the "security_questions" edge to the SecurityQuestion entity.
-func (m *UserMutation) RemovedSecurityQuestionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsecurity_questions {
-		ids = append(ids, id)
+// OldSecret returns the old "secret" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// SecurityQuestionsIDs returns the "security_questions" edge IDs in the mutation.
-func (m *UserMutation) SecurityQuestionsIDs() (ids []uuid.UUID) {
-	for id := range m.security_questions {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
 }
 
-// ResetSecurityQuestions resets all changes to the "security_questions" edge.
-func (m *UserMutation) ResetSecurityQuestions() {
-	m.security_questions = nil
-	m.clearedsecurity_questions = false
-	m.removedsecurity_questions = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookDeliveryMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
-	if m.password_hash != nil {
-		fields = append(fields, user.FieldPasswordHash)
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
-	if m.email_confirmed != nil {
-		fields = append(fields, user.FieldEmailConfirmed)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return fields
+	return oldValue.Attempts, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPasswordHash:
-		return m.PasswordHash()
-	case user.FieldEmailConfirmed:
-		return m.EmailConfirmed()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case user.FieldEmailConfirmed:
-		return m.OldEmailConfirmed(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldPasswordHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPasswordHash(v)
-		return nil
-	case user.FieldEmailConfirmed:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmailConfirmed(v)
-		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown User field %s", name)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *WebhookDeliveryMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldMaxAttempts returns the old "max_attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *WebhookDeliveryMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *WebhookDeliveryMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldPasswordHash:
-		m.ResetPasswordHash()
-		return nil
-	case user.FieldEmailConfirmed:
-		m.ResetEmailConfirmed()
-		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.projects != nil {
-		edges = append(edges, user.EdgeProjects)
-	}
-	if m.queries != nil {
-		edges = append(edges, user.EdgeQueries)
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-	if m.sessions != nil {
-		edges = append(edges, user.EdgeSessions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
-	if m.security_questions != nil {
-		edges = append(edges, user.EdgeSecurityQuestions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return edges
+	return oldValue.NextAttemptAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeProjects:
-		ids := make([]ent.Value, 0, len(m.projects))
-		for id := range m.projects {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQueries:
-		ids := make([]ent.Value, 0, len(m.queries))
-		for id := range m.queries {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.sessions))
-		for id := range m.sessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSecurityQuestions:
-		ids := make([]ent.Value, 0, len(m.security_questions))
-		for id := range m.security_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedprojects != nil {
-		edges = append(edges, user.EdgeProjects)
+// SetLockedUntil sets the "locked_until" field.
+func (m *WebhookDeliveryMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *WebhookDeliveryMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
 	}
-	if m.removedqueries != nil {
-		edges = append(edges, user.EdgeQueries)
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
 	}
-	if m.removedsessions != nil {
-		edges = append(edges, user.EdgeSessions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
 	}
-	if m.removedsecurity_questions != nil {
-		edges = append(edges, user.EdgeSecurityQuestions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
 	}
-	return edges
+	return oldValue.LockedUntil, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeProjects:
-		ids := make([]ent.Value, 0, len(m.removedprojects))
-		for id := range m.removedprojects {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQueries:
-		ids := make([]ent.Value, 0, len(m.removedqueries))
-		for id := range m.removedqueries {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.removedsessions))
-		for id := range m.removedsessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSecurityQuestions:
-		ids := make([]ent.Value, 0, len(m.removedsecurity_questions))
-		for id := range m.removedsecurity_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *WebhookDeliveryMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[webhookdelivery.FieldLockedUntil] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedprojects {
-		edges = append(edges, user.EdgeProjects)
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *WebhookDeliveryMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, webhookdelivery.FieldLockedUntil)
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
 	}
-	if m.clearedqueries {
-		edges = append(edges, user.EdgeQueries)
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
-	if m.clearedsessions {
-		edges = append(edges, user.EdgeSessions)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
-	if m.clearedsecurity_questions {
-		edges = append(edges, user.EdgeSecurityQuestions)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return edges
+	return oldValue.ResponseStatus, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeProjects:
-		return m.clearedprojects
-	case user.EdgeQueries:
-		return m.clearedqueries
-	case user.EdgeSessions:
-		return m.clearedsessions
-	case user.EdgeSecurityQuestions:
-		return m.clearedsecurity_questions
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeProjects:
-		m.ResetProjects()
-		return nil
-	case user.EdgeQueries:
-		m.ResetQueries()
-		return nil
-	case user.EdgeSessions:
-		m.ResetSessions()
-		return nil
-	case user.EdgeSecurityQuestions:
-		m.ResetSecurityQuestions()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
 }
 
-// UserPromptMutation represents an operation that mutates the UserPrompt nodes in the graph.
-type UserPromptMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int
-	query_text     *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	user           *uuid.UUID
-	cleareduser    bool
-	project        *int
-	clearedproject bool
-	results        map[int]struct{}
-	removedresults map[int]struct{}
-	clearedresults bool
-	done           bool
-	oldValue       func(context.Context) (*UserPrompt, error)
-	predicates     []predicate.UserPrompt
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
 }
 
-var _ ent.Mutation = (*UserPromptMutation)(nil)
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
 
-// userpromptOption allows management of the mutation configuration using functional options.
-type userpromptOption func(*UserPromptMutation)
+// SetResponseBody sets the "response_body" field.
+func (m *WebhookDeliveryMutation) SetResponseBody(s string) {
+	m.response_body = &s
+}
 
-// newUserPromptMutation creates new mutation for the UserPrompt entity.
-func newUserPromptMutation(c config, op Op, opts ...userpromptOption) *UserPromptMutation {
-	m := &UserPromptMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUserPrompt,
-		clearedFields: make(map[string]struct{}),
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseBody() (r string, exists bool) {
+	v := m.response_body
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldResponseBody returns the old "response_body" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
+	}
+	return oldValue.ResponseBody, nil
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (m *WebhookDeliveryMutation) ClearResponseBody() {
+	m.response_body = nil
+	m.clearedFields[webhookdelivery.FieldResponseBody] = struct{}{}
+}
+
+// ResponseBodyCleared returns if the "response_body" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseBodyCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseBody]
+	return ok
+}
+
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *WebhookDeliveryMutation) ResetResponseBody() {
+	m.response_body = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseBody)
 }
 
-// withUserPromptID sets the ID field of the mutation.
-func withUserPromptID(id int) userpromptOption {
-	return func(m *UserPromptMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UserPrompt
-		)
-		m.oldValue = func(ctx context.Context) (*UserPrompt, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UserPrompt.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// SetDurationMs sets the "duration_ms" field.
+func (m *WebhookDeliveryMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// withUserPrompt sets the old UserPrompt of the mutation.
-func withUserPrompt(node *UserPrompt) userpromptOption {
-	return func(m *UserPromptMutation) {
-		m.oldValue = func(context.Context) (*UserPrompt, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WebhookDeliveryMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserPromptMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldDurationMs returns the old "duration_ms" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserPromptMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserPromptMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserPromptMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserPrompt.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[webhookdelivery.FieldDurationMs] = struct{}{}
 }
 
-// SetQueryText sets the "query_text" field.
-func (m *UserPromptMutation) SetQueryText(s string) {
-	m.query_text = &s
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDurationMs]
+	return ok
 }
 
-// QueryText returns the value of the "query_text" field in the mutation.
-func (m *UserPromptMutation) QueryText() (r string, exists bool) {
-	v := m.query_text
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, webhookdelivery.FieldDurationMs)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQueryText returns the old "query_text" field's value of the UserPrompt entity.
-// If the UserPrompt object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPromptMutation) OldQueryText(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQueryText is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQueryText requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQueryText: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.QueryText, nil
+	return oldValue.LastError, nil
 }
 
-// ResetQueryText resets all changes to the "query_text" field.
-func (m *UserPromptMutation) ResetQueryText() {
-	m.query_text = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserPromptMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserPromptMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5491,10 +14488,10 @@ func (m *UserPromptMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserPrompt entity.
-// If the UserPrompt object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPromptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5509,151 +14506,143 @@ func (m *UserPromptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserPromptMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *UserPromptMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserPromptMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserPromptMutation) UserCleared() bool {
-	return m.cleareduser
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *UserPromptMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserPromptMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *UserPromptMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetProjectID sets the "project" edge to the Project entity by id.
-func (m *UserPromptMutation) SetProjectID(id int) {
-	m.project = &id
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearProject clears the "project" edge to the Project entity.
-func (m *UserPromptMutation) ClearProject() {
-	m.clearedproject = true
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *UserPromptMutation) ProjectCleared() bool {
-	return m.clearedproject
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProjectID returns the "project" edge ID in the mutation.
-func (m *UserPromptMutation) ProjectID() (id int, exists bool) {
-	if m.project != nil {
-		return *m.project, true
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
 }
 
-// ProjectIDs returns the "project" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProjectID instead. It exists only for internal usage by the builders.
-func (m *UserPromptMutation) ProjectIDs() (ids []int) {
-	if id := m.project; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// ResetProject resets all changes to the "project" edge.
-func (m *UserPromptMutation) ResetProject() {
-	m.project = nil
-	m.clearedproject = false
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// AddResultIDs adds the "results" edge to the QueryResult entity by ids.
-func (m *UserPromptMutation) AddResultIDs(ids ...int) {
-	if m.results == nil {
-		m.results = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.results[ids[i]] = struct{}{}
-	}
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// ClearResults clears the "results" edge to the QueryResult entity.
-func (m *UserPromptMutation) ClearResults() {
-	m.clearedresults = true
+// SetWebhookID sets the "webhook" edge to the Webhook entity by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id int) {
+	m.webhook = &id
 }
 
-// ResultsCleared reports if the "results" edge to the QueryResult entity was cleared.
-func (m *UserPromptMutation) ResultsCleared() bool {
-	return m.clearedresults
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
 }
 
-// RemoveResultIDs removes the "results" edge to the QueryResult entity by IDs.
-func (m *UserPromptMutation) RemoveResultIDs(ids ...int) {
-	if m.removedresults == nil {
-		m.removedresults = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.results, ids[i])
-		m.removedresults[ids[i]] = struct{}{}
-	}
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// RemovedResults returns the removed IDs of the "results" edge to the QueryResult entity.
-func (m *UserPromptMutation) RemovedResultsIDs() (ids []int) {
-	for id := range m.removedresults {
-		ids = append(ids, id)
+// WebhookID returns the "webhook" edge ID in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id int, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// ResultsIDs returns the "results" edge IDs in the mutation.
-func (m *UserPromptMutation) ResultsIDs() (ids []int) {
-	for id := range m.results {
-		ids = append(ids, id)
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []int) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetResults resets all changes to the "results" edge.
-func (m *UserPromptMutation) ResetResults() {
-	m.results = nil
-	m.clearedresults = false
-	m.removedresults = nil
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the UserPromptMutation builder.
-func (m *UserPromptMutation) Where(ps ...predicate.UserPrompt) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserPromptMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserPromptMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserPrompt, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5661,30 +14650,75 @@ func (m *UserPromptMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserPromptMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserPromptMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserPrompt).
-func (m *UserPromptMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserPromptMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.query_text != nil {
-		fields = append(fields, userprompt.FieldQueryText)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
+	}
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhookdelivery.FieldURL)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhookdelivery.FieldSecret)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, webhookdelivery.FieldMaxAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, webhookdelivery.FieldLockedUntil)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.response_body != nil {
+		fields = append(fields, webhookdelivery.FieldResponseBody)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, userprompt.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -5692,12 +14726,42 @@ func (m *UserPromptMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserPromptMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userprompt.FieldQueryText:
-		return m.QueryText()
-	case userprompt.FieldCreatedAt:
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldURL:
+		return m.URL()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldSecret:
+		return m.Secret()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLockedUntil:
+		return m.LockedUntil()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldResponseBody:
+		return m.ResponseBody()
+	case webhookdelivery.FieldDurationMs:
+		return m.DurationMs()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
+	case webhookdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -5705,210 +14769,434 @@ func (m *UserPromptMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserPromptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userprompt.FieldQueryText:
-		return m.OldQueryText(ctx)
-	case userprompt.FieldCreatedAt:
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldURL:
+		return m.OldURL(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	case webhookdelivery.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserPrompt field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPromptMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userprompt.FieldQueryText:
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQueryText(v)
+		m.SetEvent(v)
 		return nil
-	case userprompt.FieldCreatedAt:
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldResponseBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseBody(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UserPrompt field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserPromptMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, webhookdelivery.FieldMaxAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserPromptMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPromptMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UserPrompt numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserPromptMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldLockedUntil) {
+		fields = append(fields, webhookdelivery.FieldLockedUntil)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseBody) {
+		fields = append(fields, webhookdelivery.FieldResponseBody)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDurationMs) {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserPromptMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserPromptMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserPrompt nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldResponseBody:
+		m.ClearResponseBody()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserPromptMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case userprompt.FieldQueryText:
-		m.ResetQueryText()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case userprompt.FieldCreatedAt:
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldResponseBody:
+		m.ResetResponseBody()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
 	}
-	return fmt.Errorf("unknown UserPrompt field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserPromptMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, userprompt.EdgeUser)
-	}
-	if m.project != nil {
-		edges = append(edges, userprompt.EdgeProject)
-	}
-	if m.results != nil {
-		edges = append(edges, userprompt.EdgeResults)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserPromptMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userprompt.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case userprompt.EdgeProject:
-		if id := m.project; id != nil {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
-	case userprompt.EdgeResults:
-		ids := make([]ent.Value, 0, len(m.results))
-		for id := range m.results {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserPromptMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedresults != nil {
-		edges = append(edges, userprompt.EdgeResults)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserPromptMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case userprompt.EdgeResults:
-		ids := make([]ent.Value, 0, len(m.removedresults))
-		for id := range m.removedresults {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserPromptMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, userprompt.EdgeUser)
-	}
-	if m.clearedproject {
-		edges = append(edges, userprompt.EdgeProject)
-	}
-	if m.clearedresults {
-		edges = append(edges, userprompt.EdgeResults)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserPromptMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userprompt.EdgeUser:
-		return m.cleareduser
-	case userprompt.EdgeProject:
-		return m.clearedproject
-	case userprompt.EdgeResults:
-		return m.clearedresults
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserPromptMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case userprompt.EdgeUser:
-		m.ClearUser()
-		return nil
-	case userprompt.EdgeProject:
-		m.ClearProject()
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPrompt unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserPromptMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case userprompt.EdgeUser:
-		m.ResetUser()
-		return nil
-	case userprompt.EdgeProject:
-		m.ResetProject()
-		return nil
-	case userprompt.EdgeResults:
-		m.ResetResults()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPrompt edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -9,12 +9,24 @@ import (
 // Chunk is the predicate function for chunk builders.
 type Chunk func(*sql.Selector)
 
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
 // Document is the predicate function for document builders.
 type Document func(*sql.Selector)
 
+// ImportJob is the predicate function for importjob builders.
+type ImportJob func(*sql.Selector)
+
+// IngestJob is the predicate function for ingestjob builders.
+type IngestJob func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// ProjectSource is the predicate function for projectsource builders.
+type ProjectSource func(*sql.Selector)
+
 // QueryResult is the predicate function for queryresult builders.
 type QueryResult func(*sql.Selector)
 
@@ -29,3 +41,9 @@ type User func(*sql.Selector)
 
 // UserPrompt is the predicate function for userprompt builders.
 type UserPrompt func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -25,6 +25,16 @@ type Project struct {
 	Description string `json:"description,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ChunkStrategy holds the value of the "chunk_strategy" field.
+	ChunkStrategy string `json:"chunk_strategy,omitempty"`
+	// ChunkMaxSize holds the value of the "chunk_max_size" field.
+	ChunkMaxSize *int `json:"chunk_max_size,omitempty"`
+	// ChunkOverlap holds the value of the "chunk_overlap" field.
+	ChunkOverlap *int `json:"chunk_overlap,omitempty"`
+	// ChunkHeadingLevel holds the value of the "chunk_heading_level" field.
+	ChunkHeadingLevel *int `json:"chunk_heading_level,omitempty"`
+	// ChunkIncludeOutputs holds the value of the "chunk_include_outputs" field.
+	ChunkIncludeOutputs bool `json:"chunk_include_outputs,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges         ProjectEdges `json:"edges"`
@@ -40,9 +50,17 @@ type ProjectEdges struct {
 	Documents []*Document `json:"documents,omitempty"`
 	// Queries holds the value of the queries edge.
 	Queries []*UserPrompt `json:"queries,omitempty"`
+	// Conversations holds the value of the conversations edge.
+	Conversations []*Conversation `json:"conversations,omitempty"`
+	// ImportJobs holds the value of the import_jobs edge.
+	ImportJobs []*ImportJob `json:"import_jobs,omitempty"`
+	// Sources holds the value of the sources edge.
+	Sources []*ProjectSource `json:"sources,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -74,14 +92,52 @@ func (e ProjectEdges) QueriesOrErr() ([]*UserPrompt, error) {
 	return nil, &NotLoadedError{edge: "queries"}
 }
 
+// ConversationsOrErr returns the Conversations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ConversationsOrErr() ([]*Conversation, error) {
+	if e.loadedTypes[3] {
+		return e.Conversations, nil
+	}
+	return nil, &NotLoadedError{edge: "conversations"}
+}
+
+// ImportJobsOrErr returns the ImportJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ImportJobsOrErr() ([]*ImportJob, error) {
+	if e.loadedTypes[4] {
+		return e.ImportJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "import_jobs"}
+}
+
+// SourcesOrErr returns the Sources value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) SourcesOrErr() ([]*ProjectSource, error) {
+	if e.loadedTypes[5] {
+		return e.Sources, nil
+	}
+	return nil, &NotLoadedError{edge: "sources"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldID:
+		case project.FieldChunkIncludeOutputs:
+			values[i] = new(sql.NullBool)
+		case project.FieldID, project.FieldChunkMaxSize, project.FieldChunkOverlap, project.FieldChunkHeadingLevel:
 			values[i] = new(sql.NullInt64)
-		case project.FieldName, project.FieldDescription:
+		case project.FieldName, project.FieldDescription, project.FieldChunkStrategy:
 			values[i] = new(sql.NullString)
 		case project.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -126,6 +182,39 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case project.FieldChunkStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_strategy", values[i])
+			} else if value.Valid {
+				_m.ChunkStrategy = value.String
+			}
+		case project.FieldChunkMaxSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_max_size", values[i])
+			} else if value.Valid {
+				_m.ChunkMaxSize = new(int)
+				*_m.ChunkMaxSize = int(value.Int64)
+			}
+		case project.FieldChunkOverlap:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_overlap", values[i])
+			} else if value.Valid {
+				_m.ChunkOverlap = new(int)
+				*_m.ChunkOverlap = int(value.Int64)
+			}
+		case project.FieldChunkHeadingLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_heading_level", values[i])
+			} else if value.Valid {
+				_m.ChunkHeadingLevel = new(int)
+				*_m.ChunkHeadingLevel = int(value.Int64)
+			}
+		case project.FieldChunkIncludeOutputs:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_include_outputs", values[i])
+			} else if value.Valid {
+				_m.ChunkIncludeOutputs = value.Bool
+			}
 		case project.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_projects", values[i])
@@ -161,6 +250,26 @@ func (_m *Project) QueryQueries() *UserPromptQuery {
 	return NewProjectClient(_m.config).QueryQueries(_m)
 }
 
+// QueryConversations queries the "conversations" edge of the Project entity.
+func (_m *Project) QueryConversations() *ConversationQuery {
+	return NewProjectClient(_m.config).QueryConversations(_m)
+}
+
+// QueryImportJobs queries the "import_jobs" edge of the Project entity.
+func (_m *Project) QueryImportJobs() *ImportJobQuery {
+	return NewProjectClient(_m.config).QueryImportJobs(_m)
+}
+
+// QuerySources queries the "sources" edge of the Project entity.
+func (_m *Project) QuerySources() *ProjectSourceQuery {
+	return NewProjectClient(_m.config).QuerySources(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Project entity.
+func (_m *Project) QueryWebhooks() *WebhookQuery {
+	return NewProjectClient(_m.config).QueryWebhooks(_m)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -192,6 +301,27 @@ func (_m *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chunk_strategy=")
+	builder.WriteString(_m.ChunkStrategy)
+	builder.WriteString(", ")
+	if v := _m.ChunkMaxSize; v != nil {
+		builder.WriteString("chunk_max_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ChunkOverlap; v != nil {
+		builder.WriteString("chunk_overlap=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ChunkHeadingLevel; v != nil {
+		builder.WriteString("chunk_heading_level=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("chunk_include_outputs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChunkIncludeOutputs))
 	builder.WriteByte(')')
 	return builder.String()
 }
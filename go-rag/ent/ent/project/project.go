@@ -20,12 +20,30 @@ const (
 	FieldDescription = "description"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldChunkStrategy holds the string denoting the chunk_strategy field in the database.
+	FieldChunkStrategy = "chunk_strategy"
+	// FieldChunkMaxSize holds the string denoting the chunk_max_size field in the database.
+	FieldChunkMaxSize = "chunk_max_size"
+	// FieldChunkOverlap holds the string denoting the chunk_overlap field in the database.
+	FieldChunkOverlap = "chunk_overlap"
+	// FieldChunkHeadingLevel holds the string denoting the chunk_heading_level field in the database.
+	FieldChunkHeadingLevel = "chunk_heading_level"
+	// FieldChunkIncludeOutputs holds the string denoting the chunk_include_outputs field in the database.
+	FieldChunkIncludeOutputs = "chunk_include_outputs"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeDocuments holds the string denoting the documents edge name in mutations.
 	EdgeDocuments = "documents"
 	// EdgeQueries holds the string denoting the queries edge name in mutations.
 	EdgeQueries = "queries"
+	// EdgeConversations holds the string denoting the conversations edge name in mutations.
+	EdgeConversations = "conversations"
+	// EdgeImportJobs holds the string denoting the import_jobs edge name in mutations.
+	EdgeImportJobs = "import_jobs"
+	// EdgeSources holds the string denoting the sources edge name in mutations.
+	EdgeSources = "sources"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -49,6 +67,34 @@ const (
 	QueriesInverseTable = "user_prompts"
 	// QueriesColumn is the table column denoting the queries relation/edge.
 	QueriesColumn = "project_queries"
+	// ConversationsTable is the table that holds the conversations relation/edge.
+	ConversationsTable = "conversations"
+	// ConversationsInverseTable is the table name for the Conversation entity.
+	// It exists in this package in order to avoid circular dependency with the "conversation" package.
+	ConversationsInverseTable = "conversations"
+	// ConversationsColumn is the table column denoting the conversations relation/edge.
+	ConversationsColumn = "project_conversations"
+	// ImportJobsTable is the table that holds the import_jobs relation/edge.
+	ImportJobsTable = "import_jobs"
+	// ImportJobsInverseTable is the table name for the ImportJob entity.
+	// It exists in this package in order to avoid circular dependency with the "importjob" package.
+	ImportJobsInverseTable = "import_jobs"
+	// ImportJobsColumn is the table column denoting the import_jobs relation/edge.
+	ImportJobsColumn = "project_import_jobs"
+	// SourcesTable is the table that holds the sources relation/edge.
+	SourcesTable = "project_sources"
+	// SourcesInverseTable is the table name for the ProjectSource entity.
+	// It exists in this package in order to avoid circular dependency with the "projectsource" package.
+	SourcesInverseTable = "project_sources"
+	// SourcesColumn is the table column denoting the sources relation/edge.
+	SourcesColumn = "project_sources"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "project_webhooks"
 )
 
 // Columns holds all SQL columns for project fields.
@@ -57,6 +103,11 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldCreatedAt,
+	FieldChunkStrategy,
+	FieldChunkMaxSize,
+	FieldChunkOverlap,
+	FieldChunkHeadingLevel,
+	FieldChunkIncludeOutputs,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "projects"
@@ -83,6 +134,10 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultChunkStrategy holds the default value on creation for the "chunk_strategy" field.
+	DefaultChunkStrategy string
+	// DefaultChunkIncludeOutputs holds the default value on creation for the "chunk_include_outputs" field.
+	DefaultChunkIncludeOutputs bool
 )
 
 // OrderOption defines the ordering options for the Project queries.
@@ -108,6 +163,31 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByChunkStrategy orders the results by the chunk_strategy field.
+func ByChunkStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkStrategy, opts...).ToFunc()
+}
+
+// ByChunkMaxSize orders the results by the chunk_max_size field.
+func ByChunkMaxSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkMaxSize, opts...).ToFunc()
+}
+
+// ByChunkOverlap orders the results by the chunk_overlap field.
+func ByChunkOverlap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkOverlap, opts...).ToFunc()
+}
+
+// ByChunkHeadingLevel orders the results by the chunk_heading_level field.
+func ByChunkHeadingLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkHeadingLevel, opts...).ToFunc()
+}
+
+// ByChunkIncludeOutputs orders the results by the chunk_include_outputs field.
+func ByChunkIncludeOutputs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkIncludeOutputs, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -142,6 +222,62 @@ func ByQueries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newQueriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByConversationsCount orders the results by conversations count.
+func ByConversationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationsStep(), opts...)
+	}
+}
+
+// ByConversations orders the results by conversations terms.
+func ByConversations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImportJobsCount orders the results by import_jobs count.
+func ByImportJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportJobsStep(), opts...)
+	}
+}
+
+// ByImportJobs orders the results by import_jobs terms.
+func ByImportJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySourcesCount orders the results by sources count.
+func BySourcesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSourcesStep(), opts...)
+	}
+}
+
+// BySources orders the results by sources terms.
+func BySources(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourcesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -163,3 +299,31 @@ func newQueriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QueriesTable, QueriesColumn),
 	)
 }
+func newConversationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+	)
+}
+func newImportJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportJobsTable, ImportJobsColumn),
+	)
+}
+func newSourcesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourcesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SourcesTable, SourcesColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
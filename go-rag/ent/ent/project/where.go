@@ -70,6 +70,31 @@ func CreatedAt(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ChunkStrategy applies equality check predicate on the "chunk_strategy" field. It's identical to ChunkStrategyEQ.
+func ChunkStrategy(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkStrategy, v))
+}
+
+// ChunkMaxSize applies equality check predicate on the "chunk_max_size" field. It's identical to ChunkMaxSizeEQ.
+func ChunkMaxSize(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkMaxSize, v))
+}
+
+// ChunkOverlap applies equality check predicate on the "chunk_overlap" field. It's identical to ChunkOverlapEQ.
+func ChunkOverlap(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkOverlap, v))
+}
+
+// ChunkHeadingLevel applies equality check predicate on the "chunk_heading_level" field. It's identical to ChunkHeadingLevelEQ.
+func ChunkHeadingLevel(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkHeadingLevel, v))
+}
+
+// ChunkIncludeOutputs applies equality check predicate on the "chunk_include_outputs" field. It's identical to ChunkIncludeOutputsEQ.
+func ChunkIncludeOutputs(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkIncludeOutputs, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldName, v))
@@ -250,6 +275,231 @@ func CreatedAtLTE(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ChunkStrategyEQ applies the EQ predicate on the "chunk_strategy" field.
+func ChunkStrategyEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyNEQ applies the NEQ predicate on the "chunk_strategy" field.
+func ChunkStrategyNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyIn applies the In predicate on the "chunk_strategy" field.
+func ChunkStrategyIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldChunkStrategy, vs...))
+}
+
+// ChunkStrategyNotIn applies the NotIn predicate on the "chunk_strategy" field.
+func ChunkStrategyNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldChunkStrategy, vs...))
+}
+
+// ChunkStrategyGT applies the GT predicate on the "chunk_strategy" field.
+func ChunkStrategyGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyGTE applies the GTE predicate on the "chunk_strategy" field.
+func ChunkStrategyGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyLT applies the LT predicate on the "chunk_strategy" field.
+func ChunkStrategyLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyLTE applies the LTE predicate on the "chunk_strategy" field.
+func ChunkStrategyLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyContains applies the Contains predicate on the "chunk_strategy" field.
+func ChunkStrategyContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyHasPrefix applies the HasPrefix predicate on the "chunk_strategy" field.
+func ChunkStrategyHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyHasSuffix applies the HasSuffix predicate on the "chunk_strategy" field.
+func ChunkStrategyHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyEqualFold applies the EqualFold predicate on the "chunk_strategy" field.
+func ChunkStrategyEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldChunkStrategy, v))
+}
+
+// ChunkStrategyContainsFold applies the ContainsFold predicate on the "chunk_strategy" field.
+func ChunkStrategyContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldChunkStrategy, v))
+}
+
+// ChunkMaxSizeEQ applies the EQ predicate on the "chunk_max_size" field.
+func ChunkMaxSizeEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeNEQ applies the NEQ predicate on the "chunk_max_size" field.
+func ChunkMaxSizeNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeIn applies the In predicate on the "chunk_max_size" field.
+func ChunkMaxSizeIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldChunkMaxSize, vs...))
+}
+
+// ChunkMaxSizeNotIn applies the NotIn predicate on the "chunk_max_size" field.
+func ChunkMaxSizeNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldChunkMaxSize, vs...))
+}
+
+// ChunkMaxSizeGT applies the GT predicate on the "chunk_max_size" field.
+func ChunkMaxSizeGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeGTE applies the GTE predicate on the "chunk_max_size" field.
+func ChunkMaxSizeGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeLT applies the LT predicate on the "chunk_max_size" field.
+func ChunkMaxSizeLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeLTE applies the LTE predicate on the "chunk_max_size" field.
+func ChunkMaxSizeLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldChunkMaxSize, v))
+}
+
+// ChunkMaxSizeIsNil applies the IsNil predicate on the "chunk_max_size" field.
+func ChunkMaxSizeIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldChunkMaxSize))
+}
+
+// ChunkMaxSizeNotNil applies the NotNil predicate on the "chunk_max_size" field.
+func ChunkMaxSizeNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldChunkMaxSize))
+}
+
+// ChunkOverlapEQ applies the EQ predicate on the "chunk_overlap" field.
+func ChunkOverlapEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapNEQ applies the NEQ predicate on the "chunk_overlap" field.
+func ChunkOverlapNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapIn applies the In predicate on the "chunk_overlap" field.
+func ChunkOverlapIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldChunkOverlap, vs...))
+}
+
+// ChunkOverlapNotIn applies the NotIn predicate on the "chunk_overlap" field.
+func ChunkOverlapNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldChunkOverlap, vs...))
+}
+
+// ChunkOverlapGT applies the GT predicate on the "chunk_overlap" field.
+func ChunkOverlapGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapGTE applies the GTE predicate on the "chunk_overlap" field.
+func ChunkOverlapGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapLT applies the LT predicate on the "chunk_overlap" field.
+func ChunkOverlapLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapLTE applies the LTE predicate on the "chunk_overlap" field.
+func ChunkOverlapLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldChunkOverlap, v))
+}
+
+// ChunkOverlapIsNil applies the IsNil predicate on the "chunk_overlap" field.
+func ChunkOverlapIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldChunkOverlap))
+}
+
+// ChunkOverlapNotNil applies the NotNil predicate on the "chunk_overlap" field.
+func ChunkOverlapNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldChunkOverlap))
+}
+
+// ChunkHeadingLevelEQ applies the EQ predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelNEQ applies the NEQ predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelIn applies the In predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldChunkHeadingLevel, vs...))
+}
+
+// ChunkHeadingLevelNotIn applies the NotIn predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldChunkHeadingLevel, vs...))
+}
+
+// ChunkHeadingLevelGT applies the GT predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelGTE applies the GTE predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelLT applies the LT predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelLTE applies the LTE predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldChunkHeadingLevel, v))
+}
+
+// ChunkHeadingLevelIsNil applies the IsNil predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldChunkHeadingLevel))
+}
+
+// ChunkHeadingLevelNotNil applies the NotNil predicate on the "chunk_heading_level" field.
+func ChunkHeadingLevelNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldChunkHeadingLevel))
+}
+
+// ChunkIncludeOutputsEQ applies the EQ predicate on the "chunk_include_outputs" field.
+func ChunkIncludeOutputsEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldChunkIncludeOutputs, v))
+}
+
+// ChunkIncludeOutputsNEQ applies the NEQ predicate on the "chunk_include_outputs" field.
+func ChunkIncludeOutputsNEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldChunkIncludeOutputs, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -319,6 +569,98 @@ func HasQueriesWith(preds ...predicate.UserPrompt) predicate.Project {
 	})
 }
 
+// HasConversations applies the HasEdge predicate on the "conversations" edge.
+func HasConversations() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConversationsTable, ConversationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationsWith applies the HasEdge predicate on the "conversations" edge with a given conditions (other predicates).
+func HasConversationsWith(preds ...predicate.Conversation) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newConversationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImportJobs applies the HasEdge predicate on the "import_jobs" edge.
+func HasImportJobs() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportJobsTable, ImportJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportJobsWith applies the HasEdge predicate on the "import_jobs" edge with a given conditions (other predicates).
+func HasImportJobsWith(preds ...predicate.ImportJob) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newImportJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSources applies the HasEdge predicate on the "sources" edge.
+func HasSources() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SourcesTable, SourcesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourcesWith applies the HasEdge predicate on the "sources" edge with a given conditions (other predicates).
+func HasSourcesWith(preds ...predicate.ProjectSource) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newSourcesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Project) predicate.Project {
 	return predicate.Project(sql.AndPredicates(predicates...))
@@ -6,10 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
+	"go-rag/ent/ent/webhook"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -58,6 +62,76 @@ func (_c *ProjectCreate) SetNillableCreatedAt(v *time.Time) *ProjectCreate {
 	return _c
 }
 
+// SetChunkStrategy sets the "chunk_strategy" field.
+func (_c *ProjectCreate) SetChunkStrategy(v string) *ProjectCreate {
+	_c.mutation.SetChunkStrategy(v)
+	return _c
+}
+
+// SetNillableChunkStrategy sets the "chunk_strategy" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableChunkStrategy(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetChunkStrategy(*v)
+	}
+	return _c
+}
+
+// SetChunkMaxSize sets the "chunk_max_size" field.
+func (_c *ProjectCreate) SetChunkMaxSize(v int) *ProjectCreate {
+	_c.mutation.SetChunkMaxSize(v)
+	return _c
+}
+
+// SetNillableChunkMaxSize sets the "chunk_max_size" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableChunkMaxSize(v *int) *ProjectCreate {
+	if v != nil {
+		_c.SetChunkMaxSize(*v)
+	}
+	return _c
+}
+
+// SetChunkOverlap sets the "chunk_overlap" field.
+func (_c *ProjectCreate) SetChunkOverlap(v int) *ProjectCreate {
+	_c.mutation.SetChunkOverlap(v)
+	return _c
+}
+
+// SetNillableChunkOverlap sets the "chunk_overlap" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableChunkOverlap(v *int) *ProjectCreate {
+	if v != nil {
+		_c.SetChunkOverlap(*v)
+	}
+	return _c
+}
+
+// SetChunkHeadingLevel sets the "chunk_heading_level" field.
+func (_c *ProjectCreate) SetChunkHeadingLevel(v int) *ProjectCreate {
+	_c.mutation.SetChunkHeadingLevel(v)
+	return _c
+}
+
+// SetNillableChunkHeadingLevel sets the "chunk_heading_level" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableChunkHeadingLevel(v *int) *ProjectCreate {
+	if v != nil {
+		_c.SetChunkHeadingLevel(*v)
+	}
+	return _c
+}
+
+// SetChunkIncludeOutputs sets the "chunk_include_outputs" field.
+func (_c *ProjectCreate) SetChunkIncludeOutputs(v bool) *ProjectCreate {
+	_c.mutation.SetChunkIncludeOutputs(v)
+	return _c
+}
+
+// SetNillableChunkIncludeOutputs sets the "chunk_include_outputs" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableChunkIncludeOutputs(v *bool) *ProjectCreate {
+	if v != nil {
+		_c.SetChunkIncludeOutputs(*v)
+	}
+	return _c
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_c *ProjectCreate) SetOwnerID(id uuid.UUID) *ProjectCreate {
 	_c.mutation.SetOwnerID(id)
@@ -107,6 +181,66 @@ func (_c *ProjectCreate) AddQueries(v ...*UserPrompt) *ProjectCreate {
 	return _c.AddQueryIDs(ids...)
 }
 
+// AddConversationIDs adds the "conversations" edge to the Conversation entity by IDs.
+func (_c *ProjectCreate) AddConversationIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddConversationIDs(ids...)
+	return _c
+}
+
+// AddConversations adds the "conversations" edges to the Conversation entity.
+func (_c *ProjectCreate) AddConversations(v ...*Conversation) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConversationIDs(ids...)
+}
+
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_c *ProjectCreate) AddImportJobIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddImportJobIDs(ids...)
+	return _c
+}
+
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_c *ProjectCreate) AddImportJobs(v ...*ImportJob) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportJobIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the ProjectSource entity by IDs.
+func (_c *ProjectCreate) AddSourceIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddSourceIDs(ids...)
+	return _c
+}
+
+// AddSources adds the "sources" edges to the ProjectSource entity.
+func (_c *ProjectCreate) AddSources(v ...*ProjectSource) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSourceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *ProjectCreate) AddWebhookIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *ProjectCreate) AddWebhooks(v ...*Webhook) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_c *ProjectCreate) Mutation() *ProjectMutation {
 	return _c.mutation
@@ -146,6 +280,14 @@ func (_c *ProjectCreate) defaults() {
 		v := project.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.ChunkStrategy(); !ok {
+		v := project.DefaultChunkStrategy
+		_c.mutation.SetChunkStrategy(v)
+	}
+	if _, ok := _c.mutation.ChunkIncludeOutputs(); !ok {
+		v := project.DefaultChunkIncludeOutputs
+		_c.mutation.SetChunkIncludeOutputs(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -156,6 +298,12 @@ func (_c *ProjectCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
 	}
+	if _, ok := _c.mutation.ChunkStrategy(); !ok {
+		return &ValidationError{Name: "chunk_strategy", err: errors.New(`ent: missing required field "Project.chunk_strategy"`)}
+	}
+	if _, ok := _c.mutation.ChunkIncludeOutputs(); !ok {
+		return &ValidationError{Name: "chunk_include_outputs", err: errors.New(`ent: missing required field "Project.chunk_include_outputs"`)}
+	}
 	return nil
 }
 
@@ -194,6 +342,26 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ChunkStrategy(); ok {
+		_spec.SetField(project.FieldChunkStrategy, field.TypeString, value)
+		_node.ChunkStrategy = value
+	}
+	if value, ok := _c.mutation.ChunkMaxSize(); ok {
+		_spec.SetField(project.FieldChunkMaxSize, field.TypeInt, value)
+		_node.ChunkMaxSize = &value
+	}
+	if value, ok := _c.mutation.ChunkOverlap(); ok {
+		_spec.SetField(project.FieldChunkOverlap, field.TypeInt, value)
+		_node.ChunkOverlap = &value
+	}
+	if value, ok := _c.mutation.ChunkHeadingLevel(); ok {
+		_spec.SetField(project.FieldChunkHeadingLevel, field.TypeInt, value)
+		_node.ChunkHeadingLevel = &value
+	}
+	if value, ok := _c.mutation.ChunkIncludeOutputs(); ok {
+		_spec.SetField(project.FieldChunkIncludeOutputs, field.TypeBool, value)
+		_node.ChunkIncludeOutputs = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -243,6 +411,70 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,11 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
+	"go-rag/ent/ent/webhook"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -80,6 +84,115 @@ func (_u *ProjectUpdate) SetNillableCreatedAt(v *time.Time) *ProjectUpdate {
 	return _u
 }
 
+// SetChunkStrategy sets the "chunk_strategy" field.
+func (_u *ProjectUpdate) SetChunkStrategy(v string) *ProjectUpdate {
+	_u.mutation.SetChunkStrategy(v)
+	return _u
+}
+
+// SetNillableChunkStrategy sets the "chunk_strategy" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableChunkStrategy(v *string) *ProjectUpdate {
+	if v != nil {
+		_u.SetChunkStrategy(*v)
+	}
+	return _u
+}
+
+// SetChunkMaxSize sets the "chunk_max_size" field.
+func (_u *ProjectUpdate) SetChunkMaxSize(v int) *ProjectUpdate {
+	_u.mutation.ResetChunkMaxSize()
+	_u.mutation.SetChunkMaxSize(v)
+	return _u
+}
+
+// SetNillableChunkMaxSize sets the "chunk_max_size" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableChunkMaxSize(v *int) *ProjectUpdate {
+	if v != nil {
+		_u.SetChunkMaxSize(*v)
+	}
+	return _u
+}
+
+// AddChunkMaxSize adds value to the "chunk_max_size" field.
+func (_u *ProjectUpdate) AddChunkMaxSize(v int) *ProjectUpdate {
+	_u.mutation.AddChunkMaxSize(v)
+	return _u
+}
+
+// ClearChunkMaxSize clears the value of the "chunk_max_size" field.
+func (_u *ProjectUpdate) ClearChunkMaxSize() *ProjectUpdate {
+	_u.mutation.ClearChunkMaxSize()
+	return _u
+}
+
+// SetChunkOverlap sets the "chunk_overlap" field.
+func (_u *ProjectUpdate) SetChunkOverlap(v int) *ProjectUpdate {
+	_u.mutation.ResetChunkOverlap()
+	_u.mutation.SetChunkOverlap(v)
+	return _u
+}
+
+// SetNillableChunkOverlap sets the "chunk_overlap" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableChunkOverlap(v *int) *ProjectUpdate {
+	if v != nil {
+		_u.SetChunkOverlap(*v)
+	}
+	return _u
+}
+
+// AddChunkOverlap adds value to the "chunk_overlap" field.
+func (_u *ProjectUpdate) AddChunkOverlap(v int) *ProjectUpdate {
+	_u.mutation.AddChunkOverlap(v)
+	return _u
+}
+
+// ClearChunkOverlap clears the value of the "chunk_overlap" field.
+func (_u *ProjectUpdate) ClearChunkOverlap() *ProjectUpdate {
+	_u.mutation.ClearChunkOverlap()
+	return _u
+}
+
+// SetChunkHeadingLevel sets the "chunk_heading_level" field.
+func (_u *ProjectUpdate) SetChunkHeadingLevel(v int) *ProjectUpdate {
+	_u.mutation.ResetChunkHeadingLevel()
+	_u.mutation.SetChunkHeadingLevel(v)
+	return _u
+}
+
+// SetNillableChunkHeadingLevel sets the "chunk_heading_level" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableChunkHeadingLevel(v *int) *ProjectUpdate {
+	if v != nil {
+		_u.SetChunkHeadingLevel(*v)
+	}
+	return _u
+}
+
+// AddChunkHeadingLevel adds value to the "chunk_heading_level" field.
+func (_u *ProjectUpdate) AddChunkHeadingLevel(v int) *ProjectUpdate {
+	_u.mutation.AddChunkHeadingLevel(v)
+	return _u
+}
+
+// ClearChunkHeadingLevel clears the value of the "chunk_heading_level" field.
+func (_u *ProjectUpdate) ClearChunkHeadingLevel() *ProjectUpdate {
+	_u.mutation.ClearChunkHeadingLevel()
+	return _u
+}
+
+// SetChunkIncludeOutputs sets the "chunk_include_outputs" field.
+func (_u *ProjectUpdate) SetChunkIncludeOutputs(v bool) *ProjectUpdate {
+	_u.mutation.SetChunkIncludeOutputs(v)
+	return _u
+}
+
+// SetNillableChunkIncludeOutputs sets the "chunk_include_outputs" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableChunkIncludeOutputs(v *bool) *ProjectUpdate {
+	if v != nil {
+		_u.SetChunkIncludeOutputs(*v)
+	}
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *ProjectUpdate) SetOwnerID(id uuid.UUID) *ProjectUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -129,6 +242,66 @@ func (_u *ProjectUpdate) AddQueries(v ...*UserPrompt) *ProjectUpdate {
 	return _u.AddQueryIDs(ids...)
 }
 
+// AddConversationIDs adds the "conversations" edge to the Conversation entity by IDs.
+func (_u *ProjectUpdate) AddConversationIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddConversationIDs(ids...)
+	return _u
+}
+
+// AddConversations adds the "conversations" edges to the Conversation entity.
+func (_u *ProjectUpdate) AddConversations(v ...*Conversation) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationIDs(ids...)
+}
+
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_u *ProjectUpdate) AddImportJobIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddImportJobIDs(ids...)
+	return _u
+}
+
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_u *ProjectUpdate) AddImportJobs(v ...*ImportJob) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportJobIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the ProjectSource entity by IDs.
+func (_u *ProjectUpdate) AddSourceIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddSourceIDs(ids...)
+	return _u
+}
+
+// AddSources adds the "sources" edges to the ProjectSource entity.
+func (_u *ProjectUpdate) AddSources(v ...*ProjectSource) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSourceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *ProjectUpdate) AddWebhookIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdate) AddWebhooks(v ...*Webhook) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_u *ProjectUpdate) Mutation() *ProjectMutation {
 	return _u.mutation
@@ -182,6 +355,90 @@ func (_u *ProjectUpdate) RemoveQueries(v ...*UserPrompt) *ProjectUpdate {
 	return _u.RemoveQueryIDs(ids...)
 }
 
+// ClearConversations clears all "conversations" edges to the Conversation entity.
+func (_u *ProjectUpdate) ClearConversations() *ProjectUpdate {
+	_u.mutation.ClearConversations()
+	return _u
+}
+
+// RemoveConversationIDs removes the "conversations" edge to Conversation entities by IDs.
+func (_u *ProjectUpdate) RemoveConversationIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to Conversation entities.
+func (_u *ProjectUpdate) RemoveConversations(v ...*Conversation) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearImportJobs clears all "import_jobs" edges to the ImportJob entity.
+func (_u *ProjectUpdate) ClearImportJobs() *ProjectUpdate {
+	_u.mutation.ClearImportJobs()
+	return _u
+}
+
+// RemoveImportJobIDs removes the "import_jobs" edge to ImportJob entities by IDs.
+func (_u *ProjectUpdate) RemoveImportJobIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveImportJobIDs(ids...)
+	return _u
+}
+
+// RemoveImportJobs removes "import_jobs" edges to ImportJob entities.
+func (_u *ProjectUpdate) RemoveImportJobs(v ...*ImportJob) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportJobIDs(ids...)
+}
+
+// ClearSources clears all "sources" edges to the ProjectSource entity.
+func (_u *ProjectUpdate) ClearSources() *ProjectUpdate {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// RemoveSourceIDs removes the "sources" edge to ProjectSource entities by IDs.
+func (_u *ProjectUpdate) RemoveSourceIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveSourceIDs(ids...)
+	return _u
+}
+
+// RemoveSources removes "sources" edges to ProjectSource entities.
+func (_u *ProjectUpdate) RemoveSources(v ...*ProjectSource) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSourceIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdate) ClearWebhooks() *ProjectUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *ProjectUpdate) RemoveWebhookIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *ProjectUpdate) RemoveWebhooks(v ...*Webhook) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ProjectUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -230,6 +487,39 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ChunkStrategy(); ok {
+		_spec.SetField(project.FieldChunkStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChunkMaxSize(); ok {
+		_spec.SetField(project.FieldChunkMaxSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkMaxSize(); ok {
+		_spec.AddField(project.FieldChunkMaxSize, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkMaxSizeCleared() {
+		_spec.ClearField(project.FieldChunkMaxSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkOverlap(); ok {
+		_spec.SetField(project.FieldChunkOverlap, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkOverlap(); ok {
+		_spec.AddField(project.FieldChunkOverlap, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkOverlapCleared() {
+		_spec.ClearField(project.FieldChunkOverlap, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkHeadingLevel(); ok {
+		_spec.SetField(project.FieldChunkHeadingLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkHeadingLevel(); ok {
+		_spec.AddField(project.FieldChunkHeadingLevel, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkHeadingLevelCleared() {
+		_spec.ClearField(project.FieldChunkHeadingLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkIncludeOutputs(); ok {
+		_spec.SetField(project.FieldChunkIncludeOutputs, field.TypeBool, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -349,6 +639,186 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportJobsIDs(); len(nodes) > 0 && !_u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSourcesIDs(); len(nodes) > 0 && !_u.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{project.Label}
@@ -375,44 +845,153 @@ func (_u *ProjectUpdateOne) SetName(v string) *ProjectUpdateOne {
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableName(v *string) *ProjectUpdateOne {
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableName(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ProjectUpdateOne) SetDescription(v string) *ProjectUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDescription(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ProjectUpdateOne) ClearDescription() *ProjectUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProjectUpdateOne) SetCreatedAt(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableCreatedAt(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetChunkStrategy sets the "chunk_strategy" field.
+func (_u *ProjectUpdateOne) SetChunkStrategy(v string) *ProjectUpdateOne {
+	_u.mutation.SetChunkStrategy(v)
+	return _u
+}
+
+// SetNillableChunkStrategy sets the "chunk_strategy" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableChunkStrategy(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetChunkStrategy(*v)
+	}
+	return _u
+}
+
+// SetChunkMaxSize sets the "chunk_max_size" field.
+func (_u *ProjectUpdateOne) SetChunkMaxSize(v int) *ProjectUpdateOne {
+	_u.mutation.ResetChunkMaxSize()
+	_u.mutation.SetChunkMaxSize(v)
+	return _u
+}
+
+// SetNillableChunkMaxSize sets the "chunk_max_size" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableChunkMaxSize(v *int) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetChunkMaxSize(*v)
+	}
+	return _u
+}
+
+// AddChunkMaxSize adds value to the "chunk_max_size" field.
+func (_u *ProjectUpdateOne) AddChunkMaxSize(v int) *ProjectUpdateOne {
+	_u.mutation.AddChunkMaxSize(v)
+	return _u
+}
+
+// ClearChunkMaxSize clears the value of the "chunk_max_size" field.
+func (_u *ProjectUpdateOne) ClearChunkMaxSize() *ProjectUpdateOne {
+	_u.mutation.ClearChunkMaxSize()
+	return _u
+}
+
+// SetChunkOverlap sets the "chunk_overlap" field.
+func (_u *ProjectUpdateOne) SetChunkOverlap(v int) *ProjectUpdateOne {
+	_u.mutation.ResetChunkOverlap()
+	_u.mutation.SetChunkOverlap(v)
+	return _u
+}
+
+// SetNillableChunkOverlap sets the "chunk_overlap" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableChunkOverlap(v *int) *ProjectUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetChunkOverlap(*v)
 	}
 	return _u
 }
 
-// SetDescription sets the "description" field.
-func (_u *ProjectUpdateOne) SetDescription(v string) *ProjectUpdateOne {
-	_u.mutation.SetDescription(v)
+// AddChunkOverlap adds value to the "chunk_overlap" field.
+func (_u *ProjectUpdateOne) AddChunkOverlap(v int) *ProjectUpdateOne {
+	_u.mutation.AddChunkOverlap(v)
 	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableDescription(v *string) *ProjectUpdateOne {
+// ClearChunkOverlap clears the value of the "chunk_overlap" field.
+func (_u *ProjectUpdateOne) ClearChunkOverlap() *ProjectUpdateOne {
+	_u.mutation.ClearChunkOverlap()
+	return _u
+}
+
+// SetChunkHeadingLevel sets the "chunk_heading_level" field.
+func (_u *ProjectUpdateOne) SetChunkHeadingLevel(v int) *ProjectUpdateOne {
+	_u.mutation.ResetChunkHeadingLevel()
+	_u.mutation.SetChunkHeadingLevel(v)
+	return _u
+}
+
+// SetNillableChunkHeadingLevel sets the "chunk_heading_level" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableChunkHeadingLevel(v *int) *ProjectUpdateOne {
 	if v != nil {
-		_u.SetDescription(*v)
+		_u.SetChunkHeadingLevel(*v)
 	}
 	return _u
 }
 
-// ClearDescription clears the value of the "description" field.
-func (_u *ProjectUpdateOne) ClearDescription() *ProjectUpdateOne {
-	_u.mutation.ClearDescription()
+// AddChunkHeadingLevel adds value to the "chunk_heading_level" field.
+func (_u *ProjectUpdateOne) AddChunkHeadingLevel(v int) *ProjectUpdateOne {
+	_u.mutation.AddChunkHeadingLevel(v)
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *ProjectUpdateOne) SetCreatedAt(v time.Time) *ProjectUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// ClearChunkHeadingLevel clears the value of the "chunk_heading_level" field.
+func (_u *ProjectUpdateOne) ClearChunkHeadingLevel() *ProjectUpdateOne {
+	_u.mutation.ClearChunkHeadingLevel()
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableCreatedAt(v *time.Time) *ProjectUpdateOne {
+// SetChunkIncludeOutputs sets the "chunk_include_outputs" field.
+func (_u *ProjectUpdateOne) SetChunkIncludeOutputs(v bool) *ProjectUpdateOne {
+	_u.mutation.SetChunkIncludeOutputs(v)
+	return _u
+}
+
+// SetNillableChunkIncludeOutputs sets the "chunk_include_outputs" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableChunkIncludeOutputs(v *bool) *ProjectUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetChunkIncludeOutputs(*v)
 	}
 	return _u
 }
@@ -466,6 +1045,66 @@ func (_u *ProjectUpdateOne) AddQueries(v ...*UserPrompt) *ProjectUpdateOne {
 	return _u.AddQueryIDs(ids...)
 }
 
+// AddConversationIDs adds the "conversations" edge to the Conversation entity by IDs.
+func (_u *ProjectUpdateOne) AddConversationIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddConversationIDs(ids...)
+	return _u
+}
+
+// AddConversations adds the "conversations" edges to the Conversation entity.
+func (_u *ProjectUpdateOne) AddConversations(v ...*Conversation) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationIDs(ids...)
+}
+
+// AddImportJobIDs adds the "import_jobs" edge to the ImportJob entity by IDs.
+func (_u *ProjectUpdateOne) AddImportJobIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddImportJobIDs(ids...)
+	return _u
+}
+
+// AddImportJobs adds the "import_jobs" edges to the ImportJob entity.
+func (_u *ProjectUpdateOne) AddImportJobs(v ...*ImportJob) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportJobIDs(ids...)
+}
+
+// AddSourceIDs adds the "sources" edge to the ProjectSource entity by IDs.
+func (_u *ProjectUpdateOne) AddSourceIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddSourceIDs(ids...)
+	return _u
+}
+
+// AddSources adds the "sources" edges to the ProjectSource entity.
+func (_u *ProjectUpdateOne) AddSources(v ...*ProjectSource) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSourceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *ProjectUpdateOne) AddWebhookIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdateOne) AddWebhooks(v ...*Webhook) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_u *ProjectUpdateOne) Mutation() *ProjectMutation {
 	return _u.mutation
@@ -519,6 +1158,90 @@ func (_u *ProjectUpdateOne) RemoveQueries(v ...*UserPrompt) *ProjectUpdateOne {
 	return _u.RemoveQueryIDs(ids...)
 }
 
+// ClearConversations clears all "conversations" edges to the Conversation entity.
+func (_u *ProjectUpdateOne) ClearConversations() *ProjectUpdateOne {
+	_u.mutation.ClearConversations()
+	return _u
+}
+
+// RemoveConversationIDs removes the "conversations" edge to Conversation entities by IDs.
+func (_u *ProjectUpdateOne) RemoveConversationIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveConversationIDs(ids...)
+	return _u
+}
+
+// RemoveConversations removes "conversations" edges to Conversation entities.
+func (_u *ProjectUpdateOne) RemoveConversations(v ...*Conversation) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationIDs(ids...)
+}
+
+// ClearImportJobs clears all "import_jobs" edges to the ImportJob entity.
+func (_u *ProjectUpdateOne) ClearImportJobs() *ProjectUpdateOne {
+	_u.mutation.ClearImportJobs()
+	return _u
+}
+
+// RemoveImportJobIDs removes the "import_jobs" edge to ImportJob entities by IDs.
+func (_u *ProjectUpdateOne) RemoveImportJobIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveImportJobIDs(ids...)
+	return _u
+}
+
+// RemoveImportJobs removes "import_jobs" edges to ImportJob entities.
+func (_u *ProjectUpdateOne) RemoveImportJobs(v ...*ImportJob) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportJobIDs(ids...)
+}
+
+// ClearSources clears all "sources" edges to the ProjectSource entity.
+func (_u *ProjectUpdateOne) ClearSources() *ProjectUpdateOne {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// RemoveSourceIDs removes the "sources" edge to ProjectSource entities by IDs.
+func (_u *ProjectUpdateOne) RemoveSourceIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveSourceIDs(ids...)
+	return _u
+}
+
+// RemoveSources removes "sources" edges to ProjectSource entities.
+func (_u *ProjectUpdateOne) RemoveSources(v ...*ProjectSource) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSourceIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdateOne) ClearWebhooks() *ProjectUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *ProjectUpdateOne) RemoveWebhookIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *ProjectUpdateOne) RemoveWebhooks(v ...*Webhook) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectUpdate builder.
 func (_u *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
 	_u.mutation.Where(ps...)
@@ -597,6 +1320,39 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.ChunkStrategy(); ok {
+		_spec.SetField(project.FieldChunkStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChunkMaxSize(); ok {
+		_spec.SetField(project.FieldChunkMaxSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkMaxSize(); ok {
+		_spec.AddField(project.FieldChunkMaxSize, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkMaxSizeCleared() {
+		_spec.ClearField(project.FieldChunkMaxSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkOverlap(); ok {
+		_spec.SetField(project.FieldChunkOverlap, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkOverlap(); ok {
+		_spec.AddField(project.FieldChunkOverlap, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkOverlapCleared() {
+		_spec.ClearField(project.FieldChunkOverlap, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkHeadingLevel(); ok {
+		_spec.SetField(project.FieldChunkHeadingLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkHeadingLevel(); ok {
+		_spec.AddField(project.FieldChunkHeadingLevel, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkHeadingLevelCleared() {
+		_spec.ClearField(project.FieldChunkHeadingLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChunkIncludeOutputs(); ok {
+		_spec.SetField(project.FieldChunkIncludeOutputs, field.TypeBool, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -716,6 +1472,186 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationsIDs(); len(nodes) > 0 && !_u.mutation.ConversationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ConversationsTable,
+			Columns: []string{project.ConversationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportJobsIDs(); len(nodes) > 0 && !_u.mutation.ImportJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ImportJobsTable,
+			Columns: []string{project.ImportJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSourcesIDs(); len(nodes) > 0 && !_u.mutation.SourcesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourcesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.SourcesTable,
+			Columns: []string{project.SourcesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Project{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projectsource
+
+import (
+	"go-rag/ent/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldID, id))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldType, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldURL, v))
+}
+
+// Ref applies equality check predicate on the "ref" field. It's identical to RefEQ.
+func Ref(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldRef, v))
+}
+
+// LastCommit applies equality check predicate on the "last_commit" field. It's identical to LastCommitEQ.
+func LastCommit(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLastCommit, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldStatus, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLastError, v))
+}
+
+// SyncedAt applies equality check predicate on the "synced_at" field. It's identical to SyncedAtEQ.
+func SyncedAt(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldType, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldURL, v))
+}
+
+// RefEQ applies the EQ predicate on the "ref" field.
+func RefEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldRef, v))
+}
+
+// RefNEQ applies the NEQ predicate on the "ref" field.
+func RefNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldRef, v))
+}
+
+// RefIn applies the In predicate on the "ref" field.
+func RefIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldRef, vs...))
+}
+
+// RefNotIn applies the NotIn predicate on the "ref" field.
+func RefNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldRef, vs...))
+}
+
+// RefGT applies the GT predicate on the "ref" field.
+func RefGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldRef, v))
+}
+
+// RefGTE applies the GTE predicate on the "ref" field.
+func RefGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldRef, v))
+}
+
+// RefLT applies the LT predicate on the "ref" field.
+func RefLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldRef, v))
+}
+
+// RefLTE applies the LTE predicate on the "ref" field.
+func RefLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldRef, v))
+}
+
+// RefContains applies the Contains predicate on the "ref" field.
+func RefContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldRef, v))
+}
+
+// RefHasPrefix applies the HasPrefix predicate on the "ref" field.
+func RefHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldRef, v))
+}
+
+// RefHasSuffix applies the HasSuffix predicate on the "ref" field.
+func RefHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldRef, v))
+}
+
+// RefEqualFold applies the EqualFold predicate on the "ref" field.
+func RefEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldRef, v))
+}
+
+// RefContainsFold applies the ContainsFold predicate on the "ref" field.
+func RefContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldRef, v))
+}
+
+// LastCommitEQ applies the EQ predicate on the "last_commit" field.
+func LastCommitEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLastCommit, v))
+}
+
+// LastCommitNEQ applies the NEQ predicate on the "last_commit" field.
+func LastCommitNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldLastCommit, v))
+}
+
+// LastCommitIn applies the In predicate on the "last_commit" field.
+func LastCommitIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldLastCommit, vs...))
+}
+
+// LastCommitNotIn applies the NotIn predicate on the "last_commit" field.
+func LastCommitNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldLastCommit, vs...))
+}
+
+// LastCommitGT applies the GT predicate on the "last_commit" field.
+func LastCommitGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldLastCommit, v))
+}
+
+// LastCommitGTE applies the GTE predicate on the "last_commit" field.
+func LastCommitGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldLastCommit, v))
+}
+
+// LastCommitLT applies the LT predicate on the "last_commit" field.
+func LastCommitLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldLastCommit, v))
+}
+
+// LastCommitLTE applies the LTE predicate on the "last_commit" field.
+func LastCommitLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldLastCommit, v))
+}
+
+// LastCommitContains applies the Contains predicate on the "last_commit" field.
+func LastCommitContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldLastCommit, v))
+}
+
+// LastCommitHasPrefix applies the HasPrefix predicate on the "last_commit" field.
+func LastCommitHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldLastCommit, v))
+}
+
+// LastCommitHasSuffix applies the HasSuffix predicate on the "last_commit" field.
+func LastCommitHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldLastCommit, v))
+}
+
+// LastCommitIsNil applies the IsNil predicate on the "last_commit" field.
+func LastCommitIsNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIsNull(FieldLastCommit))
+}
+
+// LastCommitNotNil applies the NotNil predicate on the "last_commit" field.
+func LastCommitNotNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotNull(FieldLastCommit))
+}
+
+// LastCommitEqualFold applies the EqualFold predicate on the "last_commit" field.
+func LastCommitEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldLastCommit, v))
+}
+
+// LastCommitContainsFold applies the ContainsFold predicate on the "last_commit" field.
+func LastCommitContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldLastCommit, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// SyncedAtEQ applies the EQ predicate on the "synced_at" field.
+func SyncedAtEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtNEQ applies the NEQ predicate on the "synced_at" field.
+func SyncedAtNEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldSyncedAt, v))
+}
+
+// SyncedAtIn applies the In predicate on the "synced_at" field.
+func SyncedAtIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtNotIn applies the NotIn predicate on the "synced_at" field.
+func SyncedAtNotIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldSyncedAt, vs...))
+}
+
+// SyncedAtGT applies the GT predicate on the "synced_at" field.
+func SyncedAtGT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldSyncedAt, v))
+}
+
+// SyncedAtGTE applies the GTE predicate on the "synced_at" field.
+func SyncedAtGTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldSyncedAt, v))
+}
+
+// SyncedAtLT applies the LT predicate on the "synced_at" field.
+func SyncedAtLT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldSyncedAt, v))
+}
+
+// SyncedAtLTE applies the LTE predicate on the "synced_at" field.
+func SyncedAtLTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldSyncedAt, v))
+}
+
+// SyncedAtIsNil applies the IsNil predicate on the "synced_at" field.
+func SyncedAtIsNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIsNull(FieldSyncedAt))
+}
+
+// SyncedAtNotNil applies the NotNil predicate on the "synced_at" field.
+func SyncedAtNotNil() predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotNull(FieldSyncedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ProjectSource {
+	return predicate.ProjectSource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ProjectSource {
+	return predicate.ProjectSource(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDocuments applies the HasEdge predicate on the "documents" edge.
+func HasDocuments() predicate.ProjectSource {
+	return predicate.ProjectSource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentsWith applies the HasEdge predicate on the "documents" edge with a given conditions (other predicates).
+func HasDocumentsWith(preds ...predicate.Document) predicate.ProjectSource {
+	return predicate.ProjectSource(func(s *sql.Selector) {
+		step := newDocumentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProjectSource) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProjectSource) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProjectSource) predicate.ProjectSource {
+	return predicate.ProjectSource(sql.NotPredicates(p))
+}
@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectSourceCreate is the builder for creating a ProjectSource entity.
+type ProjectSourceCreate struct {
+	config
+	mutation *ProjectSourceMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (_c *ProjectSourceCreate) SetType(v string) *ProjectSourceCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableType(v *string) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *ProjectSourceCreate) SetURL(v string) *ProjectSourceCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetRef sets the "ref" field.
+func (_c *ProjectSourceCreate) SetRef(v string) *ProjectSourceCreate {
+	_c.mutation.SetRef(v)
+	return _c
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableRef(v *string) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetRef(*v)
+	}
+	return _c
+}
+
+// SetLastCommit sets the "last_commit" field.
+func (_c *ProjectSourceCreate) SetLastCommit(v string) *ProjectSourceCreate {
+	_c.mutation.SetLastCommit(v)
+	return _c
+}
+
+// SetNillableLastCommit sets the "last_commit" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableLastCommit(v *string) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetLastCommit(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProjectSourceCreate) SetStatus(v string) *ProjectSourceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableStatus(v *string) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *ProjectSourceCreate) SetLockedUntil(v time.Time) *ProjectSourceCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableLockedUntil(v *time.Time) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ProjectSourceCreate) SetLastError(v string) *ProjectSourceCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableLastError(v *string) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_c *ProjectSourceCreate) SetSyncedAt(v time.Time) *ProjectSourceCreate {
+	_c.mutation.SetSyncedAt(v)
+	return _c
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableSyncedAt(v *time.Time) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProjectSourceCreate) SetCreatedAt(v time.Time) *ProjectSourceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableCreatedAt(v *time.Time) *ProjectSourceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_c *ProjectSourceCreate) SetProjectID(id int) *ProjectSourceCreate {
+	_c.mutation.SetProjectID(id)
+	return _c
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_c *ProjectSourceCreate) SetNillableProjectID(id *int) *ProjectSourceCreate {
+	if id != nil {
+		_c = _c.SetProjectID(*id)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *ProjectSourceCreate) SetProject(v *Project) *ProjectSourceCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (_c *ProjectSourceCreate) AddDocumentIDs(ids ...int) *ProjectSourceCreate {
+	_c.mutation.AddDocumentIDs(ids...)
+	return _c
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (_c *ProjectSourceCreate) AddDocuments(v ...*Document) *ProjectSourceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the ProjectSourceMutation object of the builder.
+func (_c *ProjectSourceCreate) Mutation() *ProjectSourceMutation {
+	return _c.mutation
+}
+
+// Save creates the ProjectSource in the database.
+func (_c *ProjectSourceCreate) Save(ctx context.Context) (*ProjectSource, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProjectSourceCreate) SaveX(ctx context.Context) *ProjectSource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectSourceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectSourceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProjectSourceCreate) defaults() {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := projectsource.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.Ref(); !ok {
+		v := projectsource.DefaultRef
+		_c.mutation.SetRef(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := projectsource.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := projectsource.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProjectSourceCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProjectSource.type"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "ProjectSource.url"`)}
+	}
+	if _, ok := _c.mutation.Ref(); !ok {
+		return &ValidationError{Name: "ref", err: errors.New(`ent: missing required field "ProjectSource.ref"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProjectSource.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProjectSource.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProjectSourceCreate) sqlSave(ctx context.Context) (*ProjectSource, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProjectSourceCreate) createSpec() (*ProjectSource, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectSource{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(projectsource.Table, sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(projectsource.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(projectsource.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Ref(); ok {
+		_spec.SetField(projectsource.FieldRef, field.TypeString, value)
+		_node.Ref = value
+	}
+	if value, ok := _c.mutation.LastCommit(); ok {
+		_spec.SetField(projectsource.FieldLastCommit, field.TypeString, value)
+		_node.LastCommit = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(projectsource.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(projectsource.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(projectsource.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.SyncedAt(); ok {
+		_spec.SetField(projectsource.FieldSyncedAt, field.TypeTime, value)
+		_node.SyncedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(projectsource.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectsource.ProjectTable,
+			Columns: []string{projectsource.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_sources = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectSourceCreateBulk is the builder for creating many ProjectSource entities in bulk.
+type ProjectSourceCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectSourceCreate
+}
+
+// Save creates the ProjectSource entities in the database.
+func (_c *ProjectSourceCreateBulk) Save(ctx context.Context) ([]*ProjectSource, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProjectSource, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectSourceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProjectSourceCreateBulk) SaveX(ctx context.Context) []*ProjectSource {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectSourceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectSourceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
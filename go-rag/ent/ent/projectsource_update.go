@@ -0,0 +1,826 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectSourceUpdate is the builder for updating ProjectSource entities.
+type ProjectSourceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectSourceMutation
+}
+
+// Where appends a list predicates to the ProjectSourceUpdate builder.
+func (_u *ProjectSourceUpdate) Where(ps ...predicate.ProjectSource) *ProjectSourceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ProjectSourceUpdate) SetType(v string) *ProjectSourceUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableType(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ProjectSourceUpdate) SetURL(v string) *ProjectSourceUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableURL(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRef sets the "ref" field.
+func (_u *ProjectSourceUpdate) SetRef(v string) *ProjectSourceUpdate {
+	_u.mutation.SetRef(v)
+	return _u
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableRef(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetRef(*v)
+	}
+	return _u
+}
+
+// SetLastCommit sets the "last_commit" field.
+func (_u *ProjectSourceUpdate) SetLastCommit(v string) *ProjectSourceUpdate {
+	_u.mutation.SetLastCommit(v)
+	return _u
+}
+
+// SetNillableLastCommit sets the "last_commit" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableLastCommit(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetLastCommit(*v)
+	}
+	return _u
+}
+
+// ClearLastCommit clears the value of the "last_commit" field.
+func (_u *ProjectSourceUpdate) ClearLastCommit() *ProjectSourceUpdate {
+	_u.mutation.ClearLastCommit()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProjectSourceUpdate) SetStatus(v string) *ProjectSourceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableStatus(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ProjectSourceUpdate) SetLockedUntil(v time.Time) *ProjectSourceUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableLockedUntil(v *time.Time) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ProjectSourceUpdate) ClearLockedUntil() *ProjectSourceUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ProjectSourceUpdate) SetLastError(v string) *ProjectSourceUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableLastError(v *string) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ProjectSourceUpdate) ClearLastError() *ProjectSourceUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *ProjectSourceUpdate) SetSyncedAt(v time.Time) *ProjectSourceUpdate {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableSyncedAt(v *time.Time) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *ProjectSourceUpdate) ClearSyncedAt() *ProjectSourceUpdate {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProjectSourceUpdate) SetCreatedAt(v time.Time) *ProjectSourceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableCreatedAt(v *time.Time) *ProjectSourceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *ProjectSourceUpdate) SetProjectID(id int) *ProjectSourceUpdate {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_u *ProjectSourceUpdate) SetNillableProjectID(id *int) *ProjectSourceUpdate {
+	if id != nil {
+		_u = _u.SetProjectID(*id)
+	}
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ProjectSourceUpdate) SetProject(v *Project) *ProjectSourceUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (_u *ProjectSourceUpdate) AddDocumentIDs(ids ...int) *ProjectSourceUpdate {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (_u *ProjectSourceUpdate) AddDocuments(v ...*Document) *ProjectSourceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the ProjectSourceMutation object of the builder.
+func (_u *ProjectSourceUpdate) Mutation() *ProjectSourceMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ProjectSourceUpdate) ClearProject() *ProjectSourceUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (_u *ProjectSourceUpdate) ClearDocuments() *ProjectSourceUpdate {
+	_u.mutation.ClearDocuments()
+	return _u
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (_u *ProjectSourceUpdate) RemoveDocumentIDs(ids ...int) *ProjectSourceUpdate {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (_u *ProjectSourceUpdate) RemoveDocuments(v ...*Document) *ProjectSourceUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProjectSourceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectSourceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProjectSourceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectSourceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ProjectSourceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(projectsource.Table, projectsource.Columns, sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(projectsource.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(projectsource.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ref(); ok {
+		_spec.SetField(projectsource.FieldRef, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastCommit(); ok {
+		_spec.SetField(projectsource.FieldLastCommit, field.TypeString, value)
+	}
+	if _u.mutation.LastCommitCleared() {
+		_spec.ClearField(projectsource.FieldLastCommit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(projectsource.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(projectsource.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(projectsource.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(projectsource.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(projectsource.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(projectsource.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(projectsource.FieldSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(projectsource.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectsource.ProjectTable,
+			Columns: []string{projectsource.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectsource.ProjectTable,
+			Columns: []string{projectsource.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectsource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProjectSourceUpdateOne is the builder for updating a single ProjectSource entity.
+type ProjectSourceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectSourceMutation
+}
+
+// SetType sets the "type" field.
+func (_u *ProjectSourceUpdateOne) SetType(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableType(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ProjectSourceUpdateOne) SetURL(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableURL(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetRef sets the "ref" field.
+func (_u *ProjectSourceUpdateOne) SetRef(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetRef(v)
+	return _u
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableRef(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetRef(*v)
+	}
+	return _u
+}
+
+// SetLastCommit sets the "last_commit" field.
+func (_u *ProjectSourceUpdateOne) SetLastCommit(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetLastCommit(v)
+	return _u
+}
+
+// SetNillableLastCommit sets the "last_commit" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableLastCommit(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetLastCommit(*v)
+	}
+	return _u
+}
+
+// ClearLastCommit clears the value of the "last_commit" field.
+func (_u *ProjectSourceUpdateOne) ClearLastCommit() *ProjectSourceUpdateOne {
+	_u.mutation.ClearLastCommit()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProjectSourceUpdateOne) SetStatus(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableStatus(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ProjectSourceUpdateOne) SetLockedUntil(v time.Time) *ProjectSourceUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableLockedUntil(v *time.Time) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ProjectSourceUpdateOne) ClearLockedUntil() *ProjectSourceUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ProjectSourceUpdateOne) SetLastError(v string) *ProjectSourceUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableLastError(v *string) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *ProjectSourceUpdateOne) ClearLastError() *ProjectSourceUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSyncedAt sets the "synced_at" field.
+func (_u *ProjectSourceUpdateOne) SetSyncedAt(v time.Time) *ProjectSourceUpdateOne {
+	_u.mutation.SetSyncedAt(v)
+	return _u
+}
+
+// SetNillableSyncedAt sets the "synced_at" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableSyncedAt(v *time.Time) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearSyncedAt clears the value of the "synced_at" field.
+func (_u *ProjectSourceUpdateOne) ClearSyncedAt() *ProjectSourceUpdateOne {
+	_u.mutation.ClearSyncedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProjectSourceUpdateOne) SetCreatedAt(v time.Time) *ProjectSourceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableCreatedAt(v *time.Time) *ProjectSourceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *ProjectSourceUpdateOne) SetProjectID(id int) *ProjectSourceUpdateOne {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetNillableProjectID sets the "project" edge to the Project entity by ID if the given value is not nil.
+func (_u *ProjectSourceUpdateOne) SetNillableProjectID(id *int) *ProjectSourceUpdateOne {
+	if id != nil {
+		_u = _u.SetProjectID(*id)
+	}
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ProjectSourceUpdateOne) SetProject(v *Project) *ProjectSourceUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (_u *ProjectSourceUpdateOne) AddDocumentIDs(ids ...int) *ProjectSourceUpdateOne {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (_u *ProjectSourceUpdateOne) AddDocuments(v ...*Document) *ProjectSourceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the ProjectSourceMutation object of the builder.
+func (_u *ProjectSourceUpdateOne) Mutation() *ProjectSourceMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ProjectSourceUpdateOne) ClearProject() *ProjectSourceUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (_u *ProjectSourceUpdateOne) ClearDocuments() *ProjectSourceUpdateOne {
+	_u.mutation.ClearDocuments()
+	return _u
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (_u *ProjectSourceUpdateOne) RemoveDocumentIDs(ids ...int) *ProjectSourceUpdateOne {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (_u *ProjectSourceUpdateOne) RemoveDocuments(v ...*Document) *ProjectSourceUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentIDs(ids...)
+}
+
+// Where appends a list predicates to the ProjectSourceUpdate builder.
+func (_u *ProjectSourceUpdateOne) Where(ps ...predicate.ProjectSource) *ProjectSourceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProjectSourceUpdateOne) Select(field string, fields ...string) *ProjectSourceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProjectSource entity.
+func (_u *ProjectSourceUpdateOne) Save(ctx context.Context) (*ProjectSource, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectSourceUpdateOne) SaveX(ctx context.Context) *ProjectSource {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProjectSourceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectSourceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ProjectSourceUpdateOne) sqlSave(ctx context.Context) (_node *ProjectSource, err error) {
+	_spec := sqlgraph.NewUpdateSpec(projectsource.Table, projectsource.Columns, sqlgraph.NewFieldSpec(projectsource.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProjectSource.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projectsource.FieldID)
+		for _, f := range fields {
+			if !projectsource.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != projectsource.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(projectsource.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(projectsource.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ref(); ok {
+		_spec.SetField(projectsource.FieldRef, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastCommit(); ok {
+		_spec.SetField(projectsource.FieldLastCommit, field.TypeString, value)
+	}
+	if _u.mutation.LastCommitCleared() {
+		_spec.ClearField(projectsource.FieldLastCommit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(projectsource.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(projectsource.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(projectsource.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(projectsource.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(projectsource.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SyncedAt(); ok {
+		_spec.SetField(projectsource.FieldSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SyncedAtCleared() {
+		_spec.ClearField(projectsource.FieldSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(projectsource.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectsource.ProjectTable,
+			Columns: []string{projectsource.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectsource.ProjectTable,
+			Columns: []string{projectsource.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectsource.DocumentsTable,
+			Columns: []string{projectsource.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProjectSource{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectsource.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -23,6 +23,12 @@ type QueryResult struct {
 	Score float64 `json:"score,omitempty"`
 	// ContentSnippet holds the value of the "content_snippet" field.
 	ContentSnippet string `json:"content_snippet,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID int `json:"document_id,omitempty"`
+	// DocumentName holds the value of the "document_name" field.
+	DocumentName string `json:"document_name,omitempty"`
+	// ChunkIndex holds the value of the "chunk_index" field.
+	ChunkIndex int `json:"chunk_index,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QueryResultQuery when eager-loading is set.
 	Edges               QueryResultEdges `json:"edges"`
@@ -68,9 +74,9 @@ func (*QueryResult) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case queryresult.FieldScore:
 			values[i] = new(sql.NullFloat64)
-		case queryresult.FieldID, queryresult.FieldRank:
+		case queryresult.FieldID, queryresult.FieldRank, queryresult.FieldDocumentID, queryresult.FieldChunkIndex:
 			values[i] = new(sql.NullInt64)
-		case queryresult.FieldContentSnippet:
+		case queryresult.FieldContentSnippet, queryresult.FieldDocumentName:
 			values[i] = new(sql.NullString)
 		case queryresult.ForeignKeys[0]: // user_prompt_results
 			values[i] = new(sql.NullInt64)
@@ -113,6 +119,24 @@ func (_m *QueryResult) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ContentSnippet = value.String
 			}
+		case queryresult.FieldDocumentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value.Valid {
+				_m.DocumentID = int(value.Int64)
+			}
+		case queryresult.FieldDocumentName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_name", values[i])
+			} else if value.Valid {
+				_m.DocumentName = value.String
+			}
+		case queryresult.FieldChunkIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_index", values[i])
+			} else if value.Valid {
+				_m.ChunkIndex = int(value.Int64)
+			}
 		case queryresult.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_prompt_results", value)
@@ -174,6 +198,15 @@ func (_m *QueryResult) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("content_snippet=")
 	builder.WriteString(_m.ContentSnippet)
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("document_name=")
+	builder.WriteString(_m.DocumentName)
+	builder.WriteString(", ")
+	builder.WriteString("chunk_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChunkIndex))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -18,6 +18,12 @@ const (
 	FieldScore = "score"
 	// FieldContentSnippet holds the string denoting the content_snippet field in the database.
 	FieldContentSnippet = "content_snippet"
+	// FieldDocumentID holds the string denoting the document_id field in the database.
+	FieldDocumentID = "document_id"
+	// FieldDocumentName holds the string denoting the document_name field in the database.
+	FieldDocumentName = "document_name"
+	// FieldChunkIndex holds the string denoting the chunk_index field in the database.
+	FieldChunkIndex = "chunk_index"
 	// EdgeQuery holds the string denoting the query edge name in mutations.
 	EdgeQuery = "query"
 	// EdgeChunks holds the string denoting the chunks edge name in mutations.
@@ -44,6 +50,9 @@ var Columns = []string{
 	FieldRank,
 	FieldScore,
 	FieldContentSnippet,
+	FieldDocumentID,
+	FieldDocumentName,
+	FieldChunkIndex,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "query_results"
@@ -96,6 +105,21 @@ func ByContentSnippet(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentSnippet, opts...).ToFunc()
 }
 
+// ByDocumentID orders the results by the document_id field.
+func ByDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentID, opts...).ToFunc()
+}
+
+// ByDocumentName orders the results by the document_name field.
+func ByDocumentName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentName, opts...).ToFunc()
+}
+
+// ByChunkIndex orders the results by the chunk_index field.
+func ByChunkIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkIndex, opts...).ToFunc()
+}
+
 // ByQueryField orders the results by query field.
 func ByQueryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
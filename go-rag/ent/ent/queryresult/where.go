@@ -69,6 +69,21 @@ func ContentSnippet(v string) predicate.QueryResult {
 	return predicate.QueryResult(sql.FieldEQ(FieldContentSnippet, v))
 }
 
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentName applies equality check predicate on the "document_name" field. It's identical to DocumentNameEQ.
+func DocumentName(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldDocumentName, v))
+}
+
+// ChunkIndex applies equality check predicate on the "chunk_index" field. It's identical to ChunkIndexEQ.
+func ChunkIndex(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldChunkIndex, v))
+}
+
 // RankEQ applies the EQ predicate on the "rank" field.
 func RankEQ(v int) predicate.QueryResult {
 	return predicate.QueryResult(sql.FieldEQ(FieldRank, v))
@@ -214,6 +229,181 @@ func ContentSnippetContainsFold(v string) predicate.QueryResult {
 	return predicate.QueryResult(sql.FieldContainsFold(FieldContentSnippet, v))
 }
 
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentIDIsNil applies the IsNil predicate on the "document_id" field.
+func DocumentIDIsNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIsNull(FieldDocumentID))
+}
+
+// DocumentIDNotNil applies the NotNil predicate on the "document_id" field.
+func DocumentIDNotNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotNull(FieldDocumentID))
+}
+
+// DocumentNameEQ applies the EQ predicate on the "document_name" field.
+func DocumentNameEQ(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldDocumentName, v))
+}
+
+// DocumentNameNEQ applies the NEQ predicate on the "document_name" field.
+func DocumentNameNEQ(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNEQ(FieldDocumentName, v))
+}
+
+// DocumentNameIn applies the In predicate on the "document_name" field.
+func DocumentNameIn(vs ...string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIn(FieldDocumentName, vs...))
+}
+
+// DocumentNameNotIn applies the NotIn predicate on the "document_name" field.
+func DocumentNameNotIn(vs ...string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotIn(FieldDocumentName, vs...))
+}
+
+// DocumentNameGT applies the GT predicate on the "document_name" field.
+func DocumentNameGT(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGT(FieldDocumentName, v))
+}
+
+// DocumentNameGTE applies the GTE predicate on the "document_name" field.
+func DocumentNameGTE(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGTE(FieldDocumentName, v))
+}
+
+// DocumentNameLT applies the LT predicate on the "document_name" field.
+func DocumentNameLT(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLT(FieldDocumentName, v))
+}
+
+// DocumentNameLTE applies the LTE predicate on the "document_name" field.
+func DocumentNameLTE(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLTE(FieldDocumentName, v))
+}
+
+// DocumentNameContains applies the Contains predicate on the "document_name" field.
+func DocumentNameContains(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldContains(FieldDocumentName, v))
+}
+
+// DocumentNameHasPrefix applies the HasPrefix predicate on the "document_name" field.
+func DocumentNameHasPrefix(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldHasPrefix(FieldDocumentName, v))
+}
+
+// DocumentNameHasSuffix applies the HasSuffix predicate on the "document_name" field.
+func DocumentNameHasSuffix(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldHasSuffix(FieldDocumentName, v))
+}
+
+// DocumentNameIsNil applies the IsNil predicate on the "document_name" field.
+func DocumentNameIsNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIsNull(FieldDocumentName))
+}
+
+// DocumentNameNotNil applies the NotNil predicate on the "document_name" field.
+func DocumentNameNotNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotNull(FieldDocumentName))
+}
+
+// DocumentNameEqualFold applies the EqualFold predicate on the "document_name" field.
+func DocumentNameEqualFold(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEqualFold(FieldDocumentName, v))
+}
+
+// DocumentNameContainsFold applies the ContainsFold predicate on the "document_name" field.
+func DocumentNameContainsFold(v string) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldContainsFold(FieldDocumentName, v))
+}
+
+// ChunkIndexEQ applies the EQ predicate on the "chunk_index" field.
+func ChunkIndexEQ(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexNEQ applies the NEQ predicate on the "chunk_index" field.
+func ChunkIndexNEQ(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNEQ(FieldChunkIndex, v))
+}
+
+// ChunkIndexIn applies the In predicate on the "chunk_index" field.
+func ChunkIndexIn(vs ...int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexNotIn applies the NotIn predicate on the "chunk_index" field.
+func ChunkIndexNotIn(vs ...int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotIn(FieldChunkIndex, vs...))
+}
+
+// ChunkIndexGT applies the GT predicate on the "chunk_index" field.
+func ChunkIndexGT(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGT(FieldChunkIndex, v))
+}
+
+// ChunkIndexGTE applies the GTE predicate on the "chunk_index" field.
+func ChunkIndexGTE(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldGTE(FieldChunkIndex, v))
+}
+
+// ChunkIndexLT applies the LT predicate on the "chunk_index" field.
+func ChunkIndexLT(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLT(FieldChunkIndex, v))
+}
+
+// ChunkIndexLTE applies the LTE predicate on the "chunk_index" field.
+func ChunkIndexLTE(v int) predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldLTE(FieldChunkIndex, v))
+}
+
+// ChunkIndexIsNil applies the IsNil predicate on the "chunk_index" field.
+func ChunkIndexIsNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldIsNull(FieldChunkIndex))
+}
+
+// ChunkIndexNotNil applies the NotNil predicate on the "chunk_index" field.
+func ChunkIndexNotNil() predicate.QueryResult {
+	return predicate.QueryResult(sql.FieldNotNull(FieldChunkIndex))
+}
+
 // HasQuery applies the HasEdge predicate on the "query" edge.
 func HasQuery() predicate.QueryResult {
 	return predicate.QueryResult(func(s *sql.Selector) {
@@ -39,6 +39,48 @@ func (_c *QueryResultCreate) SetContentSnippet(v string) *QueryResultCreate {
 	return _c
 }
 
+// SetDocumentID sets the "document_id" field.
+func (_c *QueryResultCreate) SetDocumentID(v int) *QueryResultCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_c *QueryResultCreate) SetNillableDocumentID(v *int) *QueryResultCreate {
+	if v != nil {
+		_c.SetDocumentID(*v)
+	}
+	return _c
+}
+
+// SetDocumentName sets the "document_name" field.
+func (_c *QueryResultCreate) SetDocumentName(v string) *QueryResultCreate {
+	_c.mutation.SetDocumentName(v)
+	return _c
+}
+
+// SetNillableDocumentName sets the "document_name" field if the given value is not nil.
+func (_c *QueryResultCreate) SetNillableDocumentName(v *string) *QueryResultCreate {
+	if v != nil {
+		_c.SetDocumentName(*v)
+	}
+	return _c
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (_c *QueryResultCreate) SetChunkIndex(v int) *QueryResultCreate {
+	_c.mutation.SetChunkIndex(v)
+	return _c
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (_c *QueryResultCreate) SetNillableChunkIndex(v *int) *QueryResultCreate {
+	if v != nil {
+		_c.SetChunkIndex(*v)
+	}
+	return _c
+}
+
 // SetQueryID sets the "query" edge to the UserPrompt entity by ID.
 func (_c *QueryResultCreate) SetQueryID(id int) *QueryResultCreate {
 	_c.mutation.SetQueryID(id)
@@ -154,6 +196,18 @@ func (_c *QueryResultCreate) createSpec() (*QueryResult, *sqlgraph.CreateSpec) {
 		_spec.SetField(queryresult.FieldContentSnippet, field.TypeString, value)
 		_node.ContentSnippet = value
 	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(queryresult.FieldDocumentID, field.TypeInt, value)
+		_node.DocumentID = value
+	}
+	if value, ok := _c.mutation.DocumentName(); ok {
+		_spec.SetField(queryresult.FieldDocumentName, field.TypeString, value)
+		_node.DocumentName = value
+	}
+	if value, ok := _c.mutation.ChunkIndex(); ok {
+		_spec.SetField(queryresult.FieldChunkIndex, field.TypeInt, value)
+		_node.ChunkIndex = value
+	}
 	if nodes := _c.mutation.QueryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
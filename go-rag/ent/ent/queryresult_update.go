@@ -85,6 +85,80 @@ func (_u *QueryResultUpdate) SetNillableContentSnippet(v *string) *QueryResultUp
 	return _u
 }
 
+// SetDocumentID sets the "document_id" field.
+func (_u *QueryResultUpdate) SetDocumentID(v int) *QueryResultUpdate {
+	_u.mutation.ResetDocumentID()
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *QueryResultUpdate) SetNillableDocumentID(v *int) *QueryResultUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// AddDocumentID adds value to the "document_id" field.
+func (_u *QueryResultUpdate) AddDocumentID(v int) *QueryResultUpdate {
+	_u.mutation.AddDocumentID(v)
+	return _u
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (_u *QueryResultUpdate) ClearDocumentID() *QueryResultUpdate {
+	_u.mutation.ClearDocumentID()
+	return _u
+}
+
+// SetDocumentName sets the "document_name" field.
+func (_u *QueryResultUpdate) SetDocumentName(v string) *QueryResultUpdate {
+	_u.mutation.SetDocumentName(v)
+	return _u
+}
+
+// SetNillableDocumentName sets the "document_name" field if the given value is not nil.
+func (_u *QueryResultUpdate) SetNillableDocumentName(v *string) *QueryResultUpdate {
+	if v != nil {
+		_u.SetDocumentName(*v)
+	}
+	return _u
+}
+
+// ClearDocumentName clears the value of the "document_name" field.
+func (_u *QueryResultUpdate) ClearDocumentName() *QueryResultUpdate {
+	_u.mutation.ClearDocumentName()
+	return _u
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (_u *QueryResultUpdate) SetChunkIndex(v int) *QueryResultUpdate {
+	_u.mutation.ResetChunkIndex()
+	_u.mutation.SetChunkIndex(v)
+	return _u
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (_u *QueryResultUpdate) SetNillableChunkIndex(v *int) *QueryResultUpdate {
+	if v != nil {
+		_u.SetChunkIndex(*v)
+	}
+	return _u
+}
+
+// AddChunkIndex adds value to the "chunk_index" field.
+func (_u *QueryResultUpdate) AddChunkIndex(v int) *QueryResultUpdate {
+	_u.mutation.AddChunkIndex(v)
+	return _u
+}
+
+// ClearChunkIndex clears the value of the "chunk_index" field.
+func (_u *QueryResultUpdate) ClearChunkIndex() *QueryResultUpdate {
+	_u.mutation.ClearChunkIndex()
+	return _u
+}
+
 // SetQueryID sets the "query" edge to the UserPrompt entity by ID.
 func (_u *QueryResultUpdate) SetQueryID(id int) *QueryResultUpdate {
 	_u.mutation.SetQueryID(id)
@@ -202,6 +276,30 @@ func (_u *QueryResultUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.ContentSnippet(); ok {
 		_spec.SetField(queryresult.FieldContentSnippet, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(queryresult.FieldDocumentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentID(); ok {
+		_spec.AddField(queryresult.FieldDocumentID, field.TypeInt, value)
+	}
+	if _u.mutation.DocumentIDCleared() {
+		_spec.ClearField(queryresult.FieldDocumentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DocumentName(); ok {
+		_spec.SetField(queryresult.FieldDocumentName, field.TypeString, value)
+	}
+	if _u.mutation.DocumentNameCleared() {
+		_spec.ClearField(queryresult.FieldDocumentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChunkIndex(); ok {
+		_spec.SetField(queryresult.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(queryresult.FieldChunkIndex, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkIndexCleared() {
+		_spec.ClearField(queryresult.FieldChunkIndex, field.TypeInt)
+	}
 	if _u.mutation.QueryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -352,6 +450,80 @@ func (_u *QueryResultUpdateOne) SetNillableContentSnippet(v *string) *QueryResul
 	return _u
 }
 
+// SetDocumentID sets the "document_id" field.
+func (_u *QueryResultUpdateOne) SetDocumentID(v int) *QueryResultUpdateOne {
+	_u.mutation.ResetDocumentID()
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *QueryResultUpdateOne) SetNillableDocumentID(v *int) *QueryResultUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// AddDocumentID adds value to the "document_id" field.
+func (_u *QueryResultUpdateOne) AddDocumentID(v int) *QueryResultUpdateOne {
+	_u.mutation.AddDocumentID(v)
+	return _u
+}
+
+// ClearDocumentID clears the value of the "document_id" field.
+func (_u *QueryResultUpdateOne) ClearDocumentID() *QueryResultUpdateOne {
+	_u.mutation.ClearDocumentID()
+	return _u
+}
+
+// SetDocumentName sets the "document_name" field.
+func (_u *QueryResultUpdateOne) SetDocumentName(v string) *QueryResultUpdateOne {
+	_u.mutation.SetDocumentName(v)
+	return _u
+}
+
+// SetNillableDocumentName sets the "document_name" field if the given value is not nil.
+func (_u *QueryResultUpdateOne) SetNillableDocumentName(v *string) *QueryResultUpdateOne {
+	if v != nil {
+		_u.SetDocumentName(*v)
+	}
+	return _u
+}
+
+// ClearDocumentName clears the value of the "document_name" field.
+func (_u *QueryResultUpdateOne) ClearDocumentName() *QueryResultUpdateOne {
+	_u.mutation.ClearDocumentName()
+	return _u
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (_u *QueryResultUpdateOne) SetChunkIndex(v int) *QueryResultUpdateOne {
+	_u.mutation.ResetChunkIndex()
+	_u.mutation.SetChunkIndex(v)
+	return _u
+}
+
+// SetNillableChunkIndex sets the "chunk_index" field if the given value is not nil.
+func (_u *QueryResultUpdateOne) SetNillableChunkIndex(v *int) *QueryResultUpdateOne {
+	if v != nil {
+		_u.SetChunkIndex(*v)
+	}
+	return _u
+}
+
+// AddChunkIndex adds value to the "chunk_index" field.
+func (_u *QueryResultUpdateOne) AddChunkIndex(v int) *QueryResultUpdateOne {
+	_u.mutation.AddChunkIndex(v)
+	return _u
+}
+
+// ClearChunkIndex clears the value of the "chunk_index" field.
+func (_u *QueryResultUpdateOne) ClearChunkIndex() *QueryResultUpdateOne {
+	_u.mutation.ClearChunkIndex()
+	return _u
+}
+
 // SetQueryID sets the "query" edge to the UserPrompt entity by ID.
 func (_u *QueryResultUpdateOne) SetQueryID(id int) *QueryResultUpdateOne {
 	_u.mutation.SetQueryID(id)
@@ -499,6 +671,30 @@ func (_u *QueryResultUpdateOne) sqlSave(ctx context.Context) (_node *QueryResult
 	if value, ok := _u.mutation.ContentSnippet(); ok {
 		_spec.SetField(queryresult.FieldContentSnippet, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DocumentID(); ok {
+		_spec.SetField(queryresult.FieldDocumentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocumentID(); ok {
+		_spec.AddField(queryresult.FieldDocumentID, field.TypeInt, value)
+	}
+	if _u.mutation.DocumentIDCleared() {
+		_spec.ClearField(queryresult.FieldDocumentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DocumentName(); ok {
+		_spec.SetField(queryresult.FieldDocumentName, field.TypeString, value)
+	}
+	if _u.mutation.DocumentNameCleared() {
+		_spec.ClearField(queryresult.FieldDocumentName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChunkIndex(); ok {
+		_spec.SetField(queryresult.FieldChunkIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChunkIndex(); ok {
+		_spec.AddField(queryresult.FieldChunkIndex, field.TypeInt, value)
+	}
+	if _u.mutation.ChunkIndexCleared() {
+		_spec.ClearField(queryresult.FieldChunkIndex, field.TypeInt)
+	}
 	if _u.mutation.QueryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,12 +3,18 @@
 package ent
 
 import (
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/ingestjob"
 	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
 	"go-rag/ent/ent/securityquestion"
 	"go-rag/ent/ent/session"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
+	"go-rag/ent/ent/webhook"
+	"go-rag/ent/ent/webhookdelivery"
 	"go-rag/ent/schema"
 	"time"
 
@@ -19,22 +25,132 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescCreatedAt is the schema descriptor for created_at field.
+	conversationDescCreatedAt := conversationFields[1].Descriptor()
+	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
+	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationDescUpdatedAt := conversationFields[2].Descriptor()
+	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
+	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	documentFields := schema.Document{}.Fields()
 	_ = documentFields
-	// documentDescStatus is the schema descriptor for status field.
-	documentDescStatus := documentFields[3].Descriptor()
-	// document.DefaultStatus holds the default value on creation for the status field.
-	document.DefaultStatus = documentDescStatus.Default.(string)
+	// documentDescPath is the schema descriptor for path field.
+	documentDescPath := documentFields[1].Descriptor()
+	// document.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	document.PathValidator = documentDescPath.Validators[0].(func(string) error)
+	// documentDescAttempts is the schema descriptor for attempts field.
+	documentDescAttempts := documentFields[8].Descriptor()
+	// document.DefaultAttempts holds the default value on creation for the attempts field.
+	document.DefaultAttempts = documentDescAttempts.Default.(int)
+	// documentDescQueuedAt is the schema descriptor for queued_at field.
+	documentDescQueuedAt := documentFields[9].Descriptor()
+	// document.DefaultQueuedAt holds the default value on creation for the queued_at field.
+	document.DefaultQueuedAt = documentDescQueuedAt.Default.(func() time.Time)
 	// documentDescCreatedAt is the schema descriptor for created_at field.
-	documentDescCreatedAt := documentFields[4].Descriptor()
+	documentDescCreatedAt := documentFields[18].Descriptor()
 	// document.DefaultCreatedAt holds the default value on creation for the created_at field.
 	document.DefaultCreatedAt = documentDescCreatedAt.Default.(func() time.Time)
+	importjobFields := schema.ImportJob{}.Fields()
+	_ = importjobFields
+	// importjobDescStatus is the schema descriptor for status field.
+	importjobDescStatus := importjobFields[1].Descriptor()
+	// importjob.DefaultStatus holds the default value on creation for the status field.
+	importjob.DefaultStatus = importjobDescStatus.Default.(string)
+	// importjobDescTotalFiles is the schema descriptor for total_files field.
+	importjobDescTotalFiles := importjobFields[5].Descriptor()
+	// importjob.DefaultTotalFiles holds the default value on creation for the total_files field.
+	importjob.DefaultTotalFiles = importjobDescTotalFiles.Default.(int)
+	// importjobDescProcessedFiles is the schema descriptor for processed_files field.
+	importjobDescProcessedFiles := importjobFields[6].Descriptor()
+	// importjob.DefaultProcessedFiles holds the default value on creation for the processed_files field.
+	importjob.DefaultProcessedFiles = importjobDescProcessedFiles.Default.(int)
+	// importjobDescFailedFiles is the schema descriptor for failed_files field.
+	importjobDescFailedFiles := importjobFields[7].Descriptor()
+	// importjob.DefaultFailedFiles holds the default value on creation for the failed_files field.
+	importjob.DefaultFailedFiles = importjobDescFailedFiles.Default.(int)
+	// importjobDescSkippedFiles is the schema descriptor for skipped_files field.
+	importjobDescSkippedFiles := importjobFields[8].Descriptor()
+	// importjob.DefaultSkippedFiles holds the default value on creation for the skipped_files field.
+	importjob.DefaultSkippedFiles = importjobDescSkippedFiles.Default.(int)
+	// importjobDescCreatedAt is the schema descriptor for created_at field.
+	importjobDescCreatedAt := importjobFields[11].Descriptor()
+	// importjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importjob.DefaultCreatedAt = importjobDescCreatedAt.Default.(func() time.Time)
+	// importjobDescUpdatedAt is the schema descriptor for updated_at field.
+	importjobDescUpdatedAt := importjobFields[12].Descriptor()
+	// importjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importjob.DefaultUpdatedAt = importjobDescUpdatedAt.Default.(func() time.Time)
+	// importjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importjob.UpdateDefaultUpdatedAt = importjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ingestjobFields := schema.IngestJob{}.Fields()
+	_ = ingestjobFields
+	// ingestjobDescKind is the schema descriptor for kind field.
+	ingestjobDescKind := ingestjobFields[0].Descriptor()
+	// ingestjob.DefaultKind holds the default value on creation for the kind field.
+	ingestjob.DefaultKind = ingestjobDescKind.Default.(string)
+	// ingestjobDescStatus is the schema descriptor for status field.
+	ingestjobDescStatus := ingestjobFields[1].Descriptor()
+	// ingestjob.DefaultStatus holds the default value on creation for the status field.
+	ingestjob.DefaultStatus = ingestjobDescStatus.Default.(string)
+	// ingestjobDescAttempts is the schema descriptor for attempts field.
+	ingestjobDescAttempts := ingestjobFields[2].Descriptor()
+	// ingestjob.DefaultAttempts holds the default value on creation for the attempts field.
+	ingestjob.DefaultAttempts = ingestjobDescAttempts.Default.(int)
+	// ingestjobDescMaxAttempts is the schema descriptor for max_attempts field.
+	ingestjobDescMaxAttempts := ingestjobFields[3].Descriptor()
+	// ingestjob.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	ingestjob.DefaultMaxAttempts = ingestjobDescMaxAttempts.Default.(int)
+	// ingestjobDescRunAt is the schema descriptor for run_at field.
+	ingestjobDescRunAt := ingestjobFields[4].Descriptor()
+	// ingestjob.DefaultRunAt holds the default value on creation for the run_at field.
+	ingestjob.DefaultRunAt = ingestjobDescRunAt.Default.(func() time.Time)
+	// ingestjobDescCreatedAt is the schema descriptor for created_at field.
+	ingestjobDescCreatedAt := ingestjobFields[7].Descriptor()
+	// ingestjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ingestjob.DefaultCreatedAt = ingestjobDescCreatedAt.Default.(func() time.Time)
+	// ingestjobDescUpdatedAt is the schema descriptor for updated_at field.
+	ingestjobDescUpdatedAt := ingestjobFields[8].Descriptor()
+	// ingestjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ingestjob.DefaultUpdatedAt = ingestjobDescUpdatedAt.Default.(func() time.Time)
+	// ingestjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ingestjob.UpdateDefaultUpdatedAt = ingestjobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
 	// projectDescCreatedAt is the schema descriptor for created_at field.
 	projectDescCreatedAt := projectFields[2].Descriptor()
 	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
 	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescChunkStrategy is the schema descriptor for chunk_strategy field.
+	projectDescChunkStrategy := projectFields[3].Descriptor()
+	// project.DefaultChunkStrategy holds the default value on creation for the chunk_strategy field.
+	project.DefaultChunkStrategy = projectDescChunkStrategy.Default.(string)
+	// projectDescChunkIncludeOutputs is the schema descriptor for chunk_include_outputs field.
+	projectDescChunkIncludeOutputs := projectFields[7].Descriptor()
+	// project.DefaultChunkIncludeOutputs holds the default value on creation for the chunk_include_outputs field.
+	project.DefaultChunkIncludeOutputs = projectDescChunkIncludeOutputs.Default.(bool)
+	projectsourceFields := schema.ProjectSource{}.Fields()
+	_ = projectsourceFields
+	// projectsourceDescType is the schema descriptor for type field.
+	projectsourceDescType := projectsourceFields[0].Descriptor()
+	// projectsource.DefaultType holds the default value on creation for the type field.
+	projectsource.DefaultType = projectsourceDescType.Default.(string)
+	// projectsourceDescRef is the schema descriptor for ref field.
+	projectsourceDescRef := projectsourceFields[2].Descriptor()
+	// projectsource.DefaultRef holds the default value on creation for the ref field.
+	projectsource.DefaultRef = projectsourceDescRef.Default.(string)
+	// projectsourceDescStatus is the schema descriptor for status field.
+	projectsourceDescStatus := projectsourceFields[4].Descriptor()
+	// projectsource.DefaultStatus holds the default value on creation for the status field.
+	projectsource.DefaultStatus = projectsourceDescStatus.Default.(string)
+	// projectsourceDescCreatedAt is the schema descriptor for created_at field.
+	projectsourceDescCreatedAt := projectsourceFields[8].Descriptor()
+	// projectsource.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projectsource.DefaultCreatedAt = projectsourceDescCreatedAt.Default.(func() time.Time)
 	securityquestionFields := schema.SecurityQuestion{}.Fields()
 	_ = securityquestionFields
 	// securityquestionDescQuestion is the schema descriptor for question field.
@@ -82,7 +198,55 @@ func init() {
 	userpromptFields := schema.UserPrompt{}.Fields()
 	_ = userpromptFields
 	// userpromptDescCreatedAt is the schema descriptor for created_at field.
-	userpromptDescCreatedAt := userpromptFields[1].Descriptor()
+	userpromptDescCreatedAt := userpromptFields[2].Descriptor()
 	// userprompt.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userprompt.DefaultCreatedAt = userpromptDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[3].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[4].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescMaxAttempts is the schema descriptor for max_attempts field.
+	webhookdeliveryDescMaxAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	webhookdelivery.DefaultMaxAttempts = webhookdeliveryDescMaxAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[15].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
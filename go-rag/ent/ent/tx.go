@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,10 +16,18 @@ type Tx struct {
 	config
 	// Chunk is the client for interacting with the Chunk builders.
 	Chunk *ChunkClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
+	// IngestJob is the client for interacting with the IngestJob builders.
+	IngestJob *IngestJobClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectSource is the client for interacting with the ProjectSource builders.
+	ProjectSource *ProjectSourceClient
 	// QueryResult is the client for interacting with the QueryResult builders.
 	QueryResult *QueryResultClient
 	// SecurityQuestion is the client for interacting with the SecurityQuestion builders.
@@ -28,6 +38,10 @@ type Tx struct {
 	User *UserClient
 	// UserPrompt is the client for interacting with the UserPrompt builders.
 	UserPrompt *UserPromptClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -160,13 +174,19 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Chunk = NewChunkClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
 	tx.Document = NewDocumentClient(tx.config)
+	tx.ImportJob = NewImportJobClient(tx.config)
+	tx.IngestJob = NewIngestJobClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.ProjectSource = NewProjectSourceClient(tx.config)
 	tx.QueryResult = NewQueryResultClient(tx.config)
 	tx.SecurityQuestion = NewSecurityQuestionClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserPrompt = NewUserPromptClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -229,3 +249,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -38,13 +38,15 @@ type UserEdges struct {
 	Projects []*Project `json:"projects,omitempty"`
 	// Queries holds the value of the queries edge.
 	Queries []*UserPrompt `json:"queries,omitempty"`
+	// Conversations holds the value of the conversations edge.
+	Conversations []*Conversation `json:"conversations,omitempty"`
 	// Sessions holds the value of the sessions edge.
 	Sessions []*Session `json:"sessions,omitempty"`
 	// SecurityQuestions holds the value of the security_questions edge.
 	SecurityQuestions []*SecurityQuestion `json:"security_questions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // ProjectsOrErr returns the Projects value or an error if the edge
@@ -65,10 +67,19 @@ func (e UserEdges) QueriesOrErr() ([]*UserPrompt, error) {
 	return nil, &NotLoadedError{edge: "queries"}
 }
 
+// ConversationsOrErr returns the Conversations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ConversationsOrErr() ([]*Conversation, error) {
+	if e.loadedTypes[2] {
+		return e.Conversations, nil
+	}
+	return nil, &NotLoadedError{edge: "conversations"}
+}
+
 // SessionsOrErr returns the Sessions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SessionsOrErr() ([]*Session, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Sessions, nil
 	}
 	return nil, &NotLoadedError{edge: "sessions"}
@@ -77,7 +88,7 @@ func (e UserEdges) SessionsOrErr() ([]*Session, error) {
 // SecurityQuestionsOrErr returns the SecurityQuestions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SecurityQuestionsOrErr() ([]*SecurityQuestion, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.SecurityQuestions, nil
 	}
 	return nil, &NotLoadedError{edge: "security_questions"}
@@ -164,6 +175,11 @@ func (_m *User) QueryQueries() *UserPromptQuery {
 	return NewUserClient(_m.config).QueryQueries(_m)
 }
 
+// QueryConversations queries the "conversations" edge of the User entity.
+func (_m *User) QueryConversations() *ConversationQuery {
+	return NewUserClient(_m.config).QueryConversations(_m)
+}
+
 // QuerySessions queries the "sessions" edge of the User entity.
 func (_m *User) QuerySessions() *SessionQuery {
 	return NewUserClient(_m.config).QuerySessions(_m)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/securityquestion"
@@ -30,6 +31,7 @@ type UserQuery struct {
 	predicates            []predicate.User
 	withProjects          *ProjectQuery
 	withQueries           *UserPromptQuery
+	withConversations     *ConversationQuery
 	withSessions          *SessionQuery
 	withSecurityQuestions *SecurityQuestionQuery
 	// intermediate query (i.e. traversal path).
@@ -112,6 +114,28 @@ func (_q *UserQuery) QueryQueries() *UserPromptQuery {
 	return query
 }
 
+// QueryConversations chains the current query on the "conversations" edge.
+func (_q *UserQuery) QueryConversations() *ConversationQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ConversationsTable, user.ConversationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySessions chains the current query on the "sessions" edge.
 func (_q *UserQuery) QuerySessions() *SessionQuery {
 	query := (&SessionClient{config: _q.config}).Query()
@@ -350,6 +374,7 @@ func (_q *UserQuery) Clone() *UserQuery {
 		predicates:            append([]predicate.User{}, _q.predicates...),
 		withProjects:          _q.withProjects.Clone(),
 		withQueries:           _q.withQueries.Clone(),
+		withConversations:     _q.withConversations.Clone(),
 		withSessions:          _q.withSessions.Clone(),
 		withSecurityQuestions: _q.withSecurityQuestions.Clone(),
 		// clone intermediate query.
@@ -380,6 +405,17 @@ func (_q *UserQuery) WithQueries(opts ...func(*UserPromptQuery)) *UserQuery {
 	return _q
 }
 
+// WithConversations tells the query-builder to eager-load the nodes that are connected to
+// the "conversations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithConversations(opts ...func(*ConversationQuery)) *UserQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversations = query
+	return _q
+}
+
 // WithSessions tells the query-builder to eager-load the nodes that are connected to
 // the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithSessions(opts ...func(*SessionQuery)) *UserQuery {
@@ -480,9 +516,10 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withProjects != nil,
 			_q.withQueries != nil,
+			_q.withConversations != nil,
 			_q.withSessions != nil,
 			_q.withSecurityQuestions != nil,
 		}
@@ -519,6 +556,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withConversations; query != nil {
+		if err := _q.loadConversations(ctx, query, nodes,
+			func(n *User) { n.Edges.Conversations = []*Conversation{} },
+			func(n *User, e *Conversation) { n.Edges.Conversations = append(n.Edges.Conversations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withSessions; query != nil {
 		if err := _q.loadSessions(ctx, query, nodes,
 			func(n *User) { n.Edges.Sessions = []*Session{} },
@@ -598,6 +642,37 @@ func (_q *UserQuery) loadQueries(ctx context.Context, query *UserPromptQuery, no
 	}
 	return nil
 }
+func (_q *UserQuery) loadConversations(ctx context.Context, query *ConversationQuery, nodes []*User, init func(*User), assign func(*User, *Conversation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Conversation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ConversationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_conversations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_conversations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_conversations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
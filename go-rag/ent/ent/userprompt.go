@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/user"
 	"go-rag/ent/ent/userprompt"
@@ -22,14 +23,17 @@ type UserPrompt struct {
 	ID int `json:"id,omitempty"`
 	// QueryText holds the value of the "query_text" field.
 	QueryText string `json:"query_text,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserPromptQuery when eager-loading is set.
-	Edges           UserPromptEdges `json:"edges"`
-	project_queries *int
-	user_queries    *uuid.UUID
-	selectValues    sql.SelectValues
+	Edges              UserPromptEdges `json:"edges"`
+	conversation_turns *int
+	project_queries    *int
+	user_queries       *uuid.UUID
+	selectValues       sql.SelectValues
 }
 
 // UserPromptEdges holds the relations/edges for other nodes in the graph.
@@ -38,11 +42,13 @@ type UserPromptEdges struct {
 	User *User `json:"user,omitempty"`
 	// Project holds the value of the project edge.
 	Project *Project `json:"project,omitempty"`
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
 	// Results holds the value of the results edge.
 	Results []*QueryResult `json:"results,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -67,10 +73,21 @@ func (e UserPromptEdges) ProjectOrErr() (*Project, error) {
 	return nil, &NotLoadedError{edge: "project"}
 }
 
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserPromptEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
 // ResultsOrErr returns the Results value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserPromptEdges) ResultsOrErr() ([]*QueryResult, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Results, nil
 	}
 	return nil, &NotLoadedError{edge: "results"}
@@ -83,13 +100,15 @@ func (*UserPrompt) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userprompt.FieldID:
 			values[i] = new(sql.NullInt64)
-		case userprompt.FieldQueryText:
+		case userprompt.FieldQueryText, userprompt.FieldAnswer:
 			values[i] = new(sql.NullString)
 		case userprompt.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
-		case userprompt.ForeignKeys[0]: // project_queries
+		case userprompt.ForeignKeys[0]: // conversation_turns
 			values[i] = new(sql.NullInt64)
-		case userprompt.ForeignKeys[1]: // user_queries
+		case userprompt.ForeignKeys[1]: // project_queries
+			values[i] = new(sql.NullInt64)
+		case userprompt.ForeignKeys[2]: // user_queries
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -118,6 +137,12 @@ func (_m *UserPrompt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.QueryText = value.String
 			}
+		case userprompt.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
 		case userprompt.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -125,13 +150,20 @@ func (_m *UserPrompt) assignValues(columns []string, values []any) error {
 				_m.CreatedAt = value.Time
 			}
 		case userprompt.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field conversation_turns", value)
+			} else if value.Valid {
+				_m.conversation_turns = new(int)
+				*_m.conversation_turns = int(value.Int64)
+			}
+		case userprompt.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field project_queries", value)
 			} else if value.Valid {
 				_m.project_queries = new(int)
 				*_m.project_queries = int(value.Int64)
 			}
-		case userprompt.ForeignKeys[1]:
+		case userprompt.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_queries", values[i])
 			} else if value.Valid {
@@ -161,6 +193,11 @@ func (_m *UserPrompt) QueryProject() *ProjectQuery {
 	return NewUserPromptClient(_m.config).QueryProject(_m)
 }
 
+// QueryConversation queries the "conversation" edge of the UserPrompt entity.
+func (_m *UserPrompt) QueryConversation() *ConversationQuery {
+	return NewUserPromptClient(_m.config).QueryConversation(_m)
+}
+
 // QueryResults queries the "results" edge of the UserPrompt entity.
 func (_m *UserPrompt) QueryResults() *QueryResultQuery {
 	return NewUserPromptClient(_m.config).QueryResults(_m)
@@ -192,6 +229,9 @@ func (_m *UserPrompt) String() string {
 	builder.WriteString("query_text=")
 	builder.WriteString(_m.QueryText)
 	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -60,6 +60,11 @@ func QueryText(v string) predicate.UserPrompt {
 	return predicate.UserPrompt(sql.FieldEQ(FieldQueryText, v))
 }
 
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldEQ(FieldAnswer, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserPrompt {
 	return predicate.UserPrompt(sql.FieldEQ(FieldCreatedAt, v))
@@ -130,6 +135,81 @@ func QueryTextContainsFold(v string) predicate.UserPrompt {
 	return predicate.UserPrompt(sql.FieldContainsFold(FieldQueryText, v))
 }
 
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerIsNil applies the IsNil predicate on the "answer" field.
+func AnswerIsNil() predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldIsNull(FieldAnswer))
+}
+
+// AnswerNotNil applies the NotNil predicate on the "answer" field.
+func AnswerNotNil() predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldNotNull(FieldAnswer))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.UserPrompt {
+	return predicate.UserPrompt(sql.FieldContainsFold(FieldAnswer, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserPrompt {
 	return predicate.UserPrompt(sql.FieldEQ(FieldCreatedAt, v))
@@ -216,6 +296,29 @@ func HasProjectWith(preds ...predicate.Project) predicate.UserPrompt {
 	})
 }
 
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.UserPrompt {
+	return predicate.UserPrompt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.UserPrompt {
+	return predicate.UserPrompt(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasResults applies the HasEdge predicate on the "results" edge.
 func HasResults() predicate.UserPrompt {
 	return predicate.UserPrompt(func(s *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/queryresult"
 	"go-rag/ent/ent/user"
@@ -30,6 +31,20 @@ func (_c *UserPromptCreate) SetQueryText(v string) *UserPromptCreate {
 	return _c
 }
 
+// SetAnswer sets the "answer" field.
+func (_c *UserPromptCreate) SetAnswer(v string) *UserPromptCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *UserPromptCreate) SetNillableAnswer(v *string) *UserPromptCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserPromptCreate) SetCreatedAt(v time.Time) *UserPromptCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -82,6 +97,25 @@ func (_c *UserPromptCreate) SetProject(v *Project) *UserPromptCreate {
 	return _c.SetProjectID(v.ID)
 }
 
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_c *UserPromptCreate) SetConversationID(id int) *UserPromptCreate {
+	_c.mutation.SetConversationID(id)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_c *UserPromptCreate) SetNillableConversationID(id *int) *UserPromptCreate {
+	if id != nil {
+		_c = _c.SetConversationID(*id)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_c *UserPromptCreate) SetConversation(v *Conversation) *UserPromptCreate {
+	return _c.SetConversationID(v.ID)
+}
+
 // AddResultIDs adds the "results" edge to the QueryResult entity by IDs.
 func (_c *UserPromptCreate) AddResultIDs(ids ...int) *UserPromptCreate {
 	_c.mutation.AddResultIDs(ids...)
@@ -176,6 +210,10 @@ func (_c *UserPromptCreate) createSpec() (*UserPrompt, *sqlgraph.CreateSpec) {
 		_spec.SetField(userprompt.FieldQueryText, field.TypeString, value)
 		_node.QueryText = value
 	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(userprompt.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(userprompt.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -214,6 +252,23 @@ func (_c *UserPromptCreate) createSpec() (*UserPrompt, *sqlgraph.CreateSpec) {
 		_node.project_queries = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userprompt.ConversationTable,
+			Columns: []string{userprompt.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.conversation_turns = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ResultsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
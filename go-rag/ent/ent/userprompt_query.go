@@ -6,6 +6,7 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/queryresult"
@@ -23,14 +24,15 @@ import (
 // UserPromptQuery is the builder for querying UserPrompt entities.
 type UserPromptQuery struct {
 	config
-	ctx         *QueryContext
-	order       []userprompt.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.UserPrompt
-	withUser    *UserQuery
-	withProject *ProjectQuery
-	withResults *QueryResultQuery
-	withFKs     bool
+	ctx              *QueryContext
+	order            []userprompt.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.UserPrompt
+	withUser         *UserQuery
+	withProject      *ProjectQuery
+	withConversation *ConversationQuery
+	withResults      *QueryResultQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -111,6 +113,28 @@ func (_q *UserPromptQuery) QueryProject() *ProjectQuery {
 	return query
 }
 
+// QueryConversation chains the current query on the "conversation" edge.
+func (_q *UserPromptQuery) QueryConversation() *ConversationQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userprompt.Table, userprompt.FieldID, selector),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userprompt.ConversationTable, userprompt.ConversationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryResults chains the current query on the "results" edge.
 func (_q *UserPromptQuery) QueryResults() *QueryResultQuery {
 	query := (&QueryResultClient{config: _q.config}).Query()
@@ -320,14 +344,15 @@ func (_q *UserPromptQuery) Clone() *UserPromptQuery {
 		return nil
 	}
 	return &UserPromptQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]userprompt.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.UserPrompt{}, _q.predicates...),
-		withUser:    _q.withUser.Clone(),
-		withProject: _q.withProject.Clone(),
-		withResults: _q.withResults.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]userprompt.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.UserPrompt{}, _q.predicates...),
+		withUser:         _q.withUser.Clone(),
+		withProject:      _q.withProject.Clone(),
+		withConversation: _q.withConversation.Clone(),
+		withResults:      _q.withResults.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -356,6 +381,17 @@ func (_q *UserPromptQuery) WithProject(opts ...func(*ProjectQuery)) *UserPromptQ
 	return _q
 }
 
+// WithConversation tells the query-builder to eager-load the nodes that are connected to
+// the "conversation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserPromptQuery) WithConversation(opts ...func(*ConversationQuery)) *UserPromptQuery {
+	query := (&ConversationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversation = query
+	return _q
+}
+
 // WithResults tells the query-builder to eager-load the nodes that are connected to
 // the "results" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserPromptQuery) WithResults(opts ...func(*QueryResultQuery)) *UserPromptQuery {
@@ -446,13 +482,14 @@ func (_q *UserPromptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 		nodes       = []*UserPrompt{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withUser != nil,
 			_q.withProject != nil,
+			_q.withConversation != nil,
 			_q.withResults != nil,
 		}
 	)
-	if _q.withUser != nil || _q.withProject != nil {
+	if _q.withUser != nil || _q.withProject != nil || _q.withConversation != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -488,6 +525,12 @@ func (_q *UserPromptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 			return nil, err
 		}
 	}
+	if query := _q.withConversation; query != nil {
+		if err := _q.loadConversation(ctx, query, nodes, nil,
+			func(n *UserPrompt, e *Conversation) { n.Edges.Conversation = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withResults; query != nil {
 		if err := _q.loadResults(ctx, query, nodes,
 			func(n *UserPrompt) { n.Edges.Results = []*QueryResult{} },
@@ -562,6 +605,38 @@ func (_q *UserPromptQuery) loadProject(ctx context.Context, query *ProjectQuery,
 	}
 	return nil
 }
+func (_q *UserPromptQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*UserPrompt, init func(*UserPrompt), assign func(*UserPrompt, *Conversation)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserPrompt)
+	for i := range nodes {
+		if nodes[i].conversation_turns == nil {
+			continue
+		}
+		fk := *nodes[i].conversation_turns
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(conversation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "conversation_turns" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *UserPromptQuery) loadResults(ctx context.Context, query *QueryResultQuery, nodes []*UserPrompt, init func(*UserPrompt), assign func(*UserPrompt, *QueryResult)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*UserPrompt)
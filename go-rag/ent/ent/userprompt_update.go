@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go-rag/ent/ent/conversation"
 	"go-rag/ent/ent/predicate"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/queryresult"
@@ -46,6 +47,26 @@ func (_u *UserPromptUpdate) SetNillableQueryText(v *string) *UserPromptUpdate {
 	return _u
 }
 
+// SetAnswer sets the "answer" field.
+func (_u *UserPromptUpdate) SetAnswer(v string) *UserPromptUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *UserPromptUpdate) SetNillableAnswer(v *string) *UserPromptUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *UserPromptUpdate) ClearAnswer() *UserPromptUpdate {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserPromptUpdate) SetCreatedAt(v time.Time) *UserPromptUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -98,6 +119,25 @@ func (_u *UserPromptUpdate) SetProject(v *Project) *UserPromptUpdate {
 	return _u.SetProjectID(v.ID)
 }
 
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_u *UserPromptUpdate) SetConversationID(id int) *UserPromptUpdate {
+	_u.mutation.SetConversationID(id)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_u *UserPromptUpdate) SetNillableConversationID(id *int) *UserPromptUpdate {
+	if id != nil {
+		_u = _u.SetConversationID(*id)
+	}
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *UserPromptUpdate) SetConversation(v *Conversation) *UserPromptUpdate {
+	return _u.SetConversationID(v.ID)
+}
+
 // AddResultIDs adds the "results" edge to the QueryResult entity by IDs.
 func (_u *UserPromptUpdate) AddResultIDs(ids ...int) *UserPromptUpdate {
 	_u.mutation.AddResultIDs(ids...)
@@ -130,6 +170,12 @@ func (_u *UserPromptUpdate) ClearProject() *UserPromptUpdate {
 	return _u
 }
 
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *UserPromptUpdate) ClearConversation() *UserPromptUpdate {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
 // ClearResults clears all "results" edges to the QueryResult entity.
 func (_u *UserPromptUpdate) ClearResults() *UserPromptUpdate {
 	_u.mutation.ClearResults()
@@ -190,6 +236,12 @@ func (_u *UserPromptUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.QueryText(); ok {
 		_spec.SetField(userprompt.FieldQueryText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(userprompt.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(userprompt.FieldAnswer, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(userprompt.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -251,6 +303,35 @@ func (_u *UserPromptUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userprompt.ConversationTable,
+			Columns: []string{userprompt.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userprompt.ConversationTable,
+			Columns: []string{userprompt.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ResultsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -330,6 +411,26 @@ func (_u *UserPromptUpdateOne) SetNillableQueryText(v *string) *UserPromptUpdate
 	return _u
 }
 
+// SetAnswer sets the "answer" field.
+func (_u *UserPromptUpdateOne) SetAnswer(v string) *UserPromptUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *UserPromptUpdateOne) SetNillableAnswer(v *string) *UserPromptUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *UserPromptUpdateOne) ClearAnswer() *UserPromptUpdateOne {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserPromptUpdateOne) SetCreatedAt(v time.Time) *UserPromptUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -382,6 +483,25 @@ func (_u *UserPromptUpdateOne) SetProject(v *Project) *UserPromptUpdateOne {
 	return _u.SetProjectID(v.ID)
 }
 
+// SetConversationID sets the "conversation" edge to the Conversation entity by ID.
+func (_u *UserPromptUpdateOne) SetConversationID(id int) *UserPromptUpdateOne {
+	_u.mutation.SetConversationID(id)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation" edge to the Conversation entity by ID if the given value is not nil.
+func (_u *UserPromptUpdateOne) SetNillableConversationID(id *int) *UserPromptUpdateOne {
+	if id != nil {
+		_u = _u.SetConversationID(*id)
+	}
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (_u *UserPromptUpdateOne) SetConversation(v *Conversation) *UserPromptUpdateOne {
+	return _u.SetConversationID(v.ID)
+}
+
 // AddResultIDs adds the "results" edge to the QueryResult entity by IDs.
 func (_u *UserPromptUpdateOne) AddResultIDs(ids ...int) *UserPromptUpdateOne {
 	_u.mutation.AddResultIDs(ids...)
@@ -414,6 +534,12 @@ func (_u *UserPromptUpdateOne) ClearProject() *UserPromptUpdateOne {
 	return _u
 }
 
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (_u *UserPromptUpdateOne) ClearConversation() *UserPromptUpdateOne {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
 // ClearResults clears all "results" edges to the QueryResult entity.
 func (_u *UserPromptUpdateOne) ClearResults() *UserPromptUpdateOne {
 	_u.mutation.ClearResults()
@@ -504,6 +630,12 @@ func (_u *UserPromptUpdateOne) sqlSave(ctx context.Context) (_node *UserPrompt,
 	if value, ok := _u.mutation.QueryText(); ok {
 		_spec.SetField(userprompt.FieldQueryText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(userprompt.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(userprompt.FieldAnswer, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(userprompt.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -565,6 +697,35 @@ func (_u *UserPromptUpdateOne) sqlSave(ctx context.Context) (_node *UserPrompt,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userprompt.ConversationTable,
+			Columns: []string{userprompt.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userprompt.ConversationTable,
+			Columns: []string{userprompt.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ResultsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
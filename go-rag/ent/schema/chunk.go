@@ -16,6 +16,17 @@ func (Chunk) Fields() []ent.Field {
 		field.Int("index"),
 		field.Text("content"),
 		field.String("content_hash").Optional(),
+		// Chunker metadata such as the heading breadcrumb, language and
+		// declaration name, and where the chunk sits in its document.
+		field.JSON("metadata", map[string]interface{}{}).Optional(),
+		field.Int("start_offset").Optional(),
+		field.Int("end_offset").Optional(),
+		field.Int("start_line").Optional(),
+		field.Int("end_line").Optional(),
+		// The full-text search vector over content, "content_tsv", is a
+		// generated column with a GIN index, created by the migrations alone
+		// so that chunk queries never load it. migrate.sh stops when a diff
+		// against this schema would drop it.
 	}
 }
 
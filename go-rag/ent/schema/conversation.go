@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Conversation struct {
+	ent.Schema
+}
+
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("conversations").
+			Unique(),
+		edge.From("project", Project.Type).
+			Ref("conversations").
+			Unique(),
+
+		// Each turn is a UserPrompt, in creation order.
+		edge.To("turns", UserPrompt.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
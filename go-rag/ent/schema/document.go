@@ -17,9 +17,38 @@ type Document struct {
 func (Document) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name"),
+		// The document's location in the project's folder tree, such as
+		// "docs/guide/intro.md": slash-separated, without a leading slash or
+		// "." and ".." segments. Unique within a project.
+		field.String("path").NotEmpty(),
 		field.Text("content"),
+		// The uploaded file of binary formats such as PDF. Content then holds
+		// the text extracted from it.
+		field.Bytes("source").Optional().Sensitive(),
+		// The detected media type of the uploaded file, used to pick a chunker
+		// when the file extension does not.
+		field.String("mime_type").Optional(),
 		field.String("content_hash").Optional(), // .Index() is removed
-		field.String("status").Default("uploaded"),
+		// Processing moves a document from queued through chunking, embedding
+		// and indexing to completed; see embed.Transition for the rules.
+		field.Enum("status").
+			Values("queued", "chunking", "embedding", "indexing", "completed", "failed", "cancelled").
+			Default("queued"),
+		field.Text("last_error").Optional(),
+		// How many times processing of the current content was attempted.
+		field.Int("attempts").Default(0),
+		// When the document entered each state, for its latest processing run.
+		field.Time("queued_at").Default(time.Now),
+		field.Time("chunking_at").Optional().Nillable(),
+		field.Time("embedding_at").Optional().Nillable(),
+		field.Time("indexing_at").Optional().Nillable(),
+		field.Time("completed_at").Optional().Nillable(),
+		field.Time("failed_at").Optional().Nillable(),
+		field.Time("cancelled_at").Optional().Nillable(),
+		// For documents synced from a git repository, the commit they were
+		// last imported from and the file's blob, to skip unchanged files.
+		field.String("commit_sha").Optional(),
+		field.String("blob_sha").Optional(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -28,6 +57,9 @@ func (Document) Fields() []ent.Field {
 func (Document) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("content_hash"),
+		index.Fields("path").
+			Edges("project").
+			Unique(),
 	}
 }
 
@@ -36,10 +68,18 @@ func (Document) Edges() []ent.Edge {
 		edge.From("project", Project.Type).
 			Ref("documents").
 			Unique(),
+		edge.From("project_source", ProjectSource.Type).
+			Ref("documents").
+			Unique(),
 
 		edge.To("chunks", Chunk.Type).
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+
+		edge.To("jobs", IngestJob.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 	}
 }
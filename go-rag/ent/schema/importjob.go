@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJob tracks the import of an archive of files into a project.
+type ImportJob struct {
+	ent.Schema
+}
+
+func (ImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("archive_name"),
+		// pending, running, completed or failed.
+		field.String("status").Default("pending"),
+		// While pending or running, when the import's claim expires. An import
+		// whose server stopped renewing it, as after a restart, is marked failed.
+		field.Time("locked_until").Optional().Nillable(),
+		// Glob rules selecting the archive's files, as given on import.
+		field.JSON("include", []string{}).Optional(),
+		field.JSON("exclude", []string{}).Optional(),
+		// Progress counters. Total is the number of files selected for import.
+		field.Int("total_files").Default(0),
+		field.Int("processed_files").Default(0),
+		field.Int("failed_files").Default(0),
+		field.Int("skipped_files").Default(0),
+		// Per-file problems, e.g. "docs/big.pdf: file size limit exceeded".
+		field.JSON("errors", []string{}).Optional(),
+		field.Text("last_error").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+func (ImportJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("import_jobs").
+			Unique(),
+	}
+}
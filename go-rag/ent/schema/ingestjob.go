@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IngestJob is a unit of background work on a document, such as chunking and
+// embedding it, kept in the database so that it survives restarts.
+type IngestJob struct {
+	ent.Schema
+}
+
+func (IngestJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kind").Default("process_document"),
+		// queued, running, succeeded or dead. A dead job failed on every
+		// attempt and is kept for inspection until it is retried.
+		field.String("status").Default("queued"),
+		field.Int("attempts").Default(0),
+		field.Int("max_attempts").Default(5),
+		// When the job may next run; pushed back after each failed attempt.
+		field.Time("run_at").Default(time.Now),
+		// While running, when the worker's claim expires and another worker
+		// may take the job over.
+		field.Time("locked_until").Optional().Nillable(),
+		field.Text("last_error").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+func (IngestJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+	}
+}
+
+func (IngestJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("jobs").
+			Unique(),
+	}
+}
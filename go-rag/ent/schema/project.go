@@ -18,6 +18,15 @@ func (Project) Fields() []ent.Field {
 		field.String("name"),
 		field.String("description").Optional(),
 		field.Time("created_at").Default(time.Now),
+
+		// Chunking settings applied to the project's documents, with sizes in
+		// embedding model tokens. Unset sizes fall back to the chunker defaults.
+		field.String("chunk_strategy").Default("auto"),
+		field.Int("chunk_max_size").Optional().Nillable(),
+		field.Int("chunk_overlap").Optional().Nillable(),
+		field.Int("chunk_heading_level").Optional().Nillable(),
+		// Whether notebook cell outputs are embedded along with the cells.
+		field.Bool("chunk_include_outputs").Default(false),
 	}
 }
 
@@ -36,5 +45,25 @@ func (Project) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+
+		edge.To("conversations", Conversation.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+
+		edge.To("import_jobs", ImportJob.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+
+		edge.To("sources", ProjectSource.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+
+		edge.To("webhooks", Webhook.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
 	}
 }
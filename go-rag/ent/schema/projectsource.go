@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectSource is an external source whose files are kept in sync as a
+// project's documents, such as a git repository.
+type ProjectSource struct {
+	ent.Schema
+}
+
+func (ProjectSource) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").Default("git"),
+		// The repository's URL or a path on the server.
+		field.String("url"),
+		// The branch, tag or commit to import.
+		field.String("ref").Default("HEAD"),
+		// The commit the documents were last synced from.
+		field.String("last_commit").Optional(),
+		// pending, syncing, synced or failed.
+		field.String("status").Default("pending"),
+		// While syncing, when the sync's claim expires. A sync whose server
+		// stopped renewing it, as after a restart, is marked failed.
+		field.Time("locked_until").Optional().Nillable(),
+		field.Text("last_error").Optional(),
+		field.Time("synced_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ProjectSource) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("sources").
+			Unique(),
+
+		edge.To("documents", Document.Type),
+	}
+}
@@ -15,6 +15,10 @@ func (QueryResult) Fields() []ent.Field {
 		field.Int("rank"),
 		field.Float("score"),
 		field.Text("content_snippet"),
+		// Denormalized source of the hit, kept so history survives re-chunking.
+		field.Int("document_id").Optional(),
+		field.String("document_name").Optional(),
+		field.Int("chunk_index").Optional(),
 	}
 }
 
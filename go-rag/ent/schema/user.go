@@ -32,6 +32,7 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("projects", Project.Type),
 		edge.To("queries", UserPrompt.Type),
+		edge.To("conversations", Conversation.Type),
 		edge.To("sessions", Session.Type),
 		edge.To("security_questions", SecurityQuestion.Type),
 	}
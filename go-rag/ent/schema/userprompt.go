@@ -14,6 +14,7 @@ type UserPrompt struct {
 func (UserPrompt) Fields() []ent.Field {
     return []ent.Field{
         field.Text("query_text"),
+        field.Text("answer").Optional(),
         field.Time("created_at").Default(time.Now),
     }
 }
@@ -26,6 +27,9 @@ func (UserPrompt) Edges() []ent.Edge {
         edge.From("project", Project.Type).
             Ref("queries").
             Unique(),
+        edge.From("conversation", Conversation.Type).
+            Ref("turns").
+            Unique(),
         edge.To("results", QueryResult.Type),
     }
 }
\ No newline at end of file
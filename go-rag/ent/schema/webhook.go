@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook is a URL that is sent a project's events as they happen.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").NotEmpty(),
+		// Key of the HMAC-SHA256 signature sent with each delivery.
+		field.String("secret").Sensitive(),
+		// Event types to deliver, e.g. "document.indexed"; all when empty.
+		field.JSON("events", []string{}).Optional(),
+		field.Bool("active").Default(true),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("webhooks").
+			Unique(),
+
+		// Deliveries outlive their webhook, so that a project's last event,
+		// its deletion, is still delivered.
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery is one event sent to a webhook, with the outcome of its
+// latest attempt.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event"),
+		// Identifies the event across the webhooks it is delivered to.
+		field.String("event_id"),
+		// The request is fixed when the event happens, so that retries send
+		// exactly the same body. The webhook's secret is kept to sign each
+		// attempt, even once the webhook is gone, as with project.deleted.
+		field.String("url"),
+		field.Text("payload"),
+		field.String("secret").Sensitive(),
+		// pending, delivering, succeeded or failed. A failed delivery was
+		// refused on every attempt.
+		field.String("status").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Int("max_attempts").Default(8),
+		// When the delivery may next be attempted.
+		field.Time("next_attempt_at").Default(time.Now),
+		// While delivering, when the worker's claim expires.
+		field.Time("locked_until").Optional().Nillable(),
+		// The receiver's answer to the latest attempt.
+		field.Int("response_status").Optional().Nillable(),
+		field.Text("response_body").Optional(),
+		field.Int("duration_ms").Optional().Nillable(),
+		field.Text("last_error").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique(),
+	}
+}
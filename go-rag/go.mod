@@ -13,10 +13,12 @@ require (
 	golang.org/x/crypto v0.40.0
 )
 
-require github.com/qdrant/go-client v1.15.2 // indirect
+require github.com/qdrant/go-client v1.15.2
+
+require github.com/mattn/go-sqlite3 v1.14.17
 
 require (
-	golang.org/x/net v0.42.0 // indirect
+	golang.org/x/net v0.42.0
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251007200510-49b9836ed3ff // indirect
 	google.golang.org/protobuf v1.36.10
 )
@@ -36,6 +38,6 @@ require (
 	github.com/zclconf/go-cty-yaml v1.1.0 // indirect
 	golang.org/x/mod v0.25.0 // indirect
 	golang.org/x/sys v0.34.0 // indirect
-	golang.org/x/text v0.27.0 // indirect
+	golang.org/x/text v0.27.0
 	google.golang.org/grpc v1.76.0
 )
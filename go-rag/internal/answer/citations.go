@@ -0,0 +1,71 @@
+package answer
+
+import (
+	"go-rag/internal/search"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// citationPattern matches inline citation markers such as [1] or [2, 3].
+var citationPattern = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// citationsFor returns the sources cited in the answer, in order of first
+// citation. Markers that don't refer to a source are ignored.
+func citationsFor(answer string, sources []source) []Citation {
+	byMarker := make(map[int]source, len(sources))
+	for _, src := range sources {
+		byMarker[src.Marker] = src
+	}
+
+	citations := []Citation{}
+	seen := make(map[int]bool)
+	for _, match := range citationPattern.FindAllStringSubmatch(answer, -1) {
+		for _, part := range strings.Split(match[1], ",") {
+			marker, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || seen[marker] {
+				continue
+			}
+			src, ok := byMarker[marker]
+			if !ok {
+				continue
+			}
+			seen[marker] = true
+			citations = append(citations, citationOf(src))
+		}
+	}
+	return citations
+}
+
+// citationOf describes a source as a citation, without its content.
+func citationOf(src source) Citation {
+	return Citation{
+		Marker:       src.Marker,
+		ChunkID:      src.Result.ChunkID,
+		ChunkIndex:   src.Result.ChunkIndex,
+		DocumentID:   src.Result.DocumentID,
+		DocumentName: src.Result.DocumentName,
+		HeadingPath:  src.Result.HeadingPath,
+		StartLine:    src.Result.StartLine,
+		EndLine:      src.Result.EndLine,
+		Page:         src.Result.Page,
+		Score:        src.Result.Score,
+	}
+}
+
+// citedResults returns the results behind the citations, ranked by marker,
+// for recording in the query history.
+func citedResults(citations []Citation, sources []source) []search.Result {
+	byMarker := make(map[int]search.Result, len(sources))
+	for _, src := range sources {
+		byMarker[src.Marker] = src.Result
+	}
+
+	results := make([]search.Result, 0, len(citations))
+	for _, c := range citations {
+		r := byMarker[c.Marker]
+		r.Rank = c.Marker
+		results = append(results, r)
+	}
+	return results
+}
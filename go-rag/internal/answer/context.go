@@ -0,0 +1,121 @@
+package answer
+
+import (
+	"fmt"
+	"go-rag/internal/search"
+	"go-rag/services/embed"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// source is a retrieved chunk placed in the context window under a citation marker.
+type source struct {
+	Marker int
+	Result search.Result
+}
+
+// promptInstructions tells the generator how to ground and cite its answer.
+const promptInstructions = `You are answering questions about a project's documents.
+Answer the question using only the numbered sources below.
+Cite every statement with the number of the source it comes from, e.g. [1] or [2, 3].
+If the sources do not contain the answer, say that you don't know.`
+
+// buildSources numbers the results in rank order and keeps those that fit in
+// the token budget. The first source is truncated rather than dropped.
+func buildSources(results []search.Result, budget int) []source {
+	if budget <= 0 {
+		budget = defaultContextTokens
+	}
+	if budget > maxContextTokens {
+		budget = maxContextTokens
+	}
+	// Count tokens the way chunks were sized, so that a budget fits as many
+	// chunks as it was meant to.
+	countTokens := embed.DefaultTokenizer.CountTokens
+	budget -= countTokens(promptInstructions)
+
+	var sources []source
+	for _, r := range results {
+		src := source{
+			Marker: len(sources) + 1,
+			Result: r,
+		}
+		cost := countTokens(sourceHeader(src)) + countTokens(r.Content)
+		if cost > budget {
+			if len(sources) > 0 {
+				break
+			}
+			src.Result.Content = truncateTokens(r.Content, budget-countTokens(sourceHeader(src)))
+			cost = budget
+		}
+		sources = append(sources, src)
+		budget -= cost
+	}
+	return sources
+}
+
+// sourceHeader is the line that introduces a source in the prompt.
+func sourceHeader(src source) string {
+	header := fmt.Sprintf("[%d] %s", src.Marker, src.Result.DocumentName)
+	if src.Result.HeadingPath != "" {
+		header += " > " + src.Result.HeadingPath
+	}
+	if src.Result.Page > 0 {
+		header += fmt.Sprintf(" (p. %d)", src.Result.Page)
+	}
+	return header
+}
+
+// buildPrompt assembles the instructions, numbered sources and question.
+func buildPrompt(question string, sources []source) string {
+	var b strings.Builder
+	b.WriteString(promptInstructions)
+	b.WriteString("\n\nSources:\n")
+	for _, src := range sources {
+		b.WriteString("\n")
+		b.WriteString(sourceHeader(src))
+		b.WriteString("\n")
+		b.WriteString(src.Result.Content)
+		b.WriteString("\n")
+	}
+	b.WriteString("\nQuestion: ")
+	b.WriteString(question)
+	b.WriteString("\nAnswer:")
+	return b.String()
+}
+
+// truncate cuts text to at most maxBytes without splitting a UTF-8 character.
+func truncate(text string, maxBytes int) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(text) <= maxBytes {
+		return text
+	}
+	for maxBytes > 0 && !utf8.RuneStart(text[maxBytes]) {
+		maxBytes--
+	}
+	return text[:maxBytes]
+}
+
+// truncateTokens cuts text to its longest prefix of at most maxTokens, without
+// splitting a UTF-8 character.
+func truncateTokens(text string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+	// runeStart moves a byte offset back to the character it falls in.
+	runeStart := func(i int) int {
+		for i > 0 && i < len(text) && !utf8.RuneStart(text[i]) {
+			i--
+		}
+		return i
+	}
+	// A longer prefix never has fewer tokens, so the cut is the last offset
+	// before the first that goes over.
+	over := sort.Search(len(text)+1, func(i int) bool {
+		return embed.DefaultTokenizer.CountTokens(text[:runeStart(i)]) > maxTokens
+	})
+	return text[:runeStart(over-1)]
+}
@@ -0,0 +1,76 @@
+package answer
+
+import (
+	"go-rag/internal/search"
+	"go-rag/services/embed"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateTokens(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		maxTokens int
+		want      string
+	}{
+		{name: "fits", text: "a few words", maxTokens: 3, want: "a few words"},
+		{name: "cut between words", text: "a few words", maxTokens: 2, want: "a few "},
+		{name: "cut inside a long word", text: "internationalization", maxTokens: 2, want: "internatio"},
+		{name: "cjk", text: "日本語のテキスト", maxTokens: 3, want: "日本語"},
+		{name: "no budget", text: "words", maxTokens: 0, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateTokens(tt.text, tt.maxTokens)
+			if got != tt.want {
+				t.Errorf("truncateTokens(%q, %d) = %q, want %q", tt.text, tt.maxTokens, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("cut splits a character: %q", got)
+			}
+		})
+	}
+}
+
+func TestBuildSourcesFitsBudget(t *testing.T) {
+	results := []search.Result{
+		{ChunkID: 1, DocumentName: "a.md", Content: strings.Repeat("first source text ", 40)},
+		{ChunkID: 2, DocumentName: "b.md", Content: strings.Repeat("second ", 40)},
+		{ChunkID: 3, DocumentName: "c.md", Content: strings.Repeat("third ", 400)},
+	}
+	count := embed.DefaultTokenizer.CountTokens
+	used := func(sources []source) int {
+		n := count(promptInstructions)
+		for _, src := range sources {
+			n += count(sourceHeader(src)) + count(src.Result.Content)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name   string
+		budget int
+		want   int
+	}{
+		{name: "room for two", budget: 400, want: 2},
+		{name: "first source truncated", budget: 100, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources := buildSources(results, tt.budget)
+			if len(sources) != tt.want {
+				t.Fatalf("kept %d sources, want %d", len(sources), tt.want)
+			}
+			if n := used(sources); n > tt.budget {
+				t.Errorf("sources take %d tokens, over the budget of %d", n, tt.budget)
+			}
+			for i, src := range sources {
+				if src.Marker != i+1 {
+					t.Errorf("source %d is numbered %d", i, src.Marker)
+				}
+			}
+		})
+	}
+}
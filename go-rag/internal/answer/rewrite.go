@@ -0,0 +1,71 @@
+package answer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxRewriteTurns is how many of the most recent turns are shown to the
+// generator when rewriting a follow-up question.
+const maxRewriteTurns = 5
+
+// maxRewriteAnswerBytes caps each prior answer in the rewrite prompt.
+const maxRewriteAnswerBytes = 600
+
+// rewriteInstructions tells the generator how to turn a follow-up into a standalone question.
+const rewriteInstructions = `Rewrite the follow-up question as a standalone question that can be understood without the conversation.
+Resolve pronouns and references using the conversation, and keep the exact names of packages, functions, files and errors.
+Reply with the standalone question only.`
+
+// Turn is a previous question and answer in a conversation.
+type Turn struct {
+	Question string
+	Answer   string
+}
+
+// RewriteQuestion turns a follow-up question into a standalone query using the
+// prior turns, so that retrieval does not depend on conversational context.
+// Without prior turns, or if the generator fails, the question is returned as is.
+func (s *Service) RewriteQuestion(ctx context.Context, history []Turn, question string) string {
+	if len(history) == 0 {
+		return question
+	}
+	if len(history) > maxRewriteTurns {
+		history = history[len(history)-maxRewriteTurns:]
+	}
+
+	rewritten, err := s.generate(ctx, buildRewritePrompt(history, question), nil)
+	if err != nil {
+		logrus.WithError(err).Warn("service: failed to rewrite follow-up question, using it as is")
+		return question
+	}
+	// Keep only the first line in case the generator keeps talking.
+	rewritten = strings.TrimSpace(strings.SplitN(rewritten, "\n", 2)[0])
+	if rewritten == "" {
+		return question
+	}
+	return rewritten
+}
+
+// buildRewritePrompt assembles the instructions, conversation and follow-up question.
+func buildRewritePrompt(history []Turn, question string) string {
+	var b strings.Builder
+	b.WriteString(rewriteInstructions)
+	b.WriteString("\n\nConversation:\n")
+	for _, t := range history {
+		b.WriteString("User: ")
+		b.WriteString(t.Question)
+		b.WriteString("\n")
+		if t.Answer != "" {
+			b.WriteString("Assistant: ")
+			b.WriteString(truncate(t.Answer, maxRewriteAnswerBytes))
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("\nFollow-up question: ")
+	b.WriteString(question)
+	b.WriteString("\nStandalone question:")
+	return b.String()
+}
@@ -0,0 +1,140 @@
+package answer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"go-rag/services/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// scriptedGenerator is an Inferencer whose Generate RPC records each prompt
+// and replies with reply, or fails when err is set.
+type scriptedGenerator struct {
+	proto.UnimplementedInferencerServer
+	reply string
+	err   error
+
+	mu      sync.Mutex
+	prompts []string
+}
+
+func (g *scriptedGenerator) Generate(req *proto.GenerateRequest, stream grpc.ServerStreamingServer[proto.GenerateResponse]) error {
+	g.mu.Lock()
+	g.prompts = append(g.prompts, req.GetPrompt())
+	g.mu.Unlock()
+	if g.err != nil {
+		return g.err
+	}
+	for _, token := range strings.SplitAfter(g.reply, " ") {
+		if err := stream.Send(&proto.GenerateResponse{Token: token}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newInferencer serves an Inferencer over an in-memory listener and returns
+// a client of it.
+func newInferencer(t *testing.T, srv proto.InferencerServer) proto.InferencerClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterInferencerServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewInferencerClient(conn)
+}
+
+func TestRewriteQuestion(t *testing.T) {
+	history := []Turn{
+		{Question: "How are webhooks signed?", Answer: "With an HMAC of the timestamp and body [1]."},
+	}
+	tests := []struct {
+		name    string
+		history []Turn
+		reply   string
+		err     error
+		want    string
+		called  bool
+	}{
+		{name: "first question", reply: "unused", want: "And retries?"},
+		{name: "follow-up", history: history, reply: "How are webhook deliveries retried?", want: "How are webhook deliveries retried?", called: true},
+		{name: "extra lines", history: history, reply: "  How are webhook deliveries retried? \nThis resolves \"retries\".", want: "How are webhook deliveries retried?", called: true},
+		{name: "empty reply", history: history, reply: " ", want: "And retries?", called: true},
+		{name: "generator fails", history: history, err: status.Error(codes.Unavailable, "generator is down"), want: "And retries?", called: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := &scriptedGenerator{reply: tt.reply, err: tt.err}
+			s := &Service{InferenceClient: newInferencer(t, gen)}
+
+			if got := s.RewriteQuestion(context.Background(), tt.history, "And retries?"); got != tt.want {
+				t.Errorf("rewritten to %q, want %q", got, tt.want)
+			}
+			if called := len(gen.prompts) > 0; called != tt.called {
+				t.Errorf("generator called %v, want %v", called, tt.called)
+			}
+		})
+	}
+}
+
+func TestBuildRewritePrompt(t *testing.T) {
+	var history []Turn
+	for i := range maxRewriteTurns + 2 {
+		history = append(history, Turn{Question: fmt.Sprintf("question %d?", i), Answer: fmt.Sprintf("answer %d", i)})
+	}
+	history[len(history)-1].Answer = strings.Repeat("long ", maxRewriteAnswerBytes)
+
+	gen := &scriptedGenerator{reply: "standalone"}
+	s := &Service{InferenceClient: newInferencer(t, gen)}
+	s.RewriteQuestion(context.Background(), history, "and then?")
+	if len(gen.prompts) != 1 {
+		t.Fatalf("generator called %d times, want once", len(gen.prompts))
+	}
+	prompt := gen.prompts[0]
+
+	// Only the most recent turns are shown, oldest first.
+	for i := range 2 {
+		if strings.Contains(prompt, fmt.Sprintf("question %d?", i)) {
+			t.Errorf("prompt shows turn %d, older than the last %d", i, maxRewriteTurns)
+		}
+	}
+	last := -1
+	for i := 2; i < len(history); i++ {
+		at := strings.Index(prompt, fmt.Sprintf("User: question %d?\n", i))
+		if at < 0 || at < last {
+			t.Errorf("prompt lacks turn %d in order", i)
+		}
+		last = at
+	}
+	if !strings.Contains(prompt, "Assistant: answer 5\n") {
+		t.Error("prompt lacks the answers of prior turns")
+	}
+	if strings.Count(prompt, "long ") > maxRewriteAnswerBytes/len("long ") {
+		t.Error("prompt shows a prior answer past its cap")
+	}
+	if !strings.HasSuffix(prompt, "Follow-up question: and then?\nStandalone question:") {
+		t.Errorf("prompt does not end with the follow-up: %q", prompt[max(0, len(prompt)-80):])
+	}
+}
@@ -0,0 +1,195 @@
+package answer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/internal/search"
+	"go-rag/services/proto"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Defaults for the context window and the generated answer, in estimated tokens.
+const (
+	defaultContextTokens = 3000
+	maxContextTokens     = 12000
+	maxAnswerTokens      = 512
+)
+
+// noSourcesAnswer is returned without calling the generator when retrieval finds nothing.
+const noSourcesAnswer = "I couldn't find anything in this project's documents to answer that question."
+
+// Service handles grounded answer generation over a project's chunks.
+type Service struct {
+	Client          *ent.Client
+	InferenceClient proto.InferencerClient
+	SearchService   *search.Service
+}
+
+// AskRequest defines the parameters for answering a question about a project.
+// When set, StandaloneQuestion is used for retrieval and generation in place of
+// Question, which is still what gets recorded in the history.
+type AskRequest struct {
+	ProjectID          int
+	OwnerID            uuid.UUID
+	Question           string
+	StandaloneQuestion string
+	Limit              int
+	Mode               search.Mode
+	Rerank             bool
+	MaxContextTokens   int
+	// ConversationID, when set, makes the question that conversation's next
+	// turn. The answer then fails if the turn cannot be recorded.
+	ConversationID int
+}
+
+// Citation maps an inline [n] marker in the answer back to its source chunk.
+// ChunkID is zero when a historical citation's chunk has since been re-chunked away.
+// Content is only set when sources are streamed ahead of the answer.
+type Citation struct {
+	Marker       int     `json:"marker"`
+	ChunkID      int     `json:"chunk_id,omitempty"`
+	ChunkIndex   int     `json:"chunk_index"`
+	DocumentID   int     `json:"document_id"`
+	DocumentName string  `json:"document_name"`
+	HeadingPath  string  `json:"heading_path,omitempty"`
+	StartLine    int     `json:"start_line,omitempty"`
+	EndLine      int     `json:"end_line,omitempty"`
+	Page         int     `json:"page,omitempty"`
+	Score        float32 `json:"score"`
+	Content      string  `json:"content,omitempty"`
+}
+
+// Events receives the stages of an answer as they happen. Any callback may be
+// nil; an error returned by a callback aborts the answer.
+type Events struct {
+	Progress func(stage string, count int)
+	Sources  func(sources []Citation) error
+	Token    func(token string) error
+}
+
+// AskResponse is a generated answer with the citations it references.
+type AskResponse struct {
+	QueryID   int        `json:"query_id,omitempty"`
+	Answer    string     `json:"answer"`
+	Citations []Citation `json:"citations"`
+}
+
+// Ask retrieves the project's most relevant chunks, generates an answer grounded
+// in them and records the question with its cited chunks in the query history.
+func (s *Service) Ask(ctx context.Context, req AskRequest) (*AskResponse, error) {
+	return s.AskStream(ctx, req, Events{})
+}
+
+// AskStream answers like Ask, reporting retrieval progress, the sources placed
+// in the context window and each generated token through events.
+func (s *Service) AskStream(ctx context.Context, req AskRequest, events Events) (*AskResponse, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+	})
+	log.Info("service: answering question")
+
+	question := req.Question
+	if req.StandaloneQuestion != "" {
+		question = req.StandaloneQuestion
+	}
+
+	// 1. Retrieve candidate chunks.
+	searchReq := search.SearchRequest{
+		ProjectID:      req.ProjectID,
+		OwnerID:        req.OwnerID,
+		Query:          question,
+		Limit:          req.Limit,
+		Mode:           req.Mode,
+		Rerank:         req.Rerank,
+		Progress:       events.Progress,
+		ConversationID: req.ConversationID,
+	}
+	results, err := s.SearchService.Retrieve(ctx, searchReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Fit as many sources as the token budget allows.
+	sources := buildSources(results, req.MaxContextTokens)
+	if events.Sources != nil {
+		streamed := make([]Citation, 0, len(sources))
+		for _, src := range sources {
+			c := citationOf(src)
+			c.Content = src.Result.Content
+			streamed = append(streamed, c)
+		}
+		if err := events.Sources(streamed); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &AskResponse{Citations: []Citation{}}
+	if len(sources) == 0 {
+		resp.Answer = noSourcesAnswer
+	} else {
+		// 3. Generate the answer from the assembled prompt.
+		answer, err := s.generate(ctx, buildPrompt(question, sources), events.Token)
+		if err != nil {
+			log.WithError(err).Error("service: answer generation failed")
+			return nil, fmt.Errorf("could not generate answer: %w", err)
+		}
+		resp.Answer = answer
+		resp.Citations = citationsFor(answer, sources)
+	}
+
+	// 4. Record the question as asked with its cited chunks. A failure here
+	// should not fail the answer itself, unless it is a conversation's turn.
+	searchReq.Query = req.Question
+	prompt, err := s.SearchService.RecordQuery(ctx, searchReq, resp.Answer, citedResults(resp.Citations, sources))
+	if err != nil {
+		log.WithError(err).Error("service: failed to record question history")
+		if req.ConversationID != 0 {
+			return nil, fmt.Errorf("could not add turn to conversation: %w", err)
+		}
+	} else {
+		resp.QueryID = prompt.ID
+	}
+
+	log.WithFields(logrus.Fields{
+		"sources":   len(sources),
+		"citations": len(resp.Citations),
+	}).Info("service: question answered successfully")
+	return resp, nil
+}
+
+// generate streams the generator's output for prompt, calling onToken for each
+// piece as it arrives, and returns the full answer.
+func (s *Service) generate(ctx context.Context, prompt string, onToken func(string) error) (string, error) {
+	stream, err := s.InferenceClient.Generate(ctx, &proto.GenerateRequest{
+		Prompt:    prompt,
+		MaxTokens: maxAnswerTokens,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var answer strings.Builder
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		answer.WriteString(res.GetToken())
+		if onToken != nil {
+			if err := onToken(res.GetToken()); err != nil {
+				return "", err
+			}
+		}
+	}
+	return strings.TrimSpace(answer.String()), nil
+}
@@ -0,0 +1,283 @@
+package conversations
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/conversation"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/queryresult"
+	"go-rag/ent/ent/user"
+	"go-rag/ent/ent/userprompt"
+	"go-rag/internal/answer"
+	"go-rag/internal/search"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// maxTitleLength caps titles derived from a conversation's first question.
+const maxTitleLength = 80
+
+// Service handles the business logic for multi-turn conversations.
+type Service struct {
+	Client        *ent.Client
+	AnswerService *answer.Service
+}
+
+// CreateConversationRequest defines the parameters for starting a new conversation.
+type CreateConversationRequest struct {
+	ProjectID int
+	OwnerID   uuid.UUID
+	Title     *string
+}
+
+// ContinueConversationRequest defines the parameters for asking the next question.
+type ContinueConversationRequest struct {
+	ConversationID int
+	ProjectID      int
+	OwnerID        uuid.UUID
+	Question       string
+	Limit          int
+	Mode           search.Mode
+	Rerank         bool
+}
+
+// TurnView is a single question and answer in a conversation.
+type TurnView struct {
+	QueryID   int       `json:"query_id"`
+	Question  string    `json:"question"`
+	Answer    string    `json:"answer"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ConversationDetail is a conversation together with its turns in order.
+type ConversationDetail struct {
+	*ent.Conversation
+	Turns []TurnView `json:"turns"`
+}
+
+// TurnResponse is the answer to a follow-up together with the standalone
+// question it was rewritten to for retrieval.
+type TurnResponse struct {
+	*answer.AskResponse
+	StandaloneQuestion string `json:"standalone_question"`
+}
+
+// CreateConversation starts a new conversation in a project owned by the user.
+func (s *Service) CreateConversation(ctx context.Context, req CreateConversationRequest) (*ent.Conversation, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+	})
+	log.Info("service: creating new conversation")
+
+	// Security Check: Ensure the user owns the project.
+	exists, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+	if !exists {
+		log.Warn("service: attempt to create conversation in a non-existent or unowned project")
+		return nil, fmt.Errorf("project not found or access denied")
+	}
+
+	c, err := s.Client.Conversation.
+		Create().
+		SetNillableTitle(req.Title).
+		SetOwnerID(req.OwnerID).
+		SetProjectID(req.ProjectID).
+		Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to save conversation to database")
+		return nil, fmt.Errorf("could not create conversation: %w", err)
+	}
+
+	log.WithField("conversation_id", c.ID).Info("service: conversation created successfully")
+	return c, nil
+}
+
+// ListConversations returns the user's conversations in a project, most recently active first.
+func (s *Service) ListConversations(ctx context.Context, projectID int, ownerID uuid.UUID) ([]*ent.Conversation, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: listing conversations for project")
+
+	convs, err := s.Client.Conversation.
+		Query().
+		Where(
+			conversation.HasOwnerWith(user.ID(ownerID)),
+			conversation.HasProjectWith(project.ID(projectID)),
+		).
+		Order(ent.Desc(conversation.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list conversations from database")
+		return nil, err
+	}
+
+	log.WithField("count", len(convs)).Info("service: conversations listed successfully")
+	return convs, nil
+}
+
+// GetConversation returns a conversation with its turns, verifying ownership.
+func (s *Service) GetConversation(ctx context.Context, projectID, conversationID int, ownerID uuid.UUID) (*ConversationDetail, error) {
+	c, err := s.getOwnedConversation(ctx, projectID, conversationID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &ConversationDetail{Conversation: c, Turns: make([]TurnView, 0, len(c.Edges.Turns))}
+	for _, t := range c.Edges.Turns {
+		detail.Turns = append(detail.Turns, TurnView{
+			QueryID:   t.ID,
+			Question:  t.QueryText,
+			Answer:    t.Answer,
+			CreatedAt: t.CreatedAt,
+		})
+	}
+	// The turns are exposed through Turns only.
+	detail.Conversation.Edges.Turns = nil
+	return detail, nil
+}
+
+// ContinueConversation answers the next question in a conversation. Follow-ups
+// are rewritten into standalone questions using the prior turns before retrieval.
+func (s *Service) ContinueConversation(ctx context.Context, req ContinueConversationRequest) (*TurnResponse, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"conversation_id": req.ConversationID,
+		"project_id":      req.ProjectID,
+		"owner_id":        req.OwnerID,
+	})
+	log.Info("service: continuing conversation")
+
+	c, err := s.getOwnedConversation(ctx, req.ProjectID, req.ConversationID, req.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]answer.Turn, 0, len(c.Edges.Turns))
+	for _, t := range c.Edges.Turns {
+		history = append(history, answer.Turn{Question: t.QueryText, Answer: t.Answer})
+	}
+	standalone := s.AnswerService.RewriteQuestion(ctx, history, req.Question)
+	log.WithField("standalone_question", standalone).Debug("service: follow-up question rewritten")
+
+	resp, err := s.AnswerService.Ask(ctx, answer.AskRequest{
+		ProjectID:          req.ProjectID,
+		OwnerID:            req.OwnerID,
+		Question:           req.Question,
+		StandaloneQuestion: standalone,
+		Limit:              req.Limit,
+		Mode:               req.Mode,
+		Rerank:             req.Rerank,
+		// The prompt is recorded as the conversation's turn together with
+		// its results, or the answer fails.
+		ConversationID: c.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Touch the conversation so it sorts as most recently active, and title it
+	// after its first question if it has none.
+	updater := c.Update()
+	if c.Title == "" {
+		updater.SetTitle(titleFrom(req.Question))
+	}
+	if err := updater.Exec(ctx); err != nil {
+		log.WithError(err).Warn("service: failed to update conversation after turn")
+	}
+
+	log.Info("service: conversation continued successfully")
+	return &TurnResponse{AskResponse: resp, StandaloneQuestion: standalone}, nil
+}
+
+// DeleteConversation deletes a conversation along with its turns and their results.
+func (s *Service) DeleteConversation(ctx context.Context, projectID, conversationID int, ownerID uuid.UUID) error {
+	log := logrus.WithFields(logrus.Fields{
+		"conversation_id": conversationID,
+		"project_id":      projectID,
+		"owner_id":        ownerID,
+	})
+	log.Info("service: deleting conversation")
+
+	if _, err := s.getOwnedConversation(ctx, projectID, conversationID, ownerID); err != nil {
+		return err
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Query results only reference their prompt with ON DELETE SET NULL, so
+	// remove them explicitly before the turns cascade away.
+	if _, err := tx.QueryResult.
+		Delete().
+		Where(queryresult.HasQueryWith(
+			userprompt.HasConversationWith(conversation.ID(conversationID)),
+		)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		log.WithError(err).Error("service: failed to delete conversation query results")
+		return err
+	}
+
+	if err := tx.Conversation.DeleteOneID(conversationID).Exec(ctx); err != nil {
+		tx.Rollback()
+		log.WithError(err).Error("service: failed to delete conversation from database")
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Info("service: conversation deleted successfully")
+	return nil
+}
+
+// getOwnedConversation loads a conversation with its turns in order,
+// ensuring it belongs to the user and project.
+func (s *Service) getOwnedConversation(ctx context.Context, projectID, conversationID int, ownerID uuid.UUID) (*ent.Conversation, error) {
+	c, err := s.Client.Conversation.
+		Query().
+		Where(
+			conversation.ID(conversationID),
+			conversation.HasOwnerWith(user.ID(ownerID)),
+			conversation.HasProjectWith(project.ID(projectID)),
+		).
+		WithTurns(func(q *ent.UserPromptQuery) {
+			q.Order(ent.Asc(userprompt.FieldCreatedAt), ent.Asc(userprompt.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logrus.WithField("conversation_id", conversationID).Warn("service: conversation not found or access denied")
+			return nil, fmt.Errorf("conversation not found or access denied")
+		}
+		logrus.WithError(err).Error("service: database error while getting conversation")
+		return nil, err
+	}
+	return c, nil
+}
+
+// titleFrom derives a conversation title from its first question.
+func titleFrom(question string) string {
+	runes := []rune(question)
+	if len(runes) <= maxTitleLength {
+		return question
+	}
+	return string(runes[:maxTitleLength-1]) + "…"
+}
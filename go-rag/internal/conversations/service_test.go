@@ -0,0 +1,268 @@
+package conversations
+
+import (
+	"context"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/conversation"
+	"go-rag/ent/ent/queryresult"
+	"go-rag/ent/ent/userprompt"
+	"go-rag/internal/answer"
+	"go-rag/internal/search"
+	"go-rag/services/fakeinferencer"
+	"go-rag/services/proto"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient returns a client of a fresh in-memory database.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+	client, err := ent.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// rewriteRecorder is the fake Inferencer, recording the question rewriting
+// prompts it is sent and failing them when fail is set.
+type rewriteRecorder struct {
+	fakeinferencer.Server
+	fail bool
+
+	mu       sync.Mutex
+	rewrites []string
+}
+
+func (r *rewriteRecorder) Generate(req *proto.GenerateRequest, stream grpc.ServerStreamingServer[proto.GenerateResponse]) error {
+	if strings.HasSuffix(req.GetPrompt(), "Standalone question:") {
+		r.mu.Lock()
+		r.rewrites = append(r.rewrites, req.GetPrompt())
+		r.mu.Unlock()
+		if r.fail {
+			return status.Error(codes.Unavailable, "generator is down")
+		}
+	}
+	return r.Server.Generate(req, stream)
+}
+
+// newInferencer serves an Inferencer over an in-memory listener and returns
+// a client of it.
+func newInferencer(t *testing.T, srv proto.InferencerServer) proto.InferencerClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterInferencerServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewInferencerClient(conn)
+}
+
+// singleHit is a Qdrant whose every search finds one chunk.
+type singleHit struct {
+	qdrant.PointsClient
+	chunkID int
+}
+
+func (h *singleHit) Search(context.Context, *qdrant.SearchPoints, ...grpc.CallOption) (*qdrant.SearchResponse, error) {
+	return &qdrant.SearchResponse{Result: []*qdrant.ScoredPoint{
+		{Id: qdrant.NewIDNum(uint64(h.chunkID)), Score: 0.9},
+	}}, nil
+}
+
+// fixture is a conversation service over a project with one chunked document.
+type fixture struct {
+	s       *Service
+	client  *ent.Client
+	gen     *rewriteRecorder
+	project *ent.Project
+	owner   *ent.User
+}
+
+func newFixture(t *testing.T, failRewrites bool) *fixture {
+	t.Helper()
+	ctx := context.Background()
+	client := newTestClient(t)
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+	doc := client.Document.Create().SetName("webhooks.md").SetPath("webhooks.md").SetContent("x").SetProject(p).SaveX(ctx)
+	c := client.Chunk.Create().
+		SetContent("Webhook deliveries are signed with HMAC. Failed deliveries are retried.").
+		SetContentHash("h").
+		SetIndex(0).
+		SetDocument(doc).
+		SaveX(ctx)
+
+	gen := &rewriteRecorder{fail: failRewrites}
+	inferencer := newInferencer(t, gen)
+	searchService := &search.Service{Client: client, InferenceClient: inferencer, QdrantPointsClient: &singleHit{chunkID: c.ID}}
+	s := &Service{
+		Client:        client,
+		AnswerService: &answer.Service{Client: client, InferenceClient: inferencer, SearchService: searchService},
+	}
+	return &fixture{s: s, client: client, gen: gen, project: p, owner: owner}
+}
+
+func (f *fixture) ask(t *testing.T, conversationID int, question string) *TurnResponse {
+	t.Helper()
+	resp, err := f.s.ContinueConversation(context.Background(), ContinueConversationRequest{
+		ConversationID: conversationID,
+		ProjectID:      f.project.ID,
+		OwnerID:        f.owner.ID,
+		Question:       question,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func (f *fixture) newConversation(t *testing.T) *ent.Conversation {
+	t.Helper()
+	c, err := f.s.CreateConversation(context.Background(), CreateConversationRequest{ProjectID: f.project.ID, OwnerID: f.owner.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestContinueConversationRewritesFollowUps(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, false)
+	c := f.newConversation(t)
+
+	first := f.ask(t, c.ID, "How are webhooks signed?")
+	if first.StandaloneQuestion != "How are webhooks signed?" {
+		t.Errorf("first question made standalone as %q", first.StandaloneQuestion)
+	}
+	if len(f.gen.rewrites) != 0 {
+		t.Errorf("first question was rewritten")
+	}
+
+	// The fake rewriter appends the previous question to the follow-up.
+	second := f.ask(t, c.ID, "Are they retried?")
+	if want := "Are they retried? How are webhooks signed?"; second.StandaloneQuestion != want {
+		t.Errorf("follow-up made standalone as %q, want %q", second.StandaloneQuestion, want)
+	}
+	if len(f.gen.rewrites) != 1 {
+		t.Fatalf("rewrote %d questions, want 1", len(f.gen.rewrites))
+	}
+	prompt := f.gen.rewrites[0]
+	for _, want := range []string{"User: How are webhooks signed?\n", "Assistant: " + first.Answer + "\n", "Follow-up question: Are they retried?\n"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("rewrite prompt lacks %q:\n%s", want, prompt)
+		}
+	}
+
+	// Turns keep the question as asked, in order, and the conversation is
+	// titled after the first.
+	detail, err := f.s.GetConversation(ctx, f.project.ID, c.ID, f.owner.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detail.Title != "How are webhooks signed?" {
+		t.Errorf("title %q", detail.Title)
+	}
+	var questions []string
+	for _, turn := range detail.Turns {
+		questions = append(questions, turn.Question)
+	}
+	if strings.Join(questions, "|") != "How are webhooks signed?|Are they retried?" {
+		t.Errorf("turns %q", questions)
+	}
+	if detail.Turns[1].QueryID != second.QueryID || detail.Turns[1].Answer != second.Answer {
+		t.Errorf("second turn %+v does not match its answer", detail.Turns[1])
+	}
+}
+
+func TestContinueConversationFallsBackToRawQuestion(t *testing.T) {
+	f := newFixture(t, true)
+	c := f.newConversation(t)
+
+	f.ask(t, c.ID, "How are webhooks signed?")
+	resp := f.ask(t, c.ID, "Are they retried?")
+	if resp.StandaloneQuestion != "Are they retried?" {
+		t.Errorf("failed rewrite gave %q, want the question as asked", resp.StandaloneQuestion)
+	}
+	if len(f.gen.rewrites) != 1 {
+		t.Errorf("attempted %d rewrites, want 1", len(f.gen.rewrites))
+	}
+	if !strings.HasSuffix(resp.Answer, "[1]") || len(resp.Citations) != 1 {
+		t.Errorf("follow-up was not answered from the project: %q", resp.Answer)
+	}
+}
+
+func TestDeleteConversation(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, false)
+	doomed, kept := f.newConversation(t), f.newConversation(t)
+	f.ask(t, doomed.ID, "How are webhooks signed?")
+	f.ask(t, doomed.ID, "Are they retried?")
+	f.ask(t, kept.ID, "How are webhooks signed?")
+
+	inConversation := func(id int) (turns, results int) {
+		turns = f.client.UserPrompt.Query().Where(userprompt.HasConversationWith(conversation.ID(id))).CountX(ctx)
+		results = f.client.QueryResult.Query().
+			Where(queryresult.HasQueryWith(userprompt.HasConversationWith(conversation.ID(id)))).
+			CountX(ctx)
+		return turns, results
+	}
+	if turns, results := inConversation(doomed.ID); turns != 2 || results != 2 {
+		t.Fatalf("conversation has %d turns and %d results before the delete, want 2 and 2", turns, results)
+	}
+	allResults := f.client.QueryResult.Query().CountX(ctx)
+
+	if err := f.s.DeleteConversation(ctx, f.project.ID, doomed.ID, uuid.New()); err == nil {
+		t.Fatal("deleted another user's conversation")
+	}
+	if err := f.s.DeleteConversation(ctx, f.project.ID, doomed.ID, f.owner.ID); err != nil {
+		t.Fatal(err)
+	}
+	if f.client.Conversation.Query().Where(conversation.ID(doomed.ID)).ExistX(ctx) {
+		t.Error("conversation still exists")
+	}
+	if n := f.client.UserPrompt.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d prompts left, want the other conversation's one", n)
+	}
+	if n := f.client.QueryResult.Query().CountX(ctx); n != allResults-2 {
+		t.Errorf("%d query results left, want %d", n, allResults-2)
+	}
+	if n := f.client.QueryResult.Query().Where(queryresult.Not(queryresult.HasQuery())).CountX(ctx); n != 0 {
+		t.Errorf("%d query results orphaned", n)
+	}
+	if turns, results := inConversation(kept.ID); turns != 1 || results != 1 {
+		t.Errorf("other conversation has %d turns and %d results, want 1 and 1", turns, results)
+	}
+
+	if err := f.s.DeleteConversation(ctx, f.project.ID, doomed.ID, f.owner.ID); err == nil {
+		t.Error("deleted a conversation twice")
+	}
+}
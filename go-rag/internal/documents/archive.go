@@ -0,0 +1,139 @@
+package documents
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// Archive formats accepted for import.
+const (
+	archiveZip   = "zip"
+	archiveTar   = "tar"
+	archiveTarGz = "tar.gz"
+)
+
+// detectArchiveFormat identifies an archive by its first bytes.
+func detectArchiveFormat(head []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return archiveZip, nil
+	case bytes.HasPrefix(head, []byte{0x1f, 0x8b}):
+		return archiveTarGz, nil
+	case len(head) >= 262 && string(head[257:262]) == "ustar":
+		return archiveTar, nil
+	}
+	return "", errors.New("unsupported archive format")
+}
+
+// archiveEntry is a regular file in an archive. Body may only be read during
+// the walk callback.
+type archiveEntry struct {
+	Name string // Cleaned, slash-separated path within the archive.
+	Size int64
+	Body io.Reader
+}
+
+// walkArchive calls fn for each regular file of the archive at filename, in
+// archive order. Entries whose path would escape the archive, and the
+// metadata folders of git and macOS, are skipped.
+func walkArchive(filename, format string, fn func(e archiveEntry) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if format == archiveZip {
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		zr, err := zip.NewReader(f, info.Size())
+		if err != nil {
+			return fmt.Errorf("malformed zip archive: %w", err)
+		}
+		for _, zf := range zr.File {
+			name, ok := archivePath(zf.Name)
+			if !ok || !zf.Mode().IsRegular() {
+				continue
+			}
+			rc, err := zf.Open()
+			if err != nil {
+				return fmt.Errorf("malformed zip archive: %w", err)
+			}
+			err = fn(archiveEntry{Name: name, Size: int64(zf.UncompressedSize64), Body: rc})
+			rc.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var r io.Reader = f
+	if format == archiveTarGz {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("malformed gzip stream: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("malformed tar archive: %w", err)
+		}
+		name, ok := archivePath(hdr.Name)
+		if !ok || hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := fn(archiveEntry{Name: name, Size: hdr.Size, Body: tr}); err != nil {
+			return err
+		}
+	}
+}
+
+// archivePath cleans an entry's path, reporting false for paths that are
+// absolute or leave the archive and for entries that are never content.
+func archivePath(name string) (string, bool) {
+	name = path.Clean(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+		return "", false
+	}
+	for _, seg := range strings.Split(name, "/") {
+		if seg == ".git" || seg == "__MACOSX" {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+// commonRoot returns the single top-level directory every file is in, such
+// as the "repo-main/" folder of a downloaded repository, or "".
+func commonRoot(names []string) string {
+	root := ""
+	for _, name := range names {
+		dir, _, ok := strings.Cut(name, "/")
+		if !ok || (root != "" && dir != root) {
+			return ""
+		}
+		root = dir
+	}
+	if root == "" {
+		return ""
+	}
+	return root + "/"
+}
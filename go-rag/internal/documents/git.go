@@ -0,0 +1,187 @@
+package documents
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// gitProtocols are the transports a source URL may use. "file" covers a
+// repository given by its path on the server.
+const gitProtocols = "file:git:http:https:ssh"
+
+// git runs a git command, returning its standard output. The repository's
+// own configuration cannot prompt for credentials or run arbitrary
+// transports.
+func git(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ALLOW_PROTOCOL="+gitProtocols,
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return out, nil
+}
+
+// validateGitSource checks a repository URL and ref before they are handed
+// to git, so neither can be taken for an option.
+func validateGitSource(url, ref string) error {
+	if url == "" {
+		return fmt.Errorf("repository URL is required")
+	}
+	if strings.HasPrefix(url, "-") {
+		return fmt.Errorf("invalid repository URL")
+	}
+	if ref == "" || strings.HasPrefix(ref, "-") || strings.ContainsAny(ref, " \t\n\x00") {
+		return fmt.Errorf("invalid ref %q", ref)
+	}
+	return nil
+}
+
+// mirrorRepository brings the local mirror of a repository in dir up to date,
+// cloning it on first use. All branches and tags are mirrored so any ref or
+// reachable commit can be imported.
+func mirrorRepository(ctx context.Context, url, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, "HEAD")); err == nil {
+		if _, err := git(ctx, dir, "remote", "set-url", "origin", url); err != nil {
+			return err
+		}
+		_, err := git(ctx, dir, "fetch", "--quiet", "--prune", "--force", "origin")
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0o700); err != nil {
+		return err
+	}
+	// Clone next to the final location so a failed clone leaves nothing behind.
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), filepath.Base(dir)+".clone-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if _, err := git(ctx, "", "clone", "--quiet", "--mirror", "--", url, tmp); err != nil {
+		return err
+	}
+	os.RemoveAll(dir)
+	return os.Rename(tmp, dir)
+}
+
+// resolveCommit returns the full SHA of the commit a branch, tag or commit
+// names.
+func resolveCommit(ctx context.Context, dir, ref string) (string, error) {
+	out, err := git(ctx, dir, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("ref %q not found in repository", ref)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gitFile is a regular file in a commit's tree.
+type gitFile struct {
+	Path string
+	Blob string
+	Size int64
+}
+
+// listTree returns the regular files of a commit. Symbolic links and
+// submodules are not content and are left out.
+func listTree(ctx context.Context, dir, commit string) ([]gitFile, error) {
+	out, err := git(ctx, dir, "ls-tree", "-r", "-z", "--long", "--full-tree", commit)
+	if err != nil {
+		return nil, err
+	}
+	var files []gitFile
+	for _, entry := range strings.Split(string(out), "\x00") {
+		if entry == "" {
+			continue
+		}
+		// <mode> SP <type> SP <object> SP+ <size> TAB <path>
+		info, name, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(info)
+		if !ok || len(fields) != 4 {
+			return nil, fmt.Errorf("git ls-tree: unexpected entry %q", entry)
+		}
+		if fields[1] != "blob" || (fields[0] != "100644" && fields[0] != "100755") {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("git ls-tree: unexpected size %q", fields[3])
+		}
+		files = append(files, gitFile{Path: name, Blob: fields[2], Size: size})
+	}
+	return files, nil
+}
+
+// blobReader reads objects from a repository through one long-running
+// "git cat-file --batch" process.
+type blobReader struct {
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+}
+
+func newBlobReader(ctx context.Context, dir string) (*blobReader, error) {
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "--batch")
+	cmd.Dir = dir
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &blobReader{cmd: cmd, in: in, out: bufio.NewReader(out)}, nil
+}
+
+// read returns the content of a blob.
+func (r *blobReader) read(blob string) ([]byte, error) {
+	if _, err := io.WriteString(r.in, blob+"\n"); err != nil {
+		return nil, err
+	}
+	header, err := r.out.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	// <object> SP <type> SP <size> LF, or <object> SP missing LF.
+	fields := strings.Fields(header)
+	if len(fields) != 3 || fields[1] != "blob" {
+		return nil, errors.New("git cat-file: blob " + blob + " not found")
+	}
+	size, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("git cat-file: unexpected size %q", fields[2])
+	}
+	data := make([]byte, size+1) // The content is followed by a newline.
+	if _, err := io.ReadFull(r.out, data); err != nil {
+		return nil, err
+	}
+	return data[:size], nil
+}
+
+func (r *blobReader) Close() error {
+	r.in.Close()
+	return r.cmd.Wait()
+}
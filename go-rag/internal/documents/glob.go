@@ -0,0 +1,140 @@
+package documents
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// matchGlob reports whether a slash-separated relative path matches a glob
+// pattern. Segments use path.Match syntax, and a "**" segment matches any
+// number of directories. A pattern without a slash matches the base name at
+// any depth, so "*.md" selects every Markdown file.
+func matchGlob(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+	return matchSegments(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, segs []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			pattern = pattern[1:]
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := range segs {
+				if matchSegments(pattern, segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segs[0]); !ok {
+			return false
+		}
+		pattern, segs = pattern[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
+
+// validateGlobs checks that every pattern is well formed.
+func validateGlobs(patterns []string) error {
+	for _, p := range patterns {
+		for _, seg := range strings.Split(p, "/") {
+			if _, err := path.Match(seg, ""); err != nil {
+				return fmt.Errorf("invalid glob pattern %q", p)
+			}
+		}
+	}
+	return nil
+}
+
+// ignoreRule is one pattern line of a .gitignore file.
+type ignoreRule struct {
+	base     string   // Directory of the .gitignore, "" at the root.
+	segments []string // The pattern, split at slashes.
+	negate   bool     // "!pattern" re-includes what an earlier rule ignored.
+	dirOnly  bool     // "pattern/" only matches directories.
+	anchored bool     // A slash before the end ties the pattern to base.
+}
+
+// gitignore holds the rules of the .gitignore files found in an archive.
+type gitignore struct {
+	rules []ignoreRule
+}
+
+// add parses a .gitignore file in directory dir.
+func (g *gitignore) add(dir, content string) {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r := ignoreRule{base: dir}
+		if strings.HasPrefix(line, "!") {
+			r.negate, line = true, line[1:]
+		} else if strings.HasPrefix(line, `\`) {
+			line = line[1:] // An escaped leading "#" or "!".
+		}
+		if strings.HasSuffix(line, "/") {
+			r.dirOnly, line = true, strings.TrimRight(line, "/")
+		}
+		r.anchored = strings.Contains(line, "/")
+		line = strings.TrimPrefix(line, "/")
+		if line == "" {
+			continue
+		}
+		r.segments = strings.Split(line, "/")
+		g.rules = append(g.rules, r)
+	}
+}
+
+// ignored reports whether a file is ignored, either itself or through one of
+// the directories containing it, as git does not look inside an ignored
+// directory.
+func (g *gitignore) ignored(name string) bool {
+	if len(g.rules) == 0 {
+		return false
+	}
+	segs := strings.Split(name, "/")
+	for i := 1; i <= len(segs); i++ {
+		if g.matches(strings.Join(segs[:i], "/"), i < len(segs)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches applies the rules to one path in order; the last matching rule
+// decides whether it is ignored.
+func (g *gitignore) matches(name string, isDir bool) bool {
+	ignored := false
+	for _, r := range g.rules {
+		rel := name
+		if r.base != "" {
+			if !strings.HasPrefix(name, r.base+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(name, r.base+"/")
+		}
+		if r.dirOnly && !isDir {
+			continue
+		}
+		var ok bool
+		if r.anchored {
+			ok = matchSegments(r.segments, strings.Split(rel, "/"))
+		} else {
+			ok, _ = path.Match(r.segments[0], path.Base(rel))
+		}
+		if ok {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
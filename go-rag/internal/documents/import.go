@@ -0,0 +1,431 @@
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"go-rag/services/events"
+	"go-rag/services/extract"
+	"io"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// importBatchSize is how many documents are created, then embedded,
+	// together.
+	importBatchSize = 32
+	// maxImportErrors caps the per-file errors recorded on a job.
+	maxImportErrors = 100
+)
+
+// ImportArchiveRequest defines an archive of files to import into a project.
+// Include and Exclude are glob patterns over the files' paths in the archive;
+// with no Include patterns every file is a candidate.
+type ImportArchiveRequest struct {
+	Name      string
+	Body      io.Reader
+	Include   []string
+	Exclude   []string
+	ProjectID int
+	OwnerID   uuid.UUID
+}
+
+// ImportArchive receives a zip, tar or tar.gz archive and starts importing
+// its files into the project in the background, one document per file named
+// by its path in the archive. The returned job reports the progress.
+func (s *Service) ImportArchive(ctx context.Context, req ImportArchiveRequest) (*ent.ImportJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id":   req.ProjectID,
+		"owner_id":     req.OwnerID,
+		"archive_name": req.Name,
+	})
+	log.Info("service: importing archive")
+
+	if err := validateGlobs(append(append([]string(nil), req.Include...), req.Exclude...)); err != nil {
+		log.WithError(err).Warn("service: rejected import filters")
+		return nil, err
+	}
+
+	// Security Check: Ensure the user owns the project.
+	p, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: attempt to import into a non-existent or unowned project")
+			return nil, fmt.Errorf("project not found or access denied")
+		}
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+
+	// The archive itself may take up what is left of the project's limit.
+	limit := int64(math.MaxInt64 - 1)
+	if s.Limits.MaxProjectSize > 0 {
+		used, err := s.projectUsage(ctx, req.ProjectID)
+		if err != nil {
+			log.WithError(err).Error("service: failed to compute project storage usage")
+			return nil, err
+		}
+		limit = s.Limits.MaxProjectSize - used
+	}
+	spool, err := spoolUpload(req.Body, max(limit, 0))
+	if err != nil {
+		if spool != nil {
+			os.Remove(spool.path)
+		}
+		log.WithError(err).Error("service: failed to receive archive")
+		return nil, fmt.Errorf("could not receive file: %w", err)
+	}
+	format, err := detectArchiveFormat(spool.head)
+	if err == nil && spool.size > limit {
+		err = fmt.Errorf("project size limit of %d bytes exceeded", s.Limits.MaxProjectSize)
+	}
+	if err != nil {
+		os.Remove(spool.path)
+		log.WithError(err).Warn("service: rejected archive")
+		return nil, err
+	}
+
+	job, err := s.Client.ImportJob.
+		Create().
+		SetArchiveName(req.Name).
+		SetInclude(req.Include).
+		SetExclude(req.Exclude).
+		SetLockedUntil(time.Now().Add(lease)).
+		SetProject(p).
+		Save(ctx)
+	if err != nil {
+		os.Remove(spool.path)
+		log.WithError(err).Error("service: failed to create import job")
+		return nil, fmt.Errorf("could not create import job: %w", err)
+	}
+
+	imp := &archiveImport{
+		s:       s,
+		job:     job,
+		project: p,
+		archive: spool.path,
+		format:  format,
+		include: req.Include,
+		exclude: req.Exclude,
+	}
+	go imp.run(context.Background())
+
+	log.WithField("import_job_id", job.ID).Info("service: archive import started")
+	return job, nil
+}
+
+// GetImportJob retrieves an import job of a project owned by the user.
+func (s *Service) GetImportJob(ctx context.Context, projectID, jobID int, ownerID uuid.UUID) (*ent.ImportJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id":    projectID,
+		"import_job_id": jobID,
+		"owner_id":      ownerID,
+	})
+	log.Info("service: getting import job")
+
+	job, err := s.Client.ImportJob.
+		Query().
+		Where(
+			importjob.ID(jobID),
+			importjob.HasProjectWith(
+				project.ID(projectID),
+				project.HasOwnerWith(user.ID(ownerID)),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: import job not found or access denied")
+			return nil, fmt.Errorf("import job not found or access denied")
+		}
+		log.WithError(err).Error("service: database error while getting import job")
+		return nil, err
+	}
+	return job, nil
+}
+
+// archiveImport is the state of one running import.
+type archiveImport struct {
+	s       *Service
+	job     *ent.ImportJob
+	project *ent.Project
+	archive string
+	format  string
+	include []string
+	exclude []string
+
+	// remaining is how many more bytes the project may store.
+	remaining int64
+	// taken holds the document paths already in use in the project.
+	taken map[string]bool
+	// Counts since the job was last updated, and all errors so far.
+	skipped int
+	errors  []string
+	batch   []*ent.DocumentCreate
+}
+
+// run imports the archive's files and records the outcome on the job.
+func (imp *archiveImport) run(ctx context.Context) {
+	defer os.Remove(imp.archive)
+	log := logrus.WithFields(logrus.Fields{
+		"project_id":    imp.project.ID,
+		"import_job_id": imp.job.ID,
+	})
+
+	stop := keepClaim(ctx, log, func(ctx context.Context, until time.Time) error {
+		return imp.s.Client.ImportJob.UpdateOneID(imp.job.ID).SetLockedUntil(until).Exec(ctx)
+	})
+	err := imp.importFiles(ctx, log)
+	stop()
+	update := imp.s.Client.ImportJob.UpdateOneID(imp.job.ID).
+		AddSkippedFiles(imp.skipped).
+		SetErrors(imp.errors).
+		ClearLockedUntil().
+		SetFinishedAt(time.Now())
+	if err != nil {
+		log.WithError(err).Error("service: archive import failed")
+		update.SetStatus("failed").SetLastError(err.Error())
+	} else {
+		log.Info("service: archive import completed")
+		update.SetStatus("completed")
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to finalize import job")
+	}
+}
+
+func (imp *archiveImport) importFiles(ctx context.Context, log *logrus.Entry) error {
+	if err := imp.s.Client.ImportJob.UpdateOneID(imp.job.ID).SetStatus("running").Exec(ctx); err != nil {
+		return err
+	}
+
+	// First pass: read the .gitignore files and list the files to import.
+	var names []string
+	ignoreFiles := make(map[string]string)
+	err := walkArchive(imp.archive, imp.format, func(e archiveEntry) error {
+		names = append(names, e.Name)
+		if path.Base(e.Name) == ".gitignore" {
+			b, err := io.ReadAll(io.LimitReader(e.Body, 1<<20))
+			if err != nil {
+				return err
+			}
+			ignoreFiles[e.Name] = string(b)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	root := commonRoot(names)
+	// Rules of deeper .gitignore files come later so that they take precedence.
+	ignorePaths := make([]string, 0, len(ignoreFiles))
+	for name := range ignoreFiles {
+		ignorePaths = append(ignorePaths, name)
+	}
+	sort.Slice(ignorePaths, func(i, j int) bool {
+		di, dj := strings.Count(ignorePaths[i], "/"), strings.Count(ignorePaths[j], "/")
+		if di != dj {
+			return di < dj
+		}
+		return ignorePaths[i] < ignorePaths[j]
+	})
+	var ignore gitignore
+	for _, name := range ignorePaths {
+		dir := path.Dir(strings.TrimPrefix(name, root))
+		if dir == "." {
+			dir = ""
+		}
+		ignore.add(dir, ignoreFiles[name])
+	}
+	selected := func(name string) bool {
+		if ignore.ignored(name) {
+			return false
+		}
+		for _, pattern := range imp.exclude {
+			if matchGlob(pattern, name) {
+				return false
+			}
+		}
+		for _, pattern := range imp.include {
+			if matchGlob(pattern, name) {
+				return true
+			}
+		}
+		return len(imp.include) == 0
+	}
+	total := 0
+	for _, name := range names {
+		if selected(strings.TrimPrefix(name, root)) {
+			total++
+		}
+	}
+	if err := imp.s.Client.ImportJob.UpdateOneID(imp.job.ID).SetTotalFiles(total).Exec(ctx); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{"files": len(names), "selected": total}).Info("service: archive listed")
+
+	imp.remaining = math.MaxInt64
+	if limit := imp.s.Limits.MaxProjectSize; limit > 0 {
+		used, err := imp.s.projectUsage(ctx, imp.project.ID)
+		if err != nil {
+			return err
+		}
+		imp.remaining = limit - used
+	}
+
+	paths, err := imp.s.Client.Document.Query().
+		Where(document.HasProjectWith(project.ID(imp.project.ID))).
+		Select(document.FieldPath).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+	imp.taken = make(map[string]bool, len(paths))
+	for _, p := range paths {
+		imp.taken[p] = true
+	}
+
+	// Second pass: create the selected files' documents in batches.
+	err = walkArchive(imp.archive, imp.format, func(e archiveEntry) error {
+		name := strings.TrimPrefix(e.Name, root)
+		if !selected(name) {
+			return nil
+		}
+		if err := imp.add(ctx, name, e); err != nil {
+			imp.skip(name, err)
+		}
+		if len(imp.batch) == importBatchSize {
+			return imp.flush(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return imp.flush(ctx)
+}
+
+// add reads one file into the pending batch.
+func (imp *archiveImport) add(ctx context.Context, name string, e archiveEntry) error {
+	if imp.taken[name] {
+		return fmt.Errorf("a document already exists at this path")
+	}
+	if limit := imp.s.Limits.MaxFileSize; limit > 0 && e.Size > limit {
+		return fmt.Errorf("file size limit of %d bytes exceeded", limit)
+	}
+	if e.Size > imp.remaining {
+		return fmt.Errorf("project size limit of %d bytes exceeded", imp.s.Limits.MaxProjectSize)
+	}
+	data, err := io.ReadAll(io.LimitReader(e.Body, e.Size+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != e.Size {
+		return fmt.Errorf("size does not match the archive header")
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("file is empty")
+	}
+	content, source, err := documentBody(name, data)
+	if err != nil {
+		return err
+	}
+	imp.remaining -= e.Size
+	imp.taken[name] = true
+
+	create := imp.s.Client.Document.Create().
+		SetName(path.Base(name)).
+		SetPath(name).
+		SetContent(content).
+		SetContentHash(fmt.Sprintf("%x", sha256.Sum256(data))).
+		SetMimeType(detectMIMEType(name, data[:min(len(data), 512)])).
+		SetProject(imp.project)
+	if source != nil {
+		create.SetSource(source)
+	}
+	imp.batch = append(imp.batch, create)
+	return nil
+}
+
+// skip records a file that was not imported.
+func (imp *archiveImport) skip(name string, err error) {
+	imp.skipped++
+	if len(imp.errors) < maxImportErrors {
+		imp.errors = append(imp.errors, name+": "+err.Error())
+	}
+}
+
+// flush creates the pending batch of documents, queues them for embedding,
+// waits for the queue to finish them and records the progress on the job.
+func (imp *archiveImport) flush(ctx context.Context) error {
+	if len(imp.batch) == 0 && imp.skipped == 0 {
+		return nil
+	}
+	var ids []int
+	if len(imp.batch) > 0 {
+		docs, err := imp.s.Client.Document.CreateBulk(imp.batch...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("could not create documents: %w", err)
+		}
+		imp.batch = imp.batch[:0]
+
+		for _, d := range docs {
+			ids = append(ids, d.ID)
+			imp.s.publish(ctx, events.DocumentCreated, imp.project.ID, d)
+			if err := imp.s.Queue.EnqueueDocument(ctx, d.ID); err != nil {
+				return err
+			}
+		}
+		if err := imp.s.Queue.Wait(ctx, ids); err != nil {
+			return err
+		}
+	}
+
+	failed, err := imp.s.Client.Document.Query().
+		Where(document.IDIn(ids...), document.StatusEQ(document.StatusFailed)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	err = imp.s.Client.ImportJob.UpdateOneID(imp.job.ID).
+		AddProcessedFiles(len(ids) - failed).
+		AddFailedFiles(failed).
+		AddSkippedFiles(imp.skipped).
+		SetErrors(imp.errors).
+		Exec(ctx)
+	imp.skipped = 0
+	return err
+}
+
+// documentBody decides how a file is stored: formats with an extractor, such
+// as PDF, are kept as the document's source, and anything else must be UTF-8
+// text to be its content.
+func documentBody(name string, data []byte) (content string, source []byte, err error) {
+	if _, ok := extract.For(name); ok {
+		return "", data, nil
+	}
+	if utf8.Valid(data) {
+		return string(data), nil, nil
+	}
+	return "", nil, fmt.Errorf("unsupported document format")
+}
@@ -0,0 +1,106 @@
+package documents
+
+import (
+	"context"
+	"go-rag/ent/ent/importjob"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/projectsource"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// lease is how long a source sync or archive import stays claimed
+	// without its server renewing the claim. Past it, the work is taken to
+	// have died with its server.
+	lease = 2 * time.Minute
+	// recoverInterval is how often work whose claim expired is looked for.
+	recoverInterval = time.Minute
+)
+
+// Start marks source syncs and archive imports that were interrupted, such as
+// by a restart, as failed, at once and then periodically until ctx is
+// cancelled, so that they do not show as running forever.
+func (s *Service) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(recoverInterval)
+		defer ticker.Stop()
+		for {
+			if err := s.RecoverInterrupted(ctx); err != nil {
+				logrus.WithError(err).Error("service: failed to recover interrupted work")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RecoverInterrupted marks the source syncs and archive imports whose claim
+// expired as failed. An interrupted import cannot be resumed, as its archive
+// was only spooled by the server that received it.
+func (s *Service) RecoverInterrupted(ctx context.Context) error {
+	now := time.Now()
+	n, err := s.Client.ProjectSource.
+		Update().
+		Where(projectsource.Status("syncing"), syncLeaseExpired(now)).
+		SetStatus("failed").
+		SetLastError("sync was interrupted, start it again").
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		logrus.WithField("sources", n).Warn("service: marked interrupted source syncs failed")
+	}
+
+	n, err = s.Client.ImportJob.
+		Update().
+		Where(
+			importjob.StatusIn("pending", "running"),
+			importjob.Or(importjob.LockedUntilIsNil(), importjob.LockedUntilLT(now)),
+		).
+		SetStatus("failed").
+		SetLastError("import was interrupted, upload the archive again").
+		ClearLockedUntil().
+		SetFinishedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		logrus.WithField("import_jobs", n).Warn("service: marked interrupted archive imports failed")
+	}
+	return nil
+}
+
+// keepClaim runs renew every third of the lease until the returned function
+// is called, so that long work keeps its claim.
+func keepClaim(ctx context.Context, log *logrus.Entry, renew func(ctx context.Context, until time.Time) error) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := renew(ctx, time.Now().Add(lease)); err != nil {
+					log.WithError(err).Warn("service: failed to renew claim")
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// syncLeaseExpired selects the sources whose sync claim is missing or
+// expired.
+func syncLeaseExpired(now time.Time) predicate.ProjectSource {
+	return projectsource.Or(projectsource.LockedUntilIsNil(), projectsource.LockedUntilLT(now))
+}
@@ -7,7 +7,11 @@ import (
 	"go-rag/ent/ent/document"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/user"
+	"go-rag/internal/jobs"
 	"go-rag/services/embed"
+	"go-rag/services/events"
+	"go-rag/services/extract"
+	"path"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -17,12 +21,27 @@ import (
 type Service struct {
 	Client       *ent.Client
 	EmbedService *embed.Service
+	// Queue processes documents in the background.
+	Queue  *jobs.Queue
+	Limits UploadLimits
+	// GitCacheDir holds the local mirrors of git repository sources.
+	GitCacheDir string
+	// Events, when set, is told about created, updated and deleted documents.
+	Events *events.Bus
 }
 
 // CreateDocumentRequest defines the parameters for creating a new document.
 type CreateDocumentRequest struct {
-	Name        string
-	Content     string
+	Name string
+	// Path places the document in the project's folder tree. It defaults to
+	// Name, and Name to the last element of Path.
+	Path    string
+	Content string
+	// Source is an uploaded binary file, such as a PDF, to extract the
+	// content from. It is used instead of Content when set.
+	Source []byte
+	// MIMEType is the detected media type of an uploaded file, if known.
+	MIMEType    string
 	ContentHash string
 	ProjectID   int
 	OwnerID     uuid.UUID
@@ -32,7 +51,9 @@ type UpdateDocumentRequest struct {
 	DocumentID  int
 	OwnerID     uuid.UUID
 	Name        *string
+	Path        *string
 	Content     *string
+	Source      []byte
 	ContentHash *string
 }
 
@@ -45,6 +66,25 @@ func (s *Service) CreateDocument(ctx context.Context, req CreateDocumentRequest)
 	})
 	log.Info("service: creating new document")
 
+	if req.Path == "" {
+		req.Path = req.Name
+	}
+	docPath, err := normalizePath(req.Path)
+	if err != nil {
+		log.WithError(err).Warn("service: rejected document path")
+		return nil, err
+	}
+	if req.Name == "" {
+		req.Name = path.Base(docPath)
+	}
+
+	if req.Source != nil {
+		if _, ok := extract.For(req.Name); !ok {
+			log.Warn("service: attempt to upload a binary document in an unsupported format")
+			return nil, fmt.Errorf("unsupported document format")
+		}
+	}
+
 	// Security Check: Ensure the user owns the project.
 	p, err := s.Client.Project.
 		Query().
@@ -62,20 +102,45 @@ func (s *Service) CreateDocument(ctx context.Context, req CreateDocumentRequest)
 		return nil, err
 	}
 
-	doc, err := s.Client.Document.
+	size := int64(len(req.Content) + len(req.Source))
+	if err := s.checkFileSize(size); err != nil {
+		log.WithError(err).Warn("service: rejected document")
+		return nil, err
+	}
+	if err := s.checkProjectSize(ctx, p.ID, size); err != nil {
+		log.WithError(err).Warn("service: rejected document")
+		return nil, err
+	}
+
+	creator := s.Client.Document.
 		Create().
 		SetName(req.Name).
+		SetPath(docPath).
 		SetContent(req.Content).
 		SetContentHash(req.ContentHash).
-		SetProject(p).
-		Save(ctx)
+		SetProject(p)
+	if req.Source != nil {
+		creator.SetSource(req.Source)
+	}
+	if req.MIMEType != "" {
+		creator.SetMimeType(req.MIMEType)
+	}
+	doc, err := creator.Save(ctx)
 
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			log.WithField("path", docPath).Warn("service: document path already taken")
+			return nil, fmt.Errorf("a document already exists at path %q", docPath)
+		}
 		log.WithError(err).Error("service: failed to save document to database")
 		return nil, fmt.Errorf("could not create document: %w", err)
 	}
 
-	go s.EmbedService.ProcessDocument(context.Background(), doc.ID)
+	s.publish(ctx, events.DocumentCreated, p.ID, doc)
+	// Should queueing fail, the document is picked up again on startup.
+	if err := s.Queue.EnqueueDocument(ctx, doc.ID); err != nil {
+		log.WithError(err).Error("service: failed to queue document for processing")
+	}
 
 	log.WithField("document_id", doc.ID).Info("service: document created successfully")
 	return doc, nil
@@ -153,6 +218,40 @@ func (s *Service) UpdateDocument(ctx context.Context, req UpdateDocumentRequest)
 		return nil, err
 	}
 
+	name := doc.Name
+	if req.Name != nil {
+		name = *req.Name
+	}
+	if req.Source != nil {
+		if _, ok := extract.For(name); !ok {
+			log.Warn("service: attempt to upload a binary document in an unsupported format")
+			return nil, fmt.Errorf("unsupported document format")
+		}
+	}
+
+	projectID, err := doc.QueryProject().OnlyID(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to find project of document")
+		return nil, err
+	}
+	if req.Content != nil || req.Source != nil {
+		// The new body replaces the stored one: text replaces both content
+		// and source, while a new file keeps the content until it is
+		// extracted.
+		body, stored := int64(len(req.Source)), int64(len(doc.Content)+len(req.Source))
+		if req.Content != nil {
+			body, stored = int64(len(*req.Content)), int64(len(*req.Content))
+		}
+		if err := s.checkFileSize(body); err != nil {
+			log.WithError(err).Warn("service: rejected document update")
+			return nil, err
+		}
+		if err := s.checkProjectSize(ctx, projectID, stored-int64(len(doc.Content)+len(doc.Source))); err != nil {
+			log.WithError(err).Warn("service: rejected document update")
+			return nil, err
+		}
+	}
+
 	// Prepare the update operation.
 	updater := doc.Update()
 
@@ -160,25 +259,85 @@ func (s *Service) UpdateDocument(ctx context.Context, req UpdateDocumentRequest)
 	if req.Name != nil {
 		updater.SetName(*req.Name)
 	}
+	if req.Path != nil {
+		docPath, err := normalizePath(*req.Path)
+		if err != nil {
+			log.WithError(err).Warn("service: rejected document path")
+			return nil, err
+		}
+		updater.SetPath(docPath)
+	}
 	if req.Content != nil {
 		updater.SetContent(*req.Content)
+		updater.ClearSource()                    // Plain text replaces any uploaded file
 		updater.SetContentHash(*req.ContentHash) // Also update the hash
 	}
+	if req.Source != nil {
+		// The content is extracted from the new file when it is processed.
+		updater.SetSource(req.Source)
+		updater.SetContentHash(*req.ContentHash)
+	}
+	// New content, or a name that picks another extractor or chunker,
+	// starts a new processing run.
+	reprocess := req.Content != nil || req.Source != nil || nameChangesChunks(doc.Name, name)
+	if reprocess {
+		embed.Transition(updater.Mutation(), document.StatusQueued)
+		updater.SetAttempts(0).ClearLastError()
+	}
 
 	// Save the changes.
 	updatedDoc, err := updater.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) && req.Path != nil {
+			log.Warn("service: document path already taken")
+			return nil, fmt.Errorf("a document already exists at path %q", *req.Path)
+		}
 		log.WithError(err).Error("service: failed to update document in database")
 		return nil, err
 	}
 
-	if req.Content != nil {
-		go s.EmbedService.ProcessDocument(context.Background(), updatedDoc.ID)
+	if updatedDoc.Path != doc.Path {
+		if err := s.EmbedService.SetDocumentPath(ctx, updatedDoc.ID, updatedDoc.Path); err != nil {
+			log.WithError(err).Error("service: failed to update path of document vectors")
+		}
+	}
+	s.publish(ctx, events.DocumentUpdated, projectID, updatedDoc)
+	if reprocess {
+		if err := s.Queue.EnqueueDocument(ctx, updatedDoc.ID); err != nil {
+			log.WithError(err).Error("service: failed to queue document for processing")
+		}
 	}
 	log.Info("service: document updated successfully")
 	return updatedDoc, nil
 }
 
+// CancelDocument stops a document's processing. A document that already
+// completed or failed cannot be cancelled.
+func (s *Service) CancelDocument(ctx context.Context, documentID int, ownerID uuid.UUID) (*ent.Document, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"document_id": documentID,
+		"owner_id":    ownerID,
+	})
+	log.Info("service: cancelling document processing")
+
+	// Verify ownership first.
+	if _, err := s.GetDocumentByID(ctx, documentID, ownerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.EmbedService.SetStatus(ctx, documentID, document.StatusCancelled, nil); err != nil {
+		log.WithError(err).Warn("service: document processing could not be cancelled")
+		return nil, err
+	}
+	if err := s.Queue.CancelDocument(ctx, documentID); err != nil {
+		log.WithError(err).Error("service: failed to cancel queued jobs")
+		return nil, err
+	}
+
+	log.Info("service: document processing cancelled")
+	return s.GetDocumentByID(ctx, documentID, ownerID)
+}
+
 // DeleteDocument deletes a document and its associated vectors.
 func (s *Service) DeleteDocument(ctx context.Context, documentID int, ownerID uuid.UUID) error {
 	log := logrus.WithFields(logrus.Fields{
@@ -189,7 +348,7 @@ func (s *Service) DeleteDocument(ctx context.Context, documentID int, ownerID uu
 
 	// First, verify the user owns the document before doing anything.
 	// We get the document here to ensure it exists and belongs to the user.
-	_, err := s.Client.Document.
+	doc, err := s.Client.Document.
 		Query().
 		Where(
 			document.ID(documentID),
@@ -197,6 +356,10 @@ func (s *Service) DeleteDocument(ctx context.Context, documentID int, ownerID uu
 				project.HasOwnerWith(user.ID(ownerID)),
 			),
 		).
+		Select(document.FieldPath).
+		WithProject(func(q *ent.ProjectQuery) {
+			q.Select(project.FieldID)
+		}).
 		Only(ctx)
 	if err != nil {
 		log.WithError(err).Warn("service: document not found or access denied for deletion")
@@ -216,7 +379,18 @@ func (s *Service) DeleteDocument(ctx context.Context, documentID int, ownerID uu
 		log.WithError(err).Error("service: failed to delete document from database")
 		return err
 	}
+	s.publish(ctx, events.DocumentDeleted, doc.Edges.Project.ID, doc)
 
 	log.Info("service: document deleted successfully from all stores")
 	return nil
 }
+
+// publish announces a change to one of a project's documents.
+func (s *Service) publish(ctx context.Context, eventType string, projectID int, doc *ent.Document) {
+	s.Events.Publish(ctx, events.Event{
+		Type:       eventType,
+		ProjectID:  projectID,
+		DocumentID: doc.ID,
+		Path:       doc.Path,
+	})
+}
@@ -0,0 +1,48 @@
+package documents
+
+import (
+	"context"
+	"go-rag/internal/jobs"
+	"go-rag/services/embed"
+	"strings"
+	"testing"
+)
+
+func TestDocumentBodiesRespectFileSizeLimit(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	embedService := &embed.Service{Client: client}
+	s := &Service{
+		Client:       client,
+		EmbedService: embedService,
+		Queue:        &jobs.Queue{Client: client, EmbedService: embedService},
+		Limits:       UploadLimits{MaxFileSize: 16},
+	}
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+
+	doc, err := s.CreateDocument(ctx, CreateDocumentRequest{Path: "a.md", Content: "# Small\n", ProjectID: p.ID, OwnerID: owner.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.CreateDocument(ctx, CreateDocumentRequest{Path: "b.md", Content: strings.Repeat("x", 17), ProjectID: p.ID, OwnerID: owner.ID})
+	if err == nil || !strings.Contains(err.Error(), "file size limit") {
+		t.Errorf("created an oversized document, error %v", err)
+	}
+
+	big, hash := strings.Repeat("y", 17), "h"
+	_, err = s.UpdateDocument(ctx, UpdateDocumentRequest{DocumentID: doc.ID, OwnerID: owner.ID, Content: &big, ContentHash: &hash})
+	if err == nil || !strings.Contains(err.Error(), "file size limit") {
+		t.Errorf("updated a document past the limit, error %v", err)
+	}
+	if got := client.Document.GetX(ctx, doc.ID); got.Content != "# Small\n" {
+		t.Errorf("content %q after a refused update", got.Content)
+	}
+
+	// Renaming a document is not an upload and is not checked.
+	name := "renamed.md"
+	if _, err := s.UpdateDocument(ctx, UpdateDocumentRequest{DocumentID: doc.ID, OwnerID: owner.ID, Name: &name}); err != nil {
+		t.Errorf("rename failed: %v", err)
+	}
+}
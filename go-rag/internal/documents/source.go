@@ -0,0 +1,528 @@
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/projectsource"
+	"go-rag/ent/ent/user"
+	"go-rag/services/embed"
+	"go-rag/services/events"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Project source types.
+const sourceTypeGit = "git"
+
+// CreateSourceRequest defines a git repository to keep in sync with a
+// project. URL may be anything git can clone from the server, including a
+// local path; Ref is a branch, tag or commit and defaults to the
+// repository's default branch.
+type CreateSourceRequest struct {
+	Type      string
+	URL       string
+	Ref       string
+	ProjectID int
+	OwnerID   uuid.UUID
+}
+
+// CreateSource adds a git repository source to a project and starts
+// importing its files in the background, one document per file named by its
+// path in the repository.
+func (s *Service) CreateSource(ctx context.Context, req CreateSourceRequest) (*ent.ProjectSource, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+		"url":        req.URL,
+		"ref":        req.Ref,
+	})
+	log.Info("service: creating project source")
+
+	if req.Type == "" {
+		req.Type = sourceTypeGit
+	}
+	if req.Ref == "" {
+		req.Ref = "HEAD"
+	}
+	if req.Type != sourceTypeGit {
+		return nil, fmt.Errorf("unsupported source type %q", req.Type)
+	}
+	if err := validateGitSource(req.URL, req.Ref); err != nil {
+		log.WithError(err).Warn("service: rejected project source")
+		return nil, err
+	}
+
+	// Security Check: Ensure the user owns the project.
+	p, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: attempt to add a source to a non-existent or unowned project")
+			return nil, fmt.Errorf("project not found or access denied")
+		}
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+
+	src, err := s.Client.ProjectSource.
+		Create().
+		SetType(req.Type).
+		SetURL(req.URL).
+		SetRef(req.Ref).
+		SetStatus("syncing").
+		SetLockedUntil(time.Now().Add(lease)).
+		SetProject(p).
+		Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to create project source")
+		return nil, fmt.Errorf("could not create project source: %w", err)
+	}
+
+	go s.syncSource(context.Background(), src, p)
+
+	log.WithField("source_id", src.ID).Info("service: project source created, sync started")
+	return src, nil
+}
+
+// ListSources retrieves the sources of a project owned by the user.
+func (s *Service) ListSources(ctx context.Context, projectID int, ownerID uuid.UUID) ([]*ent.ProjectSource, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: listing project sources")
+
+	sources, err := s.Client.ProjectSource.
+		Query().
+		Where(
+			projectsource.HasProjectWith(
+				project.ID(projectID),
+				project.HasOwnerWith(user.ID(ownerID)),
+			),
+		).
+		Order(ent.Asc(projectsource.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list project sources")
+		return nil, err
+	}
+	return sources, nil
+}
+
+// GetSource retrieves a source of a project owned by the user.
+func (s *Service) GetSource(ctx context.Context, projectID, sourceID int, ownerID uuid.UUID) (*ent.ProjectSource, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"source_id":  sourceID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: getting project source")
+
+	src, err := s.Client.ProjectSource.
+		Query().
+		Where(
+			projectsource.ID(sourceID),
+			projectsource.HasProjectWith(
+				project.ID(projectID),
+				project.HasOwnerWith(user.ID(ownerID)),
+			),
+		).
+		WithProject().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: project source not found or access denied")
+			return nil, fmt.Errorf("source not found or access denied")
+		}
+		log.WithError(err).Error("service: database error while getting project source")
+		return nil, err
+	}
+	return src, nil
+}
+
+// SyncSource re-imports a source in the background, optionally switching it
+// to another ref first. Only files whose blob changed since the last sync
+// are processed again, and files no longer in the repository are removed.
+func (s *Service) SyncSource(ctx context.Context, projectID, sourceID int, ref *string, ownerID uuid.UUID) (*ent.ProjectSource, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"source_id":  sourceID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: syncing project source")
+
+	src, err := s.GetSource(ctx, projectID, sourceID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if ref != nil {
+		if err := validateGitSource(src.URL, *ref); err != nil {
+			log.WithError(err).Warn("service: rejected source ref")
+			return nil, err
+		}
+	}
+
+	// Claim the source, so that two syncs never run at once. A sync whose
+	// claim expired died with its server and is replaced.
+	now := time.Now()
+	update := s.Client.ProjectSource.
+		Update().
+		Where(
+			projectsource.ID(src.ID),
+			projectsource.Or(projectsource.StatusNEQ("syncing"), syncLeaseExpired(now)),
+		).
+		SetStatus("syncing").
+		SetLockedUntil(now.Add(lease))
+	if ref != nil {
+		update.SetRef(*ref)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to start source sync")
+		return nil, err
+	}
+	if n == 0 {
+		log.Warn("service: source sync already in progress")
+		return nil, fmt.Errorf("sync already in progress")
+	}
+
+	p := src.Edges.Project
+	if src, err = s.Client.ProjectSource.Get(ctx, src.ID); err != nil {
+		return nil, err
+	}
+	go s.syncSource(context.Background(), src, p)
+
+	log.Info("service: source sync started")
+	return src, nil
+}
+
+// DeleteSource removes a source along with the documents imported from it.
+func (s *Service) DeleteSource(ctx context.Context, projectID, sourceID int, ownerID uuid.UUID) error {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"source_id":  sourceID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: deleting project source")
+
+	src, err := s.GetSource(ctx, projectID, sourceID, ownerID)
+	if err != nil {
+		return err
+	}
+	if src.Status == "syncing" && src.LockedUntil != nil && src.LockedUntil.After(time.Now()) {
+		return fmt.Errorf("sync already in progress")
+	}
+
+	docs, err := s.Client.Document.
+		Query().
+		Where(document.HasProjectSourceWith(projectsource.ID(src.ID))).
+		Select(document.FieldPath).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list source documents")
+		return err
+	}
+	ids := make([]int, len(docs))
+	for i, d := range docs {
+		ids[i] = d.ID
+		if err := s.EmbedService.DeleteDocumentVectors(ctx, d.ID); err != nil {
+			log.WithError(err).WithField("document_id", d.ID).Error("service: failed to delete document vectors from Qdrant")
+		}
+	}
+	if _, err := s.Client.Document.Delete().Where(document.IDIn(ids...)).Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to delete source documents")
+		return err
+	}
+	for _, d := range docs {
+		s.publish(ctx, events.DocumentDeleted, projectID, d)
+	}
+	if err := s.Client.ProjectSource.DeleteOneID(src.ID).Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to delete project source")
+		return err
+	}
+	os.RemoveAll(s.sourceMirror(src.ID))
+
+	log.WithField("documents", len(ids)).Info("service: project source deleted")
+	return nil
+}
+
+// sourceMirror is where the local mirror of a source's repository is kept.
+func (s *Service) sourceMirror(sourceID int) string {
+	dir := s.GitCacheDir
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "go-rag-git")
+	}
+	return filepath.Join(dir, strconv.Itoa(sourceID)+".git")
+}
+
+// syncSource imports the files of the source's ref and records the outcome
+// on the source.
+func (s *Service) syncSource(ctx context.Context, src *ent.ProjectSource, p *ent.Project) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": p.ID,
+		"source_id":  src.ID,
+		"ref":        src.Ref,
+	})
+
+	stop := keepClaim(ctx, log, func(ctx context.Context, until time.Time) error {
+		return s.Client.ProjectSource.UpdateOneID(src.ID).SetLockedUntil(until).Exec(ctx)
+	})
+	commit, err := s.syncGitSource(ctx, src, p, log)
+	stop()
+	update := s.Client.ProjectSource.UpdateOneID(src.ID).SetSyncedAt(time.Now()).ClearLockedUntil()
+	if err != nil {
+		log.WithError(err).Error("service: source sync failed")
+		update.SetStatus("failed").SetLastError(err.Error())
+	} else {
+		log.WithField("commit", commit).Info("service: source sync completed")
+		update.SetStatus("synced").SetLastCommit(commit).ClearLastError()
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to finalize source sync")
+	}
+}
+
+// sourceDocumentSizesQuery lists the bytes stored for each of a source's
+// documents, counted as in projectUsageQuery.
+const sourceDocumentSizesQuery = `
+SELECT "id", octet_length("content") + COALESCE(octet_length("source"), 0)
+FROM "documents"
+WHERE "project_source_documents" = $1`
+
+// sourceDocumentSizes returns the bytes stored for each of a source's
+// documents, by document ID.
+func (s *Service) sourceDocumentSizes(ctx context.Context, sourceID int) (map[int]int64, error) {
+	rows, err := s.Client.QueryContext(ctx, sourceDocumentSizesQuery, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query source document sizes: %w", err)
+	}
+	defer rows.Close()
+	sizes := make(map[int]int64)
+	for rows.Next() {
+		var (
+			id   int
+			size int64
+		)
+		if err := rows.Scan(&id, &size); err != nil {
+			return nil, fmt.Errorf("could not scan source document size: %w", err)
+		}
+		sizes[id] = size
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read source document sizes: %w", err)
+	}
+	return sizes, nil
+}
+
+// syncGitSource brings the source's documents to the commit its ref points
+// to, returning that commit. A document whose blob is unchanged is only
+// stamped with the new commit; a changed one is updated and processed again,
+// where unchanged chunks keep their embeddings.
+func (s *Service) syncGitSource(ctx context.Context, src *ent.ProjectSource, p *ent.Project, log *logrus.Entry) (string, error) {
+	dir := s.sourceMirror(src.ID)
+	if err := mirrorRepository(ctx, src.URL, dir); err != nil {
+		return "", err
+	}
+	commit, err := resolveCommit(ctx, dir, src.Ref)
+	if err != nil {
+		return "", err
+	}
+	files, err := listTree(ctx, dir, commit)
+	if err != nil {
+		return "", err
+	}
+	log.WithFields(logrus.Fields{"commit": commit, "files": len(files)}).Info("service: repository listed")
+
+	existing, err := s.Client.Document.
+		Query().
+		Where(document.HasProjectSourceWith(projectsource.ID(src.ID))).
+		Select(document.FieldPath, document.FieldBlobSha, document.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+	byPath := make(map[string]*ent.Document, len(existing))
+	for _, d := range existing {
+		byPath[d.Path] = d
+	}
+	// Paths taken by the project's other documents are left alone.
+	others, err := s.Client.Document.
+		Query().
+		Where(
+			document.HasProjectWith(project.ID(p.ID)),
+			document.Not(document.HasProjectSourceWith(projectsource.ID(src.ID))),
+		).
+		Select(document.FieldPath).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+	taken := make(map[string]bool, len(others))
+	for _, other := range others {
+		taken[other] = true
+	}
+
+	remaining := int64(math.MaxInt64)
+	var sizes map[int]int64
+	if limit := s.Limits.MaxProjectSize; limit > 0 {
+		used, err := s.projectUsage(ctx, p.ID)
+		if err != nil {
+			return "", err
+		}
+		remaining = limit - used
+		if sizes, err = s.sourceDocumentSizes(ctx, src.ID); err != nil {
+			return "", err
+		}
+	}
+
+	blobs, err := newBlobReader(ctx, dir)
+	if err != nil {
+		return "", err
+	}
+	defer blobs.Close()
+
+	var unchanged, changed []int
+	for _, f := range files {
+		// A tracked file that can no longer be imported stays in byPath, so
+		// that its outdated document is removed below.
+		doc := byPath[f.Path]
+		// A document that failed or was cancelled before is processed again
+		// even if its blob is the same.
+		if doc != nil && doc.BlobSha == f.Blob && doc.Status != document.StatusFailed && doc.Status != document.StatusCancelled {
+			delete(byPath, f.Path)
+			unchanged = append(unchanged, doc.ID)
+			continue
+		}
+
+		// A changed file replaces its document, whose bytes are freed
+		// either way: a file that is skipped below has its document
+		// removed.
+		if doc != nil {
+			remaining += sizes[doc.ID]
+		}
+
+		flog := log.WithField("path", f.Path)
+		if taken[f.Path] {
+			flog.Warn("service: skipping repository file, a document already exists at its path")
+			continue
+		}
+		if limit := s.Limits.MaxFileSize; limit > 0 && f.Size > limit {
+			flog.Warn("service: skipping repository file over the file size limit")
+			continue
+		}
+		if f.Size > remaining {
+			flog.Warn("service: skipping repository file over the project size limit")
+			continue
+		}
+		if f.Size == 0 {
+			continue
+		}
+		data, err := blobs.read(f.Blob)
+		if err != nil {
+			return "", err
+		}
+		content, source, err := documentBody(f.Path, data)
+		if err != nil {
+			flog.WithError(err).Warn("service: skipping repository file")
+			continue
+		}
+		delete(byPath, f.Path)
+		remaining -= f.Size
+
+		hash := fmt.Sprintf("%x", sha256.Sum256(data))
+		mimeType := detectMIMEType(f.Path, data[:min(len(data), 512)])
+		if doc != nil {
+			update := s.Client.Document.UpdateOneID(doc.ID).
+				SetContent(content).
+				SetContentHash(hash).
+				SetMimeType(mimeType).
+				SetCommitSha(commit).
+				SetBlobSha(f.Blob).
+				SetAttempts(0).
+				ClearLastError()
+			embed.Transition(update.Mutation(), document.StatusQueued)
+			if source != nil {
+				update.SetSource(source)
+			} else {
+				update.ClearSource()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return "", fmt.Errorf("could not update document %q: %w", f.Path, err)
+			}
+			s.publish(ctx, events.DocumentUpdated, p.ID, doc)
+			changed = append(changed, doc.ID)
+			continue
+		}
+		create := s.Client.Document.Create().
+			SetName(path.Base(f.Path)).
+			SetPath(f.Path).
+			SetContent(content).
+			SetContentHash(hash).
+			SetMimeType(mimeType).
+			SetCommitSha(commit).
+			SetBlobSha(f.Blob).
+			SetProject(p).
+			SetProjectSource(src)
+		if source != nil {
+			create.SetSource(source)
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return "", fmt.Errorf("could not create document %q: %w", f.Path, err)
+		}
+		s.publish(ctx, events.DocumentCreated, p.ID, created)
+		changed = append(changed, created.ID)
+	}
+
+	if len(unchanged) > 0 {
+		err := s.Client.Document.Update().
+			Where(document.IDIn(unchanged...)).
+			SetCommitSha(commit).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Files removed from the repository are removed from the project.
+	for name, doc := range byPath {
+		if err := s.EmbedService.DeleteDocumentVectors(ctx, doc.ID); err != nil {
+			log.WithError(err).WithField("path", name).Error("service: failed to delete document vectors from Qdrant")
+		}
+		if err := s.Client.Document.DeleteOneID(doc.ID).Exec(ctx); err != nil {
+			return "", fmt.Errorf("could not delete document %q: %w", name, err)
+		}
+		s.publish(ctx, events.DocumentDeleted, p.ID, doc)
+	}
+	log.WithFields(logrus.Fields{
+		"unchanged": len(unchanged),
+		"changed":   len(changed),
+		"removed":   len(byPath),
+	}).Info("service: repository documents updated")
+
+	for _, id := range changed {
+		if err := s.Queue.EnqueueDocument(ctx, id); err != nil {
+			return "", err
+		}
+	}
+	if err := s.Queue.Wait(ctx, changed); err != nil {
+		return "", err
+	}
+	return commit, nil
+}
@@ -0,0 +1,341 @@
+package documents
+
+import (
+	"context"
+	"database/sql"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
+	"go-rag/internal/jobs"
+	"go-rag/services/embed"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/mattn/go-sqlite3"
+)
+
+// The test database provides Postgres' octet_length, which the project size
+// queries use and SQLite lacks.
+func init() {
+	sql.Register("sqlite3_octet_length", &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("octet_length", func(v interface{}) interface{} {
+				switch v := v.(type) {
+				case string:
+					return int64(len(v))
+				case []byte:
+					return int64(len(v))
+				}
+				return nil
+			}, true)
+		},
+	})
+}
+
+// newTestClient returns a client of a fresh in-memory database.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+	db, err := sql.Open("sqlite3_octet_length", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testRepo is a bare repository fed from a work tree, standing in for a
+// remote git server.
+type testRepo struct {
+	t    *testing.T
+	work string
+	bare string
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	r := &testRepo{t: t, work: filepath.Join(dir, "work"), bare: filepath.Join(dir, "remote.git")}
+	r.git("", "init", "--bare", "--initial-branch=main", r.bare)
+	r.git("", "init", "--initial-branch=main", r.work)
+	return r
+}
+
+func (r *testRepo) git(dir string, args ...string) string {
+	r.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// commit writes the files, removing those mapped to nil, and pushes the
+// commit to the bare repository, returning its SHA.
+func (r *testRepo) commit(files map[string]*string) string {
+	r.t.Helper()
+	for name, content := range files {
+		p := filepath.Join(r.work, name)
+		if content == nil {
+			if err := os.Remove(p); err != nil {
+				r.t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.WriteFile(p, []byte(*content), 0o644); err != nil {
+			r.t.Fatal(err)
+		}
+	}
+	r.git(r.work, "add", "-A")
+	r.git(r.work, "commit", "-q", "-m", "update")
+	r.git(r.work, "push", "-q", r.bare, "HEAD:refs/heads/main")
+	return r.git(r.work, "rev-parse", "HEAD")
+}
+
+// processedCounter stands in for the ingest workers: it settles every queued
+// job and counts the jobs run per document.
+type processedCounter struct {
+	mu     sync.Mutex
+	counts map[int]int
+}
+
+func runStandInWorker(t *testing.T, client *ent.Client) *processedCounter {
+	pc := &processedCounter{counts: map[int]int{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		for ctx.Err() == nil {
+			queued, err := client.IngestJob.Query().
+				Where(ingestjob.Status(jobs.StatusQueued)).
+				WithDocument().
+				All(ctx)
+			if err == nil {
+				for _, j := range queued {
+					client.IngestJob.UpdateOneID(j.ID).SetStatus(jobs.StatusSucceeded).Exec(ctx)
+					pc.mu.Lock()
+					pc.counts[j.Edges.Document.ID]++
+					pc.mu.Unlock()
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+	return pc
+}
+
+func (pc *processedCounter) get(id int) int {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	return pc.counts[id]
+}
+
+func TestSyncGitSource(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	processed := runStandInWorker(t, client)
+
+	embedService := &embed.Service{Client: client}
+	s := &Service{
+		Client:       client,
+		EmbedService: embedService,
+		Queue:        &jobs.Queue{Client: client, EmbedService: embedService},
+		Limits:       UploadLimits{MaxFileSize: 64},
+		GitCacheDir:  t.TempDir(),
+	}
+
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("repo").SetOwner(owner).SaveX(ctx)
+
+	text := func(s string) *string { return &s }
+	repo := newTestRepo(t)
+	first := repo.commit(map[string]*string{
+		"kept.md":    text("# Kept\n"),
+		"changed.md": text("# Before\n"),
+		"removed.md": text("# Removed\n"),
+		"emptied.md": text("# Emptied later\n"),
+		"grown.md":   text("# Grows past the size limit later\n"),
+	})
+
+	src := client.ProjectSource.Create().
+		SetURL(repo.bare).
+		SetRef("main").
+		SetStatus("syncing").
+		SetProject(p).
+		SaveX(ctx)
+
+	sync := func(want string) map[string]*ent.Document {
+		t.Helper()
+		s.syncSource(ctx, src, p)
+		src = client.ProjectSource.GetX(ctx, src.ID)
+		if src.Status != "synced" || src.LastCommit != want {
+			t.Fatalf("source %s at %q (error %q), want synced at %q", src.Status, src.LastCommit, src.LastError, want)
+		}
+		if src.LockedUntil != nil {
+			t.Errorf("source still claimed after its sync")
+		}
+		docs := client.Document.Query().Where(document.HasProjectSource()).AllX(ctx)
+		byPath := make(map[string]*ent.Document, len(docs))
+		for _, d := range docs {
+			byPath[d.Path] = d
+		}
+		return byPath
+	}
+
+	docs := sync(first)
+	if len(docs) != 5 {
+		t.Fatalf("got %d documents after the first sync, want 5", len(docs))
+	}
+	for path, d := range docs {
+		if d.CommitSha != first {
+			t.Errorf("%s: commit %q, want %q", path, d.CommitSha, first)
+		}
+		if n := processed.get(d.ID); n != 1 {
+			t.Errorf("%s: processed %d times, want 1", path, n)
+		}
+	}
+	before := docs
+
+	second := repo.commit(map[string]*string{
+		"changed.md": text("# After\n"),
+		"removed.md": nil,
+		"emptied.md": text(""),
+		"grown.md":   text(strings.Repeat("# Too big\n", 10)),
+		"added.md":   text("# Added\n"),
+	})
+	docs = sync(second)
+
+	for _, path := range []string{"removed.md", "emptied.md", "grown.md"} {
+		if _, ok := docs[path]; ok {
+			t.Errorf("%s: still a document, want it deleted", path)
+		}
+	}
+	for _, path := range []string{"kept.md", "changed.md", "added.md"} {
+		d, ok := docs[path]
+		if !ok {
+			t.Fatalf("%s: missing after the second sync", path)
+		}
+		if d.CommitSha != second {
+			t.Errorf("%s: commit %q, want %q", path, d.CommitSha, second)
+		}
+	}
+	if d := docs["changed.md"]; d.Content != "# After\n" {
+		t.Errorf("changed.md: content %q, want the new blob", d.Content)
+	}
+
+	// Only changed blobs are processed again.
+	if n := processed.get(before["kept.md"].ID); n != 1 {
+		t.Errorf("kept.md: processed %d times, want 1", n)
+	}
+	if n := processed.get(before["changed.md"].ID); n != 2 {
+		t.Errorf("changed.md: processed %d times, want 2", n)
+	}
+	if n := processed.get(docs["added.md"].ID); n != 1 {
+		t.Errorf("added.md: processed %d times, want 1", n)
+	}
+}
+
+func TestSyncGitSourceCreditsReplacedDocuments(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	runStandInWorker(t, client)
+
+	embedService := &embed.Service{Client: client}
+	s := &Service{
+		Client:       client,
+		EmbedService: embedService,
+		Queue:        &jobs.Queue{Client: client, EmbedService: embedService},
+		Limits:       UploadLimits{MaxProjectSize: 30},
+		GitCacheDir:  t.TempDir(),
+	}
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("repo").SetOwner(owner).SaveX(ctx)
+
+	text := func(s string) *string { return &s }
+	repo := newTestRepo(t)
+	repo.commit(map[string]*string{"a.md": text("# A first\n"), "b.md": text("# B first\n"), "c.md": text("# C first\n")})
+	src := client.ProjectSource.Create().SetURL(repo.bare).SetRef("main").SetStatus("syncing").SetProject(p).SaveX(ctx)
+	contents := func() map[string]string {
+		t.Helper()
+		s.syncSource(ctx, src, p)
+		if src = client.ProjectSource.GetX(ctx, src.ID); src.Status != "synced" {
+			t.Fatalf("source %s (error %q), want synced", src.Status, src.LastError)
+		}
+		byPath := map[string]string{}
+		for _, d := range client.Document.Query().AllX(ctx) {
+			byPath[d.Path] = d.Content
+		}
+		return byPath
+	}
+	if got := contents(); len(got) != 3 {
+		t.Fatalf("got %d documents after the first sync, want 3", len(got))
+	}
+
+	// The project is full, but each changed file only replaces its own bytes.
+	// One that grows past what is left is dropped.
+	repo.commit(map[string]*string{"a.md": text("# A again\n"), "b.md": text("# B again\n"), "c.md": text("# C grows\n!")})
+	got := contents()
+	want := map[string]string{"a.md": "# A again\n", "b.md": "# B again\n"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("documents %q, want %q", got, want)
+	}
+}
+
+func TestRecoverInterrupted(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := &Service{Client: client}
+
+	p := client.Project.Create().SetName("p").SaveX(ctx)
+	stale := client.ProjectSource.Create().SetURL("x").SetStatus("syncing").
+		SetLockedUntil(time.Now().Add(-time.Second)).SetProject(p).SaveX(ctx)
+	running := client.ProjectSource.Create().SetURL("x").SetStatus("syncing").
+		SetLockedUntil(time.Now().Add(lease)).SetProject(p).SaveX(ctx)
+
+	staleImport := client.ImportJob.Create().SetArchiveName("a.zip").SetStatus("running").
+		SetLockedUntil(time.Now().Add(-time.Second)).SetProject(p).SaveX(ctx)
+	runningImport := client.ImportJob.Create().SetArchiveName("b.zip").SetStatus("running").
+		SetLockedUntil(time.Now().Add(lease)).SetProject(p).SaveX(ctx)
+	doneImport := client.ImportJob.Create().SetArchiveName("c.zip").SetStatus("completed").
+		SetProject(p).SaveX(ctx)
+
+	if err := s.RecoverInterrupted(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := client.ProjectSource.GetX(ctx, stale.ID); got.Status != "failed" {
+		t.Errorf("stale sync is %s, want failed", got.Status)
+	}
+	if got := client.ProjectSource.GetX(ctx, running.ID); got.Status != "syncing" {
+		t.Errorf("running sync is %s, want syncing", got.Status)
+	}
+	if got := client.ImportJob.GetX(ctx, staleImport.ID); got.Status != "failed" || got.FinishedAt == nil {
+		t.Errorf("stale import is %s, want failed and finished", got.Status)
+	}
+	if got := client.ImportJob.GetX(ctx, runningImport.ID); got.Status != "running" {
+		t.Errorf("running import is %s, want running", got.Status)
+	}
+	if got := client.ImportJob.GetX(ctx, doneImport.ID); got.Status != "completed" {
+		t.Errorf("completed import is %s, want completed", got.Status)
+	}
+}
@@ -0,0 +1,388 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"go-rag/services/embed"
+	"go-rag/services/events"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// maxPathLength bounds a document path, in bytes.
+const maxPathLength = 1024
+
+// normalizePath cleans a document path into its stored form: slash-separated
+// and relative to the project root. Paths that are empty or would leave the
+// root are rejected.
+func normalizePath(p string) (string, error) {
+	slashed := strings.ReplaceAll(p, `\`, "/")
+	cleaned := strings.TrimPrefix(path.Clean("/"+slashed), "/")
+	if cleaned == "" || len(cleaned) > maxPathLength || strings.ContainsRune(cleaned, 0) {
+		return "", fmt.Errorf("invalid document path %q", p)
+	}
+	for _, seg := range strings.Split(slashed, "/") {
+		if seg == ".." {
+			return "", fmt.Errorf("invalid document path %q", p)
+		}
+	}
+	return cleaned, nil
+}
+
+// normalizeFolder is normalizePath for folders, where "" and "/" name the
+// project root.
+func normalizeFolder(p string) (string, error) {
+	if strings.Trim(p, "/") == "" {
+		return "", nil
+	}
+	return normalizePath(p)
+}
+
+// likeEscaper escapes the LIKE wildcards in a literal, for patterns that
+// declare backslash as their escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// inSubfolder matches the documents nested in a subfolder of the folder with
+// the given prefix, rather than directly inside it.
+func inSubfolder(prefix string) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		pattern := likeEscaper.Replace(prefix) + "%/%"
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(document.FieldPath)).WriteOp(sql.OpLike).Arg(pattern)
+			b.WriteString(` ESCAPE '\'`)
+		}))
+	})
+}
+
+// folderName groups a query by the subfolder of the folder with the given
+// prefix that each document is in, and selects its name.
+func folderName(prefix string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		start := utf8.RuneCountInString(prefix) + 1
+		col := s.C(document.FieldPath)
+		var name string
+		if s.Dialect() == dialect.Postgres {
+			name = fmt.Sprintf("SPLIT_PART(SUBSTR(%s, %d), '/', 1)", col, start)
+		} else {
+			name = fmt.Sprintf("SUBSTR(%[1]s, %[2]d, INSTR(SUBSTR(%[1]s, %[2]d), '/') - 1)", col, start)
+		}
+		s.GroupBy(name)
+		return sql.As(name, "name")
+	}
+}
+
+// Folder is a subfolder in a directory listing.
+type Folder struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Documents counts the documents anywhere under the folder.
+	Documents int `json:"documents"`
+}
+
+// Directory lists the immediate children of a folder.
+type Directory struct {
+	Path      string          `json:"path"`
+	Folders   []Folder        `json:"folders"`
+	Documents []*ent.Document `json:"documents"`
+}
+
+// MovePathRequest moves a document, or a folder with everything under it, to
+// a new path in the same project.
+type MovePathRequest struct {
+	ProjectID int
+	From      string
+	To        string
+	OwnerID   uuid.UUID
+}
+
+// ListDirectory returns the folders and documents directly inside a folder of
+// a project. An empty dir lists the project root.
+func (s *Service) ListDirectory(ctx context.Context, projectID int, dir string, ownerID uuid.UUID) (*Directory, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+		"path":       dir,
+	})
+	log.Info("service: listing directory")
+
+	dir, err := normalizeFolder(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyProjectOwner(ctx, projectID, ownerID); err != nil {
+		return nil, err
+	}
+
+	inProject := document.HasProjectWith(project.ID(projectID))
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+	// The listing needs neither the text nor the uploaded file.
+	docs, err := s.Client.Document.
+		Query().
+		Where(inProject, document.PathHasPrefix(prefix), document.Not(inSubfolder(prefix))).
+		Select(
+			document.FieldName,
+			document.FieldPath,
+			document.FieldMimeType,
+			document.FieldContentHash,
+			document.FieldStatus,
+			document.FieldLastError,
+			document.FieldAttempts,
+			document.FieldQueuedAt,
+			document.FieldChunkingAt,
+			document.FieldEmbeddingAt,
+			document.FieldIndexingAt,
+			document.FieldCompletedAt,
+			document.FieldFailedAt,
+			document.FieldCancelledAt,
+			document.FieldCommitSha,
+			document.FieldBlobSha,
+			document.FieldCreatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list directory")
+		return nil, err
+	}
+	// Subfolders are counted in the database rather than by loading every
+	// document under them.
+	var folders []struct {
+		Name      string `json:"name"`
+		Documents int    `json:"documents"`
+	}
+	err = s.Client.Document.
+		Query().
+		Where(inProject, document.PathHasPrefix(prefix), inSubfolder(prefix)).
+		Aggregate(folderName(prefix), ent.As(ent.Count(), "documents")).
+		Scan(ctx, &folders)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list subfolders")
+		return nil, err
+	}
+	if dir != "" && len(docs) == 0 && len(folders) == 0 {
+		return nil, fmt.Errorf("folder not found")
+	}
+
+	listing := &Directory{Path: dir, Folders: []Folder{}, Documents: docs}
+	for _, f := range folders {
+		listing.Folders = append(listing.Folders, Folder{Name: f.Name, Path: prefix + f.Name, Documents: f.Documents})
+	}
+	sort.Slice(listing.Folders, func(i, j int) bool { return listing.Folders[i].Name < listing.Folders[j].Name })
+	sort.Slice(listing.Documents, func(i, j int) bool { return listing.Documents[i].Path < listing.Documents[j].Path })
+
+	log.WithFields(logrus.Fields{
+		"folders":   len(listing.Folders),
+		"documents": len(listing.Documents),
+	}).Info("service: directory listed successfully")
+	return listing, nil
+}
+
+// MovePath renames a document or a folder. Moving a folder moves every
+// document under it, and fails without changing anything if any of their
+// new paths is taken. Documents whose new extension may chunk them
+// differently are processed again. It returns the number of documents moved.
+func (s *Service) MovePath(ctx context.Context, req MovePathRequest) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+		"from":       req.From,
+		"to":         req.To,
+	})
+	log.Info("service: moving path")
+
+	from, err := normalizePath(req.From)
+	if err != nil {
+		return 0, err
+	}
+	to, err := normalizePath(req.To)
+	if err != nil {
+		return 0, err
+	}
+	if to == from || strings.HasPrefix(to, from+"/") {
+		return 0, fmt.Errorf("invalid destination: cannot move %q into itself", from)
+	}
+	if err := s.verifyProjectOwner(ctx, req.ProjectID, req.OwnerID); err != nil {
+		return 0, err
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	inProject := document.HasProjectWith(project.ID(req.ProjectID))
+	docs, err := tx.Document.
+		Query().
+		Where(inProject, document.Or(document.Path(from), document.PathHasPrefix(from+"/"))).
+		Select(document.FieldName, document.FieldPath).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to query documents to move")
+		return 0, err
+	}
+	if len(docs) == 0 {
+		return 0, fmt.Errorf("path not found")
+	}
+
+	// The destination must be free, both as a document and as a folder.
+	targets := make([]string, len(docs))
+	for i, d := range docs {
+		targets[i] = to + strings.TrimPrefix(d.Path, from)
+	}
+	taken, err := tx.Document.
+		Query().
+		Where(
+			inProject,
+			document.Or(document.PathIn(append(targets, to)...), document.PathHasPrefix(to+"/")),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to check destination path")
+		return 0, err
+	}
+	if taken {
+		log.Warn("service: destination path already taken")
+		return 0, fmt.Errorf("a document already exists at path %q", to)
+	}
+
+	var requeue []int
+	for i, d := range docs {
+		update := tx.Document.UpdateOneID(d.ID).SetPath(targets[i])
+		// Keep names that follow the file name in step with it. A new
+		// extension may select another extractor or chunker, so the
+		// document is processed again.
+		if name := path.Base(targets[i]); d.Name == path.Base(d.Path) && d.Name != name {
+			update.SetName(name)
+			if nameChangesChunks(d.Name, name) {
+				embed.Transition(update.Mutation(), document.StatusQueued)
+				update.SetAttempts(0).ClearLastError()
+				requeue = append(requeue, d.ID)
+			}
+		}
+		if err := update.Exec(ctx); err != nil {
+			// A document created at the destination since the check above.
+			if ent.IsConstraintError(err) {
+				return 0, fmt.Errorf("a document already exists at path %q", targets[i])
+			}
+			log.WithError(err).Error("service: failed to move document")
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Error("service: failed to commit move")
+		return 0, err
+	}
+	for i, d := range docs {
+		d.Path = targets[i]
+		if err := s.EmbedService.SetDocumentPath(ctx, d.ID, d.Path); err != nil {
+			log.WithError(err).WithField("document_id", d.ID).Error("service: failed to update path of document vectors")
+		}
+		s.publish(ctx, events.DocumentUpdated, req.ProjectID, d)
+	}
+	for _, id := range requeue {
+		if err := s.Queue.EnqueueDocument(ctx, id); err != nil {
+			log.WithError(err).WithField("document_id", id).Error("service: failed to queue moved document for processing")
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"documents": len(docs),
+		"requeued":  len(requeue),
+	}).Info("service: path moved successfully")
+	return len(docs), nil
+}
+
+// DeletePath deletes a document or a folder with everything under it, along
+// with their vectors. It returns the number of documents deleted.
+func (s *Service) DeletePath(ctx context.Context, projectID int, p string, ownerID uuid.UUID) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+		"path":       p,
+	})
+	log.Info("service: deleting path")
+
+	p, err := normalizePath(p)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.verifyProjectOwner(ctx, projectID, ownerID); err != nil {
+		return 0, err
+	}
+
+	docs, err := s.Client.Document.
+		Query().
+		Where(
+			document.HasProjectWith(project.ID(projectID)),
+			document.Or(document.Path(p), document.PathHasPrefix(p+"/")),
+		).
+		Select(document.FieldPath).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to query documents to delete")
+		return 0, err
+	}
+	if len(docs) == 0 {
+		return 0, fmt.Errorf("path not found")
+	}
+
+	// Delete associated vectors from Qdrant, then the documents from Postgres.
+	// The database's ON DELETE CASCADE deletes their chunks.
+	ids := make([]int, len(docs))
+	for i, d := range docs {
+		ids[i] = d.ID
+		if err := s.EmbedService.DeleteDocumentVectors(ctx, d.ID); err != nil {
+			log.WithError(err).WithField("document_id", d.ID).Error("service: failed to delete document vectors from Qdrant")
+		}
+	}
+	if _, err := s.Client.Document.Delete().Where(document.IDIn(ids...)).Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to delete documents from database")
+		return 0, err
+	}
+	for _, d := range docs {
+		s.publish(ctx, events.DocumentDeleted, projectID, d)
+	}
+
+	log.WithField("documents", len(ids)).Info("service: path deleted successfully")
+	return len(ids), nil
+}
+
+// nameChangesChunks reports whether renaming a document may change how it is
+// extracted or chunked, which both go by its file extension.
+func nameChangesChunks(from, to string) bool {
+	return !strings.EqualFold(path.Ext(from), path.Ext(to))
+}
+
+// verifyProjectOwner checks that the project exists and belongs to the user.
+func (s *Service) verifyProjectOwner(ctx context.Context, projectID int, ownerID uuid.UUID) error {
+	exists, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(projectID),
+			project.HasOwnerWith(user.ID(ownerID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("project_id", projectID).Error("service: failed to verify project ownership")
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("project not found or access denied")
+	}
+	return nil
+}
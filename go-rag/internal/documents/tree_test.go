@@ -0,0 +1,330 @@
+package documents
+
+import (
+	"context"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
+	"go-rag/internal/jobs"
+	"go-rag/services/embed"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
+)
+
+// fakePoints records the vector deletions and payload updates sent to Qdrant.
+type fakePoints struct {
+	qdrant.PointsClient
+
+	mu      sync.Mutex
+	deleted []uint64
+	paths   map[int64]string
+}
+
+func (f *fakePoints) Delete(_ context.Context, in *qdrant.DeletePoints, _ ...grpc.CallOption) (*qdrant.PointsOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range in.GetPoints().GetPoints().GetIds() {
+		f.deleted = append(f.deleted, id.GetNum())
+	}
+	return &qdrant.PointsOperationResponse{}, nil
+}
+
+func (f *fakePoints) SetPayload(_ context.Context, in *qdrant.SetPayloadPoints, _ ...grpc.CallOption) (*qdrant.PointsOperationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range in.GetPointsSelector().GetFilter().GetMust() {
+		if f.paths == nil {
+			f.paths = map[int64]string{}
+		}
+		f.paths[c.GetField().GetMatch().GetInteger()] = in.GetPayload()["path"].GetStringValue()
+	}
+	return &qdrant.PointsOperationResponse{}, nil
+}
+
+// newTreeService returns a service over a fresh database and a project owned
+// by a new user, with a document at each of the paths.
+func newTreeService(t *testing.T, paths ...string) (*Service, *fakePoints, *ent.Project, uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+	client := newTestClient(t)
+	points := &fakePoints{}
+	embedService := &embed.Service{Client: client, QdrantPointsClient: points}
+	s := &Service{
+		Client:       client,
+		EmbedService: embedService,
+		Queue:        &jobs.Queue{Client: client, EmbedService: embedService},
+	}
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+	for _, docPath := range paths {
+		client.Document.Create().
+			SetName(docPath[strings.LastIndex(docPath, "/")+1:]).
+			SetPath(docPath).
+			SetContent("content of " + docPath).
+			SetStatus(document.StatusCompleted).
+			SetProject(p).
+			SaveX(ctx)
+	}
+	return s, points, p, owner.ID
+}
+
+// projectPaths returns the paths of a project's documents, sorted.
+func projectPaths(t *testing.T, p *ent.Project) []string {
+	t.Helper()
+	paths := p.QueryDocuments().Select(document.FieldPath).StringsX(context.Background())
+	sort.Strings(paths)
+	return paths
+}
+
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		in, want string
+		wantErr  bool
+	}{
+		{in: "a.md", want: "a.md"},
+		{in: "/docs/a.md", want: "docs/a.md"},
+		{in: `docs\guide\a.md`, want: "docs/guide/a.md"},
+		{in: "docs//./a.md", want: "docs/a.md"},
+		{in: "docs/", want: "docs"},
+		{in: "", wantErr: true},
+		{in: "/", wantErr: true},
+		{in: "../a.md", wantErr: true},
+		{in: "docs/../../a.md", wantErr: true},
+		{in: "docs/../a.md", wantErr: true},
+		{in: "a\x00.md", wantErr: true},
+		{in: strings.Repeat("a", maxPathLength+1), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := normalizePath(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("normalizePath(%q) = %q, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("normalizePath(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+
+	for _, root := range []string{"", "/", "//"} {
+		if got, err := normalizeFolder(root); got != "" || err != nil {
+			t.Errorf("normalizeFolder(%q) = %q, %v, want the root", root, got, err)
+		}
+	}
+}
+
+func TestListDirectory(t *testing.T) {
+	s, _, p, owner := newTreeService(t,
+		"README.md",
+		"docs/intro.md",
+		"docs/guide/setup.md",
+		"docs/guide/deep/more.md",
+		"docs/api/ref.md",
+		"docs_old/a.md",
+		"src/main.go",
+		"100%_done/x.md",
+	)
+	ctx := context.Background()
+
+	tests := []struct {
+		dir       string
+		folders   []Folder
+		documents []string
+	}{
+		{
+			dir: "",
+			folders: []Folder{
+				{Name: "100%_done", Path: "100%_done", Documents: 1},
+				{Name: "docs", Path: "docs", Documents: 4},
+				{Name: "docs_old", Path: "docs_old", Documents: 1},
+				{Name: "src", Path: "src", Documents: 1},
+			},
+			documents: []string{"README.md"},
+		},
+		{
+			dir: "/docs/",
+			folders: []Folder{
+				{Name: "api", Path: "docs/api", Documents: 1},
+				{Name: "guide", Path: "docs/guide", Documents: 2},
+			},
+			documents: []string{"docs/intro.md"},
+		},
+		{
+			dir:       "docs/guide",
+			folders:   []Folder{{Name: "deep", Path: "docs/guide/deep", Documents: 1}},
+			documents: []string{"docs/guide/setup.md"},
+		},
+		// Wildcards in the folder name match only themselves.
+		{dir: "100%_done", folders: []Folder{}, documents: []string{"100%_done/x.md"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			listing, err := s.ListDirectory(ctx, p.ID, tt.dir, owner)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(listing.Folders, tt.folders) {
+				t.Errorf("folders %+v, want %+v", listing.Folders, tt.folders)
+			}
+			var documents []string
+			for _, d := range listing.Documents {
+				documents = append(documents, d.Path)
+				if d.Content != "" {
+					t.Errorf("listing loaded the content of %s", d.Path)
+				}
+			}
+			if !reflect.DeepEqual(documents, tt.documents) {
+				t.Errorf("documents %q, want %q", documents, tt.documents)
+			}
+		})
+	}
+
+	for _, dir := range []string{"missing", "docs/intro.md", "doc"} {
+		if _, err := s.ListDirectory(ctx, p.ID, dir, owner); err == nil || err.Error() != "folder not found" {
+			t.Errorf("listing %q: error %v, want folder not found", dir, err)
+		}
+	}
+	if _, err := s.ListDirectory(ctx, p.ID, "", uuid.New()); err == nil {
+		t.Error("listed a directory of another user's project")
+	}
+}
+
+func TestMovePath(t *testing.T) {
+	ctx := context.Background()
+	initial := []string{"docs/a.md", "docs/guide/b.md", "docs/guide/c.txt", "notes.md", "other/d.md"}
+
+	tests := []struct {
+		name     string
+		from, to string
+		wantErr  string
+		moved    int
+		want     []string
+	}{
+		{
+			name: "document", from: "notes.md", to: "archive/notes.md", moved: 1,
+			want: []string{"archive/notes.md", "docs/a.md", "docs/guide/b.md", "docs/guide/c.txt", "other/d.md"},
+		},
+		{
+			name: "subtree", from: "docs/guide", to: "manual", moved: 2,
+			want: []string{"docs/a.md", "manual/b.md", "manual/c.txt", "notes.md", "other/d.md"},
+		},
+		{
+			name: "whole folder", from: "/docs/", to: "site/docs", moved: 3,
+			want: []string{"notes.md", "other/d.md", "site/docs/a.md", "site/docs/guide/b.md", "site/docs/guide/c.txt"},
+		},
+		{name: "into itself", from: "docs", to: "docs/guide/docs", wantErr: "into itself"},
+		{name: "onto itself", from: "docs", to: "docs/", wantErr: "into itself"},
+		{name: "onto a document", from: "notes.md", to: "docs/a.md", wantErr: "already exists"},
+		{name: "onto a folder", from: "notes.md", to: "other", wantErr: "already exists"},
+		{name: "onto a moved path", from: "docs/guide", to: "other", wantErr: "already exists"},
+		{name: "missing", from: "nothing", to: "else", wantErr: "path not found"},
+		{name: "a prefix of a name", from: "doc", to: "x", wantErr: "path not found"},
+		{name: "out of the root", from: "notes.md", to: "../notes.md", wantErr: "invalid document path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, points, p, owner := newTreeService(t, initial...)
+			moved, err := s.MovePath(ctx, MovePathRequest{ProjectID: p.ID, From: tt.from, To: tt.to, OwnerID: owner})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error %v, want %q", err, tt.wantErr)
+				}
+				// A refused move changes nothing.
+				if got := projectPaths(t, p); !reflect.DeepEqual(got, initial) {
+					t.Errorf("paths %q after a refused move", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if moved != tt.moved {
+				t.Errorf("moved %d documents, want %d", moved, tt.moved)
+			}
+			if got := projectPaths(t, p); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("paths %q, want %q", got, tt.want)
+			}
+			// The vectors of every moved document carry its new path.
+			docs := s.Client.Document.Query().AllX(ctx)
+			for _, d := range docs {
+				if got, ok := points.paths[int64(d.ID)]; ok && got != d.Path {
+					t.Errorf("vectors of %s have path %q", d.Path, got)
+				}
+				if d.Name != d.Path[strings.LastIndex(d.Path, "/")+1:] {
+					t.Errorf("document at %s is named %q", d.Path, d.Name)
+				}
+			}
+			if len(points.paths) != tt.moved {
+				t.Errorf("updated the vectors of %d documents, want %d", len(points.paths), tt.moved)
+			}
+		})
+	}
+}
+
+func TestMovePathRequeuesRenamedExtensions(t *testing.T) {
+	ctx := context.Background()
+	s, _, p, owner := newTreeService(t, "src/a.txt", "src/b.md")
+
+	if _, err := s.MovePath(ctx, MovePathRequest{ProjectID: p.ID, From: "src/a.txt", To: "src/a.go", OwnerID: owner}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.MovePath(ctx, MovePathRequest{ProjectID: p.ID, From: "src/b.md", To: "docs/b.MD", OwnerID: owner}); err != nil {
+		t.Fatal(err)
+	}
+
+	renamed := s.Client.Document.Query().Where(document.Path("src/a.go")).OnlyX(ctx)
+	if renamed.Status != document.StatusQueued {
+		t.Errorf("document with a new extension is %s, want queued", renamed.Status)
+	}
+	moved := s.Client.Document.Query().Where(document.Path("docs/b.MD")).OnlyX(ctx)
+	if moved.Status != document.StatusCompleted {
+		t.Errorf("document with the same extension is %s, want completed", moved.Status)
+	}
+	queued := s.Client.IngestJob.Query().Where(ingestjob.Status(jobs.StatusQueued)).QueryDocument().IDsX(ctx)
+	if !reflect.DeepEqual(queued, []int{renamed.ID}) {
+		t.Errorf("queued documents %v, want [%d]", queued, renamed.ID)
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	ctx := context.Background()
+	s, points, p, owner := newTreeService(t, "docs/a.md", "docs/guide/b.md", "docs_old/c.md", "notes.md")
+	var chunkIDs []uint64
+	for _, d := range s.Client.Document.Query().Where(document.PathHasPrefix("docs/")).AllX(ctx) {
+		c := s.Client.Chunk.Create().SetIndex(0).SetContent(d.Content).SetDocument(d).SaveX(ctx)
+		chunkIDs = append(chunkIDs, uint64(c.ID))
+	}
+
+	if _, err := s.DeletePath(ctx, p.ID, "doc", owner); err == nil || err.Error() != "path not found" {
+		t.Errorf("deleting a prefix of a name: error %v, want path not found", err)
+	}
+	if _, err := s.DeletePath(ctx, p.ID, "docs", uuid.New()); err == nil {
+		t.Error("deleted a path of another user's project")
+	}
+
+	deleted, err := s.DeletePath(ctx, p.ID, "docs/", owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Errorf("deleted %d documents, want 2", deleted)
+	}
+	if got, want := projectPaths(t, p), []string{"docs_old/c.md", "notes.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paths %q, want %q", got, want)
+	}
+	sort.Slice(points.deleted, func(i, j int) bool { return points.deleted[i] < points.deleted[j] })
+	if !reflect.DeepEqual(points.deleted, chunkIDs) {
+		t.Errorf("deleted vectors %v, want %v", points.deleted, chunkIDs)
+	}
+	if n := s.Client.Chunk.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d chunks left after deleting their documents", n)
+	}
+}
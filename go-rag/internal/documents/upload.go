@@ -0,0 +1,282 @@
+package documents
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Default upload limits, used when the environment does not set them.
+const (
+	defaultMaxFileSize    = 32 << 20 // 32 MiB
+	defaultMaxProjectSize = 1 << 30  // 1 GiB
+)
+
+// maxUploadSize caps an uploaded file whatever MaxFileSize allows. A document
+// is stored in the database as a whole, so an upload is read into memory
+// before it is stored.
+const maxUploadSize = 256 << 20 // 256 MiB
+
+// UploadLimits bounds the size of uploaded files, in bytes. MaxProjectSize
+// applies to the content and source files of all of a project's documents
+// together. A zero limit is not enforced, though an uploaded file is still
+// capped at maxUploadSize.
+type UploadLimits struct {
+	MaxFileSize    int64
+	MaxProjectSize int64
+}
+
+// LoadUploadLimits reads the upload limits from UPLOAD_MAX_FILE_SIZE and
+// UPLOAD_MAX_PROJECT_SIZE, in bytes, falling back to the defaults.
+func LoadUploadLimits() UploadLimits {
+	limits := UploadLimits{MaxFileSize: defaultMaxFileSize, MaxProjectSize: defaultMaxProjectSize}
+	for env, limit := range map[string]*int64{
+		"UPLOAD_MAX_FILE_SIZE":    &limits.MaxFileSize,
+		"UPLOAD_MAX_PROJECT_SIZE": &limits.MaxProjectSize,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			logrus.WithField("variable", env).Warn("ignoring invalid upload limit, using the default")
+			continue
+		}
+		*limit = n
+	}
+	logrus.WithFields(logrus.Fields{
+		"max_file_size":    limits.MaxFileSize,
+		"max_project_size": limits.MaxProjectSize,
+	}).Info("upload limits loaded")
+	return limits
+}
+
+// UploadDocumentRequest defines a file uploaded to a project. Body is read
+// once, as it arrives.
+type UploadDocumentRequest struct {
+	Name      string
+	Body      io.Reader
+	ProjectID int
+	OwnerID   uuid.UUID
+}
+
+// projectUsageQuery totals the bytes stored for a project's documents.
+const projectUsageQuery = `
+SELECT COALESCE(SUM(octet_length("content") + COALESCE(octet_length("source"), 0)), 0)
+FROM "documents"
+WHERE "project_documents" = $1`
+
+// UploadDocument stores an uploaded file as a new document. The file is
+// spooled to a temporary file, hashing it and sniffing its type on the way,
+// so an oversized file is rejected as soon as it crosses the limit, without
+// being read into memory. A file within the limits, which are never above
+// maxUploadSize, is then read into memory whole to be stored in the document.
+func (s *Service) UploadDocument(ctx context.Context, req UploadDocumentRequest) (*ent.Document, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id":    req.ProjectID,
+		"owner_id":      req.OwnerID,
+		"document_name": req.Name,
+	})
+	log.Info("service: uploading document")
+
+	// Security Check: Ensure the user owns the project.
+	exists, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+	if !exists {
+		log.Warn("service: attempt to upload document to a non-existent or unowned project")
+		return nil, fmt.Errorf("project not found or access denied")
+	}
+
+	limit, err := s.uploadAllowance(ctx, req.ProjectID)
+	if err != nil {
+		log.WithError(err).Error("service: failed to compute project storage usage")
+		return nil, err
+	}
+	if limit <= 0 {
+		log.Warn("service: project storage limit reached")
+		return nil, fmt.Errorf("project size limit of %d bytes exceeded", s.Limits.MaxProjectSize)
+	}
+
+	spool, err := spoolUpload(req.Body, limit)
+	if spool != nil {
+		defer os.Remove(spool.path)
+	}
+	if err != nil {
+		log.WithError(err).Error("service: failed to receive uploaded file")
+		return nil, fmt.Errorf("could not receive file: %w", err)
+	}
+	if spool.size > limit {
+		log.WithField("limit", limit).Warn("service: uploaded file exceeds the size limit")
+		if limit == s.maxFileSize() {
+			return nil, fmt.Errorf("file size limit of %d bytes exceeded", limit)
+		}
+		return nil, fmt.Errorf("project size limit of %d bytes exceeded", s.Limits.MaxProjectSize)
+	}
+	if spool.size == 0 {
+		return nil, fmt.Errorf("uploaded file is empty")
+	}
+
+	data, err := os.ReadFile(spool.path)
+	if err != nil {
+		log.WithError(err).Error("service: failed to read spooled upload")
+		return nil, err
+	}
+	createReq := CreateDocumentRequest{
+		Path:        req.Name,
+		MIMEType:    detectMIMEType(req.Name, spool.head),
+		ContentHash: spool.hash,
+		ProjectID:   req.ProjectID,
+		OwnerID:     req.OwnerID,
+	}
+	createReq.Content, createReq.Source, err = documentBody(req.Name, data)
+	if err != nil {
+		log.WithField("mime_type", createReq.MIMEType).Warn("service: uploaded file is neither text nor a supported binary format")
+		return nil, err
+	}
+	return s.CreateDocument(ctx, createReq)
+}
+
+// uploadAllowance returns how many bytes may be uploaded to a project in one
+// file: the per-file limit, or less if the project is close to its own limit.
+func (s *Service) uploadAllowance(ctx context.Context, projectID int) (int64, error) {
+	limit := s.maxFileSize()
+	if s.Limits.MaxProjectSize <= 0 {
+		return limit, nil
+	}
+
+	used, err := s.projectUsage(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return min(limit, s.Limits.MaxProjectSize-used), nil
+}
+
+// maxFileSize returns the largest file that may be uploaded: MaxFileSize,
+// capped at maxUploadSize.
+func (s *Service) maxFileSize() int64 {
+	if limit := s.Limits.MaxFileSize; limit > 0 && limit < maxUploadSize {
+		return limit
+	}
+	return maxUploadSize
+}
+
+// checkProjectSize returns an error if growing the project's documents by
+// added bytes would take the project past its size limit.
+func (s *Service) checkProjectSize(ctx context.Context, projectID int, added int64) error {
+	limit := s.Limits.MaxProjectSize
+	if limit <= 0 || added <= 0 {
+		return nil
+	}
+	used, err := s.projectUsage(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if used+added > limit {
+		return fmt.Errorf("project size limit of %d bytes exceeded", limit)
+	}
+	return nil
+}
+
+// checkFileSize returns an error if a document body of size bytes is over
+// the per-file limit.
+func (s *Service) checkFileSize(size int64) error {
+	if limit := s.Limits.MaxFileSize; limit > 0 && size > limit {
+		return fmt.Errorf("file size limit of %d bytes exceeded", limit)
+	}
+	return nil
+}
+
+// projectUsage returns the bytes stored for a project's documents.
+func (s *Service) projectUsage(ctx context.Context, projectID int) (int64, error) {
+	rows, err := s.Client.QueryContext(ctx, projectUsageQuery, projectID)
+	if err != nil {
+		return 0, fmt.Errorf("could not query project size: %w", err)
+	}
+	defer rows.Close()
+	var used int64
+	if rows.Next() {
+		if err := rows.Scan(&used); err != nil {
+			return 0, fmt.Errorf("could not scan project size: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("could not read project size: %w", err)
+	}
+	return used, nil
+}
+
+// spooledUpload is an uploaded file written to a temporary file.
+type spooledUpload struct {
+	path string
+	size int64
+	hash string
+	head []byte // The first bytes, for content type detection.
+}
+
+// spoolUpload copies body to a temporary file, reading at most one byte past
+// limit so that an oversized file is detected without reading all of it.
+func spoolUpload(body io.Reader, limit int64) (*spooledUpload, error) {
+	f, err := os.CreateTemp("", "go-rag-upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	spool := &spooledUpload{path: f.Name()}
+
+	h := sha256.New()
+	head := &headWriter{max: 512}
+	n, err := io.Copy(io.MultiWriter(f, h, head), io.LimitReader(body, limit+1))
+	if err != nil {
+		return spool, err
+	}
+	spool.size, spool.hash, spool.head = n, fmt.Sprintf("%x", h.Sum(nil)), head.buf
+	return spool, f.Close()
+}
+
+// headWriter keeps the first max bytes written to it.
+type headWriter struct {
+	buf []byte
+	max int
+}
+
+func (w *headWriter) Write(p []byte) (int, error) {
+	if n := w.max - len(w.buf); n > 0 {
+		w.buf = append(w.buf, p[:min(n, len(p))]...)
+	}
+	return len(p), nil
+}
+
+// detectMIMEType returns the media type of a file by its extension, or by
+// sniffing its first bytes when the extension is not a known one.
+func detectMIMEType(name string, head []byte) string {
+	t := mime.TypeByExtension(path.Ext(name))
+	if t == "" {
+		t = http.DetectContentType(head)
+	}
+	if mediaType, _, err := mime.ParseMediaType(t); err == nil {
+		return mediaType
+	}
+	return t
+}
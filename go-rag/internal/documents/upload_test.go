@@ -0,0 +1,72 @@
+package documents
+
+import (
+	"context"
+	"go-rag/internal/jobs"
+	"go-rag/services/embed"
+	"strings"
+	"testing"
+)
+
+func TestUploadDocumentLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  UploadLimits
+		used    int
+		body    string
+		wantErr string
+	}{
+		{name: "within the limits", limits: UploadLimits{MaxFileSize: 16, MaxProjectSize: 64}, body: "# Fits\n"},
+		{name: "over the file limit", limits: UploadLimits{MaxFileSize: 16}, body: strings.Repeat("x", 17), wantErr: "file size limit of 16 bytes"},
+		{name: "over the project limit", limits: UploadLimits{MaxFileSize: 16, MaxProjectSize: 24}, used: 16, body: strings.Repeat("x", 9), wantErr: "project size limit of 24 bytes"},
+		{name: "project full", limits: UploadLimits{MaxProjectSize: 16}, used: 16, body: "x", wantErr: "project size limit of 16 bytes"},
+		{name: "no limits", body: strings.Repeat("x", 1<<10)},
+		{name: "empty", body: "", wantErr: "empty"},
+		{name: "binary", body: "\xff\xfe\x00", wantErr: "unsupported document format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			embedService := &embed.Service{Client: client}
+			s := &Service{
+				Client:       client,
+				EmbedService: embedService,
+				Queue:        &jobs.Queue{Client: client, EmbedService: embedService},
+				Limits:       tt.limits,
+			}
+			owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+			p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+			if tt.used > 0 {
+				client.Document.Create().SetName("used.md").SetPath("used.md").SetContent(strings.Repeat("u", tt.used)).SetProject(p).SaveX(ctx)
+			}
+
+			doc, err := s.UploadDocument(ctx, UploadDocumentRequest{Name: "up.md", Body: strings.NewReader(tt.body), ProjectID: p.ID, OwnerID: owner.ID})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.Content != tt.body || doc.ContentHash == "" {
+				t.Errorf("stored %q with hash %q", doc.Content, doc.ContentHash)
+			}
+		})
+	}
+}
+
+func TestMaxFileSizeIsCapped(t *testing.T) {
+	for _, limit := range []int64{0, maxUploadSize, maxUploadSize + 1} {
+		s := &Service{Limits: UploadLimits{MaxFileSize: limit}}
+		if got := s.maxFileSize(); got != maxUploadSize {
+			t.Errorf("MaxFileSize %d allows %d bytes, want %d", limit, got, maxUploadSize)
+		}
+	}
+	s := &Service{Limits: UploadLimits{MaxFileSize: 1 << 20}}
+	if got := s.maxFileSize(); got != 1<<20 {
+		t.Errorf("allows %d bytes, want the configured %d", got, 1<<20)
+	}
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/internal/answer"
+	"go-rag/internal/auth"
+	"go-rag/internal/search"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// AnswerHandler handles HTTP requests for generated answers.
+type AnswerHandler struct {
+	AnswerService *answer.Service
+}
+
+type askRequest struct {
+	Question         string `json:"question"`
+	Limit            int    `json:"limit"`
+	Mode             string `json:"mode"`
+	Rerank           bool   `json:"rerank"`
+	MaxContextTokens int    `json:"max_context_tokens"`
+}
+
+// decodeAskRequest reads the parameters of a question. It writes an error
+// response and returns false if they are invalid.
+func decodeAskRequest(w http.ResponseWriter, r *http.Request) (answer.AskRequest, bool) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return answer.AskRequest{}, false
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return answer.AskRequest{}, false
+	}
+
+	var req askRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return answer.AskRequest{}, false
+	}
+	if strings.TrimSpace(req.Question) == "" {
+		respondError(w, http.StatusBadRequest, "Field 'question' is required")
+		return answer.AskRequest{}, false
+	}
+
+	return answer.AskRequest{
+		ProjectID:        projectID,
+		OwnerID:          ownerID,
+		Question:         req.Question,
+		Limit:            req.Limit,
+		Mode:             search.Mode(req.Mode),
+		Rerank:           req.Rerank,
+		MaxContextTokens: req.MaxContextTokens,
+	}, true
+}
+
+// askErrorStatus maps an answer error to an HTTP status and client message.
+func askErrorStatus(err error) (int, string) {
+	if strings.Contains(err.Error(), "project not found or access denied") {
+		return http.StatusNotFound, "Project not found or access denied"
+	}
+	if strings.Contains(err.Error(), "invalid search") {
+		return http.StatusBadRequest, err.Error()
+	}
+	return http.StatusInternalServerError, "Failed to answer question"
+}
+
+// Ask handles POST /projects/{projectID}/ask
+func (h *AnswerHandler) Ask(w http.ResponseWriter, r *http.Request) {
+	serviceReq, ok := decodeAskRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.AnswerService.Ask(r.Context(), serviceReq)
+	if err != nil {
+		code, message := askErrorStatus(err)
+		if code == http.StatusInternalServerError {
+			logrus.WithError(err).Error("handler: failed to answer question")
+		}
+		respondError(w, code, message)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// AskStream handles POST /projects/{projectID}/ask/stream
+// It streams "progress" events during retrieval, a "sources" event with the
+// chunks in the context window, a "token" event per generated token and a
+// final "citations" event carrying the full answer.
+func (h *AnswerHandler) AskStream(w http.ResponseWriter, r *http.Request) {
+	sse, ok := newSSEWriter(w)
+	if !ok {
+		respondError(w, http.StatusInternalServerError, "Streaming unsupported")
+		return
+	}
+
+	serviceReq, ok := decodeAskRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// The request context is cancelled when the client disconnects, which
+	// aborts the in-flight retrieval and generation gRPC calls.
+	ctx := r.Context()
+	events := answer.Events{
+		Progress: func(stage string, count int) {
+			sse.Event("progress", map[string]interface{}{"stage": stage, "count": count})
+		},
+		Sources: func(sources []answer.Citation) error {
+			return sse.Event("sources", sources)
+		},
+		Token: func(token string) error {
+			return sse.Event("token", map[string]string{"token": token})
+		},
+	}
+
+	resp, err := h.AnswerService.AskStream(ctx, serviceReq, events)
+	if err != nil {
+		if ctx.Err() != nil {
+			logrus.WithError(err).Info("handler: answer stream cancelled by client")
+			return
+		}
+		code, message := askErrorStatus(err)
+		if code == http.StatusInternalServerError {
+			logrus.WithError(err).Error("handler: failed to stream answer")
+		}
+		sse.Fail(code, message)
+		return
+	}
+
+	sse.Event("citations", resp)
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/internal/auth"
+	"go-rag/internal/conversations"
+	"go-rag/internal/search"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// ConversationHandler handles HTTP requests for conversations.
+type ConversationHandler struct {
+	ConversationService *conversations.Service
+}
+
+type createConversationRequest struct {
+	Title *string `json:"title"`
+}
+
+type continueConversationRequest struct {
+	Question string `json:"question"`
+	Limit    int    `json:"limit"`
+	Mode     string `json:"mode"`
+	Rerank   bool   `json:"rerank"`
+}
+
+// CreateConversation handles POST /projects/{projectID}/conversations
+func (h *ConversationHandler) CreateConversation(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	// The body is optional; an empty one starts an untitled conversation.
+	var req createConversationRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request payload")
+			return
+		}
+	}
+
+	serviceReq := conversations.CreateConversationRequest{
+		ProjectID: projectID,
+		OwnerID:   ownerID,
+		Title:     req.Title,
+	}
+
+	c, err := h.ConversationService.CreateConversation(r.Context(), serviceReq)
+	if err != nil {
+		if strings.Contains(err.Error(), "project not found or access denied") {
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to create conversation")
+			respondError(w, http.StatusInternalServerError, "Failed to create conversation")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, c)
+}
+
+// ListConversations handles GET /projects/{projectID}/conversations
+func (h *ConversationHandler) ListConversations(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	convs, err := h.ConversationService.ListConversations(r.Context(), projectID, ownerID)
+	if err != nil {
+		logrus.WithError(err).Error("handler: failed to list conversations")
+		respondError(w, http.StatusInternalServerError, "Failed to retrieve conversations")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, convs)
+}
+
+// GetConversation handles GET /projects/{projectID}/conversations/{conversationID}
+func (h *ConversationHandler) GetConversation(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	conversationID, err := strconv.Atoi(chi.URLParam(r, "conversationID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	c, err := h.ConversationService.GetConversation(r.Context(), projectID, conversationID, ownerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "conversation not found or access denied") {
+			respondError(w, http.StatusNotFound, "Conversation not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to get conversation")
+			respondError(w, http.StatusInternalServerError, "Failed to retrieve conversation")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, c)
+}
+
+// ContinueConversation handles POST /projects/{projectID}/conversations/{conversationID}/messages
+func (h *ConversationHandler) ContinueConversation(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	conversationID, err := strconv.Atoi(chi.URLParam(r, "conversationID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	var req continueConversationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if strings.TrimSpace(req.Question) == "" {
+		respondError(w, http.StatusBadRequest, "Field 'question' is required")
+		return
+	}
+
+	serviceReq := conversations.ContinueConversationRequest{
+		ConversationID: conversationID,
+		ProjectID:      projectID,
+		OwnerID:        ownerID,
+		Question:       req.Question,
+		Limit:          req.Limit,
+		Mode:           search.Mode(req.Mode),
+		Rerank:         req.Rerank,
+	}
+
+	resp, err := h.ConversationService.ContinueConversation(r.Context(), serviceReq)
+	if err != nil {
+		if strings.Contains(err.Error(), "conversation not found or access denied") {
+			respondError(w, http.StatusNotFound, "Conversation not found or access denied")
+		} else if strings.Contains(err.Error(), "invalid search") {
+			respondError(w, http.StatusBadRequest, err.Error())
+		} else {
+			logrus.WithError(err).Error("handler: failed to continue conversation")
+			respondError(w, http.StatusInternalServerError, "Failed to continue conversation")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// DeleteConversation handles DELETE /projects/{projectID}/conversations/{conversationID}
+func (h *ConversationHandler) DeleteConversation(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	conversationID, err := strconv.Atoi(chi.URLParam(r, "conversationID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid conversation ID")
+		return
+	}
+
+	err = h.ConversationService.DeleteConversation(r.Context(), projectID, conversationID, ownerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "conversation not found or access denied") {
+			respondError(w, http.StatusNotFound, "Conversation not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to delete conversation")
+			respondError(w, http.StatusInternalServerError, "Failed to delete conversation")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]string{"message": "Conversation deleted successfully"})
+}
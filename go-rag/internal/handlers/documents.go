@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"go-rag/ent/ent"
 	"go-rag/internal/auth"
 	"go-rag/internal/documents"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -20,8 +24,14 @@ type DocumentHandler struct {
 }
 
 type createDocumentRequest struct {
-	Name    string `json:"name"`
+	Name string `json:"name"`
+	// Path places the document in a folder, such as "docs/intro.md". It
+	// defaults to Name.
+	Path    string `json:"path"`
 	Content string `json:"content"`
+	// ContentBase64 carries a binary file, such as a PDF or DOCX, instead of
+	// Content.
+	ContentBase64 string `json:"content_base64"`
 }
 
 func getContentHash(content []byte) string {
@@ -30,8 +40,10 @@ func getContentHash(content []byte) string {
 }
 
 type updateDocumentRequest struct {
-	Name    *string `json:"name"`
-	Content *string `json:"content"`
+	Name          *string `json:"name"`
+	Path          *string `json:"path"`
+	Content       *string `json:"content"`
+	ContentBase64 *string `json:"content_base64"`
 }
 
 // CreateDocument handles POST /projects/{projectID}/documents
@@ -53,28 +65,44 @@ func (h *DocumentHandler) CreateDocument(w http.ResponseWriter, r *http.Request)
 		respondError(w, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
-	if req.Name == "" || req.Content == "" {
-		respondError(w, http.StatusBadRequest, "Fields 'name' and 'content' are required")
+	if (req.Name == "" && req.Path == "") || (req.Content == "") == (req.ContentBase64 == "") {
+		respondError(w, http.StatusBadRequest, "Field 'name' or 'path' and exactly one of 'content' or 'content_base64' are required")
 		return
 	}
 
-	// Calculate the hash from the content provided by the user.
-	contentBytes := []byte(req.Content)
-	hash := getContentHash(contentBytes)
-
 	// Call the service with the content AND the new hash.
 	serviceReq := documents.CreateDocumentRequest{
-		Name:        req.Name,
-		Content:     req.Content,
-		ContentHash: hash,
-		ProjectID:   projectID,
-		OwnerID:     ownerID,
+		Name:      req.Name,
+		Path:      req.Path,
+		Content:   req.Content,
+		ProjectID: projectID,
+		OwnerID:   ownerID,
+	}
+
+	// Calculate the hash from the content provided by the user.
+	contentBytes := []byte(req.Content)
+	if req.ContentBase64 != "" {
+		contentBytes, err = base64.StdEncoding.DecodeString(req.ContentBase64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Field 'content_base64' is not valid base64")
+			return
+		}
+		serviceReq.Source = contentBytes
 	}
+	serviceReq.ContentHash = getContentHash(contentBytes)
 
 	doc, err := h.DocumentService.CreateDocument(r.Context(), serviceReq)
 	if err != nil {
 		if strings.Contains(err.Error(), "project not found or access denied") {
 			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		} else if strings.Contains(err.Error(), "unsupported document format") {
+			respondError(w, http.StatusUnsupportedMediaType, "Unsupported document format")
+		} else if strings.Contains(err.Error(), "invalid document path") {
+			respondError(w, http.StatusBadRequest, err.Error())
+		} else if strings.Contains(err.Error(), "already exists") {
+			respondError(w, http.StatusConflict, err.Error())
+		} else if strings.Contains(err.Error(), "size limit") {
+			respondError(w, http.StatusRequestEntityTooLarge, err.Error())
 		} else {
 			logrus.WithError(err).Error("handler: failed to create document")
 			respondError(w, http.StatusInternalServerError, "Failed to create document")
@@ -85,6 +113,116 @@ func (h *DocumentHandler) CreateDocument(w http.ResponseWriter, r *http.Request)
 	respondJSON(w, http.StatusCreated, doc)
 }
 
+// uploadError reports a file of an upload that could not be stored.
+type uploadError struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// uploadResponse lists the documents created by an upload and the files that
+// failed.
+type uploadResponse struct {
+	Documents []*ent.Document `json:"documents"`
+	Errors    []uploadError   `json:"errors,omitempty"`
+}
+
+// UploadDocuments handles POST /projects/{projectID}/documents/upload
+func (h *DocumentHandler) UploadDocuments(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	// No upload can be larger than the project limit allows, whatever its
+	// files; the margin leaves room for the multipart framing.
+	if limit := h.DocumentService.Limits.MaxProjectSize; limit > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, limit+1<<20)
+	}
+
+	// Read the parts as they arrive rather than parsing the whole form.
+	mr, err := r.MultipartReader()
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Request must be multipart/form-data")
+		return
+	}
+
+	res := uploadResponse{Documents: []*ent.Document{}}
+	status := http.StatusBadRequest // Of the first failure, if every file fails.
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(w, http.StatusRequestEntityTooLarge, "Upload exceeds the project size limit")
+			return
+		}
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Malformed multipart request")
+			return
+		}
+		if part.FileName() == "" {
+			continue // Not a file field.
+		}
+
+		doc, err := h.DocumentService.UploadDocument(r.Context(), documents.UploadDocumentRequest{
+			Name:      part.FileName(),
+			Body:      part,
+			ProjectID: projectID,
+			OwnerID:   ownerID,
+		})
+		part.Close()
+		if err == nil {
+			res.Documents = append(res.Documents, doc)
+			continue
+		}
+
+		msg := err.Error()
+		code := http.StatusInternalServerError
+		switch {
+		case errors.As(err, &tooLarge):
+			respondError(w, http.StatusRequestEntityTooLarge, "Upload exceeds the project size limit")
+			return
+		case strings.Contains(msg, "project not found or access denied"):
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+			return
+		case strings.Contains(msg, "size limit"):
+			code = http.StatusRequestEntityTooLarge
+		case strings.Contains(msg, "unsupported document format"):
+			code = http.StatusUnsupportedMediaType
+		case strings.Contains(msg, "file is empty"), strings.Contains(msg, "invalid document path"):
+			code = http.StatusBadRequest
+		case strings.Contains(msg, "already exists"):
+			code = http.StatusConflict
+		default:
+			logrus.WithError(err).Error("handler: failed to upload document")
+			msg = "Failed to store file"
+		}
+		if len(res.Errors) == 0 {
+			status = code
+		}
+		res.Errors = append(res.Errors, uploadError{Name: part.FileName(), Error: msg})
+	}
+
+	if len(res.Documents) == 0 && len(res.Errors) == 0 {
+		respondError(w, http.StatusBadRequest, "At least one file is required")
+		return
+	}
+	if len(res.Documents) == 0 {
+		respondJSON(w, status, res)
+		return
+	}
+	respondJSON(w, http.StatusCreated, res)
+}
+
 // ListDocuments handles GET /projects/{projectID}/documents
 func (h *DocumentHandler) ListDocuments(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := auth.GetUserID(r.Context())
@@ -158,8 +296,12 @@ func (h *DocumentHandler) UpdateDocument(w http.ResponseWriter, r *http.Request)
 	}
 
 	// At least one field must be provided for an update.
-	if req.Name == nil && req.Content == nil {
-		respondError(w, http.StatusBadRequest, "At least one field ('name' or 'content') must be provided for an update")
+	if req.Name == nil && req.Path == nil && req.Content == nil && req.ContentBase64 == nil {
+		respondError(w, http.StatusBadRequest, "At least one field ('name', 'path', 'content' or 'content_base64') must be provided for an update")
+		return
+	}
+	if req.Content != nil && req.ContentBase64 != nil {
+		respondError(w, http.StatusBadRequest, "Only one of 'content' or 'content_base64' may be provided")
 		return
 	}
 
@@ -167,6 +309,7 @@ func (h *DocumentHandler) UpdateDocument(w http.ResponseWriter, r *http.Request)
 		DocumentID: documentID,
 		OwnerID:    ownerID,
 		Name:       req.Name,
+		Path:       req.Path,
 	}
 
 	// If content is being updated, we must re-calculate the hash.
@@ -176,11 +319,29 @@ func (h *DocumentHandler) UpdateDocument(w http.ResponseWriter, r *http.Request)
 		serviceReq.Content = req.Content
 		serviceReq.ContentHash = &hash
 	}
+	if req.ContentBase64 != nil {
+		source, err := base64.StdEncoding.DecodeString(*req.ContentBase64)
+		if err != nil || len(source) == 0 {
+			respondError(w, http.StatusBadRequest, "Field 'content_base64' is not valid base64")
+			return
+		}
+		hash := getContentHash(source)
+		serviceReq.Source = source
+		serviceReq.ContentHash = &hash
+	}
 
 	doc, err := h.DocumentService.UpdateDocument(r.Context(), serviceReq)
 	if err != nil {
 		if strings.Contains(err.Error(), "document not found or access denied") {
 			respondError(w, http.StatusNotFound, "Document not found or access denied")
+		} else if strings.Contains(err.Error(), "unsupported document format") {
+			respondError(w, http.StatusUnsupportedMediaType, "Unsupported document format")
+		} else if strings.Contains(err.Error(), "invalid document path") {
+			respondError(w, http.StatusBadRequest, err.Error())
+		} else if strings.Contains(err.Error(), "already exists") {
+			respondError(w, http.StatusConflict, err.Error())
+		} else if strings.Contains(err.Error(), "size limit") {
+			respondError(w, http.StatusRequestEntityTooLarge, err.Error())
 		} else {
 			logrus.WithError(err).Error("handler: failed to update document")
 			respondError(w, http.StatusInternalServerError, "Failed to update document")
@@ -218,3 +379,33 @@ func (h *DocumentHandler) DeleteDocument(w http.ResponseWriter, r *http.Request)
 
 	respondJSON(w, http.StatusOK, map[string]string{"message": "Document deleted successfully"})
 }
+
+// CancelDocument handles POST /projects/{projectID}/documents/{documentID}/cancel
+func (h *DocumentHandler) CancelDocument(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	documentID, err := strconv.Atoi(chi.URLParam(r, "documentID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid document ID")
+		return
+	}
+
+	doc, err := h.DocumentService.CancelDocument(r.Context(), documentID, ownerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "document not found or access denied") {
+			respondError(w, http.StatusNotFound, "Document not found or access denied")
+		} else if strings.Contains(err.Error(), "invalid status transition") {
+			respondError(w, http.StatusConflict, "Document is not being processed")
+		} else {
+			logrus.WithError(err).Error("handler: failed to cancel document")
+			respondError(w, http.StatusInternalServerError, "Failed to cancel document")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, doc)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"go-rag/ent/ent"
+	"go-rag/internal/auth"
+	"go-rag/internal/projects"
+	"go-rag/services/events"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// keepAliveInterval is how often an idle event stream is sent a comment.
+const keepAliveInterval = 25 * time.Second
+
+// EventHandler handles HTTP requests for project event streams.
+type EventHandler struct {
+	Bus            *events.Bus
+	ProjectService *projects.Service
+
+	// keepAlive overrides keepAliveInterval when set.
+	keepAlive time.Duration
+}
+
+// StreamEvents handles GET /projects/{projectID}/events
+//
+// The response is a Server-Sent Events stream of the project's document
+// events, such as "document.status" when a document moves to a new
+// processing state. A "resync" event means events may have been missed and
+// the documents should be fetched again.
+func (h *EventHandler) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	sse, ok := newSSEWriter(w)
+	if !ok {
+		respondError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	if _, err := h.ProjectService.GetProjectByID(r.Context(), projectID, ownerID); err != nil {
+		if ent.IsNotFound(err) {
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to get project for event stream")
+			respondError(w, http.StatusInternalServerError, "Failed to open event stream")
+		}
+		return
+	}
+
+	stream, unsubscribe := h.Bus.Subscribe(projectID)
+	defer unsubscribe()
+
+	// Start the stream right away so the client knows it is subscribed.
+	if err := sse.Event("ready", map[string]int{"project_id": projectID}); err != nil {
+		return
+	}
+
+	interval := keepAliveInterval
+	if h.keepAlive > 0 {
+		interval = h.keepAlive
+	}
+	keepAlive := time.NewTicker(interval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev := <-stream:
+			if err := sse.Event(ev.Type, ev); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if err := sse.Comment("keep-alive"); err != nil {
+				return
+			}
+		}
+	}
+}
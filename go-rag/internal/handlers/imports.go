@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"errors"
+	"go-rag/internal/auth"
+	"go-rag/internal/documents"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// maxImportFieldSize bounds the include and exclude form fields of an import.
+const maxImportFieldSize = 64 << 10
+
+// ImportArchive handles POST /projects/{projectID}/imports
+//
+// The request is multipart/form-data with the archive in an "archive" file
+// field. Glob patterns may be given in "include" and "exclude" fields, or
+// query parameters, each repeated or comma-separated; form fields must come
+// before the archive, which is read as it arrives.
+func (h *DocumentHandler) ImportArchive(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	if limit := h.DocumentService.Limits.MaxProjectSize; limit > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, limit+1<<20)
+	}
+	mr, err := r.MultipartReader()
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Request must be multipart/form-data")
+		return
+	}
+
+	req := documents.ImportArchiveRequest{
+		Include:   globList(r.URL.Query()["include"]),
+		Exclude:   globList(r.URL.Query()["exclude"]),
+		ProjectID: projectID,
+		OwnerID:   ownerID,
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			respondError(w, http.StatusBadRequest, "Field 'archive' is required")
+			return
+		}
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Malformed multipart request")
+			return
+		}
+		if part.FormName() == "include" || part.FormName() == "exclude" {
+			value, err := io.ReadAll(io.LimitReader(part, maxImportFieldSize))
+			if err != nil {
+				respondError(w, http.StatusBadRequest, "Malformed multipart request")
+				return
+			}
+			patterns := globList([]string{string(value)})
+			if part.FormName() == "include" {
+				req.Include = append(req.Include, patterns...)
+			} else {
+				req.Exclude = append(req.Exclude, patterns...)
+			}
+			continue
+		}
+		if part.FormName() != "archive" || part.FileName() == "" {
+			continue
+		}
+		req.Name, req.Body = part.FileName(), part
+		break
+	}
+
+	job, err := h.DocumentService.ImportArchive(r.Context(), req)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge), strings.Contains(err.Error(), "size limit"):
+			respondError(w, http.StatusRequestEntityTooLarge, "Archive exceeds the project size limit")
+		case strings.Contains(err.Error(), "project not found or access denied"):
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		case strings.Contains(err.Error(), "unsupported archive format"):
+			respondError(w, http.StatusUnsupportedMediaType, "Archive must be a zip, tar or tar.gz file")
+		case strings.Contains(err.Error(), "invalid glob pattern"):
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			logrus.WithError(err).Error("handler: failed to import archive")
+			respondError(w, http.StatusInternalServerError, "Failed to import archive")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, job)
+}
+
+// GetImportJob handles GET /projects/{projectID}/imports/{importID}
+func (h *DocumentHandler) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+	jobID, err := strconv.Atoi(chi.URLParam(r, "importID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid import ID")
+		return
+	}
+
+	job, err := h.DocumentService.GetImportJob(r.Context(), projectID, jobID, ownerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "import job not found or access denied") {
+			respondError(w, http.StatusNotFound, "Import job not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to get import job")
+			respondError(w, http.StatusInternalServerError, "Failed to retrieve import job")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, job)
+}
+
+// globList splits comma- and newline-separated glob patterns.
+func globList(values []string) []string {
+	var patterns []string
+	for _, v := range values {
+		for _, p := range strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == '\n' }) {
+			if p = strings.TrimSpace(p); p != "" {
+				patterns = append(patterns, p)
+			}
+		}
+	}
+	return patterns
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"go-rag/internal/auth"
+	"go-rag/internal/jobs"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// JobHandler handles HTTP requests for ingest jobs.
+type JobHandler struct {
+	Queue *jobs.Queue
+}
+
+// ListJobs handles GET /projects/{projectID}/jobs
+//
+// An optional status query parameter filters the jobs, e.g. "dead" for the
+// jobs that failed on every attempt.
+func (h *JobHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	list, err := h.Queue.ListJobs(r.Context(), projectID, r.URL.Query().Get("status"), ownerID)
+	if err != nil {
+		logrus.WithError(err).Error("handler: failed to list jobs")
+		respondError(w, http.StatusInternalServerError, "Failed to list jobs")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, list)
+}
+
+// RetryJob handles POST /projects/{projectID}/jobs/{jobID}/retry
+func (h *JobHandler) RetryJob(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+	jobID, err := strconv.Atoi(chi.URLParam(r, "jobID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	job, err := h.Queue.RetryJob(r.Context(), projectID, jobID, ownerID)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "job not found or access denied"):
+			respondError(w, http.StatusNotFound, "Job not found or access denied")
+		case strings.Contains(err.Error(), "only dead jobs"):
+			respondError(w, http.StatusConflict, "Only dead jobs can be retried")
+		default:
+			logrus.WithError(err).Error("handler: failed to retry job")
+			respondError(w, http.StatusInternalServerError, "Failed to retry job")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, job)
+}
@@ -7,6 +7,7 @@ import (
 	"go-rag/internal/projects"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/sirupsen/logrus"
@@ -17,14 +18,35 @@ type ProjectHandler struct {
 	ProjectService *projects.Service
 }
 
+// chunkSettingsRequest is the chunking configuration accepted on project create and update.
+type chunkSettingsRequest struct {
+	ChunkStrategy       *string `json:"chunk_strategy"`
+	ChunkMaxSize        *int    `json:"chunk_max_size"`
+	ChunkOverlap        *int    `json:"chunk_overlap"`
+	ChunkHeadingLevel   *int    `json:"chunk_heading_level"`
+	ChunkIncludeOutputs *bool   `json:"chunk_include_outputs"`
+}
+
+func (c chunkSettingsRequest) settings() projects.ChunkSettings {
+	return projects.ChunkSettings{
+		Strategy:       c.ChunkStrategy,
+		MaxSize:        c.ChunkMaxSize,
+		Overlap:        c.ChunkOverlap,
+		HeadingLevel:   c.ChunkHeadingLevel,
+		IncludeOutputs: c.ChunkIncludeOutputs,
+	}
+}
+
 type createProjectRequest struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
+	chunkSettingsRequest
 }
 
 type updateProjectRequest struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
+	chunkSettingsRequest
 }
 
 // respondJSON is a helper to write JSON responses.
@@ -66,11 +88,16 @@ func (h *ProjectHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 	serviceReq := projects.CreateProjectRequest{
 		Name:        req.Name,
 		Description: req.Description,
+		Chunking:    req.settings(),
 		OwnerID:     ownerID,
 	}
 
 	p, err := h.ProjectService.CreateProject(r.Context(), serviceReq)
 	if err != nil {
+		if strings.Contains(err.Error(), "invalid chunking settings") {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		logrus.WithError(err).Error("handler: failed to create project")
 		respondError(w, http.StatusInternalServerError, "Failed to create project")
 		return
@@ -149,6 +176,7 @@ func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
 		ProjectID:   projectID,
 		Name:        req.Name,
 		Description: req.Description,
+		Chunking:    req.settings(),
 		OwnerID:     ownerID,
 	}
 
@@ -156,6 +184,8 @@ func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		if ent.IsNotFound(err) {
 			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		} else if strings.Contains(err.Error(), "invalid chunking settings") {
+			respondError(w, http.StatusBadRequest, err.Error())
 		} else {
 			logrus.WithError(err).Error("handler: failed to update project")
 			respondError(w, http.StatusInternalServerError, "Failed to update project")
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/internal/auth"
+	"go-rag/internal/search"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// SearchHandler handles HTTP requests for retrieval.
+type SearchHandler struct {
+	SearchService *search.Service
+}
+
+// searchFilters restricts a search to chunks with matching metadata.
+type searchFilters struct {
+	Language string `json:"language"`
+	Heading  string `json:"heading"`
+}
+
+type searchRequest struct {
+	Query         string        `json:"query"`
+	Limit         int           `json:"limit"`
+	Mode          string        `json:"mode"`
+	Filters       searchFilters `json:"filters"`
+	VectorWeight  float64       `json:"vector_weight"`
+	LexicalWeight float64       `json:"lexical_weight"`
+	// Rerank enables the cross-encoder stage over RerankCandidates hits.
+	Rerank           bool `json:"rerank"`
+	RerankCandidates int  `json:"rerank_candidates"`
+}
+
+// decodeSearchRequest reads the search parameters of a request. It writes an
+// error response and returns false if they are invalid.
+func decodeSearchRequest(w http.ResponseWriter, r *http.Request) (search.SearchRequest, bool) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return search.SearchRequest{}, false
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return search.SearchRequest{}, false
+	}
+
+	var req searchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return search.SearchRequest{}, false
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		respondError(w, http.StatusBadRequest, "Field 'query' is required")
+		return search.SearchRequest{}, false
+	}
+
+	serviceReq := search.SearchRequest{
+		ProjectID:        projectID,
+		OwnerID:          ownerID,
+		Query:            req.Query,
+		Limit:            req.Limit,
+		Mode:             search.Mode(req.Mode),
+		Language:         req.Filters.Language,
+		Heading:          req.Filters.Heading,
+		VectorWeight:     req.VectorWeight,
+		LexicalWeight:    req.LexicalWeight,
+		Rerank:           req.Rerank,
+		RerankCandidates: req.RerankCandidates,
+	}
+	// The mode may also be given as a query parameter, e.g. ?mode=lexical
+	if mode := r.URL.Query().Get("mode"); mode != "" {
+		serviceReq.Mode = search.Mode(mode)
+	}
+	return serviceReq, true
+}
+
+// searchErrorStatus maps a search error to an HTTP status and client message.
+func searchErrorStatus(err error) (int, string) {
+	if strings.Contains(err.Error(), "project not found or access denied") {
+		return http.StatusNotFound, "Project not found or access denied"
+	}
+	if strings.Contains(err.Error(), "invalid search") {
+		return http.StatusBadRequest, err.Error()
+	}
+	return http.StatusInternalServerError, "Failed to search project"
+}
+
+// Search handles POST /projects/{projectID}/search
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	serviceReq, ok := decodeSearchRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.SearchService.Search(r.Context(), serviceReq)
+	if err != nil {
+		code, message := searchErrorStatus(err)
+		if code == http.StatusInternalServerError {
+			logrus.WithError(err).Error("handler: failed to search project")
+		}
+		respondError(w, code, message)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// SearchStream handles POST /projects/{projectID}/search/stream
+// It streams a "progress" event per retrieval stage, then a final "results" event.
+func (h *SearchHandler) SearchStream(w http.ResponseWriter, r *http.Request) {
+	sse, ok := newSSEWriter(w)
+	if !ok {
+		respondError(w, http.StatusInternalServerError, "Streaming unsupported")
+		return
+	}
+
+	serviceReq, ok := decodeSearchRequest(w, r)
+	if !ok {
+		return
+	}
+
+	// The request context is cancelled when the client disconnects, which
+	// aborts the in-flight gRPC and database calls.
+	ctx := r.Context()
+	serviceReq.Progress = func(stage string, count int) {
+		sse.Event("progress", map[string]interface{}{"stage": stage, "count": count})
+	}
+
+	resp, err := h.SearchService.Search(ctx, serviceReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			logrus.WithError(err).Info("handler: search stream cancelled by client")
+			return
+		}
+		code, message := searchErrorStatus(err)
+		if code == http.StatusInternalServerError {
+			logrus.WithError(err).Error("handler: failed to stream search")
+		}
+		sse.Fail(code, message)
+		return
+	}
+
+	sse.Event("results", resp)
+}
+
+// ListQueries handles GET /projects/{projectID}/queries
+func (h *SearchHandler) ListQueries(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	queries, err := h.SearchService.ListQueries(r.Context(), projectID, ownerID)
+	if err != nil {
+		logrus.WithError(err).Error("handler: failed to list queries")
+		respondError(w, http.StatusInternalServerError, "Failed to retrieve queries")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, queries)
+}
+
+// GetQuery handles GET /projects/{projectID}/queries/{queryID}
+func (h *SearchHandler) GetQuery(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	queryID, err := strconv.Atoi(chi.URLParam(r, "queryID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid query ID")
+		return
+	}
+
+	query, err := h.SearchService.GetQuery(r.Context(), projectID, queryID, ownerID)
+	if err != nil {
+		if strings.Contains(err.Error(), "query not found or access denied") {
+			respondError(w, http.StatusNotFound, "Query not found or access denied")
+		} else {
+			logrus.WithError(err).Error("handler: failed to get query")
+			respondError(w, http.StatusInternalServerError, "Failed to retrieve query")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, query)
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/internal/auth"
+	"go-rag/internal/documents"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type createSourceRequest struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	Ref  string `json:"ref"`
+}
+
+type syncSourceRequest struct {
+	Ref *string `json:"ref"`
+}
+
+// CreateSource handles POST /projects/{projectID}/sources
+func (h *DocumentHandler) CreateSource(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req createSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if req.URL == "" {
+		respondError(w, http.StatusBadRequest, "Field 'url' is required")
+		return
+	}
+
+	src, err := h.DocumentService.CreateSource(r.Context(), documents.CreateSourceRequest{
+		Type:      req.Type,
+		URL:       req.URL,
+		Ref:       req.Ref,
+		ProjectID: projectID,
+		OwnerID:   ownerID,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "project not found or access denied"):
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		case strings.Contains(err.Error(), "unsupported source type"),
+			strings.Contains(err.Error(), "invalid"):
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			logrus.WithError(err).Error("handler: failed to create source")
+			respondError(w, http.StatusInternalServerError, "Failed to create source")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, src)
+}
+
+// ListSources handles GET /projects/{projectID}/sources
+func (h *DocumentHandler) ListSources(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	sources, err := h.DocumentService.ListSources(r.Context(), projectID, ownerID)
+	if err != nil {
+		logrus.WithError(err).Error("handler: failed to list sources")
+		respondError(w, http.StatusInternalServerError, "Failed to list sources")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sources)
+}
+
+// GetSource handles GET /projects/{projectID}/sources/{sourceID}
+func (h *DocumentHandler) GetSource(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, sourceID, ok := sourceParams(w, r)
+	if !ok {
+		return
+	}
+
+	src, err := h.DocumentService.GetSource(r.Context(), projectID, sourceID, ownerID)
+	if err != nil {
+		respondSourceError(w, err, "Failed to retrieve source")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, src)
+}
+
+// SyncSource handles POST /projects/{projectID}/sources/{sourceID}/sync
+//
+// The optional body {"ref": "..."} switches the source to another branch,
+// tag or commit before syncing.
+func (h *DocumentHandler) SyncSource(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, sourceID, ok := sourceParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req syncSourceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	src, err := h.DocumentService.SyncSource(r.Context(), projectID, sourceID, req.Ref, ownerID)
+	if err != nil {
+		respondSourceError(w, err, "Failed to sync source")
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, src)
+}
+
+// DeleteSource handles DELETE /projects/{projectID}/sources/{sourceID}
+func (h *DocumentHandler) DeleteSource(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, sourceID, ok := sourceParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.DocumentService.DeleteSource(r.Context(), projectID, sourceID, ownerID); err != nil {
+		respondSourceError(w, err, "Failed to delete source")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sourceParams reads the user and the project and source IDs of a source
+// request, responding with an error if any is missing or malformed.
+func sourceParams(w http.ResponseWriter, r *http.Request) (ownerID uuid.UUID, projectID, sourceID int, ok bool) {
+	ownerID, ok = auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return ownerID, 0, 0, false
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return ownerID, 0, 0, false
+	}
+	sourceID, err = strconv.Atoi(chi.URLParam(r, "sourceID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid source ID")
+		return ownerID, 0, 0, false
+	}
+	return ownerID, projectID, sourceID, true
+}
+
+// respondSourceError maps a source service error to a response.
+func respondSourceError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case strings.Contains(err.Error(), "source not found or access denied"):
+		respondError(w, http.StatusNotFound, "Source not found or access denied")
+	case strings.Contains(err.Error(), "sync already in progress"):
+		respondError(w, http.StatusConflict, "Source sync already in progress")
+	case strings.Contains(err.Error(), "invalid"):
+		respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logrus.WithError(err).Error("handler: " + strings.ToLower(message))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// sseWriter writes Server-Sent Events to a response. The event-stream headers
+// are only sent with the first event, so a request that fails before producing
+// anything can still be answered with a regular JSON error.
+type sseWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+// newSSEWriter returns an sseWriter, or false if the response can't be streamed.
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+	return &sseWriter{w: w, flusher: flusher}, true
+}
+
+// Event writes a single named event with a JSON payload and flushes it to the client.
+func (s *sseWriter) Event(event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+		h := s.w.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		h.Set("Connection", "keep-alive")
+		h.Set("X-Accel-Buffering", "no") // Disable proxy buffering.
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// Comment writes a comment line, which clients ignore. It keeps an idle
+// stream from being closed by proxies along the way.
+func (s *sseWriter) Comment(text string) error {
+	if _, err := fmt.Fprintf(s.w, ": %s\n\n", text); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// Fail reports an error, as an "error" event if the stream has started or as
+// a JSON error response otherwise.
+func (s *sseWriter) Fail(code int, message string) {
+	if !s.started {
+		respondError(s.w, code, message)
+		return
+	}
+	s.Event("error", map[string]string{"error": message})
+}
@@ -0,0 +1,426 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"go-rag/ent/ent"
+	"go-rag/internal/answer"
+	"go-rag/internal/auth"
+	"go-rag/internal/projects"
+	"go-rag/internal/search"
+	"go-rag/services/events"
+	"go-rag/services/fakeinferencer"
+	"go-rag/services/proto"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-chi/chi/v5"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient returns a client of a fresh in-memory database.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+	client, err := ent.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// newInferencer serves an Inferencer over an in-memory listener and returns
+// a client of it.
+func newInferencer(t *testing.T, srv proto.InferencerServer) proto.InferencerClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterInferencerServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewInferencerClient(conn)
+}
+
+// scriptedGenerator is the fake Inferencer with a Generate RPC that streams
+// tokens, then waits for the call to be cancelled when hang is set.
+type scriptedGenerator struct {
+	fakeinferencer.Server
+	tokens    []string
+	hang      bool
+	cancelled chan struct{}
+}
+
+func (g *scriptedGenerator) Generate(_ *proto.GenerateRequest, stream grpc.ServerStreamingServer[proto.GenerateResponse]) error {
+	for _, token := range g.tokens {
+		if err := stream.Send(&proto.GenerateResponse{Token: token}); err != nil {
+			return err
+		}
+	}
+	if g.hang {
+		<-stream.Context().Done()
+		close(g.cancelled)
+		return stream.Context().Err()
+	}
+	return nil
+}
+
+// singleHit is a Qdrant whose every search finds one chunk.
+type singleHit struct {
+	qdrant.PointsClient
+	chunkID int
+}
+
+func (h *singleHit) Search(context.Context, *qdrant.SearchPoints, ...grpc.CallOption) (*qdrant.SearchResponse, error) {
+	return &qdrant.SearchResponse{Result: []*qdrant.ScoredPoint{
+		{Id: qdrant.NewIDNum(uint64(h.chunkID)), Score: 0.9},
+	}}, nil
+}
+
+// sseFrame is an event or comment read from an event stream.
+type sseFrame struct {
+	Event   string
+	Data    string
+	Comment string
+}
+
+// readFrame reads the next frame of an event stream, joining multiple data
+// lines with newlines as a client would.
+func readFrame(t *testing.T, r *bufio.Reader) sseFrame {
+	t.Helper()
+	var (
+		f    sseFrame
+		data []string
+	)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("stream ended before a complete frame: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			f.Data = strings.Join(data, "\n")
+			return f
+		case strings.HasPrefix(line, ":"):
+			f.Comment = strings.TrimSpace(line[1:])
+		default:
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				f.Event = value
+			case "data":
+				data = append(data, value)
+			default:
+				t.Fatalf("unexpected line %q", line)
+			}
+		}
+	}
+}
+
+// streamFixture serves the streaming handlers over a project with one chunked
+// document, authenticating every request as the project's owner.
+type streamFixture struct {
+	server    *httptest.Server
+	projectID int
+	done      chan string // The path of each request whose handler returned.
+}
+
+func newStreamFixture(t *testing.T, gen *scriptedGenerator) *streamFixture {
+	t.Helper()
+	ctx := context.Background()
+	client := newTestClient(t)
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+	doc := client.Document.Create().SetName("webhooks.md").SetPath("webhooks.md").SetContent("x").SetProject(p).SaveX(ctx)
+	c := client.Chunk.Create().SetContent("Webhook deliveries are signed.").SetContentHash("h").SetIndex(0).SetDocument(doc).SaveX(ctx)
+
+	inferencer := newInferencer(t, gen)
+	searchService := &search.Service{Client: client, InferenceClient: inferencer, QdrantPointsClient: &singleHit{chunkID: c.ID}}
+	f := &streamFixture{projectID: p.ID, done: make(chan string, 16)}
+	answerHandler := &AnswerHandler{AnswerService: &answer.Service{Client: client, InferenceClient: inferencer, SearchService: searchService}}
+	searchHandler := &SearchHandler{SearchService: searchService}
+	eventHandler := &EventHandler{Bus: &events.Bus{}, ProjectService: &projects.Service{Client: client}, keepAlive: 20 * time.Millisecond}
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() { f.done <- r.URL.Path }()
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), auth.UserIDKey, owner.ID)))
+		})
+	})
+	r.Post("/projects/{projectID}/ask/stream", answerHandler.AskStream)
+	r.Post("/projects/{projectID}/search/stream", searchHandler.SearchStream)
+	r.Get("/projects/{projectID}/events", eventHandler.StreamEvents)
+	f.server = httptest.NewServer(r)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// open starts a request and returns its response.
+func (f *streamFixture) open(t *testing.T, ctx context.Context, method, path, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, method, f.server.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+// returned waits for the handler of a request to path to return.
+func (f *streamFixture) returned(t *testing.T, path string) {
+	t.Helper()
+	for {
+		select {
+		case p := <-f.done:
+			if p == path {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("handler of %s did not return", path)
+		}
+	}
+}
+
+func TestSSEWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	sse, ok := newSSEWriter(rec)
+	if !ok {
+		t.Fatal("recorder cannot be streamed to")
+	}
+
+	if err := sse.Event("token", map[string]string{"token": "two\nlines"}); err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Flushed {
+		t.Error("event was not flushed")
+	}
+	if err := sse.Comment("keep-alive"); err != nil {
+		t.Fatal(err)
+	}
+	sse.Fail(http.StatusInternalServerError, "generation failed")
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status %d, want 200", rec.Code)
+	}
+	for header, want := range map[string]string{"Content-Type": "text/event-stream", "Cache-Control": "no-cache", "X-Accel-Buffering": "no"} {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s: %q, want %q", header, got, want)
+		}
+	}
+
+	// A multi-line token stays on one data line, so it cannot end the event
+	// early, and decodes back to both lines.
+	want := "event: token\ndata: {\"token\":\"two\\nlines\"}\n\n" +
+		": keep-alive\n\n" +
+		"event: error\ndata: {\"error\":\"generation failed\"}\n\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("stream:\n%q\nwant:\n%q", got, want)
+	}
+	frame := readFrame(t, bufio.NewReader(strings.NewReader(rec.Body.String())))
+	var token map[string]string
+	if err := json.Unmarshal([]byte(frame.Data), &token); err != nil || token["token"] != "two\nlines" {
+		t.Errorf("token event decodes to %q, %v", token, err)
+	}
+}
+
+func TestSSEWriterFailsBeforeStreaming(t *testing.T) {
+	rec := httptest.NewRecorder()
+	sse, _ := newSSEWriter(rec)
+	sse.Fail(http.StatusNotFound, "Project not found or access denied")
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status %d, want 404", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type %q, want a JSON error", ct)
+	}
+	if !strings.Contains(rec.Body.String(), `"error":"Project not found or access denied"`) {
+		t.Errorf("body %q", rec.Body.String())
+	}
+}
+
+// unflushable is a ResponseWriter that cannot stream.
+type unflushable struct{ http.ResponseWriter }
+
+func TestNewSSEWriterNeedsFlusher(t *testing.T) {
+	if _, ok := newSSEWriter(unflushable{httptest.NewRecorder()}); ok {
+		t.Error("got a writer for a response that cannot be flushed")
+	}
+}
+
+func TestAskStream(t *testing.T) {
+	f := newStreamFixture(t, &scriptedGenerator{tokens: []string{"Deliveries", " are\nsigned", " [1]."}})
+	res := f.open(t, context.Background(), http.MethodPost, "/projects/"+strconv.Itoa(f.projectID)+"/ask/stream", `{"question":"How are webhooks signed?"}`)
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("content type %q", ct)
+	}
+
+	r := bufio.NewReader(res.Body)
+	var (
+		names  []string
+		tokens strings.Builder
+		final  answer.AskResponse
+	)
+	for {
+		frame := readFrame(t, r)
+		if names == nil || names[len(names)-1] != frame.Event {
+			names = append(names, frame.Event)
+		}
+		switch frame.Event {
+		case "token":
+			var token map[string]string
+			if err := json.Unmarshal([]byte(frame.Data), &token); err != nil {
+				t.Fatal(err)
+			}
+			tokens.WriteString(token["token"])
+		case "citations":
+			if err := json.Unmarshal([]byte(frame.Data), &final); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if frame.Event == "citations" || frame.Event == "error" {
+			break
+		}
+	}
+
+	if got := strings.Join(names, ","); got != "progress,sources,token,citations" {
+		t.Errorf("events %s, want progress, sources, tokens and citations in order", got)
+	}
+	if tokens.String() != "Deliveries are\nsigned [1]." || final.Answer != tokens.String() {
+		t.Errorf("tokens %q and answer %q, want the generated text", tokens.String(), final.Answer)
+	}
+	if len(final.Citations) != 1 {
+		t.Errorf("got %d citations, want 1", len(final.Citations))
+	}
+	if rest, _ := io.ReadAll(r); len(rest) != 0 {
+		t.Errorf("stream continues after the citations: %q", rest)
+	}
+}
+
+func TestAskStreamCancelledByClient(t *testing.T) {
+	gen := &scriptedGenerator{tokens: []string{"Deliveries"}, hang: true, cancelled: make(chan struct{})}
+	f := newStreamFixture(t, gen)
+	ctx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+	path := "/projects/" + strconv.Itoa(f.projectID) + "/ask/stream"
+	res := f.open(t, ctx, http.MethodPost, path, `{"question":"How are webhooks signed?"}`)
+
+	r := bufio.NewReader(res.Body)
+	for readFrame(t, r).Event != "token" {
+	}
+	disconnect()
+
+	select {
+	case <-gen.cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("generation was not cancelled when the client went away")
+	}
+	f.returned(t, path)
+}
+
+func TestSearchStream(t *testing.T) {
+	f := newStreamFixture(t, &scriptedGenerator{})
+	res := f.open(t, context.Background(), http.MethodPost, "/projects/"+strconv.Itoa(f.projectID)+"/search/stream", `{"query":"webhook signing"}`)
+
+	r := bufio.NewReader(res.Body)
+	var stages []string
+	for {
+		frame := readFrame(t, r)
+		if frame.Event == "results" {
+			var resp search.SearchResponse
+			if err := json.Unmarshal([]byte(frame.Data), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Results) != 1 {
+				t.Errorf("got %d results, want 1", len(resp.Results))
+			}
+			break
+		}
+		if frame.Event != "progress" {
+			t.Fatalf("unexpected %q event", frame.Event)
+		}
+		var progress struct{ Stage string }
+		if err := json.Unmarshal([]byte(frame.Data), &progress); err != nil {
+			t.Fatal(err)
+		}
+		stages = append(stages, progress.Stage)
+	}
+	if got := strings.Join(stages, ","); got != search.StageVector+","+search.StageHydrated {
+		t.Errorf("progress stages %s", got)
+	}
+}
+
+func TestStreamErrorsBeforeStreaming(t *testing.T) {
+	f := newStreamFixture(t, &scriptedGenerator{})
+	tests := []struct {
+		method, path, body string
+		code               int
+	}{
+		{http.MethodPost, "/projects/" + strconv.Itoa(f.projectID+1) + "/ask/stream", `{"question":"q"}`, http.StatusNotFound},
+		{http.MethodPost, "/projects/" + strconv.Itoa(f.projectID) + "/ask/stream", `{"question":" "}`, http.StatusBadRequest},
+		{http.MethodPost, "/projects/" + strconv.Itoa(f.projectID) + "/search/stream", `{"query":"q","mode":"fuzzy"}`, http.StatusBadRequest},
+		{http.MethodGet, "/projects/" + strconv.Itoa(f.projectID+1) + "/events", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		res := f.open(t, context.Background(), tt.method, tt.path, tt.body)
+		if res.StatusCode != tt.code || res.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("%s %s: %d %s, want a %d JSON error", tt.method, tt.path, res.StatusCode, res.Header.Get("Content-Type"), tt.code)
+		}
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	f := newStreamFixture(t, &scriptedGenerator{})
+	ctx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+	path := "/projects/" + strconv.Itoa(f.projectID) + "/events"
+	res := f.open(t, ctx, http.MethodGet, path, "")
+
+	r := bufio.NewReader(res.Body)
+	ready := readFrame(t, r)
+	if ready.Event != "ready" || ready.Data != `{"project_id":`+strconv.Itoa(f.projectID)+`}` {
+		t.Errorf("first frame %+v, want the ready event", ready)
+	}
+	// An idle stream is kept alive with comments.
+	for range 2 {
+		if frame := readFrame(t, r); frame.Comment != "keep-alive" {
+			t.Errorf("idle stream sent %+v, want a keep-alive comment", frame)
+		}
+	}
+
+	disconnect()
+	f.returned(t, path)
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/internal/auth"
+	"go-rag/internal/documents"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+type movePathRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ListDirectory handles GET /projects/{projectID}/tree?path=docs/guide
+//
+// Without a path the project root is listed.
+func (h *DocumentHandler) ListDirectory(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	dir, err := h.DocumentService.ListDirectory(r.Context(), projectID, r.URL.Query().Get("path"), ownerID)
+	if err != nil {
+		respondTreeError(w, err, "Failed to list directory")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dir)
+}
+
+// MovePath handles POST /projects/{projectID}/tree/move
+//
+// The body {"from": "docs/old", "to": "docs/new"} renames a document or a
+// folder with everything under it.
+func (h *DocumentHandler) MovePath(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req movePathRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if req.From == "" || req.To == "" {
+		respondError(w, http.StatusBadRequest, "Fields 'from' and 'to' are required")
+		return
+	}
+
+	moved, err := h.DocumentService.MovePath(r.Context(), documents.MovePathRequest{
+		ProjectID: projectID,
+		From:      req.From,
+		To:        req.To,
+		OwnerID:   ownerID,
+	})
+	if err != nil {
+		respondTreeError(w, err, "Failed to move path")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]int{"moved": moved})
+}
+
+// DeletePath handles DELETE /projects/{projectID}/tree?path=docs/old
+//
+// A folder is deleted with every document under it.
+func (h *DocumentHandler) DeletePath(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	p := r.URL.Query().Get("path")
+	if strings.Trim(p, "/") == "" {
+		respondError(w, http.StatusBadRequest, "Query parameter 'path' is required")
+		return
+	}
+
+	deleted, err := h.DocumentService.DeletePath(r.Context(), projectID, p, ownerID)
+	if err != nil {
+		respondTreeError(w, err, "Failed to delete path")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]int{"deleted": deleted})
+}
+
+// respondTreeError maps a folder operation's service error to a response.
+func respondTreeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case strings.Contains(err.Error(), "project not found or access denied"):
+		respondError(w, http.StatusNotFound, "Project not found or access denied")
+	case strings.Contains(err.Error(), "folder not found"), strings.Contains(err.Error(), "path not found"):
+		respondError(w, http.StatusNotFound, "Path not found")
+	case strings.Contains(err.Error(), "invalid"):
+		respondError(w, http.StatusBadRequest, err.Error())
+	case strings.Contains(err.Error(), "already exists"):
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		logrus.WithError(err).Error("handler: " + strings.ToLower(message))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"encoding/json"
+	"go-rag/ent/ent"
+	"go-rag/internal/auth"
+	"go-rag/internal/webhooks"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// WebhookHandler handles HTTP requests for project webhooks.
+type WebhookHandler struct {
+	WebhookService *webhooks.Service
+}
+
+type createWebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+type updateWebhookRequest struct {
+	URL    *string  `json:"url"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+}
+
+// createdWebhook is a new webhook along with its secret, which is only shown
+// when the webhook is created.
+type createdWebhook struct {
+	*ent.Webhook
+	Secret string `json:"secret"`
+}
+
+// CreateWebhook handles POST /projects/{projectID}/webhooks
+//
+// The body {"url": "https://example.com/hook", "events": ["document.indexed"]}
+// subscribes the URL to the listed events, or to all of them when none are
+// listed. Without a "secret" one is generated and returned.
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req createWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if req.URL == "" {
+		respondError(w, http.StatusBadRequest, "Field 'url' is required")
+		return
+	}
+
+	hook, err := h.WebhookService.CreateWebhook(r.Context(), webhooks.CreateWebhookRequest{
+		ProjectID: projectID,
+		OwnerID:   ownerID,
+		URL:       req.URL,
+		Events:    req.Events,
+		Secret:    req.Secret,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "project not found or access denied"):
+			respondError(w, http.StatusNotFound, "Project not found or access denied")
+		case strings.Contains(err.Error(), "invalid"):
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			logrus.WithError(err).Error("handler: failed to create webhook")
+			respondError(w, http.StatusInternalServerError, "Failed to create webhook")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, createdWebhook{Webhook: hook, Secret: hook.Secret})
+}
+
+// ListWebhooks handles GET /projects/{projectID}/webhooks
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	list, err := h.WebhookService.ListWebhooks(r.Context(), projectID, ownerID)
+	if err != nil {
+		logrus.WithError(err).Error("handler: failed to list webhooks")
+		respondError(w, http.StatusInternalServerError, "Failed to list webhooks")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, list)
+}
+
+// GetWebhook handles GET /projects/{projectID}/webhooks/{webhookID}
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, webhookID, ok := webhookParams(w, r)
+	if !ok {
+		return
+	}
+
+	hook, err := h.WebhookService.GetWebhook(r.Context(), projectID, webhookID, ownerID)
+	if err != nil {
+		respondWebhookError(w, err, "Failed to get webhook")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, hook)
+}
+
+// UpdateWebhook handles PUT /projects/{projectID}/webhooks/{webhookID}
+//
+// Fields left out of the body are unchanged; {"active": false} pauses the
+// webhook without losing its configuration.
+func (h *WebhookHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, webhookID, ok := webhookParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	hook, err := h.WebhookService.UpdateWebhook(r.Context(), webhooks.UpdateWebhookRequest{
+		ProjectID: projectID,
+		WebhookID: webhookID,
+		OwnerID:   ownerID,
+		URL:       req.URL,
+		Events:    req.Events,
+		Active:    req.Active,
+	})
+	if err != nil {
+		respondWebhookError(w, err, "Failed to update webhook")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, hook)
+}
+
+// DeleteWebhook handles DELETE /projects/{projectID}/webhooks/{webhookID}
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, webhookID, ok := webhookParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.WebhookService.DeleteWebhook(r.Context(), projectID, webhookID, ownerID); err != nil {
+		respondWebhookError(w, err, "Failed to delete webhook")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeliveries handles GET /projects/{projectID}/webhooks/{webhookID}/deliveries
+//
+// An optional status query parameter filters the deliveries, e.g. "failed"
+// for those the receiver refused on every attempt.
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	ownerID, projectID, webhookID, ok := webhookParams(w, r)
+	if !ok {
+		return
+	}
+
+	list, err := h.WebhookService.ListDeliveries(r.Context(), projectID, webhookID, r.URL.Query().Get("status"), ownerID)
+	if err != nil {
+		respondWebhookError(w, err, "Failed to list webhook deliveries")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, list)
+}
+
+// webhookParams reads the user and the project and webhook IDs of a webhook
+// request, responding with an error if any is missing or malformed.
+func webhookParams(w http.ResponseWriter, r *http.Request) (ownerID uuid.UUID, projectID, webhookID int, ok bool) {
+	ownerID, ok = auth.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return ownerID, 0, 0, false
+	}
+
+	projectID, err := strconv.Atoi(chi.URLParam(r, "projectID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return ownerID, 0, 0, false
+	}
+	webhookID, err = strconv.Atoi(chi.URLParam(r, "webhookID"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid webhook ID")
+		return ownerID, 0, 0, false
+	}
+	return ownerID, projectID, webhookID, true
+}
+
+// respondWebhookError maps a webhook service error to a response.
+func respondWebhookError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case strings.Contains(err.Error(), "webhook not found or access denied"):
+		respondError(w, http.StatusNotFound, "Webhook not found or access denied")
+	case strings.Contains(err.Error(), "invalid"):
+		respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logrus.WithError(err).Error("handler: " + strings.ToLower(message))
+		respondError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -0,0 +1,127 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"go-rag/services/embed"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// maxListedJobs caps how many jobs ListJobs returns, newest first.
+const maxListedJobs = 500
+
+// ListJobs retrieves the jobs of a project owned by the user, optionally
+// only those in one status, such as the dead letters.
+func (q *Queue) ListJobs(ctx context.Context, projectID int, status string, ownerID uuid.UUID) ([]*ent.IngestJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+		"status":     status,
+	})
+	log.Info("service: listing ingest jobs")
+
+	query := q.Client.IngestJob.
+		Query().
+		Where(ingestjob.HasDocumentWith(inOwnedProject(projectID, ownerID)))
+	if status != "" {
+		query.Where(ingestjob.Status(status))
+	}
+	jobs, err := query.
+		WithDocument(func(dq *ent.DocumentQuery) {
+			dq.Select(document.FieldName, document.FieldPath)
+		}).
+		Order(ent.Desc(ingestjob.FieldID)).
+		Limit(maxListedJobs).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list ingest jobs")
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// RetryJob puts a dead job back in the queue with a fresh set of attempts.
+func (q *Queue) RetryJob(ctx context.Context, projectID, jobID int, ownerID uuid.UUID) (*ent.IngestJob, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"job_id":     jobID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: retrying ingest job")
+
+	job, err := q.Client.IngestJob.
+		Query().
+		Where(
+			ingestjob.ID(jobID),
+			ingestjob.HasDocumentWith(inOwnedProject(projectID, ownerID)),
+		).
+		WithDocument(func(dq *ent.DocumentQuery) {
+			dq.Select(document.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: ingest job not found or access denied")
+			return nil, fmt.Errorf("job not found or access denied")
+		}
+		log.WithError(err).Error("service: database error while getting ingest job")
+		return nil, err
+	}
+	if job.Status != StatusDead {
+		return nil, fmt.Errorf("only dead jobs can be retried")
+	}
+
+	doc := job.Edges.Document
+	job, err = job.Update().
+		SetStatus(StatusQueued).
+		SetAttempts(0).
+		SetRunAt(time.Now()).
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to requeue ingest job")
+		return nil, err
+	}
+	// The document starts over too.
+	update := q.Client.Document.UpdateOneID(doc.ID).SetAttempts(0)
+	embed.Transition(update.Mutation(), document.StatusQueued)
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		log.WithError(err).Error("service: failed to requeue document")
+		return nil, err
+	}
+	q.EmbedService.PublishStatus(ctx, doc.ID, document.StatusQueued, nil)
+	q.workers.Wake()
+
+	log.Info("service: ingest job requeued")
+	return job, nil
+}
+
+// CancelDocument drops the jobs waiting to process a document. A job that is
+// running stops at its next stage, once it finds the document cancelled.
+func (q *Queue) CancelDocument(ctx context.Context, documentID int) error {
+	return q.Client.IngestJob.Update().
+		Where(
+			ingestjob.HasDocumentWith(document.ID(documentID)),
+			ingestjob.Status(StatusQueued),
+		).
+		SetStatus(StatusCancelled).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
+
+// inOwnedProject selects the documents of a project owned by the user.
+func inOwnedProject(projectID int, ownerID uuid.UUID) predicate.Document {
+	return document.HasProjectWith(
+		project.ID(projectID),
+		project.HasOwnerWith(user.ID(ownerID)),
+	)
+}
@@ -0,0 +1,349 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/ingestjob"
+	"go-rag/internal/worker"
+	"go-rag/services/embed"
+	"runtime/debug"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Job kinds.
+const KindProcessDocument = "process_document"
+
+// Job statuses.
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+	StatusCancelled = "cancelled"
+)
+
+// Defaults, used when the environment does not set them.
+const (
+	defaultWorkers     = 4
+	defaultMaxAttempts = 5
+	defaultBackoff     = 10 * time.Second
+	maxBackoff         = 30 * time.Minute
+	// lease is how long a claimed job stays locked without a heartbeat before
+	// another worker may take it over, as after a crash.
+	lease = 5 * time.Minute
+	// pollInterval is how often idle workers look for due jobs, in case no
+	// enqueue on this instance woke them.
+	pollInterval = 2 * time.Second
+)
+
+// Config tunes the queue.
+type Config struct {
+	Workers     int
+	MaxAttempts int
+	// Backoff is the delay before the first retry. It doubles with every
+	// further attempt.
+	Backoff time.Duration
+}
+
+// LoadConfig reads the queue settings from INGEST_WORKERS,
+// INGEST_MAX_ATTEMPTS and INGEST_BACKOFF (a duration such as "10s"),
+// falling back to the defaults.
+func LoadConfig() Config {
+	cfg := Config{Workers: defaultWorkers, MaxAttempts: defaultMaxAttempts, Backoff: defaultBackoff}
+	worker.IntFromEnv("INGEST_WORKERS", &cfg.Workers)
+	worker.IntFromEnv("INGEST_MAX_ATTEMPTS", &cfg.MaxAttempts)
+	worker.DurationFromEnv("INGEST_BACKOFF", &cfg.Backoff)
+	logrus.WithFields(logrus.Fields{
+		"workers":      cfg.Workers,
+		"max_attempts": cfg.MaxAttempts,
+		"backoff":      cfg.Backoff,
+	}).Info("ingest queue settings loaded")
+	return cfg
+}
+
+// Queue runs document processing as jobs stored in Postgres. Any number of
+// server instances may work the same queue: each job is claimed by exactly
+// one worker, and a job whose worker died is taken over once its claim
+// expires. Failed jobs are retried with exponential backoff and, after the
+// last attempt, kept as dead jobs.
+type Queue struct {
+	Client       *ent.Client
+	EmbedService *embed.Service
+	Config       Config
+
+	workers worker.Pool
+	// process processes a document; nil uses EmbedService.ProcessDocument.
+	process func(ctx context.Context, documentID int) error
+}
+
+// claimQuery takes the next due job, or one whose worker's claim expired,
+// skipping jobs other workers are claiming at the same moment and documents
+// another worker is still processing.
+const claimQuery = `
+UPDATE "ingest_jobs"
+SET "status" = 'running',
+    "attempts" = "attempts" + 1,
+    "locked_until" = now() + make_interval(secs => $1),
+    "updated_at" = now()
+WHERE "id" = (
+  SELECT j."id" FROM "ingest_jobs" AS j
+  WHERE ((j."status" = 'queued' AND j."run_at" <= now())
+      OR (j."status" = 'running' AND j."locked_until" < now()))
+    AND NOT EXISTS (
+      SELECT 1 FROM "ingest_jobs" AS r
+      WHERE r."document_jobs" = j."document_jobs" AND r."id" <> j."id"
+        AND r."status" = 'running' AND r."locked_until" >= now()
+    )
+  ORDER BY j."run_at", j."id"
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "kind", COALESCE("document_jobs", 0), "attempts", "max_attempts"`
+
+// claimedJob is a job a worker holds.
+type claimedJob struct {
+	id          int
+	kind        string
+	documentID  int
+	attempts    int
+	maxAttempts int
+}
+
+// Start re-queues interrupted work and starts the workers, which run until
+// ctx is cancelled.
+func (q *Queue) Start(ctx context.Context) {
+	if q.Config.Workers < 1 {
+		q.Config = LoadConfig()
+	}
+	if err := q.Recover(ctx); err != nil {
+		logrus.WithError(err).Error("queue: failed to recover unfinished documents")
+	}
+	q.workers.Start(ctx, "queue", q.Config.Workers, pollInterval, q.runNext)
+}
+
+// EnqueueDocument schedules a document to be processed. A document that
+// already has a job waiting is not queued twice: that job will see the latest
+// content, so it is only brought forward and given a fresh set of attempts.
+func (q *Queue) EnqueueDocument(ctx context.Context, documentID int) error {
+	n, err := q.Client.IngestJob.Update().
+		Where(
+			ingestjob.HasDocumentWith(document.ID(documentID)),
+			ingestjob.Status(StatusQueued),
+		).
+		SetRunAt(time.Now()).
+		SetAttempts(0).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("could not reschedule queued jobs: %w", err)
+	}
+	if n == 0 {
+		err := q.Client.IngestJob.Create().
+			SetKind(KindProcessDocument).
+			SetMaxAttempts(q.maxAttempts()).
+			SetDocumentID(documentID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("could not queue document: %w", err)
+		}
+	}
+	q.EmbedService.PublishStatus(ctx, documentID, document.StatusQueued, nil)
+	q.workers.Wake()
+	return nil
+}
+
+// Wait blocks until none of the documents has a job left to run, that is
+// until each was processed or its job died.
+func (q *Queue) Wait(ctx context.Context, documentIDs []int) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		pending, err := q.Client.IngestJob.Query().
+			Where(
+				ingestjob.HasDocumentWith(document.IDIn(documentIDs...)),
+				ingestjob.StatusIn(StatusQueued, StatusRunning),
+			).
+			Exist(ctx)
+		if err != nil || !pending {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Recover queues the documents that were left unprocessed, such as by a
+// restart before their job was stored, and that have no job to finish them.
+func (q *Queue) Recover(ctx context.Context) error {
+	ids, err := q.Client.Document.Query().
+		Where(
+			document.StatusNotIn(document.StatusCompleted, document.StatusFailed, document.StatusCancelled),
+			document.Not(document.HasJobsWith(ingestjob.StatusIn(StatusQueued, StatusRunning))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := q.EnqueueDocument(ctx, id); err != nil {
+			return err
+		}
+	}
+	if len(ids) > 0 {
+		logrus.WithField("documents", len(ids)).Info("queue: re-queued unfinished documents")
+	}
+	return nil
+}
+
+// runNext claims the next due job and runs it, reporting false if there was
+// none.
+func (q *Queue) runNext(ctx context.Context) (bool, error) {
+	var job claimedJob
+	ok, err := worker.Claim(ctx, q.Client, claimQuery, lease,
+		&job.id, &job.kind, &job.documentID, &job.attempts, &job.maxAttempts)
+	if err != nil || !ok {
+		return false, err
+	}
+	q.run(ctx, &job)
+	return true, nil
+}
+
+// run executes a claimed job and records its outcome.
+func (q *Queue) run(ctx context.Context, job *claimedJob) {
+	log := logrus.WithFields(logrus.Fields{
+		"job_id":      job.id,
+		"document_id": job.documentID,
+		"attempt":     job.attempts,
+	})
+
+	var err error
+	switch {
+	case job.attempts > job.maxAttempts:
+		// The job was taken over after its last attempt's worker died.
+		err = fmt.Errorf("worker stopped during the final attempt")
+	case job.kind != KindProcessDocument || job.documentID == 0:
+		err = fmt.Errorf("unknown job kind %q", job.kind)
+		job.attempts = job.maxAttempts
+	default:
+		log.Info("queue: running job")
+		err = q.processDocument(ctx, job)
+	}
+
+	if err == nil {
+		q.settle(ctx, log, q.Client.IngestJob.UpdateOneID(job.id).
+			SetStatus(StatusSucceeded).
+			ClearLockedUntil().
+			ClearLastError().
+			SetFinishedAt(time.Now()))
+		log.Info("queue: job succeeded")
+		return
+	}
+
+	if ent.IsNotFound(err) {
+		// The document was deleted, and its jobs with it.
+		log.Info("queue: document no longer exists, dropping job")
+		return
+	}
+	if cancelled, _ := q.Client.Document.Query().
+		Where(document.ID(job.documentID), document.StatusEQ(document.StatusCancelled)).
+		Exist(ctx); cancelled {
+		log.Info("queue: document processing was cancelled")
+		q.settle(ctx, log, q.Client.IngestJob.UpdateOneID(job.id).
+			SetStatus(StatusCancelled).
+			ClearLockedUntil().
+			SetFinishedAt(time.Now()))
+		return
+	}
+
+	if job.attempts >= job.maxAttempts {
+		log.WithError(err).Error("queue: job failed on its last attempt, moving it to the dead letters")
+		q.settle(ctx, log, q.Client.IngestJob.UpdateOneID(job.id).
+			SetStatus(StatusDead).
+			ClearLockedUntil().
+			SetLastError(err.Error()).
+			SetFinishedAt(time.Now()))
+		if err := q.EmbedService.SetStatus(ctx, job.documentID, document.StatusFailed, err); err != nil {
+			log.WithError(err).Error("queue: failed to mark document failed")
+		}
+		return
+	}
+
+	delay := worker.Backoff(q.backoff(), maxBackoff, job.attempts)
+	log.WithError(err).WithField("retry_in", delay).Warn("queue: job failed, retrying later")
+	q.settle(ctx, log, q.Client.IngestJob.UpdateOneID(job.id).
+		SetStatus(StatusQueued).
+		ClearLockedUntil().
+		SetLastError(err.Error()).
+		SetRunAt(time.Now().Add(delay)))
+	if err := q.EmbedService.SetStatus(ctx, job.documentID, document.StatusQueued, err); err != nil {
+		log.WithError(err).Error("queue: failed to requeue document")
+	}
+}
+
+// processDocument runs ProcessDocument, renewing the job's claim while it
+// works so that a long document is not taken over by another worker. A panic
+// fails the attempt like any other error instead of taking the server down.
+func (q *Queue) processDocument(ctx context.Context, job *claimedJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.WithField("job_id", job.id).WithField("stack", string(debug.Stack())).Error("queue: job panicked")
+			err = fmt.Errorf("processing panicked: %v", r)
+		}
+	}()
+
+	if err := q.Client.Document.UpdateOneID(job.documentID).AddAttempts(1).Exec(ctx); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := q.Client.IngestJob.UpdateOneID(job.id).
+					SetLockedUntil(time.Now().Add(lease)).
+					Exec(ctx)
+				if err != nil {
+					logrus.WithError(err).WithField("job_id", job.id).Warn("queue: failed to renew job claim")
+				}
+			}
+		}
+	}()
+	if q.process != nil {
+		return q.process(ctx, job.documentID)
+	}
+	return q.EmbedService.ProcessDocument(ctx, job.documentID)
+}
+
+// settle applies an outcome update, logging rather than returning a failure
+// since the job's claim expiring will bring it back either way.
+func (q *Queue) settle(ctx context.Context, log *logrus.Entry, update interface{ Exec(context.Context) error }) {
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		log.WithError(err).Error("queue: failed to record job outcome")
+	}
+}
+
+func (q *Queue) maxAttempts() int {
+	if q.Config.MaxAttempts > 0 {
+		return q.Config.MaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+func (q *Queue) backoff() time.Duration {
+	if q.Config.Backoff > 0 {
+		return q.Config.Backoff
+	}
+	return defaultBackoff
+}
@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"go-rag/ent/ent"
+	"go-rag/services/embed"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRunSettlesFailedAttempts(t *testing.T) {
+	tests := []struct {
+		name    string
+		owned   bool
+		process func(ctx context.Context, documentID int) error
+		want    string
+	}{
+		{
+			name:  "processing panics",
+			owned: true,
+			process: func(context.Context, int) error {
+				var m map[string]int
+				m["boom"]++
+				return nil
+			},
+			want: "processing panicked: assignment to entry in nil map",
+		},
+		{
+			name:    "processing fails",
+			owned:   true,
+			process: func(context.Context, int) error { return errors.New("inferencer unavailable") },
+			want:    "inferencer unavailable",
+		},
+		{
+			name: "project without an owner",
+			want: "does not belong to an owned project",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+			client, err := ent.Open(dialect.SQLite, dsn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			if err := client.Schema.Create(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			create := client.Project.Create().SetName("p")
+			if tt.owned {
+				create.SetOwner(client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx))
+			}
+			p := create.SaveX(ctx)
+			doc := client.Document.Create().SetName("a.md").SetPath("a.md").SetContent("# A\n").SetProject(p).SaveX(ctx)
+			job := client.IngestJob.Create().SetDocument(doc).SetStatus(StatusRunning).SetAttempts(1).SetMaxAttempts(2).SaveX(ctx)
+
+			q := &Queue{Client: client, EmbedService: &embed.Service{Client: client}, process: tt.process}
+			q.run(ctx, &claimedJob{id: job.ID, kind: KindProcessDocument, documentID: doc.ID, attempts: 1, maxAttempts: 2})
+
+			job = client.IngestJob.GetX(ctx, job.ID)
+			if job.Status != StatusQueued || !strings.Contains(job.LastError, tt.want) {
+				t.Fatalf("job is %s with error %q, want it queued for a retry after %q", job.Status, job.LastError, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"go-rag/ent/ent"
 	"go-rag/ent/ent/project"
 	"go-rag/ent/ent/user"
+	"go-rag/services/embed"
+	"go-rag/services/events"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -14,12 +16,32 @@ import (
 // Service handles the business logic for projects.
 type Service struct {
 	Client *ent.Client
+	// Events, when set, is told about deleted projects.
+	Events *events.Bus
+}
+
+// Bounds for the per-project chunking settings. Sizes are in tokens.
+const (
+	minChunkMaxSize      = 32
+	maxChunkHeadingLevel = 6
+)
+
+// ChunkSettings holds the chunking configuration of a project. MaxSize and
+// Overlap are in embedding model tokens. Nil fields are left unchanged on
+// update and use the defaults on create.
+type ChunkSettings struct {
+	Strategy       *string
+	MaxSize        *int
+	Overlap        *int
+	HeadingLevel   *int
+	IncludeOutputs *bool
 }
 
 // CreateProjectRequest defines the parameters for creating a new project.
 type CreateProjectRequest struct {
 	Name        string
 	Description *string
+	Chunking    ChunkSettings
 	OwnerID     uuid.UUID
 }
 
@@ -28,9 +50,31 @@ type UpdateProjectRequest struct {
 	ProjectID   int
 	Name        *string
 	Description *string
+	Chunking    ChunkSettings
 	OwnerID     uuid.UUID // To verify ownership
 }
 
+// validate checks the chunking settings against the supported strategies and bounds.
+func (c ChunkSettings) validate() error {
+	if c.Strategy != nil && !embed.ValidStrategy(*c.Strategy) {
+		return fmt.Errorf("invalid chunking settings: unknown strategy %q", *c.Strategy)
+	}
+	if c.MaxSize != nil && (*c.MaxSize < minChunkMaxSize || *c.MaxSize > embed.MaxModelTokens) {
+		return fmt.Errorf("invalid chunking settings: max size must be between %d and %d tokens", minChunkMaxSize, embed.MaxModelTokens)
+	}
+	// The overlap may be at most half a chunk so every chunk adds new content.
+	if c.Overlap != nil && (*c.Overlap < 0 || *c.Overlap > embed.MaxModelTokens/2) {
+		return fmt.Errorf("invalid chunking settings: overlap must be between 0 and %d tokens", embed.MaxModelTokens/2)
+	}
+	if c.MaxSize != nil && c.Overlap != nil && *c.Overlap > *c.MaxSize/2 {
+		return fmt.Errorf("invalid chunking settings: overlap must be at most half the max size")
+	}
+	if c.HeadingLevel != nil && (*c.HeadingLevel < 1 || *c.HeadingLevel > maxChunkHeadingLevel) {
+		return fmt.Errorf("invalid chunking settings: heading level must be between 1 and %d", maxChunkHeadingLevel)
+	}
+	return nil
+}
+
 // CreateProject creates a new project for a given user.
 func (s *Service) CreateProject(ctx context.Context, req CreateProjectRequest) (*ent.Project, error) {
 	log := logrus.WithFields(logrus.Fields{
@@ -39,11 +83,21 @@ func (s *Service) CreateProject(ctx context.Context, req CreateProjectRequest) (
 	})
 	log.Info("service: creating new project")
 
+	if err := req.Chunking.validate(); err != nil {
+		log.WithError(err).Warn("service: rejected project chunking settings")
+		return nil, err
+	}
+
 	// The `AddOwnerID` method links the project to the user (owner).
 	p, err := s.Client.Project.
 		Create().
 		SetName(req.Name).
 		SetNillableDescription(req.Description).
+		SetNillableChunkStrategy(req.Chunking.Strategy).
+		SetNillableChunkMaxSize(req.Chunking.MaxSize).
+		SetNillableChunkOverlap(req.Chunking.Overlap).
+		SetNillableChunkHeadingLevel(req.Chunking.HeadingLevel).
+		SetNillableChunkIncludeOutputs(req.Chunking.IncludeOutputs).
 		SetOwnerID(req.OwnerID).
 		Save(ctx)
 
@@ -112,6 +166,11 @@ func (s *Service) UpdateProject(ctx context.Context, req UpdateProjectRequest) (
 	})
 	log.Info("service: updating project")
 
+	if err := req.Chunking.validate(); err != nil {
+		log.WithError(err).Warn("service: rejected project chunking settings")
+		return nil, err
+	}
+
 	// First, verify ownership and get the project.
 	p, err := s.GetProjectByID(ctx, req.ProjectID, req.OwnerID)
 	if err != nil {
@@ -125,6 +184,13 @@ func (s *Service) UpdateProject(ctx context.Context, req UpdateProjectRequest) (
 	if req.Description != nil {
 		updater.SetDescription(*req.Description)
 	}
+	// New chunking settings apply to documents as they are next processed.
+	updater.
+		SetNillableChunkStrategy(req.Chunking.Strategy).
+		SetNillableChunkMaxSize(req.Chunking.MaxSize).
+		SetNillableChunkOverlap(req.Chunking.Overlap).
+		SetNillableChunkHeadingLevel(req.Chunking.HeadingLevel).
+		SetNillableChunkIncludeOutputs(req.Chunking.IncludeOutputs)
 
 	updatedProject, err := updater.Save(ctx)
 	if err != nil {
@@ -144,8 +210,33 @@ func (s *Service) DeleteProject(ctx context.Context, projectID int, ownerID uuid
 	})
 	log.Info("service: deleting project")
 
+	exists, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(projectID),
+			project.HasOwnerWith(user.ID(ownerID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return err
+	}
+	if !exists {
+		log.Warn("service: project not found or access denied for deletion")
+		return fmt.Errorf("project not found or access denied")
+	}
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to start transaction")
+		return err
+	}
+	// Announce the deletion within its transaction, while the project's
+	// webhooks, which are deleted with it, can still be found to be sent the
+	// event. Their deliveries are then kept only if the delete commits.
+	s.Events.Publish(ent.NewTxContext(ctx, tx), events.Event{Type: events.ProjectDeleted, ProjectID: projectID})
+
 	// The delete operation is filtered by both project ID and owner ID for security.
-	n, err := s.Client.Project.
+	n, err := tx.Project.
 		Delete().
 		Where(
 			project.ID(projectID),
@@ -154,13 +245,19 @@ func (s *Service) DeleteProject(ctx context.Context, projectID int, ownerID uuid
 		Exec(ctx)
 
 	if err != nil {
+		tx.Rollback()
 		log.WithError(err).Error("service: failed to delete project from database")
 		return err
 	}
 	if n == 0 {
+		tx.Rollback()
 		log.Warn("service: project not found or access denied for deletion")
 		return fmt.Errorf("project not found or access denied")
 	}
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Error("service: failed to commit project deletion")
+		return err
+	}
 
 	log.Info("service: project deleted successfully")
 	return nil
@@ -0,0 +1,105 @@
+package projects
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/project"
+	"go-rag/internal/webhooks"
+	"go-rag/services/events"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+)
+
+// The test database stands in for Postgres' pg_notify, which publishing an
+// event calls.
+func init() {
+	sql.Register("sqlite3_pg_notify", &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("pg_notify", func(_, _ string) interface{} { return nil }, false)
+		},
+	})
+}
+
+// newTestClient returns a client of a fresh in-memory database.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite3_pg_notify", "file:"+strings.ReplaceAll(t.Name(), "/", "_")+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestDeleteProject(t *testing.T) {
+	tests := []struct {
+		name       string
+		otherOwner bool
+		failDelete bool
+		wantErr    bool
+		// deliveries is the number of project.deleted deliveries recorded.
+		deliveries int
+	}{
+		{name: "deleted", deliveries: 1},
+		{name: "not the owner", otherOwner: true, wantErr: true},
+		// Receivers must not be told about a deletion that did not happen.
+		{name: "delete fails", failDelete: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			if tt.failDelete {
+				client.Project.Use(func(next ent.Mutator) ent.Mutator {
+					return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+						if m.Op().Is(ent.OpDelete) {
+							return nil, errors.New("database is down")
+						}
+						return next.Mutate(ctx, m)
+					})
+				})
+			}
+			bus := &events.Bus{Client: client}
+			bus.OnPublish((&webhooks.Service{Client: client}).Record)
+			s := &Service{Client: client, Events: bus}
+
+			owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+			p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+			client.Webhook.Create().SetURL("https://example.com/hook").SetSecret("s").SetProject(p).SaveX(ctx)
+			client.Webhook.Create().SetURL("https://example.com/other").SetSecret("s").SetEvents([]string{webhooks.EventDocumentIndexed}).SetProject(p).SaveX(ctx)
+
+			requester := owner.ID
+			if tt.otherOwner {
+				requester = uuid.New()
+			}
+			err := s.DeleteProject(ctx, p.ID, requester)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want one: %v", err, tt.wantErr)
+			}
+			if exists := client.Project.Query().Where(project.ID(p.ID)).ExistX(ctx); exists != tt.wantErr {
+				t.Errorf("project exists %v after the delete", exists)
+			}
+
+			deliveries := client.WebhookDelivery.Query().AllX(ctx)
+			if len(deliveries) != tt.deliveries {
+				t.Fatalf("%d deliveries recorded, want %d", len(deliveries), tt.deliveries)
+			}
+			for _, d := range deliveries {
+				if d.Event != events.ProjectDeleted || d.URL != "https://example.com/hook" {
+					t.Errorf("recorded %s to %s", d.Event, d.URL)
+				}
+			}
+		})
+	}
+}
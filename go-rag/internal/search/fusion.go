@@ -0,0 +1,45 @@
+package search
+
+import "sort"
+
+// rrfK dampens the contribution of top ranks in reciprocal rank fusion.
+// 60 is the constant from the original RRF paper.
+const rrfK = 60
+
+// scoredChunk is a chunk ID with the score assigned by a single retriever.
+type scoredChunk struct {
+	ChunkID int
+	Score   float32
+}
+
+// rankedList is one retriever's ranking and its weight in the fusion.
+type rankedList struct {
+	Hits   []scoredChunk
+	Weight float64
+}
+
+// reciprocalRankFusion merges several rankings into one. Each chunk scores
+// sum(weight / (rrfK + rank)) over the lists it appears in, so chunks ranked
+// well by several retrievers rise to the top regardless of raw score scales.
+func reciprocalRankFusion(lists []rankedList) []scoredChunk {
+	scores := make(map[int]float64)
+	var order []int
+	for _, list := range lists {
+		for i, hit := range list.Hits {
+			if _, seen := scores[hit.ChunkID]; !seen {
+				order = append(order, hit.ChunkID)
+			}
+			scores[hit.ChunkID] += list.Weight / float64(rrfK+i+1)
+		}
+	}
+
+	fused := make([]scoredChunk, 0, len(order))
+	for _, id := range order {
+		fused = append(fused, scoredChunk{ChunkID: id, Score: float32(scores[id])})
+	}
+	// Stable sort keeps first-seen order for ties.
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Score > fused[j].Score
+	})
+	return fused
+}
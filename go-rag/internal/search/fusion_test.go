@@ -0,0 +1,102 @@
+package search
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// hits returns a ranking of the chunk IDs, best first.
+func hits(ids ...int) []scoredChunk {
+	out := make([]scoredChunk, len(ids))
+	for i, id := range ids {
+		out[i] = scoredChunk{ChunkID: id, Score: float32(len(ids) - i)}
+	}
+	return out
+}
+
+func fusedIDs(fused []scoredChunk) []int {
+	ids := make([]int, len(fused))
+	for i, f := range fused {
+		ids[i] = f.ChunkID
+	}
+	return ids
+}
+
+func TestReciprocalRankFusion(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists []rankedList
+		want  []int
+	}{
+		{
+			name: "no lists",
+			want: []int{},
+		},
+		{
+			name:  "single list keeps its order",
+			lists: []rankedList{{Hits: hits(3, 1, 2), Weight: 1}},
+			want:  []int{3, 1, 2},
+		},
+		{
+			name: "found by both retrievers ranks first",
+			lists: []rankedList{
+				{Hits: hits(1, 2, 3), Weight: 1},
+				{Hits: hits(4, 5, 3), Weight: 1},
+			},
+			want: []int{3, 1, 4, 2, 5},
+		},
+		{
+			name: "ties keep the order first seen",
+			lists: []rankedList{
+				{Hits: hits(1, 2), Weight: 1},
+				{Hits: hits(2, 1), Weight: 1},
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "heavier list wins disagreements",
+			lists: []rankedList{
+				{Hits: hits(1, 2), Weight: 1},
+				{Hits: hits(2, 1), Weight: 2},
+			},
+			want: []int{2, 1},
+		},
+		{
+			name: "zero weight contributes nothing",
+			lists: []rankedList{
+				{Hits: hits(1, 2, 3), Weight: 0},
+				{Hits: hits(3, 2), Weight: 1},
+			},
+			want: []int{3, 2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fusedIDs(reciprocalRankFusion(tt.lists))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fused order %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReciprocalRankFusionScores(t *testing.T) {
+	fused := reciprocalRankFusion([]rankedList{
+		{Hits: hits(1, 2), Weight: 1},
+		{Hits: hits(2), Weight: 0.5},
+	})
+	// Raw retriever scores are ignored; only ranks count.
+	want := map[int]float64{
+		1: 1.0 / (rrfK + 1),
+		2: 1.0/(rrfK+2) + 0.5/(rrfK+1),
+	}
+	if len(fused) != len(want) {
+		t.Fatalf("got %d chunks, want %d", len(fused), len(want))
+	}
+	for _, f := range fused {
+		if math.Abs(float64(f.Score)-want[f.ChunkID]) > 1e-6 {
+			t.Errorf("chunk %d scores %v, want %v", f.ChunkID, f.Score, want[f.ChunkID])
+		}
+	}
+}
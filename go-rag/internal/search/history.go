@@ -0,0 +1,210 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/predicate"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/queryresult"
+	"go-rag/ent/ent/user"
+	"go-rag/ent/ent/userprompt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// QuerySummary is a past query as shown in a project's query history.
+type QuerySummary struct {
+	ID          int       `json:"id"`
+	QueryText   string    `json:"query_text"`
+	ResultCount int       `json:"result_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// QueryDetail is a past query together with the results it returned.
+type QueryDetail struct {
+	ID        int       `json:"id"`
+	QueryText string    `json:"query_text"`
+	Answer    string    `json:"answer,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Results   []Result  `json:"results"`
+}
+
+// RecordQuery persists a query as a UserPrompt and one QueryResult per result.
+// The answer is stored alongside the prompt when one was generated.
+func (s *Service) RecordQuery(ctx context.Context, req SearchRequest, answer string, results []Result) (*ent.UserPrompt, error) {
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	promptCreate := tx.UserPrompt.
+		Create().
+		SetQueryText(req.Query).
+		SetUserID(req.OwnerID).
+		SetProjectID(req.ProjectID)
+	if answer != "" {
+		promptCreate.SetAnswer(answer)
+	}
+	if req.ConversationID != 0 {
+		promptCreate.SetConversationID(req.ConversationID)
+	}
+
+	prompt, err := promptCreate.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to save user prompt: %w", err)
+	}
+
+	if len(results) > 0 {
+		builders := make([]*ent.QueryResultCreate, 0, len(results))
+		for _, r := range results {
+			builders = append(builders, tx.QueryResult.
+				Create().
+				SetRank(r.Rank).
+				SetScore(float64(r.Score)).
+				SetContentSnippet(r.Content).
+				SetDocumentID(r.DocumentID).
+				SetDocumentName(r.DocumentName).
+				SetChunkIndex(r.ChunkIndex).
+				SetQuery(prompt).
+				AddChunkIDs(r.ChunkID))
+		}
+		if _, err := tx.QueryResult.CreateBulk(builders...).Save(ctx); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to save query results: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"query_id":     prompt.ID,
+		"result_count": len(results),
+	}).Info("service: query recorded in history")
+	return prompt, nil
+}
+
+// ListQueries returns the user's past queries for a project, newest first.
+func (s *Service) ListQueries(ctx context.Context, projectID int, ownerID uuid.UUID) ([]QuerySummary, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: listing queries for project")
+
+	owned := []predicate.UserPrompt{
+		userprompt.HasUserWith(user.ID(ownerID)),
+		userprompt.HasProjectWith(
+			project.ID(projectID),
+			project.HasOwnerWith(user.ID(ownerID)),
+		),
+	}
+	prompts, err := s.Client.UserPrompt.
+		Query().
+		Where(owned...).
+		Order(ent.Desc(userprompt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list queries from database")
+		return nil, err
+	}
+
+	// Count the results of every listed query in the database rather than
+	// loading them.
+	var counts []struct {
+		QueryID int `json:"user_prompt_results"`
+		Count   int `json:"count"`
+	}
+	err = s.Client.QueryResult.
+		Query().
+		Where(queryresult.HasQueryWith(owned...)).
+		GroupBy(queryresult.QueryColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		log.WithError(err).Error("service: failed to count query results")
+		return nil, err
+	}
+	resultCounts := make(map[int]int, len(counts))
+	for _, c := range counts {
+		resultCounts[c.QueryID] = c.Count
+	}
+
+	summaries := make([]QuerySummary, 0, len(prompts))
+	for _, p := range prompts {
+		summaries = append(summaries, QuerySummary{
+			ID:          p.ID,
+			QueryText:   p.QueryText,
+			ResultCount: resultCounts[p.ID],
+			CreatedAt:   p.CreatedAt,
+		})
+	}
+
+	log.WithField("count", len(summaries)).Info("service: queries listed successfully")
+	return summaries, nil
+}
+
+// GetQuery returns a past query and its results in their original rank order.
+func (s *Service) GetQuery(ctx context.Context, projectID, queryID int, ownerID uuid.UUID) (*QueryDetail, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"query_id":   queryID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: getting query by id")
+
+	p, err := s.Client.UserPrompt.
+		Query().
+		Where(
+			userprompt.ID(queryID),
+			userprompt.HasUserWith(user.ID(ownerID)),
+			userprompt.HasProjectWith(
+				project.ID(projectID),
+				project.HasOwnerWith(user.ID(ownerID)),
+			),
+		).
+		WithResults(func(q *ent.QueryResultQuery) {
+			q.Order(ent.Asc(queryresult.FieldRank)).WithChunks()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: query not found or access denied")
+			return nil, fmt.Errorf("query not found or access denied")
+		}
+		log.WithError(err).Error("service: database error while getting query")
+		return nil, err
+	}
+
+	detail := &QueryDetail{
+		ID:        p.ID,
+		QueryText: p.QueryText,
+		Answer:    p.Answer,
+		CreatedAt: p.CreatedAt,
+		Results:   make([]Result, 0, len(p.Edges.Results)),
+	}
+	for _, qr := range p.Edges.Results {
+		r := Result{
+			Rank:         qr.Rank,
+			Score:        float32(qr.Score),
+			ChunkIndex:   qr.ChunkIndex,
+			DocumentID:   qr.DocumentID,
+			DocumentName: qr.DocumentName,
+			Content:      qr.ContentSnippet,
+		}
+		// The chunk edge is dropped when the document is re-chunked.
+		if len(qr.Edges.Chunks) > 0 {
+			r.ChunkID = qr.Edges.Chunks[0].ID
+			r.setLocation(qr.Edges.Chunks[0])
+		}
+		detail.Results = append(detail.Results, r)
+	}
+
+	log.Info("service: query retrieved successfully")
+	return detail, nil
+}
@@ -0,0 +1,104 @@
+package search
+
+import (
+	"context"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/userprompt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestClient returns a client of a fresh in-memory database.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client, err := ent.Open(dialect.SQLite, "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testProject creates a project with one chunked document and returns the
+// project, its owner and the chunk.
+func testProject(t *testing.T, client *ent.Client) (*ent.Project, *ent.User, *ent.Chunk) {
+	t.Helper()
+	ctx := context.Background()
+	owner := client.User.Create().SetEmail("owner@example.com").SetPasswordHash("x").SaveX(ctx)
+	p := client.Project.Create().SetName("p").SetOwner(owner).SaveX(ctx)
+	doc := client.Document.Create().SetName("a.md").SetPath("a.md").SetContent("# A\n").SetProject(p).SaveX(ctx)
+	c := client.Chunk.Create().SetContent("# A").SetContentHash("h").SetIndex(0).SetDocument(doc).SaveX(ctx)
+	return p, owner, c
+}
+
+func TestRecordQueryAddsConversationTurn(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := &Service{Client: client}
+	p, owner, c := testProject(t, client)
+	conv := client.Conversation.Create().SetProject(p).SetOwner(owner).SaveX(ctx)
+
+	results := []Result{{Rank: 1, Score: 0.5, ChunkID: c.ID, DocumentID: c.QueryDocument().OnlyIDX(ctx), Content: c.Content}}
+	prompt, err := s.RecordQuery(ctx, SearchRequest{ProjectID: p.ID, OwnerID: owner.ID, Query: "what is a?", ConversationID: conv.ID}, "A is a.", results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	turn := client.UserPrompt.Query().Where(userprompt.ID(prompt.ID)).WithConversation().WithResults().OnlyX(ctx)
+	if turn.Edges.Conversation == nil || turn.Edges.Conversation.ID != conv.ID {
+		t.Errorf("prompt not recorded as a turn of conversation %d", conv.ID)
+	}
+	if len(turn.Edges.Results) != 1 {
+		t.Errorf("got %d results recorded with the turn, want 1", len(turn.Edges.Results))
+	}
+}
+
+func TestListQueriesCountsResults(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := &Service{Client: client}
+	p, owner, c := testProject(t, client)
+	docID := c.QueryDocument().OnlyIDX(ctx)
+
+	// Another owner's project and query must not show up or be counted.
+	other := client.User.Create().SetEmail("other@example.com").SetPasswordHash("x").SaveX(ctx)
+	otherProject := client.Project.Create().SetName("q").SetOwner(other).SaveX(ctx)
+
+	record := func(projectID int, ownerID uuid.UUID, query string, n int) {
+		t.Helper()
+		results := make([]Result, n)
+		for i := range results {
+			results[i] = Result{Rank: i + 1, ChunkID: c.ID, DocumentID: docID}
+		}
+		if _, err := s.RecordQuery(ctx, SearchRequest{ProjectID: projectID, OwnerID: ownerID, Query: query}, "", results); err != nil {
+			t.Fatal(err)
+		}
+	}
+	record(p.ID, owner.ID, "none", 0)
+	record(p.ID, owner.ID, "three", 3)
+	record(p.ID, owner.ID, "one", 1)
+	record(otherProject.ID, other.ID, "elsewhere", 2)
+
+	summaries, err := s.ListQueries(ctx, p.ID, owner.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{"none": 0, "three": 3, "one": 1}
+	if len(summaries) != len(want) {
+		t.Fatalf("listed %d queries, want %d", len(summaries), len(want))
+	}
+	for _, q := range summaries {
+		n, ok := want[q.QueryText]
+		if !ok {
+			t.Errorf("listed query %q of another project", q.QueryText)
+		} else if q.ResultCount != n {
+			t.Errorf("query %q: %d results, want %d", q.QueryText, q.ResultCount, n)
+		}
+	}
+}
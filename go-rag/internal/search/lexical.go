@@ -0,0 +1,46 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"go-rag/services/embed"
+)
+
+// lexicalQuery ranks a project's chunks against the query with Postgres full-text
+// search. The plainto_tsquery terms are OR-ed together so that a chunk matching
+// only some identifiers of the query is still a candidate. Empty language and
+// heading filters match every chunk.
+const lexicalQuery = `
+SELECT c."id", ts_rank_cd(c."content_tsv", q.query) AS score
+FROM "chunks" c
+JOIN "documents" d ON d."id" = c."document_chunks",
+     (SELECT NULLIF(replace(plainto_tsquery($1::regconfig, $2)::text, '&', '|'), '')::tsquery AS query) q
+WHERE d."project_documents" = $3
+  AND c."content_tsv" @@ q.query
+  AND ($5 = '' OR c."metadata"->>'language' = $5)
+  AND ($6 = '' OR c."metadata"->'headings' ? $6)
+ORDER BY score DESC, c."id"
+LIMIT $4`
+
+// lexicalSearch returns the project's chunks that best match the query terms.
+func (s *Service) lexicalSearch(ctx context.Context, req SearchRequest, limit int) ([]scoredChunk, error) {
+	rows, err := s.Client.QueryContext(ctx, lexicalQuery, embed.TextSearchConfig, req.Query, req.ProjectID, limit, req.Language, req.Heading)
+	if err != nil {
+		return nil, fmt.Errorf("could not run full-text search: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []scoredChunk
+	for rows.Next() {
+		var hit scoredChunk
+		if err := rows.Scan(&hit.ChunkID, &hit.Score); err != nil {
+			return nil, fmt.Errorf("could not scan full-text search row: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read full-text search rows: %w", err)
+	}
+	req.progress(StageLexical, len(hits))
+	return hits, nil
+}
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"sort"
+
+	"go-rag/services/proto"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultRerankCandidates is how many candidates are retrieved for reranking
+// when the request does not set a pool size.
+const defaultRerankCandidates = 50
+
+// rerankPool returns the number of candidates to retrieve for reranking,
+// never fewer than the requested limit nor more than maxCandidates.
+func rerankPool(requested, limit int) int {
+	pool := requested
+	if pool <= 0 {
+		pool = defaultRerankCandidates
+	}
+	if pool < limit {
+		pool = limit
+	}
+	if pool > maxCandidates {
+		pool = maxCandidates
+	}
+	return pool
+}
+
+// rerank reorders the candidates by cross-encoder score. If the reranker fails
+// or is unavailable, the retrieval order is kept.
+func (s *Service) rerank(ctx context.Context, query string, candidates []Result) []Result {
+	if len(candidates) == 0 {
+		return candidates
+	}
+
+	passages := make([]string, len(candidates))
+	for i, c := range candidates {
+		passages[i] = c.Content
+	}
+
+	res, err := s.InferenceClient.Rerank(ctx, &proto.RerankRequest{Query: query, Passages: passages})
+	if err != nil {
+		logrus.WithError(err).Warn("service: reranker unavailable, falling back to retrieval order")
+		return candidates
+	}
+	if len(res.GetScores()) != len(candidates) {
+		logrus.WithFields(logrus.Fields{
+			"candidates": len(candidates),
+			"scores":     len(res.GetScores()),
+		}).Warn("service: reranker returned mismatched scores, falling back to retrieval order")
+		return candidates
+	}
+
+	reranked := make([]Result, len(candidates))
+	copy(reranked, candidates)
+	for i := range reranked {
+		reranked[i].Score = res.GetScores()[i]
+	}
+	// Stable sort keeps retrieval order for equal reranker scores.
+	sort.SliceStable(reranked, func(i, j int) bool {
+		return reranked[i].Score > reranked[j].Score
+	})
+	for i := range reranked {
+		reranked[i].Rank = i + 1
+	}
+	return reranked
+}
@@ -0,0 +1,133 @@
+package search
+
+import (
+	"context"
+	"net"
+	"slices"
+	"testing"
+
+	"go-rag/services/fakeinferencer"
+	"go-rag/services/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// failingReranker is the fake Inferencer with a Rerank RPC that always fails.
+type failingReranker struct {
+	fakeinferencer.Server
+}
+
+func (*failingReranker) Rerank(context.Context, *proto.RerankRequest) (*proto.RerankResponse, error) {
+	return nil, status.Error(codes.Unavailable, "reranker is down")
+}
+
+// shortReranker is the fake Inferencer with a Rerank RPC that drops a score.
+type shortReranker struct {
+	fakeinferencer.Server
+}
+
+func (s *shortReranker) Rerank(ctx context.Context, req *proto.RerankRequest) (*proto.RerankResponse, error) {
+	res, err := s.Server.Rerank(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Scores = res.Scores[1:]
+	return res, nil
+}
+
+// newInferencer serves an Inferencer over an in-memory listener and returns
+// a client of it.
+func newInferencer(t *testing.T, srv proto.InferencerServer) proto.InferencerClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterInferencerServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewInferencerClient(conn)
+}
+
+// candidates are hits in retrieval order. The fake reranker scores them by
+// how many of the query's terms they contain.
+func candidates() []Result {
+	return []Result{
+		{Rank: 1, Score: 0.9, ChunkID: 1, Content: "Installing the command line tools."},
+		{Rank: 2, Score: 0.8, ChunkID: 2, Content: "Configure the webhook secret and retry policy."},
+		{Rank: 3, Score: 0.7, ChunkID: 3, Content: "The webhook retry policy doubles the backoff."},
+		{Rank: 4, Score: 0.6, ChunkID: 4, Content: "Release notes."},
+		{Rank: 5, Score: 0.5, ChunkID: 5, Content: "Webhook deliveries are signed."},
+	}
+}
+
+func chunkIDs(results []Result) []int {
+	ids := make([]int, len(results))
+	for i, r := range results {
+		ids[i] = r.ChunkID
+	}
+	return ids
+}
+
+func TestRerankOrdersByRerankerScore(t *testing.T) {
+	s := &Service{InferenceClient: newInferencer(t, &fakeinferencer.Server{})}
+
+	got := s.rerank(context.Background(), "webhook retry policy", candidates())
+
+	// 2 and 3 match all three terms and keep their retrieval order, 5 matches
+	// one, and the rest none.
+	if want := []int{2, 3, 5, 1, 4}; !slices.Equal(chunkIDs(got), want) {
+		t.Fatalf("reranked order %v, want %v", chunkIDs(got), want)
+	}
+	for i, r := range got {
+		if r.Rank != i+1 {
+			t.Errorf("result %d has rank %d", i, r.Rank)
+		}
+		if i > 0 && r.Score > got[i-1].Score {
+			t.Errorf("result %d scores %v, above the result before it", i, r.Score)
+		}
+	}
+	if got[0].Score != 1 {
+		t.Errorf("top score %v, want the reranker's 1", got[0].Score)
+	}
+}
+
+func TestRerankFallsBackToRetrievalOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		srv  proto.InferencerServer
+	}{
+		{name: "rerank fails", srv: &failingReranker{}},
+		{name: "scores mismatch", srv: &shortReranker{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{InferenceClient: newInferencer(t, tt.srv)}
+			want := candidates()
+
+			got := s.rerank(context.Background(), "webhook retry policy", candidates())
+
+			if !slices.Equal(chunkIDs(got), chunkIDs(want)) {
+				t.Fatalf("order %v, want the retrieval order %v", chunkIDs(got), chunkIDs(want))
+			}
+			for i := range got {
+				if got[i].Rank != want[i].Rank || got[i].Score != want[i].Score {
+					t.Errorf("result %d is rank %d score %v, want it unchanged", i, got[i].Rank, got[i].Score)
+				}
+			}
+		})
+	}
+}
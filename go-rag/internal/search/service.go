@@ -0,0 +1,372 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/chunk"
+	"go-rag/ent/ent/document"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"go-rag/services/embed"
+	"go-rag/services/proto"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+	"github.com/sirupsen/logrus"
+)
+
+// Default and maximum number of hits returned by a single search.
+const (
+	defaultLimit = 10
+	maxLimit     = 50
+)
+
+// Hybrid search fetches this many candidates per requested result from each
+// retriever before fusing, up to maxCandidates.
+const (
+	candidatesPerResult = 4
+	maxCandidates       = 200
+)
+
+// Mode selects the retrieval strategy used by a search. Searches that do not
+// set one use ModeVector; hybrid search has to be asked for.
+type Mode string
+
+const (
+	ModeVector  Mode = "vector"
+	ModeLexical Mode = "lexical"
+	ModeHybrid  Mode = "hybrid"
+)
+
+// Service handles semantic retrieval over a project's chunks.
+type Service struct {
+	Client             *ent.Client
+	InferenceClient    proto.InferencerClient
+	QdrantPointsClient qdrant.PointsClient
+}
+
+// SearchRequest defines the parameters for searching a project.
+// Language and Heading, when set, restrict the search to chunks in that
+// language or under a heading with that exact title.
+// Weights only apply to hybrid mode; when both are zero they default to 1.
+// With Rerank set, RerankCandidates hits are retrieved and reordered by the
+// reranker before being cut down to Limit. Progress, when set, is called as
+// each retrieval stage completes with the number of hits it produced.
+// ConversationID, when set, records the query as that conversation's next
+// turn.
+type SearchRequest struct {
+	ProjectID        int
+	OwnerID          uuid.UUID
+	Query            string
+	Limit            int
+	Mode             Mode
+	Language         string
+	Heading          string
+	VectorWeight     float64
+	LexicalWeight    float64
+	Rerank           bool
+	RerankCandidates int
+	Progress         func(stage string, count int)
+	ConversationID   int
+}
+
+// Retrieval stages reported through SearchRequest.Progress.
+const (
+	StageVector   = "vector"
+	StageLexical  = "lexical"
+	StageFused    = "fused"
+	StageHydrated = "hydrated"
+	StageReranked = "reranked"
+)
+
+// progress reports a completed stage if the caller asked for progress.
+func (req SearchRequest) progress(stage string, count int) {
+	if req.Progress != nil {
+		req.Progress(stage, count)
+	}
+}
+
+// Result is a single ranked chunk returned by a search, with where in its
+// document the chunk comes from. ChunkID is zero, and the location unknown,
+// when a historical hit's chunk has since been re-chunked away.
+type Result struct {
+	Rank         int     `json:"rank"`
+	Score        float32 `json:"score"`
+	ChunkID      int     `json:"chunk_id,omitempty"`
+	ChunkIndex   int     `json:"chunk_index"`
+	DocumentID   int     `json:"document_id"`
+	DocumentName string  `json:"document_name"`
+	HeadingPath  string  `json:"heading_path,omitempty"`
+	Language     string  `json:"language,omitempty"`
+	StartLine    int     `json:"start_line,omitempty"`
+	EndLine      int     `json:"end_line,omitempty"`
+	Page         int     `json:"page,omitempty"`
+	Content      string  `json:"content"`
+}
+
+// setLocation fills in where in its document the result's chunk comes from.
+func (r *Result) setLocation(c *ent.Chunk) {
+	r.StartLine = c.StartLine
+	r.EndLine = c.EndLine
+	r.Language, _ = c.Metadata["language"].(string)
+	if page, ok := c.Metadata["page"].(float64); ok {
+		r.Page = int(page)
+	}
+	// Metadata is decoded from JSON, so numbers are float64 and the
+	// breadcrumb is a []interface{}.
+	if headings, ok := c.Metadata["headings"].([]interface{}); ok {
+		parts := make([]string, 0, len(headings))
+		for _, h := range headings {
+			if s, ok := h.(string); ok && s != "" {
+				parts = append(parts, s)
+			}
+		}
+		r.HeadingPath = strings.Join(parts, " > ")
+	}
+}
+
+// SearchResponse is the ranked result list along with the ID of the recorded query.
+type SearchResponse struct {
+	QueryID int      `json:"query_id,omitempty"`
+	Results []Result `json:"results"`
+}
+
+// Search returns the project's most relevant chunks for the query, ranked by score.
+// Every search is recorded as a UserPrompt with one QueryResult per hit.
+func (s *Service) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	results, err := s.Retrieve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SearchResponse{Results: results}
+
+	// A failure to record history should not fail the search itself.
+	prompt, err := s.RecordQuery(ctx, req, "", results)
+	if err != nil {
+		logrus.WithError(err).WithField("project_id", req.ProjectID).Error("service: failed to record search history")
+	} else {
+		resp.QueryID = prompt.ID
+	}
+
+	return resp, nil
+}
+
+// Retrieve runs the requested retrieval mode and hydrates the hits from Postgres
+// without recording the query in the project's history.
+func (s *Service) Retrieve(ctx context.Context, req SearchRequest) ([]Result, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+		"mode":       req.Mode,
+	})
+	log.Info("service: searching project")
+
+	mode := req.Mode
+	if mode == "" {
+		mode = ModeVector
+	}
+	if mode != ModeVector && mode != ModeLexical && mode != ModeHybrid {
+		return nil, fmt.Errorf("invalid search mode %q", mode)
+	}
+	if req.VectorWeight < 0 || req.LexicalWeight < 0 {
+		return nil, fmt.Errorf("invalid search weights: weights must not be negative")
+	}
+
+	// Security Check: Ensure the user owns the project.
+	exists, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+	if !exists {
+		log.Warn("service: attempt to search a non-existent or unowned project")
+		return nil, fmt.Errorf("project not found or access denied")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	// Over-fetch candidates when a reranking stage follows retrieval.
+	fetch := limit
+	if req.Rerank {
+		fetch = rerankPool(req.RerankCandidates, limit)
+	}
+
+	var hits []scoredChunk
+	switch mode {
+	case ModeVector:
+		hits, err = s.vectorSearch(ctx, req, fetch)
+	case ModeLexical:
+		hits, err = s.lexicalSearch(ctx, req, fetch)
+	case ModeHybrid:
+		hits, err = s.hybridSearch(ctx, req, fetch)
+	}
+	if err != nil {
+		log.WithError(err).Error("service: retrieval failed")
+		return nil, err
+	}
+
+	results, err := s.hydrate(ctx, req.ProjectID, hits)
+	if err != nil {
+		log.WithError(err).Error("service: failed to load chunks for search hits")
+		return nil, err
+	}
+	req.progress(StageHydrated, len(results))
+
+	if req.Rerank {
+		results = s.rerank(ctx, req.Query, results)
+		req.progress(StageReranked, len(results))
+	}
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	log.WithFields(logrus.Fields{
+		"hits":    len(hits),
+		"results": len(results),
+	}).Info("service: search completed successfully")
+	return results, nil
+}
+
+// hybridSearch over-fetches from both retrievers and fuses their rankings.
+func (s *Service) hybridSearch(ctx context.Context, req SearchRequest, limit int) ([]scoredChunk, error) {
+	pool := limit * candidatesPerResult
+	if pool > maxCandidates {
+		pool = maxCandidates
+	}
+
+	vectorHits, err := s.vectorSearch(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+	lexicalHits, err := s.lexicalSearch(ctx, req, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	vectorWeight, lexicalWeight := req.VectorWeight, req.LexicalWeight
+	if vectorWeight == 0 && lexicalWeight == 0 {
+		vectorWeight, lexicalWeight = 1, 1
+	}
+
+	fused := reciprocalRankFusion([]rankedList{
+		{Hits: vectorHits, Weight: vectorWeight},
+		{Hits: lexicalHits, Weight: lexicalWeight},
+	})
+	if len(fused) > limit {
+		fused = fused[:limit]
+	}
+	req.progress(StageFused, len(fused))
+	return fused, nil
+}
+
+// vectorSearch embeds the query and searches Qdrant, scoped to the owner's project.
+func (s *Service) vectorSearch(ctx context.Context, req SearchRequest, limit int) ([]scoredChunk, error) {
+	res, err := s.InferenceClient.GetEmbedding(ctx, &proto.EmbeddingRequest{Text: req.Query})
+	if err != nil {
+		return nil, fmt.Errorf("could not embed query: %w", err)
+	}
+
+	searchRes, err := s.QdrantPointsClient.Search(ctx, &qdrant.SearchPoints{
+		CollectionName: embed.CollectionName,
+		Vector:         res.Embedding,
+		Filter: &qdrant.Filter{
+			Must: vectorConditions(req),
+		},
+		Limit: uint64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not search vectors: %w", err)
+	}
+
+	// Point IDs are chunk IDs.
+	hits := make([]scoredChunk, 0, len(searchRes.GetResult()))
+	for _, point := range searchRes.GetResult() {
+		hits = append(hits, scoredChunk{
+			ChunkID: int(point.GetId().GetNum()),
+			Score:   point.GetScore(),
+		})
+	}
+	req.progress(StageVector, len(hits))
+	return hits, nil
+}
+
+// vectorConditions scopes a vector search to the owner's project and the
+// request's metadata filters.
+func vectorConditions(req SearchRequest) []*qdrant.Condition {
+	conditions := []*qdrant.Condition{
+		qdrant.NewMatchKeyword("user_id", req.OwnerID.String()),
+		qdrant.NewMatchInt("project_id", int64(req.ProjectID)),
+	}
+	if req.Language != "" {
+		conditions = append(conditions, qdrant.NewMatchKeyword("language", req.Language))
+	}
+	if req.Heading != "" {
+		conditions = append(conditions, qdrant.NewMatchKeyword("headings", req.Heading))
+	}
+	return conditions
+}
+
+// hydrate loads the chunks behind the hits, keeping the hit order and
+// skipping hits whose chunk no longer exists.
+func (s *Service) hydrate(ctx context.Context, projectID int, hits []scoredChunk) ([]Result, error) {
+	if len(hits) == 0 {
+		return []Result{}, nil
+	}
+
+	chunkIDs := make([]int, 0, len(hits))
+	for _, hit := range hits {
+		chunkIDs = append(chunkIDs, hit.ChunkID)
+	}
+
+	chunks, err := s.Client.Chunk.
+		Query().
+		Where(
+			chunk.IDIn(chunkIDs...),
+			chunk.HasDocumentWith(document.HasProjectWith(project.ID(projectID))),
+		).
+		WithDocument().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chunksByID := make(map[int]*ent.Chunk, len(chunks))
+	for _, c := range chunks {
+		chunksByID[c.ID] = c
+	}
+
+	results := make([]Result, 0, len(hits))
+	for _, hit := range hits {
+		c, ok := chunksByID[hit.ChunkID]
+		if !ok || c.Edges.Document == nil {
+			continue
+		}
+		r := Result{
+			Rank:         len(results) + 1,
+			Score:        hit.Score,
+			ChunkID:      c.ID,
+			ChunkIndex:   c.Index,
+			DocumentID:   c.Edges.Document.ID,
+			DocumentName: c.Edges.Document.Name,
+			Content:      c.Content,
+		}
+		r.setLocation(c)
+		results = append(results, r)
+	}
+	return results, nil
+}
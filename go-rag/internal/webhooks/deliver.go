@@ -0,0 +1,391 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/webhook"
+	"go-rag/internal/worker"
+	"go-rag/services/events"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Delivery statuses.
+const (
+	StatusPending    = "pending"
+	StatusDelivering = "delivering"
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+)
+
+// Request headers sent with each delivery.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature-256"
+)
+
+// Defaults, used when the environment does not set them.
+const (
+	defaultWorkers     = 2
+	defaultMaxAttempts = 8
+	defaultBackoff     = 30 * time.Second
+	defaultTimeout     = 10 * time.Second
+	maxBackoff         = time.Hour
+	// pollInterval is how often idle workers look for due deliveries.
+	pollInterval = 2 * time.Second
+	// maxResponseBody is how much of a receiver's response is kept.
+	maxResponseBody = 1024
+)
+
+// Config tunes the delivery of webhooks.
+type Config struct {
+	Workers     int
+	MaxAttempts int
+	// Backoff is the delay before the first retry. It doubles with every
+	// further attempt.
+	Backoff time.Duration
+	// Timeout bounds each delivery request.
+	Timeout time.Duration
+	// AllowPrivateNetworks lets webhooks point at loopback, link-local and
+	// private addresses. Off, a webhook cannot be used to reach services
+	// on the server's own network.
+	AllowPrivateNetworks bool
+}
+
+// LoadConfig reads the delivery settings from WEBHOOK_WORKERS,
+// WEBHOOK_MAX_ATTEMPTS, WEBHOOK_BACKOFF and WEBHOOK_TIMEOUT (durations such
+// as "30s") and WEBHOOK_ALLOW_PRIVATE_NETWORKS, falling back to the
+// defaults.
+func LoadConfig() Config {
+	cfg := Config{
+		Workers:     defaultWorkers,
+		MaxAttempts: defaultMaxAttempts,
+		Backoff:     defaultBackoff,
+		Timeout:     defaultTimeout,
+	}
+	worker.IntFromEnv("WEBHOOK_WORKERS", &cfg.Workers)
+	worker.IntFromEnv("WEBHOOK_MAX_ATTEMPTS", &cfg.MaxAttempts)
+	worker.DurationFromEnv("WEBHOOK_BACKOFF", &cfg.Backoff)
+	worker.DurationFromEnv("WEBHOOK_TIMEOUT", &cfg.Timeout)
+	if v := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"); v != "" {
+		allow, err := strconv.ParseBool(v)
+		if err != nil {
+			logrus.WithField("variable", "WEBHOOK_ALLOW_PRIVATE_NETWORKS").Warn("ignoring invalid setting, using the default")
+		} else {
+			cfg.AllowPrivateNetworks = allow
+		}
+	}
+	logrus.WithFields(logrus.Fields{
+		"workers":                cfg.Workers,
+		"max_attempts":           cfg.MaxAttempts,
+		"backoff":                cfg.Backoff,
+		"timeout":                cfg.Timeout,
+		"allow_private_networks": cfg.AllowPrivateNetworks,
+	}).Info("webhook settings loaded")
+	return cfg
+}
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	// ID identifies the event; it is the same in every webhook's delivery
+	// of it, and in the retries of a delivery.
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	ProjectID int         `json:"project_id"`
+	Data      PayloadData `json:"data"`
+}
+
+// PayloadData describes the document an event is about, if any.
+type PayloadData struct {
+	DocumentID int    `json:"document_id,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// claimQuery takes the next due delivery, or one whose worker's claim
+// expired, skipping deliveries other workers are claiming at the same moment.
+const claimQuery = `
+UPDATE "webhook_deliveries"
+SET "status" = 'delivering',
+    "attempts" = "attempts" + 1,
+    "locked_until" = now() + make_interval(secs => $1),
+    "updated_at" = now()
+WHERE "id" = (
+  SELECT "id" FROM "webhook_deliveries"
+  WHERE ("status" = 'pending' AND "next_attempt_at" <= now())
+     OR ("status" = 'delivering' AND "locked_until" < now())
+  ORDER BY "next_attempt_at", "id"
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING "id", "event", "url", "payload", "secret", "attempts", "max_attempts"`
+
+// claimedDelivery is a delivery a worker holds.
+type claimedDelivery struct {
+	id          int
+	event       string
+	url         string
+	payload     string
+	secret      string
+	attempts    int
+	maxAttempts int
+}
+
+// Sign returns the signature of a delivery attempt, as sent in the
+// X-Webhook-Signature-256 header: "sha256=" and the hex HMAC-SHA256, keyed
+// with the webhook's secret, of the X-Webhook-Timestamp value, a dot and the
+// body. Signing the time lets receivers refuse replayed deliveries.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a delivery as a receiver would: the signature must match the
+// timestamp and body, and the timestamp, in Unix seconds, must be within
+// tolerance of now.
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration) error {
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return fmt.Errorf("invalid webhook signature")
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid webhook timestamp")
+	}
+	if age := time.Since(time.Unix(sec, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("webhook timestamp outside the tolerance")
+	}
+	return nil
+}
+
+// Start starts the delivery workers, which run until ctx is cancelled.
+func (s *Service) Start(ctx context.Context) {
+	if s.Config.Workers < 1 {
+		s.Config = LoadConfig()
+	}
+	if s.HTTPClient == nil {
+		s.HTTPClient = newHTTPClient(s.Config)
+	}
+	s.workers.Start(ctx, "webhooks", s.Config.Workers, pollInterval, s.deliverNext)
+}
+
+// Record stores a delivery of an event for each of the project's active
+// webhooks subscribed to it. It is meant to be registered with the event
+// bus's OnPublish, so that each event is recorded once whichever instance
+// publishes it. When ctx carries a transaction, as when a project is deleted,
+// the deliveries are recorded in it and kept only if it commits.
+func (s *Service) Record(ctx context.Context, ev events.Event) {
+	eventType := webhookEvent(ev)
+	if eventType == "" {
+		return
+	}
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": ev.ProjectID,
+		"event":      eventType,
+	})
+
+	client := s.Client
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	hooks, err := client.Webhook.
+		Query().
+		Where(
+			webhook.HasProjectWith(project.ID(ev.ProjectID)),
+			webhook.Active(true),
+		).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("webhooks: failed to find webhooks for event")
+		return
+	}
+	var targets []*ent.Webhook
+	for _, h := range hooks {
+		if len(h.Events) == 0 || slices.Contains(h.Events, eventType) {
+			targets = append(targets, h)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	p := Payload{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: ev.Time,
+		ProjectID: ev.ProjectID,
+		Data: PayloadData{
+			DocumentID: ev.DocumentID,
+			Path:       ev.Path,
+			Status:     ev.Status,
+			Error:      ev.Error,
+		},
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+	}
+	body, err := json.Marshal(p)
+	if err != nil {
+		log.WithError(err).Error("webhooks: failed to encode payload")
+		return
+	}
+
+	creates := make([]*ent.WebhookDeliveryCreate, len(targets))
+	for i, h := range targets {
+		creates[i] = client.WebhookDelivery.Create().
+			SetEvent(eventType).
+			SetEventID(p.ID).
+			SetURL(h.URL).
+			SetPayload(string(body)).
+			SetSecret(h.Secret).
+			SetMaxAttempts(s.maxAttempts()).
+			SetWebhook(h)
+	}
+	if err := client.WebhookDelivery.CreateBulk(creates...).Exec(ctx); err != nil {
+		log.WithError(err).Error("webhooks: failed to record deliveries")
+		return
+	}
+	s.workers.Wake()
+}
+
+// webhookEvent returns the webhook event type of a bus event, or "" if
+// webhooks are not sent the event.
+func webhookEvent(ev events.Event) string {
+	switch ev.Type {
+	case events.DocumentCreated, events.DocumentUpdated, events.DocumentDeleted, events.ProjectDeleted:
+		return ev.Type
+	case events.DocumentStatus:
+		switch ev.Status {
+		case "completed":
+			return EventDocumentIndexed
+		case "failed":
+			return EventDocumentFailed
+		}
+	}
+	return ""
+}
+
+// deliverNext claims the next due delivery and sends it, reporting false if
+// there was none.
+func (s *Service) deliverNext(ctx context.Context) (bool, error) {
+	// The claim outlasts the request, so a delivery is only taken over when
+	// its worker is gone.
+	lease := s.Config.timeout() + 30*time.Second
+	var d claimedDelivery
+	ok, err := worker.Claim(ctx, s.Client, claimQuery, lease,
+		&d.id, &d.event, &d.url, &d.payload, &d.secret, &d.attempts, &d.maxAttempts)
+	if err != nil || !ok {
+		return false, err
+	}
+	s.deliver(ctx, &d)
+	return true, nil
+}
+
+// deliver sends a claimed delivery and records the outcome. A delivery is
+// successful when the receiver answers with a 2xx status.
+func (s *Service) deliver(ctx context.Context, d *claimedDelivery) {
+	log := logrus.WithFields(logrus.Fields{
+		"delivery_id": d.id,
+		"event":       d.event,
+		"attempt":     d.attempts,
+	})
+
+	start := time.Now()
+	status, body, err := s.send(ctx, d)
+	update := s.Client.WebhookDelivery.UpdateOneID(d.id).
+		ClearLockedUntil().
+		SetResponseBody(body).
+		SetDurationMs(int(time.Since(start).Milliseconds()))
+	if status != 0 {
+		update.SetResponseStatus(status)
+	} else {
+		update.ClearResponseStatus()
+	}
+	if err == nil && (status < 200 || status > 299) {
+		err = fmt.Errorf("receiver answered %d %s", status, http.StatusText(status))
+	}
+
+	switch {
+	case err == nil:
+		update.SetStatus(StatusSucceeded).ClearLastError().SetDeliveredAt(time.Now())
+		log.Info("webhooks: delivery succeeded")
+	case d.attempts >= d.maxAttempts:
+		update.SetStatus(StatusFailed).SetLastError(err.Error())
+		log.WithError(err).Error("webhooks: delivery failed on its last attempt")
+	default:
+		delay := worker.Backoff(s.backoff(), maxBackoff, d.attempts)
+		update.SetStatus(StatusPending).SetLastError(err.Error()).SetNextAttemptAt(time.Now().Add(delay))
+		log.WithError(err).WithField("retry_in", delay).Warn("webhooks: delivery failed, retrying later")
+	}
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		log.WithError(err).Error("webhooks: failed to record delivery outcome")
+	}
+}
+
+// send posts a delivery to its receiver, returning the response status and
+// the start of the response body.
+func (s *Service) send(ctx context.Context, d *claimedDelivery) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader([]byte(d.payload)))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-rag-webhooks")
+	req.Header.Set(HeaderEvent, d.event)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(d.id))
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(d.secret, timestamp, []byte(d.payload)))
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	// Drain a little more so the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	// Postgres text holds neither invalid UTF-8 nor NUL bytes.
+	body = bytes.ReplaceAll(bytes.ToValidUTF8(body, nil), []byte{0}, nil)
+	return resp.StatusCode, string(body), nil
+}
+
+func (s *Service) maxAttempts() int {
+	if s.Config.MaxAttempts > 0 {
+		return s.Config.MaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+func (c Config) timeout() time.Duration {
+	if c.Timeout > 0 {
+		return c.Timeout
+	}
+	return defaultTimeout
+}
+
+func (s *Service) backoff() time.Duration {
+	if s.Config.Backoff > 0 {
+		return s.Config.Backoff
+	}
+	return defaultBackoff
+}
@@ -0,0 +1,213 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/webhookdelivery"
+	"go-rag/services/events"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// receiver is a webhook endpoint that verifies what it is sent and answers
+// with the queued statuses, then 204.
+type receiver struct {
+	t      *testing.T
+	secret string
+
+	mu       sync.Mutex
+	statuses []int
+	received []Payload
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	err := Verify(rc.secret, r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), body, 5*time.Minute)
+	if err != nil {
+		rc.t.Errorf("receiver: %v", err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	var p Payload
+	if err := json.Unmarshal(body, &p); err != nil || r.Header.Get(HeaderEvent) != p.Type {
+		rc.t.Errorf("receiver: payload %s does not match event %q", body, r.Header.Get(HeaderEvent))
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.received = append(rc.received, p)
+	status := http.StatusNoContent
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+type deliveryTest struct {
+	ctx      context.Context
+	client   *ent.Client
+	service  *Service
+	receiver *receiver
+	hook     *ent.Webhook
+}
+
+func newDeliveryTest(t *testing.T, statuses ...int) *deliveryTest {
+	t.Helper()
+	ctx := context.Background()
+	client, err := ent.Open(dialect.SQLite, "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	rc := &receiver{t: t, secret: "s3cret", statuses: statuses}
+	srv := httptest.NewServer(rc)
+	t.Cleanup(srv.Close)
+
+	cfg := Config{MaxAttempts: 3, Backoff: time.Minute, AllowPrivateNetworks: true}
+	s := &Service{Client: client, Config: cfg, HTTPClient: newHTTPClient(cfg)}
+
+	p := client.Project.Create().SetName("p").SaveX(ctx)
+	hook := client.Webhook.Create().
+		SetURL(srv.URL + "/hook").
+		SetSecret(rc.secret).
+		SetEvents([]string{EventDocumentIndexed}).
+		SetProject(p).
+		SaveX(ctx)
+	return &deliveryTest{ctx: ctx, client: client, service: s, receiver: rc, hook: hook}
+}
+
+// record has the service record a document being indexed and returns the
+// delivery it stored.
+func (dt *deliveryTest) record(t *testing.T) *ent.WebhookDelivery {
+	t.Helper()
+	dt.service.Record(dt.ctx, events.Event{
+		Type:       events.DocumentStatus,
+		ProjectID:  dt.hook.QueryProject().OnlyIDX(dt.ctx),
+		DocumentID: 7,
+		Path:       "docs/a.md",
+		Status:     "completed",
+		Time:       time.Now(),
+	})
+	return dt.client.WebhookDelivery.Query().OnlyX(dt.ctx)
+}
+
+// attempt sends the delivery as a worker that claimed it would, and returns
+// it as recorded afterwards.
+func (dt *deliveryTest) attempt(d *ent.WebhookDelivery) *ent.WebhookDelivery {
+	d = dt.client.WebhookDelivery.UpdateOne(d).
+		SetStatus(StatusDelivering).
+		AddAttempts(1).
+		SaveX(dt.ctx)
+	dt.service.deliver(dt.ctx, &claimedDelivery{
+		id:          d.ID,
+		event:       d.Event,
+		url:         d.URL,
+		payload:     d.Payload,
+		secret:      d.Secret,
+		attempts:    d.Attempts,
+		maxAttempts: d.MaxAttempts,
+	})
+	return dt.client.WebhookDelivery.GetX(dt.ctx, d.ID)
+}
+
+func TestDeliverySignedAndRecorded(t *testing.T) {
+	dt := newDeliveryTest(t)
+	d := dt.record(t)
+	if d.Event != EventDocumentIndexed || d.Status != StatusPending || d.MaxAttempts != 3 {
+		t.Fatalf("recorded %s delivery %s with %d attempts", d.Event, d.Status, d.MaxAttempts)
+	}
+
+	d = dt.attempt(d)
+	if d.Status != StatusSucceeded || d.DeliveredAt == nil || d.LastError != "" {
+		t.Fatalf("delivery is %s (error %q), want succeeded", d.Status, d.LastError)
+	}
+	if d.ResponseStatus == nil || *d.ResponseStatus != http.StatusNoContent {
+		t.Errorf("response status %v, want 204", d.ResponseStatus)
+	}
+	if len(dt.receiver.received) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(dt.receiver.received))
+	}
+	if p := dt.receiver.received[0]; p.Type != EventDocumentIndexed || p.Data.DocumentID != 7 || p.Data.Path != "docs/a.md" {
+		t.Errorf("receiver got %+v", p)
+	}
+}
+
+func TestVerifyRefusesTamperedAndReplayedDeliveries(t *testing.T) {
+	body := []byte(`{"type":"document.indexed"}`)
+	now := time.Now().Unix()
+	ts := func(unix int64) string { return strconv.FormatInt(unix, 10) }
+
+	sig := Sign("s3cret", ts(now), body)
+	if err := Verify("s3cret", ts(now), sig, body, time.Minute); err != nil {
+		t.Fatalf("valid delivery refused: %v", err)
+	}
+	if err := Verify("other", ts(now), sig, body, time.Minute); err == nil {
+		t.Error("accepted with the wrong secret")
+	}
+	if err := Verify("s3cret", ts(now), sig, []byte(`{"type":"project.deleted"}`), time.Minute); err == nil {
+		t.Error("accepted a tampered body")
+	}
+	if err := Verify("s3cret", ts(now+1), sig, body, time.Minute); err == nil {
+		t.Error("accepted a signature for another timestamp")
+	}
+	old := ts(now - 600)
+	if err := Verify("s3cret", old, Sign("s3cret", old, body), body, time.Minute); err == nil {
+		t.Error("accepted a replayed delivery")
+	}
+}
+
+func TestDeliveryRetriedWithBackoff(t *testing.T) {
+	dt := newDeliveryTest(t, http.StatusServiceUnavailable, http.StatusBadGateway)
+	d := dt.record(t)
+
+	// Each failed attempt is retried after twice the previous delay.
+	for attempt, base := range []time.Duration{time.Minute, 2 * time.Minute} {
+		before := time.Now()
+		d = dt.attempt(d)
+		if d.Status != StatusPending {
+			t.Fatalf("attempt %d: delivery is %s, want pending", attempt+1, d.Status)
+		}
+		if d.ResponseStatus == nil || *d.ResponseStatus < 500 || !strings.Contains(d.LastError, "receiver answered") {
+			t.Errorf("attempt %d: recorded status %v, error %q", attempt+1, d.ResponseStatus, d.LastError)
+		}
+		if wait := d.NextAttemptAt.Sub(before); wait < base || wait > base+base/10+time.Second {
+			t.Errorf("attempt %d: retry in %s, want %s plus jitter", attempt+1, wait, base)
+		}
+	}
+
+	d = dt.attempt(d)
+	if d.Status != StatusSucceeded || d.Attempts != 3 {
+		t.Fatalf("delivery is %s after %d attempts, want succeeded after 3", d.Status, d.Attempts)
+	}
+}
+
+func TestDeliveryFailsAfterMaxAttempts(t *testing.T) {
+	dt := newDeliveryTest(t, 500, 500, 500, 500)
+	d := dt.record(t)
+	for i := 0; i < 3; i++ {
+		d = dt.attempt(d)
+	}
+	if d.Status != StatusFailed || d.Attempts != 3 {
+		t.Fatalf("delivery is %s after %d attempts, want failed after 3", d.Status, d.Attempts)
+	}
+	if !strings.Contains(d.LastError, "500") {
+		t.Errorf("last error %q, want the receiver's answer", d.LastError)
+	}
+	if n := dt.client.WebhookDelivery.Query().Where(webhookdelivery.Status(StatusFailed)).CountX(dt.ctx); n != 1 {
+		t.Errorf("%d failed deliveries listed, want 1", n)
+	}
+}
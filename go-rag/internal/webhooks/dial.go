@@ -0,0 +1,90 @@
+package webhooks
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which like the private
+// ranges only reaches hosts on the server's side.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// internalAddr reports whether an address is in a loopback, link-local,
+// private or otherwise non-public range.
+func internalAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsPrivate() ||
+		addr.IsUnspecified() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// guardDial refuses connections to internal addresses. It runs as the
+// dialer's Control, once the host name was resolved, so that a name cannot
+// pass validation and then resolve to an internal address when the delivery
+// is sent.
+func guardDial(network, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("webhook address %q: %w", address, err)
+	}
+	if internalAddr(ap.Addr()) {
+		return fmt.Errorf("webhook address %s is in a private network", ap.Addr())
+	}
+	return nil
+}
+
+// newHTTPClient returns the client deliveries are sent with. Unless the
+// configuration allows private networks, it only connects to public
+// addresses, and it never uses a proxy, which could reach them on its behalf.
+func newHTTPClient(cfg Config) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.timeout(), KeepAlive: 30 * time.Second}
+	if !cfg.AllowPrivateNetworks {
+		dialer.Control = guardDial
+	}
+	return &http.Client{
+		Timeout: cfg.timeout(),
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		// A redirect is reported as the receiver's answer rather than
+		// followed, so deliveries only go where they were configured to.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// validateURL checks that deliveries can be sent to a URL. Hosts that are
+// internal addresses, or localhost, are refused up front unless private
+// networks are allowed; other names are checked when they are dialed.
+func (s *Service) validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL %q", raw)
+	}
+	if s.Config.AllowPrivateNetworks {
+		return nil
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("invalid webhook URL %q: host is in a private network", raw)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && internalAddr(addr) {
+		return fmt.Errorf("invalid webhook URL %q: host is in a private network", raw)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		private bool
+	}{
+		{url: "https://hooks.example.com/in"},
+		{url: "http://203.0.113.7:8080/in"},
+		{url: "http://127.0.0.1/in", private: true},
+		{url: "http://localhost:9000/in", private: true},
+		{url: "http://api.localhost/in", private: true},
+		{url: "http://10.1.2.3/in", private: true},
+		{url: "http://192.168.0.10/in", private: true},
+		{url: "http://169.254.169.254/latest/meta-data", private: true},
+		{url: "http://100.64.0.1/in", private: true},
+		{url: "http://0.0.0.0/in", private: true},
+		{url: "http://[::1]/in", private: true},
+		{url: "http://[fe80::1]/in", private: true},
+		{url: "http://[fd00::1]/in", private: true},
+		{url: "http://[::ffff:127.0.0.1]/in", private: true},
+	}
+	guarded := &Service{}
+	open := &Service{Config: Config{AllowPrivateNetworks: true}}
+	for _, tt := range tests {
+		err := guarded.validateURL(tt.url)
+		if tt.private != (err != nil) {
+			t.Errorf("%s: got error %v, want refused %v", tt.url, err, tt.private)
+		}
+		if err := open.validateURL(tt.url); err != nil {
+			t.Errorf("%s: refused with private networks allowed: %v", tt.url, err)
+		}
+	}
+
+	for _, raw := range []string{"ftp://example.com/", "example.com/in", "http://"} {
+		if err := open.validateURL(raw); err == nil {
+			t.Errorf("%s: accepted, want it refused", raw)
+		}
+	}
+}
+
+// A name that resolves to an internal address is caught when it is dialed.
+func TestHTTPClientRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	url := strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)
+
+	_, err := newHTTPClient(Config{}).Get(url)
+	if err == nil || !strings.Contains(err.Error(), "private network") {
+		t.Fatalf("got error %v, want the connection refused", err)
+	}
+
+	resp, err := newHTTPClient(Config{AllowPrivateNetworks: true}).Get(url)
+	if err != nil {
+		t.Fatalf("private networks allowed: %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,316 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/project"
+	"go-rag/ent/ent/user"
+	"go-rag/ent/ent/webhook"
+	"go-rag/ent/ent/webhookdelivery"
+	"go-rag/internal/worker"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Event types a webhook can subscribe to.
+const (
+	EventDocumentCreated = "document.created"
+	EventDocumentUpdated = "document.updated"
+	EventDocumentIndexed = "document.indexed"
+	EventDocumentFailed  = "document.failed"
+	EventDocumentDeleted = "document.deleted"
+	EventProjectDeleted  = "project.deleted"
+)
+
+// EventTypes lists every event type a webhook can subscribe to.
+var EventTypes = []string{
+	EventDocumentCreated,
+	EventDocumentUpdated,
+	EventDocumentIndexed,
+	EventDocumentFailed,
+	EventDocumentDeleted,
+	EventProjectDeleted,
+}
+
+// maxListedDeliveries caps how many deliveries ListDeliveries returns,
+// newest first.
+const maxListedDeliveries = 200
+
+// Service manages webhooks and sends them their projects' events. Like the
+// ingest queue, deliveries are stored in Postgres and may be worked by any
+// number of server instances.
+type Service struct {
+	Client *ent.Client
+	// HTTPClient sends the deliveries. When nil, a client with the
+	// configured timeout that does not follow redirects, and only connects
+	// to private networks if the configuration allows it, is used.
+	HTTPClient *http.Client
+	Config     Config
+
+	workers worker.Pool
+}
+
+// CreateWebhookRequest defines the parameters for creating a webhook.
+type CreateWebhookRequest struct {
+	ProjectID int
+	OwnerID   uuid.UUID
+	URL       string
+	// Events to deliver; all when empty.
+	Events []string
+	// Secret signs the deliveries. One is generated when empty.
+	Secret string
+}
+
+// UpdateWebhookRequest defines the changes to a webhook. Nil fields are left
+// unchanged.
+type UpdateWebhookRequest struct {
+	ProjectID int
+	WebhookID int
+	OwnerID   uuid.UUID
+	URL       *string
+	Events    []string
+	Active    *bool
+}
+
+// CreateWebhook subscribes a URL to a project's events. The returned webhook
+// carries its secret, which is not shown again.
+func (s *Service) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*ent.Webhook, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"owner_id":   req.OwnerID,
+	})
+	log.Info("service: creating webhook")
+
+	if err := s.validateURL(req.URL); err != nil {
+		return nil, err
+	}
+	if err := validateEvents(req.Events); err != nil {
+		return nil, err
+	}
+	if req.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			log.WithError(err).Error("service: failed to generate webhook secret")
+			return nil, err
+		}
+		req.Secret = secret
+	}
+
+	p, err := s.Client.Project.
+		Query().
+		Where(
+			project.ID(req.ProjectID),
+			project.HasOwnerWith(user.ID(req.OwnerID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: attempt to create webhook for a non-existent or unowned project")
+			return nil, fmt.Errorf("project not found or access denied")
+		}
+		log.WithError(err).Error("service: failed to verify project ownership")
+		return nil, err
+	}
+
+	hook, err := s.Client.Webhook.
+		Create().
+		SetURL(req.URL).
+		SetSecret(req.Secret).
+		SetEvents(req.Events).
+		SetProject(p).
+		Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to save webhook")
+		return nil, err
+	}
+
+	log.WithField("webhook_id", hook.ID).Info("service: webhook created successfully")
+	return hook, nil
+}
+
+// ListWebhooks retrieves the webhooks of a project owned by the user.
+func (s *Service) ListWebhooks(ctx context.Context, projectID int, ownerID uuid.UUID) ([]*ent.Webhook, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: listing webhooks")
+
+	hooks, err := s.Client.Webhook.
+		Query().
+		Where(webhook.HasProjectWith(
+			project.ID(projectID),
+			project.HasOwnerWith(user.ID(ownerID)),
+		)).
+		Order(ent.Asc(webhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list webhooks")
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// GetWebhook retrieves a webhook of a project owned by the user.
+func (s *Service) GetWebhook(ctx context.Context, projectID, webhookID int, ownerID uuid.UUID) (*ent.Webhook, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"webhook_id": webhookID,
+		"owner_id":   ownerID,
+	})
+
+	hook, err := s.Client.Webhook.
+		Query().
+		Where(
+			webhook.ID(webhookID),
+			webhook.HasProjectWith(
+				project.ID(projectID),
+				project.HasOwnerWith(user.ID(ownerID)),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Warn("service: webhook not found or access denied")
+			return nil, fmt.Errorf("webhook not found or access denied")
+		}
+		log.WithError(err).Error("service: database error while getting webhook")
+		return nil, err
+	}
+	return hook, nil
+}
+
+// UpdateWebhook changes a webhook's URL, events or whether it is active.
+func (s *Service) UpdateWebhook(ctx context.Context, req UpdateWebhookRequest) (*ent.Webhook, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": req.ProjectID,
+		"webhook_id": req.WebhookID,
+		"owner_id":   req.OwnerID,
+	})
+	log.Info("service: updating webhook")
+
+	hook, err := s.GetWebhook(ctx, req.ProjectID, req.WebhookID, req.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := hook.Update()
+	if req.URL != nil {
+		if err := s.validateURL(*req.URL); err != nil {
+			return nil, err
+		}
+		update.SetURL(*req.URL)
+	}
+	if req.Events != nil {
+		if err := validateEvents(req.Events); err != nil {
+			return nil, err
+		}
+		update.SetEvents(req.Events)
+	}
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+	hook, err = update.Save(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to update webhook")
+		return nil, err
+	}
+
+	log.Info("service: webhook updated successfully")
+	return hook, nil
+}
+
+// DeleteWebhook deletes a webhook along with its delivery log. Deliveries
+// still pending are dropped.
+func (s *Service) DeleteWebhook(ctx context.Context, projectID, webhookID int, ownerID uuid.UUID) error {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"webhook_id": webhookID,
+		"owner_id":   ownerID,
+	})
+	log.Info("service: deleting webhook")
+
+	hook, err := s.GetWebhook(ctx, projectID, webhookID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(hook.ID))).
+		Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to delete webhook deliveries")
+		return err
+	}
+	if err := tx.Webhook.DeleteOneID(hook.ID).Exec(ctx); err != nil {
+		log.WithError(err).Error("service: failed to delete webhook")
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Error("service: failed to commit webhook deletion")
+		return err
+	}
+
+	log.Info("service: webhook deleted successfully")
+	return nil
+}
+
+// ListDeliveries retrieves the delivery log of a webhook, optionally only the
+// deliveries in one status, such as those that failed.
+func (s *Service) ListDeliveries(ctx context.Context, projectID, webhookID int, status string, ownerID uuid.UUID) ([]*ent.WebhookDelivery, error) {
+	log := logrus.WithFields(logrus.Fields{
+		"project_id": projectID,
+		"webhook_id": webhookID,
+		"owner_id":   ownerID,
+		"status":     status,
+	})
+	log.Info("service: listing webhook deliveries")
+
+	hook, err := s.GetWebhook(ctx, projectID, webhookID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := hook.QueryDeliveries()
+	if status != "" {
+		query.Where(webhookdelivery.Status(status))
+	}
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Limit(maxListedDeliveries).
+		All(ctx)
+	if err != nil {
+		log.WithError(err).Error("service: failed to list webhook deliveries")
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// validateEvents checks that every event type can be subscribed to.
+func validateEvents(types []string) error {
+	for _, t := range types {
+		if !slices.Contains(EventTypes, t) {
+			return fmt.Errorf("invalid event type %q", t)
+		}
+	}
+	return nil
+}
+
+// generateSecret returns a random signing secret.
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,140 @@
+// Package worker holds what the database-backed queues have in common: the
+// worker loop that claims and runs work, retry backoff and the settings read
+// from the environment.
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Pool runs workers that take work from a queue stored in the database. The
+// zero value is ready to be started; Wake may be called before Start.
+type Pool struct {
+	wake chan struct{}
+}
+
+// Start starts n workers, which run until ctx is cancelled. Each time it is
+// woken, and every poll interval in case work was queued by another
+// instance, a worker calls next until next reports that nothing was due or
+// fails. name prefixes the log messages.
+func (p *Pool) Start(ctx context.Context, name string, n int, poll time.Duration, next func(ctx context.Context) (bool, error)) {
+	p.wake = make(chan struct{}, n)
+	for i := 0; i < n; i++ {
+		go p.work(ctx, logrus.WithField("worker", i), name, poll, next)
+	}
+	logrus.WithField("workers", n).Info(name + ": workers started")
+}
+
+// Wake tells an idle worker that work was queued.
+func (p *Pool) Wake() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// work is one worker's loop.
+func (p *Pool) work(ctx context.Context, log *logrus.Entry, name string, poll time.Duration, next func(ctx context.Context) (bool, error)) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-p.wake:
+		}
+
+		// Drain the queue before waiting again.
+		for ctx.Err() == nil {
+			ran, err := next(ctx)
+			if err != nil {
+				log.WithError(err).Error(name + ": failed to claim work")
+				break
+			}
+			if !ran {
+				break
+			}
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(poll)
+	}
+}
+
+// Querier runs a query, as an ent client does.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Claim runs a claim query, which is given the lease in seconds, and scans
+// the claimed row into dest. It reports false if nothing was claimed.
+func Claim(ctx context.Context, db Querier, query string, lease time.Duration, dest ...any) (bool, error) {
+	rows, err := db.QueryContext(ctx, query, lease.Seconds())
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return false, rows.Err()
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return false, err
+	}
+	return true, rows.Err()
+}
+
+// Backoff returns the delay before retrying after the given attempt: base
+// doubled for each earlier attempt up to limit, with up to 10% jitter so
+// that work that failed together does not retry together.
+func Backoff(base, limit time.Duration, attempt int) time.Duration {
+	d := base
+	for i := 1; i < attempt && d < limit; i++ {
+		d *= 2
+	}
+	d = min(d, limit)
+	return d + time.Duration(rand.Int63n(int64(d)/10+1))
+}
+
+// IntFromEnv sets value to the positive integer in the environment variable,
+// if it is set. An invalid value is logged and leaves the default.
+func IntFromEnv(env string, value *int) {
+	v := os.Getenv(env)
+	if v == "" {
+		return
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		logrus.WithField("variable", env).Warn("ignoring invalid setting, using the default")
+		return
+	}
+	*value = n
+}
+
+// DurationFromEnv sets value to the positive duration, such as "30s", in the
+// environment variable, if it is set. An invalid value is logged and leaves
+// the default.
+func DurationFromEnv(env string, value *time.Duration) {
+	v := os.Getenv(env)
+	if v == "" {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		logrus.WithField("variable", env).Warn("ignoring invalid setting, using the default")
+		return
+	}
+	*value = d
+}
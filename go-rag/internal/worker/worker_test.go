@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 10 * time.Second},
+		{attempt: 2, want: 20 * time.Second},
+		{attempt: 4, want: 80 * time.Second},
+		// Capped at the limit.
+		{attempt: 10, want: time.Minute * 2},
+		{attempt: 1000, want: time.Minute * 2},
+	}
+	for _, tt := range tests {
+		got := Backoff(10*time.Second, 2*time.Minute, tt.attempt)
+		if got < tt.want || got > tt.want+tt.want/10 {
+			t.Errorf("attempt %d: got %s, want %s plus at most 10%%", tt.attempt, got, tt.want)
+		}
+	}
+}
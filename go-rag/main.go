@@ -4,19 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
 
+	"go-rag/internal/answer"
 	"go-rag/internal/auth"
+	"go-rag/internal/conversations"
 	"go-rag/internal/db"
 	"go-rag/internal/documents"
 	"go-rag/internal/handlers"
+	"go-rag/internal/jobs"
 	"go-rag/internal/projects"
+	"go-rag/internal/search"
 	"go-rag/internal/user"
+	"go-rag/internal/webhooks"
 
 	"go-rag/services/embed"
+	"go-rag/services/events"
 	"go-rag/services/qdrant"
 )
 
@@ -71,15 +78,49 @@ func main() {
 	// setup services
 	logrus.Debug("initializing services")
 	userService := &user.Service{Client: client}
-	projectService := &projects.Service{Client: client}
-	embedService := &embed.Service{Client: client, InferenceClient: inferenceClient, QdrantPointsClient: qdrantPointsClient}
-	documentService := &documents.Service{Client: client, EmbedService: embedService}
+	eventBus := &events.Bus{Client: client, DatabaseURL: os.Getenv("DATABASE_URL")}
+	projectService := &projects.Service{Client: client, Events: eventBus}
+	embedService := &embed.Service{Client: client, InferenceClient: inferenceClient, QdrantPointsClient: qdrantPointsClient, Events: eventBus}
+	ingestQueue := &jobs.Queue{Client: client, EmbedService: embedService, Config: jobs.LoadConfig()}
+	documentService := &documents.Service{
+		Client:       client,
+		EmbedService: embedService,
+		Queue:        ingestQueue,
+		Limits:       documents.LoadUploadLimits(),
+		GitCacheDir:  os.Getenv("GIT_CACHE_DIR"),
+		Events:       eventBus,
+	}
+	searchService := &search.Service{Client: client, InferenceClient: inferenceClient, QdrantPointsClient: qdrantPointsClient}
+	answerService := &answer.Service{Client: client, InferenceClient: inferenceClient, SearchService: searchService}
+	conversationService := &conversations.Service{Client: client, AnswerService: answerService}
+	webhookService := &webhooks.Service{Client: client, Config: webhooks.LoadConfig()}
+	eventBus.OnPublish(webhookService.Record)
 
 	authHandler := &handlers.AuthHandler{UserService: userService}
 	projectHandler := &handlers.ProjectHandler{ProjectService: projectService}
 	documentHandler := &handlers.DocumentHandler{DocumentService: documentService}
+	jobHandler := &handlers.JobHandler{Queue: ingestQueue}
+	eventHandler := &handlers.EventHandler{Bus: eventBus, ProjectService: projectService}
+	webhookHandler := &handlers.WebhookHandler{WebhookService: webhookService}
+	searchHandler := &handlers.SearchHandler{SearchService: searchService}
+	answerHandler := &handlers.AnswerHandler{AnswerService: answerService}
+	conversationHandler := &handlers.ConversationHandler{ConversationService: conversationService}
 	logrus.Info("services initialized successfully")
 
+	// Relay the events of every server instance to this one's subscribers.
+	if err := eventBus.Start(context.Background()); err != nil {
+		logrus.WithError(err).Fatal("could not listen for project events")
+	}
+
+	// Start sending webhooks before any work produces events for them.
+	webhookService.Start(context.Background())
+
+	// Pick up documents left unprocessed by the last run, then start working
+	// the ingest queue.
+	ingestQueue.Start(context.Background())
+	// Fail the source syncs and archive imports that a server stopped during.
+	documentService.Start(context.Background())
+
 	logrus.Debug("setting up HTTP router")
 	r := chi.NewRouter()
 
@@ -114,16 +155,85 @@ func main() {
 				r.Put("/", projectHandler.UpdateProject)
 				r.Delete("/", projectHandler.DeleteProject)
 
+				// Retrieval over the project's chunks
+				r.Post("/search", searchHandler.Search)
+				r.Post("/search/stream", searchHandler.SearchStream)
+				r.Post("/ask", answerHandler.Ask)
+				r.Post("/ask/stream", answerHandler.AskStream)
+
+				// Multi-turn conversations within the project
+				r.Route("/conversations", func(r chi.Router) {
+					r.Post("/", conversationHandler.CreateConversation)
+					r.Get("/", conversationHandler.ListConversations)
+
+					r.Route("/{conversationID}", func(r chi.Router) {
+						r.Get("/", conversationHandler.GetConversation)
+						r.Delete("/", conversationHandler.DeleteConversation)
+						r.Post("/messages", conversationHandler.ContinueConversation)
+					})
+				})
+
+				// Bulk imports of archives into the project
+				r.Route("/imports", func(r chi.Router) {
+					r.Post("/", documentHandler.ImportArchive)
+					r.Get("/{importID}", documentHandler.GetImportJob)
+				})
+
+				// Live document events for the project
+				r.Get("/events", eventHandler.StreamEvents)
+
+				// Webhooks sent the project's events, and their delivery logs
+				r.Route("/webhooks", func(r chi.Router) {
+					r.Post("/", webhookHandler.CreateWebhook)
+					r.Get("/", webhookHandler.ListWebhooks)
+					r.Route("/{webhookID}", func(r chi.Router) {
+						r.Get("/", webhookHandler.GetWebhook)
+						r.Put("/", webhookHandler.UpdateWebhook)
+						r.Delete("/", webhookHandler.DeleteWebhook)
+						r.Get("/deliveries", webhookHandler.ListDeliveries)
+					})
+				})
+
+				// Ingest jobs of the project's documents
+				r.Route("/jobs", func(r chi.Router) {
+					r.Get("/", jobHandler.ListJobs)
+					r.Post("/{jobID}/retry", jobHandler.RetryJob)
+				})
+
+				r.Route("/tree", func(r chi.Router) {
+					r.Get("/", documentHandler.ListDirectory)
+					r.Delete("/", documentHandler.DeletePath)
+					r.Post("/move", documentHandler.MovePath)
+				})
+
+				r.Route("/sources", func(r chi.Router) {
+					r.Post("/", documentHandler.CreateSource)
+					r.Get("/", documentHandler.ListSources)
+					r.Route("/{sourceID}", func(r chi.Router) {
+						r.Get("/", documentHandler.GetSource)
+						r.Delete("/", documentHandler.DeleteSource)
+						r.Post("/sync", documentHandler.SyncSource)
+					})
+				})
+
+				// Search history for the specific project
+				r.Route("/queries", func(r chi.Router) {
+					r.Get("/", searchHandler.ListQueries)
+					r.Get("/{queryID}", searchHandler.GetQuery)
+				})
+
 				// Nested Document Routes for the specific project
 				r.Route("/documents", func(r chi.Router) {
 					r.Post("/", documentHandler.CreateDocument)
 					r.Get("/", documentHandler.ListDocuments)
+					r.Post("/upload", documentHandler.UploadDocuments)
 
 					// Routes for a specific document
 					r.Route("/{documentID}", func(r chi.Router) {
 						r.Get("/", documentHandler.GetDocument)
 						r.Delete("/", documentHandler.DeleteDocument)
 						r.Put("/", documentHandler.UpdateDocument)
+						r.Post("/cancel", documentHandler.CancelDocument)
 					})
 				})
 			})
@@ -64,6 +64,14 @@ atlas migrate diff "$MIGRATION_NAME" \
   --dev-url "$DEV_DB_URL"
 echo "[ok] Migration diff created."
 
+# chunks.content_tsv is a generated column the ent schema leaves out, so a diff
+# always tries to drop it.
+if grep -l 'content_tsv' migrations/*_"$MIGRATION_NAME".sql 2>/dev/null; then
+  echo "[error] The new migration drops chunks.content_tsv, which is managed in the migrations only."
+  echo "        Remove those statements, run 'atlas migrate hash --dir $MIGRATIONS_DIR' and apply again."
+  exit 1
+fi
+
 echo "[step 3/3] Applying migrations to $DB_NAME..."
 if atlas migrate status --dir "$MIGRATIONS_DIR" --url "$MAIN_DB_URL" --revisions-schema="$REVISIONS_SCHEMA" 2>&1 | grep -q "Error:"; then
   echo "[init] No revision table found, initializing..."
@@ -0,0 +1,2 @@
+-- Modify "query_results" table
+ALTER TABLE "query_results" ADD COLUMN "document_id" bigint NULL, ADD COLUMN "document_name" character varying NULL, ADD COLUMN "chunk_index" bigint NULL;
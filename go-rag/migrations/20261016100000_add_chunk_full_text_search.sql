@@ -0,0 +1,6 @@
+-- Modify "chunks" table
+ALTER TABLE "chunks" ADD COLUMN "content_tsv" tsvector NULL;
+-- Create index "chunk_content_tsv" to table: "chunks"
+CREATE INDEX "chunk_content_tsv" ON "chunks" USING GIN ("content_tsv");
+-- Backfill the full-text vectors of existing chunks
+UPDATE "chunks" SET "content_tsv" = to_tsvector('simple', "content");
@@ -0,0 +1,2 @@
+-- Modify "user_prompts" table
+ALTER TABLE "user_prompts" ADD COLUMN "answer" text NULL;
@@ -0,0 +1,14 @@
+-- Create "conversations" table
+CREATE TABLE "conversations" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "title" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "project_conversations" bigint NULL,
+  "user_conversations" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "conversations_projects_conversations" FOREIGN KEY ("project_conversations") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "conversations_users_conversations" FOREIGN KEY ("user_conversations") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Modify "user_prompts" table
+ALTER TABLE "user_prompts" ADD COLUMN "conversation_turns" bigint NULL, ADD CONSTRAINT "user_prompts_conversations_turns" FOREIGN KEY ("conversation_turns") REFERENCES "conversations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+-- Modify "projects" table
+ALTER TABLE "projects" ADD COLUMN "chunk_strategy" character varying NOT NULL DEFAULT 'auto', ADD COLUMN "chunk_max_size" bigint NULL, ADD COLUMN "chunk_overlap" bigint NULL, ADD COLUMN "chunk_heading_level" bigint NULL;
@@ -0,0 +1,2 @@
+-- Modify "chunks" table
+ALTER TABLE "chunks" ADD COLUMN "metadata" jsonb NULL, ADD COLUMN "start_offset" bigint NULL, ADD COLUMN "end_offset" bigint NULL, ADD COLUMN "start_line" bigint NULL, ADD COLUMN "end_line" bigint NULL;
@@ -0,0 +1,2 @@
+-- Modify "documents" table
+ALTER TABLE "documents" ADD COLUMN "source" bytea NULL, ADD COLUMN "last_error" text NULL;
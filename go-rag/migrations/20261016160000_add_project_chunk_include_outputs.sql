@@ -0,0 +1,2 @@
+-- Modify "projects" table
+ALTER TABLE "projects" ADD COLUMN "chunk_include_outputs" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "documents" table
+ALTER TABLE "documents" ADD COLUMN "mime_type" character varying NULL;
@@ -0,0 +1,20 @@
+-- Create "import_jobs" table
+CREATE TABLE "import_jobs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "archive_name" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "include" jsonb NULL,
+  "exclude" jsonb NULL,
+  "total_files" bigint NOT NULL DEFAULT 0,
+  "processed_files" bigint NOT NULL DEFAULT 0,
+  "failed_files" bigint NOT NULL DEFAULT 0,
+  "skipped_files" bigint NOT NULL DEFAULT 0,
+  "errors" jsonb NULL,
+  "last_error" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "project_import_jobs" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "import_jobs_projects_import_jobs" FOREIGN KEY ("project_import_jobs") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+-- Create "project_sources" table
+CREATE TABLE "project_sources" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "type" character varying NOT NULL DEFAULT 'git',
+  "url" character varying NOT NULL,
+  "ref" character varying NOT NULL DEFAULT 'HEAD',
+  "last_commit" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "last_error" text NULL,
+  "synced_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "project_sources" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "project_sources_projects_sources" FOREIGN KEY ("project_sources") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "documents" table
+ALTER TABLE "documents" ADD COLUMN "commit_sha" character varying NULL, ADD COLUMN "blob_sha" character varying NULL, ADD COLUMN "project_source_documents" bigint NULL, ADD CONSTRAINT "documents_project_sources_documents" FOREIGN KEY ("project_source_documents") REFERENCES "project_sources" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,12 @@
+-- Modify "documents" table
+ALTER TABLE "documents" ADD COLUMN "path" character varying NULL;
+-- Backfill paths from names, suffixing the ID where a project has the same name twice
+UPDATE "documents" SET "path" = COALESCE(NULLIF(ltrim(replace("name", '\', '/'), '/'), ''), 'document-' || "id");
+UPDATE "documents" AS d SET "path" = d."path" || ' (' || d."id" || ')'
+WHERE EXISTS (
+  SELECT 1 FROM "documents" AS o
+  WHERE o."project_documents" = d."project_documents" AND o."path" = d."path" AND o."id" < d."id"
+);
+ALTER TABLE "documents" ALTER COLUMN "path" SET NOT NULL;
+-- Create index "document_path_project_documents" to table: "documents"
+CREATE UNIQUE INDEX "document_path_project_documents" ON "documents" ("path", "project_documents");
@@ -0,0 +1,19 @@
+-- Create "ingest_jobs" table
+CREATE TABLE "ingest_jobs" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "kind" character varying NOT NULL DEFAULT 'process_document',
+  "status" character varying NOT NULL DEFAULT 'queued',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "max_attempts" bigint NOT NULL DEFAULT 5,
+  "run_at" timestamptz NOT NULL,
+  "locked_until" timestamptz NULL,
+  "last_error" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "document_jobs" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "ingest_jobs_documents_jobs" FOREIGN KEY ("document_jobs") REFERENCES "documents" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "ingestjob_status_run_at" to table: "ingest_jobs"
+CREATE INDEX "ingestjob_status_run_at" ON "ingest_jobs" ("status", "run_at");
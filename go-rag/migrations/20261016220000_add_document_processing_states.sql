@@ -0,0 +1,8 @@
+-- Modify "documents" table
+ALTER TABLE "documents" ALTER COLUMN "status" SET DEFAULT 'queued', ADD COLUMN "attempts" bigint NOT NULL DEFAULT 0, ADD COLUMN "queued_at" timestamptz NULL, ADD COLUMN "chunking_at" timestamptz NULL, ADD COLUMN "embedding_at" timestamptz NULL, ADD COLUMN "indexing_at" timestamptz NULL, ADD COLUMN "completed_at" timestamptz NULL, ADD COLUMN "failed_at" timestamptz NULL, ADD COLUMN "cancelled_at" timestamptz NULL;
+-- Map the former free-form statuses onto the processing states
+UPDATE "documents" SET "status" = 'queued' WHERE "status" NOT IN ('queued', 'chunking', 'embedding', 'indexing', 'completed', 'failed', 'cancelled');
+UPDATE "documents" SET "queued_at" = "created_at";
+UPDATE "documents" SET "completed_at" = "created_at" WHERE "status" = 'completed';
+UPDATE "documents" SET "failed_at" = "created_at" WHERE "status" = 'failed';
+ALTER TABLE "documents" ALTER COLUMN "queued_at" SET NOT NULL;
@@ -0,0 +1,39 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "url" character varying NOT NULL,
+  "secret" character varying NOT NULL,
+  "events" jsonb NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "project_webhooks" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhooks_projects_webhooks" FOREIGN KEY ("project_webhooks") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "event" character varying NOT NULL,
+  "event_id" character varying NOT NULL,
+  "url" character varying NOT NULL,
+  "payload" text NOT NULL,
+  "signature" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "max_attempts" bigint NOT NULL DEFAULT 8,
+  "next_attempt_at" timestamptz NOT NULL,
+  "locked_until" timestamptz NULL,
+  "response_status" bigint NULL,
+  "response_body" text NULL,
+  "duration_ms" bigint NULL,
+  "last_error" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "delivered_at" timestamptz NULL,
+  "webhook_deliveries" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_deliveries") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,2 @@
+-- Modify "project_sources" table
+ALTER TABLE "project_sources" ADD COLUMN "locked_until" timestamptz NULL;
@@ -0,0 +1,2 @@
+-- Modify "import_jobs" table
+ALTER TABLE "import_jobs" ADD COLUMN "locked_until" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "webhook_deliveries" table
+ALTER TABLE "webhook_deliveries" ADD COLUMN "secret" character varying NOT NULL DEFAULT '';
+UPDATE "webhook_deliveries" AS d SET "secret" = w."secret" FROM "webhooks" AS w WHERE w."id" = d."webhook_deliveries";
+ALTER TABLE "webhook_deliveries" ALTER COLUMN "secret" DROP DEFAULT, DROP COLUMN "signature";
@@ -0,0 +1,6 @@
+-- Rebuild "content_tsv" as a column generated from "content", which ent does not map
+DROP INDEX "chunk_content_tsv";
+ALTER TABLE "chunks" DROP COLUMN "content_tsv";
+ALTER TABLE "chunks" ADD COLUMN "content_tsv" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "content")) STORED;
+-- Create index "chunk_content_tsv" to table: "chunks"
+CREATE INDEX "chunk_content_tsv" ON "chunks" USING GIN ("content_tsv");
@@ -1,21 +1,62 @@
 package embed
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/text"
 )
 
-// Chunk represents a piece of content to be embedded.
+// Chunk represents a piece of content to be embedded. StartOffset and
+// EndOffset are the byte range [start, end) the chunk covers in the document,
+// and StartLine and EndLine the 1-based lines it spans. Index is the chunk's
+// position in the document, assigned by ProcessDocument.
 type Chunk struct {
+	Index       int
 	Content     string
 	ContentHash string
 	Metadata    map[string]interface{}
+	StartOffset int
+	EndOffset   int
+	StartLine   int
+	EndLine     int
+}
+
+// lineIndex maps byte offsets in a document to 1-based line numbers.
+type lineIndex []int
+
+// newLineIndex records the offset at which each line of content starts.
+func newLineIndex(content string) lineIndex {
+	idx := lineIndex{0}
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			idx = append(idx, i+1)
+		}
+	}
+	return idx
+}
+
+// lineStart returns the offset of the start of the line containing off.
+func (idx lineIndex) lineStart(off int) int {
+	return idx[idx.line(off)-1]
+}
+
+// line returns the line containing the byte at off.
+func (idx lineIndex) line(off int) int {
+	return sort.SearchInts(idx, off+1)
+}
+
+// locate sets the chunk's byte range and the lines it spans.
+func (idx lineIndex) locate(c *Chunk, start, end int) {
+	c.StartOffset, c.EndOffset = start, end
+	c.StartLine = idx.line(start)
+	c.EndLine = idx.line(max(end-1, start))
 }
 
 // getContentHash calculates the SHA256 hash of a string.
@@ -24,99 +65,222 @@ func getContentHash(content string) string {
 	return fmt.Sprintf("%x", hashBytes)
 }
 
-// Approximation: target maximum words per chunk
-const maxWordsPerChunk = 256
-
-// Markdown heading level to split sections (Level 2 => ##)
+// Default Markdown heading level to split sections (Level 2 => ##)
 const headingLevelToSplit = 2
 
 // ChunkMarkdown precisely splits Markdown content and calculates a hash for each chunk.
 func ChunkMarkdown(content string) []Chunk {
-	mdParser := goldmark.New()
-	reader := text.NewReader([]byte(content))
-	docAST := mdParser.Parser().Parse(reader)
+	return chunkMarkdown(content, ChunkOptions{})
+}
+
+// chunkMarkdown splits Markdown into sections at headings of opts.HeadingLevel
+// and above, then packs each section's top-level blocks into chunks up to the
+// token budget. Fenced code blocks, tables and HTML blocks are kept whole;
+// other blocks over budget are split at paragraph, line and sentence
+// boundaries. Each chunk records the full heading path (H1 > H2 > H3) in effect
+// where it starts, and the document's front matter, if any.
+func chunkMarkdown(content string, opts ChunkOptions) []Chunk {
+	splitLevel := opts.headingLevel(headingLevelToSplit)
+	sp := newSplitter(content, opts)
+	lines := newLineIndex(content)
+
+	// Blank out the front matter rather than cutting it off, so offsets in the
+	// AST still match content.
+	frontMatter, bodyStart := parseFrontMatter(content)
+	source := []byte(content)
+	for i := 0; i < bodyStart; i++ {
+		if source[i] != '\n' {
+			source[i] = ' '
+		}
+	}
+
+	mdParser := goldmark.New(goldmark.WithExtensions(extension.Table))
+	docAST := mdParser.Parser().Parse(text.NewReader(source))
+
+	blocks := markdownBlocks(docAST, source, lines, bodyStart)
 
 	var chunks []Chunk
-	var currentChunk bytes.Buffer
-	var currentHeadings []string
+	chunkSections(sp, blocks, splitLevel, func(seg segment, path []string) {
+		chunks = append(chunks, markdownChunk(content[seg.start:seg.end], path, frontMatter, seg.start, seg.end, lines))
+	})
+	return chunks
+}
 
-	for node := docAST.FirstChild(); node != nil; node = node.NextSibling() {
-		if heading, ok := node.(*ast.Heading); ok && heading.Level == headingLevelToSplit {
-			if currentChunk.Len() > 0 {
-				chunks = append(chunks, splitSectionByWords(currentChunk.String(), currentHeadings)...)
+// chunkSections packs blocks of sp.doc into chunks one section at a time, where
+// a section starts at every heading at splitLevel or above, and calls emit with
+// each chunk's range and the heading path in effect where it starts.
+func chunkSections(sp *splitter, blocks []docBlock, splitLevel int, emit func(seg segment, path []string)) {
+	for i := 0; i < len(blocks); {
+		j := i + 1
+		for j < len(blocks) && (blocks[j].level == 0 || blocks[j].level > splitLevel) {
+			j++
+		}
+		var pieces []segment
+		for _, b := range blocks[i:j] {
+			pieces = append(pieces, b.pieces(sp)...)
+		}
+		for _, seg := range sp.merge(pieces) {
+			if seg.start == seg.end {
+				continue
 			}
-			currentChunk.Reset()
-			currentHeadings = []string{string(heading.Text(reader.Source()))}
+			path := blocks[i].path
+			for _, b := range blocks[i:j] {
+				if b.start <= seg.start {
+					path = b.path
+				}
+			}
+			emit(seg, path)
 		}
-		if node.Lines() == nil || node.Lines().Len() == 0 {
+		i = j
+	}
+}
+
+// segment is a byte range [start, end) of a document.
+type segment struct {
+	start, end int
+}
+
+// docBlock is a top-level block of a structured document, such as a heading,
+// paragraph, list, table or code block. Markdown blocks run from the start of
+// their first line to the start of the next block, so nodes the parser reports
+// without a position, such as thematic breaks, stay with the block before them.
+type docBlock struct {
+	segment
+	level  int      // Heading level, or 0 if the block is not a heading.
+	atomic bool     // Whether the block must not be split across chunks.
+	path   []string // Headings in effect from the start of the block.
+}
+
+// headingPath tracks the headings in effect while walking a document.
+type headingPath struct {
+	levels []int
+	titles []string
+}
+
+// push enters a heading, leaving any sections at the same or a deeper level.
+func (h *headingPath) push(level int, title string) {
+	for len(h.levels) > 0 && h.levels[len(h.levels)-1] >= level {
+		h.levels, h.titles = h.levels[:len(h.levels)-1], h.titles[:len(h.titles)-1]
+	}
+	h.levels = append(h.levels, level)
+	h.titles = append(h.titles, title)
+}
+
+// path returns a copy of the current heading titles, outermost first.
+func (h *headingPath) path() []string {
+	return append([]string(nil), h.titles...)
+}
+
+// markdownBlocks lists the top-level blocks of a parsed document that start
+// at or after bodyStart, tracking the heading path as it goes.
+func markdownBlocks(doc ast.Node, source []byte, lines lineIndex, bodyStart int) []docBlock {
+	var blocks []docBlock
+	var headings headingPath
+
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		start, ok := markdownNodeStart(node, lines)
+		if !ok || start < bodyStart {
+			continue
+		}
+		start = lines.lineStart(start)
+		if n := len(blocks); n > 0 && start <= blocks[n-1].start {
 			continue
 		}
 
-		start := node.Lines().At(0).Start
-		end := node.Lines().At(node.Lines().Len() - 1).Stop
-		currentChunk.Write(reader.Source()[start:end])
-		currentChunk.WriteString("\n\n")
+		b := docBlock{segment: segment{start: start}}
+		switch n := node.(type) {
+		case *ast.Heading:
+			headings.push(n.Level, strings.TrimSpace(string(n.Text(source))))
+			b.level = n.Level
+		case *ast.FencedCodeBlock, *ast.CodeBlock, *ast.HTMLBlock, *extast.Table:
+			b.atomic = true
+		}
+		b.path = headings.path()
+		blocks = append(blocks, b)
 	}
 
-	if currentChunk.Len() > 0 {
-		chunks = append(chunks, splitSectionByWords(currentChunk.String(), currentHeadings)...)
+	for i := range blocks {
+		blocks[i].end = len(source)
+		if i+1 < len(blocks) {
+			blocks[i].end = blocks[i+1].start
+		}
 	}
-
-	return chunks
+	return blocks
 }
 
-// splitSectionByWords splits a section and adds content hashes.
-func splitSectionByWords(section string, headings []string) []Chunk {
-	var finalChunks []Chunk
-	words := strings.Fields(section)
-
-	if len(words) == 0 {
-		return finalChunks
+// pieces returns the block as pieces for splitter.merge. A block within the
+// budget is a single piece. An atomic block over the budget is still kept
+// whole, and only broken at line ends if it exceeds the model's context.
+func (b docBlock) pieces(sp *splitter) []segment {
+	tokens := sp.tokens(b.segment)
+	switch {
+	case tokens <= sp.maxTokens:
+		return []segment{b.segment}
+	case !b.atomic:
+		return sp.pieces(b.segment, 0)
+	case tokens <= MaxModelTokens:
+		return []segment{b.segment}
 	}
+	model := *sp
+	model.maxTokens = MaxModelTokens
+	return model.pieces(b.segment, 1)
+}
 
-	if len(words) <= maxWordsPerChunk {
-		content := strings.TrimSpace(section)
-		finalChunks = append(finalChunks, Chunk{
-			Content:     content,
-			ContentHash: getContentHash(content),
-			Metadata:    map[string]interface{}{"headings": strings.Join(headings, " > ")},
-		})
-	} else {
-		var buf strings.Builder
-		currentWordCount := 0
-		for _, word := range words {
-			buf.WriteString(word)
-			buf.WriteString(" ")
-			currentWordCount++
-
-			if currentWordCount >= maxWordsPerChunk {
-				content := strings.TrimSpace(buf.String())
-				finalChunks = append(finalChunks, Chunk{
-					Content:     content,
-					ContentHash: getContentHash(content),
-					Metadata:    map[string]interface{}{"headings": strings.Join(headings, " > ")},
-				})
-				buf.Reset()
-				currentWordCount = 0
+// markdownNodeStart returns the offset at which a node's source begins, found
+// through its first descendant that has a position.
+func markdownNodeStart(n ast.Node, lines lineIndex) (int, bool) {
+	switch n := n.(type) {
+	case *ast.FencedCodeBlock:
+		// Lines hold only the code; the block starts at the opening fence.
+		if n.Info != nil {
+			return n.Info.Segment.Start, true
+		}
+		if n.Lines().Len() > 0 {
+			if line := lines.line(n.Lines().At(0).Start); line > 1 {
+				return lines[line-2], true
 			}
 		}
-		if buf.Len() > 0 {
-			content := strings.TrimSpace(buf.String())
-			finalChunks = append(finalChunks, Chunk{
-				Content:     content,
-				ContentHash: getContentHash(content),
-				Metadata:    map[string]interface{}{"headings": strings.Join(headings, " > ")},
-			})
+		return 0, false
+	case *ast.Text:
+		return n.Segment.Start, true
+	}
+	if n.Type() == ast.TypeBlock && n.Lines().Len() > 0 {
+		return n.Lines().At(0).Start, true
+	}
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if start, ok := markdownNodeStart(c, lines); ok {
+			return start, true
 		}
 	}
-	return finalChunks
+	return 0, false
+}
+
+// markdownChunk builds a chunk under the given heading path. The document's
+// front matter, if any, is attached to every chunk.
+func markdownChunk(content string, headings []string, frontMatter map[string]interface{}, start, end int, lines lineIndex) Chunk {
+	c := Chunk{
+		Content:     content,
+		ContentHash: getContentHash(content),
+		Metadata: map[string]interface{}{
+			"language": "markdown",
+			"headings": append([]string(nil), headings...),
+		},
+	}
+	if len(frontMatter) > 0 {
+		c.Metadata["front_matter"] = frontMatter
+	}
+	lines.locate(&c, start, end)
+	return c
 }
 
 // chunkCodeFile treats code files as one chunk and adds a content hash.
 func chunkCodeFile(content string) []Chunk {
 	trimmedContent := strings.TrimSpace(content)
-	return []Chunk{{
+	c := Chunk{
 		Content:     trimmedContent,
 		ContentHash: getContentHash(trimmedContent),
-	}}
+	}
+	start := strings.Index(content, trimmedContent)
+	newLineIndex(content).locate(&c, start, start+len(trimmedContent))
+	return []Chunk{c}
 }
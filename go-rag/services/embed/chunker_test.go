@@ -0,0 +1,149 @@
+package embed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// chunkerInput is a document of a kind a chunker handles.
+type chunkerInput struct {
+	name    string
+	path    string
+	src     string
+	chunker Chunker
+	// verbatim is set when each chunk's content includes its source range
+	// as is, rather than text extracted from it.
+	verbatim bool
+	// covering is set when every non-space byte of the source is in some
+	// chunk's range; other formats leave out front matter, headers or
+	// wrapping structure.
+	covering bool
+}
+
+// chunkerInputs returns a document of each kind the chunkers handle, long
+// enough to need several chunks at a small budget.
+func chunkerInputs() []chunkerInput {
+	para := strings.Repeat("The quick brown fox jumps over the lazy dog near the river bank. ", 12)
+
+	var js strings.Builder
+	js.WriteString("[\n")
+	for i := 0; i < 200; i++ {
+		if i > 0 {
+			js.WriteString(",\n")
+		}
+		fmt.Fprintf(&js, "  {\"id\": %d, \"name\": \"item %d\", \"tags\": [\"a\", \"b\"]}", i, i)
+	}
+	js.WriteString("\n]\n")
+
+	var csv strings.Builder
+	csv.WriteString("id,name,description\n")
+	for i := 0; i < 300; i++ {
+		fmt.Fprintf(&csv, "%d,item %d,\"a longer description, with a comma\"\n", i, i)
+	}
+
+	cells := strings.Repeat(`{"cell_type":"markdown","source":["# Heading\n","`+para+`"]},`+
+		`{"cell_type":"code","source":["import os\n","print(os.getcwd())\n"],"outputs":[]},`, 10)
+
+	return []chunkerInput{
+		{
+			name:     "markdown",
+			path:     "a.md",
+			src:      "---\ntitle: Doc\n---\n# Title\n\nIntro paragraph.\n\n## Setup\n\n" + para + "\n\n" + para + "\n\n```go\nfunc main() {}\n```\n\n## Usage\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n" + para + "\n",
+			chunker:  MarkdownChunker,
+			verbatim: true,
+		},
+		{name: "text", path: "a.txt", src: para + "\n\n" + para + para, chunker: TextChunker, verbatim: true, covering: true},
+		{name: "cjk text", path: "a.txt", src: strings.Repeat("日本語のテキストです。", 200), chunker: TextChunker, verbatim: true, covering: true},
+		{
+			name:     "rst",
+			path:     "a.rst",
+			src:      "Title\n=====\n\n" + para + "\n\nSection\n-------\n\n" + para + "\n\n" + para + "\n\n.. code-block:: python\n\n   print(1)\n",
+			chunker:  RSTChunker,
+			verbatim: true,
+			covering: true,
+		},
+		{
+			name:     "go",
+			path:     "a.go",
+			src:      "package p\n\nimport \"fmt\"\n\n" + strings.Repeat("// F does things.\nfunc F() {\n\tfmt.Println(\"a\")\n}\n\n", 40) + "func Long() {\n" + strings.Repeat("\tx := compute(1, 2, 3)\n", 150) + "}\n",
+			chunker:  GoChunker,
+			verbatim: true,
+			covering: true,
+		},
+		{
+			name:     "python",
+			path:     "a.py",
+			src:      "import os\n\n" + strings.Repeat("class A:\n    def f(self):\n        return os.getcwd()\n\n", 30) + "def long():\n" + strings.Repeat("    x = compute(1, 2, 3)\n", 150),
+			chunker:  CodeChunker,
+			verbatim: true,
+			covering: true,
+		},
+		{name: "json", path: "a.json", src: js.String(), chunker: JSONChunker, verbatim: true},
+		{name: "csv", path: "a.csv", src: csv.String(), chunker: CSVChunker, verbatim: true},
+		{
+			name:    "html",
+			path:    "a.html",
+			src:     "<html><body><h1>T</h1><p>" + para + "</p><h2>S</h2><p>" + para + para + "</p><pre>code\nmore</pre></body></html>",
+			chunker: HTMLChunker,
+		},
+		{
+			name:    "notebook",
+			path:    "a.ipynb",
+			src:     `{"cells":[` + strings.TrimSuffix(cells, ",") + `],"metadata":{"kernelspec":{"language":"python"}}}`,
+			chunker: NotebookChunker,
+		},
+	}
+}
+
+func TestChunkerInvariants(t *testing.T) {
+	for _, in := range chunkerInputs() {
+		for _, budget := range []int{48, 64, 128} {
+			t.Run(fmt.Sprintf("%s/%d", in.name, budget), func(t *testing.T) {
+				opts := ChunkOptions{MaxTokens: budget}
+				chunks := in.chunker.Chunk(in.path, in.src, opts)
+				if len(chunks) < 2 {
+					t.Fatalf("got %d chunks, want the document split", len(chunks))
+				}
+
+				lines := newLineIndex(in.src)
+				covered := make([]bool, len(in.src))
+				for i, c := range chunks {
+					if n := opts.tokenizer().CountTokens(c.Content); n > budget {
+						t.Errorf("chunk %d: %d tokens, over the budget of %d", i, n, budget)
+					}
+					if c.StartOffset < 0 || c.StartOffset >= c.EndOffset || c.EndOffset > len(in.src) {
+						t.Fatalf("chunk %d: range [%d, %d) of %d bytes", i, c.StartOffset, c.EndOffset, len(in.src))
+					}
+					if i > 0 && c.StartOffset < chunks[i-1].StartOffset {
+						t.Errorf("chunk %d starts at %d, before chunk %d at %d", i, c.StartOffset, i-1, chunks[i-1].StartOffset)
+					}
+					if want := lines.line(c.StartOffset); c.StartLine != want {
+						t.Errorf("chunk %d: starts on line %d, want %d", i, c.StartLine, want)
+					}
+					if want := lines.line(c.EndOffset - 1); c.EndLine != want {
+						t.Errorf("chunk %d: ends on line %d, want %d", i, c.EndLine, want)
+					}
+					if c.ContentHash != getContentHash(c.Content) {
+						t.Errorf("chunk %d: hash does not match its content", i)
+					}
+					if in.verbatim && !strings.Contains(c.Content, in.src[c.StartOffset:c.EndOffset]) {
+						t.Errorf("chunk %d: content does not include its source range %q", i, in.src[c.StartOffset:c.EndOffset])
+					}
+					for j := c.StartOffset; j < c.EndOffset; j++ {
+						covered[j] = true
+					}
+				}
+				if !in.covering {
+					return
+				}
+				for j, ok := range covered {
+					if !ok && !unicode.IsSpace(rune(in.src[j])) {
+						t.Fatalf("byte %d (%q) is in no chunk", j, in.src[j:min(len(in.src), j+20)])
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package embed
+
+import (
+	"path"
+	"strings"
+	"unicode/utf8"
+)
+
+// blockStyle describes how a language delimits its top-level blocks.
+type blockStyle int
+
+const (
+	// styleBlock starts a new block at every unindented line outside brackets,
+	// which covers both brace-delimited and indentation-delimited languages.
+	styleBlock blockStyle = iota
+	// styleStatement starts a new block only after a line ending in ';'.
+	styleStatement
+)
+
+// language describes how source files of one language are chunked.
+type language struct {
+	Name     string
+	Style    blockStyle
+	Comments []string // Line comment prefixes.
+	Prefixes []string // Decorator and attribute prefixes that belong to the next block.
+	Quotes   string   // String delimiters; brackets between them are ignored.
+}
+
+// Comment prefixes and string delimiters shared by several languages.
+var (
+	slashComments = []string{"//", "/*", "*"}
+	hashComments  = []string{"#"}
+)
+
+const allQuotes = "\"'`"
+
+var (
+	langPython     = language{Name: "python", Comments: hashComments, Prefixes: []string{"@"}, Quotes: allQuotes}
+	langTypeScript = language{Name: "typescript", Comments: slashComments, Prefixes: []string{"@"}, Quotes: allQuotes}
+	langJavaScript = language{Name: "javascript", Comments: slashComments, Prefixes: []string{"@"}, Quotes: allQuotes}
+	// Rust lifetimes ('a) would otherwise be read as unterminated strings.
+	langRust  = language{Name: "rust", Comments: slashComments, Prefixes: []string{"#["}, Quotes: `"`}
+	langJava  = language{Name: "java", Comments: slashComments, Prefixes: []string{"@"}, Quotes: allQuotes}
+	langC     = language{Name: "c", Comments: slashComments, Prefixes: []string{"#"}, Quotes: allQuotes}
+	langCPP   = language{Name: "cpp", Comments: slashComments, Prefixes: []string{"#", "template"}, Quotes: allQuotes}
+	langShell = language{Name: "shell", Comments: hashComments, Quotes: allQuotes}
+	langSQL   = language{Name: "sql", Style: styleStatement, Comments: []string{"--", "/*", "*"}, Quotes: allQuotes}
+	langYAML  = language{Name: "yaml", Comments: hashComments, Prefixes: []string{"---"}, Quotes: allQuotes}
+	langTOML  = language{Name: "toml", Comments: hashComments, Quotes: allQuotes}
+)
+
+// languagesByExt maps lower-case file extensions to their language.
+var languagesByExt = map[string]language{
+	".py":   langPython,
+	".ts":   langTypeScript,
+	".tsx":  langTypeScript,
+	".js":   langJavaScript,
+	".jsx":  langJavaScript,
+	".mjs":  langJavaScript,
+	".cjs":  langJavaScript,
+	".rs":   langRust,
+	".java": langJava,
+	".c":    langC,
+	".h":    langC,
+	".cpp":  langCPP,
+	".hpp":  langCPP,
+	".cc":   langCPP,
+	".sh":   langShell,
+	".sql":  langSQL,
+	".yaml": langYAML,
+	".yml":  langYAML,
+	".toml": langTOML,
+}
+
+// detectLanguage returns the language of a file by its extension.
+func detectLanguage(filename string) (language, bool) {
+	lang, ok := languagesByExt[strings.ToLower(path.Ext(filename))]
+	return lang, ok
+}
+
+// codeBlock is a run of source lines, [start, end) in line indices.
+type codeBlock struct {
+	start, end int
+}
+
+// ChunkCode splits source code into function- and class-sized chunks by finding
+// the top-level blocks of the file's language, then packing adjacent blocks up
+// to the token budget. Blocks over budget are split at blank lines, then line
+// ends, with opts.Overlap tokens repeated between the parts. Files in unknown
+// languages are split the same way as a single block.
+func ChunkCode(filename, content string, opts ChunkOptions) []Chunk {
+	lang, ok := detectLanguage(filename)
+	if !ok {
+		// Without a known block structure, split at blank lines and line ends.
+		return splitPlain(content, opts, "")
+	}
+
+	offsets := newLineIndex(content)
+	sp := newSplitter(content, opts)
+	lines := strings.Split(content, "\n")
+
+	// Turn line ranges into byte ranges of the document.
+	var blocks []segment
+	for _, b := range topLevelBlocks(lines, lang) {
+		end := len(content)
+		if b.end < len(offsets) {
+			end = offsets[b.end]
+		}
+		blocks = append(blocks, segment{offsets[b.start], end})
+	}
+
+	var chunks []Chunk
+	for _, b := range sp.pack(blocks) {
+		for _, part := range sp.split(b) {
+			chunks = appendCodeChunk(chunks, content, offsets, part, lang)
+		}
+	}
+
+	if len(chunks) == 0 {
+		return chunkCodeFile(content)
+	}
+	return chunks
+}
+
+// topLevelBlocks finds where each top-level block of the source begins. Comment,
+// decorator and attribute lines are kept with the block that follows them.
+func topLevelBlocks(lines []string, lang language) []codeBlock {
+	var blocks []codeBlock
+	depth := 0
+	start := 0
+	leading := true // Whether the current block holds only comments and prefixes so far.
+	prevEnded := true
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		topLevel := depth == 0 && line[0] != ' ' && line[0] != '\t' && !startsWithCloser(trimmed)
+		if lang.Style == styleStatement {
+			topLevel = depth == 0 && prevEnded
+		}
+		header := hasPrefix(trimmed, lang.Comments) || hasPrefix(trimmed, lang.Prefixes)
+
+		if topLevel && i > start && !leading {
+			blocks = append(blocks, codeBlock{start: start, end: i})
+			start = i
+			leading = true
+		}
+		if !header {
+			leading = false
+		}
+
+		if !hasPrefix(trimmed, lang.Comments) {
+			depth += bracketDelta(trimmed, lang.Quotes)
+			if depth < 0 {
+				depth = 0
+			}
+		}
+		prevEnded = strings.HasSuffix(trimmed, ";")
+	}
+	return append(blocks, codeBlock{start: start, end: len(lines)})
+}
+
+// appendCodeChunk appends the code in seg as a chunk, with its line range, the
+// language and the first line of code as the block's signature.
+func appendCodeChunk(chunks []Chunk, doc string, offsets lineIndex, seg segment, lang language) []Chunk {
+	content := doc[seg.start:seg.end]
+	if strings.TrimSpace(content) == "" {
+		return chunks
+	}
+
+	metadata := map[string]interface{}{
+		"language": lang.Name,
+	}
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && !hasPrefix(trimmed, lang.Comments) && !hasPrefix(trimmed, lang.Prefixes) {
+			metadata["signature"] = truncateLine(trimmed, 120)
+			break
+		}
+	}
+
+	c := Chunk{
+		Content:     content,
+		ContentHash: getContentHash(content),
+		Metadata:    metadata,
+	}
+	offsets.locate(&c, seg.start, seg.end)
+	return append(chunks, c)
+}
+
+// bracketDelta returns the change in bracket nesting over a line, ignoring
+// brackets inside strings delimited by any of quotes.
+func bracketDelta(line, quotes string) int {
+	delta := 0
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case strings.ContainsRune(quotes, r):
+			quote = r
+		case r == '{' || r == '(' || r == '[':
+			delta++
+		case r == '}' || r == ')' || r == ']':
+			delta--
+		}
+	}
+	return delta
+}
+
+// startsWithCloser reports whether a line starts by closing a bracket, as the
+// last line of a brace-delimited block does.
+func startsWithCloser(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "}") || strings.HasPrefix(trimmed, ")") || strings.HasPrefix(trimmed, "]")
+}
+
+// hasPrefix reports whether s starts with any of the prefixes.
+func hasPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// truncateLine shortens s to at most n bytes without splitting a rune.
+func truncateLine(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
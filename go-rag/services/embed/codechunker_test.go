@@ -0,0 +1,137 @@
+package embed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTopLevelBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		src  string
+		// firsts are the first lines of the blocks found, in order.
+		firsts []string
+	}{
+		{
+			// Comments and decorators stay with the definition below them.
+			name:   "python",
+			path:   "a.py",
+			src:    "import os\n\n# Serves the index.\n@app.route('/')\ndef index():\n    return 'hi'\n\nclass A:\n    def m(self):\n        pass\n",
+			firsts: []string{"import os", "# Serves the index.", "class A:"},
+		},
+		{
+			// Closing lines and continued parameter lists are not new blocks.
+			name:   "typescript",
+			path:   "a.ts",
+			src:    "const config = {\n  a: 1,\n}\n\nexport function f(\n  x: number,\n): number {\n  return x\n}\n",
+			firsts: []string{"const config = {", "export function f("},
+		},
+		{
+			name:   "brackets in strings",
+			path:   "a.js",
+			src:    "const open = \"{\";\nconst close = '}';\nfunction g() {}\n",
+			firsts: []string{"const open = \"{\";", "const close = '}';", "function g() {}"},
+		},
+		{
+			// Lifetimes are not quotes, and attributes belong to the item.
+			name:   "rust",
+			path:   "a.rs",
+			src:    "#[derive(Debug)]\nstruct S<'a> {\n    s: &'a str,\n}\n\nimpl<'a> S<'a> {\n    fn f(&self) {}\n}\n",
+			firsts: []string{"#[derive(Debug)]", "impl<'a> S<'a> {"},
+		},
+		{
+			// Statements end at semicolons, not at unindented lines.
+			name:   "sql",
+			path:   "a.sql",
+			src:    "-- Users.\nCREATE TABLE users (\nid int\n);\n\nINSERT INTO users\nVALUES (1);\nSELECT 1;\n",
+			firsts: []string{"-- Users.", "INSERT INTO users", "SELECT 1;"},
+		},
+		{
+			name:   "yaml",
+			path:   "a.yml",
+			src:    "---\nname: ci\non:\n  push: {}\njobs:\n  build:\n    runs-on: x\n",
+			firsts: []string{"---", "on:", "jobs:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, ok := detectLanguage(tt.path)
+			if !ok {
+				t.Fatalf("no language for %s", tt.path)
+			}
+			lines := strings.Split(tt.src, "\n")
+			blocks := topLevelBlocks(lines, lang)
+
+			var firsts []string
+			for i, b := range blocks {
+				if i > 0 && b.start != blocks[i-1].end {
+					t.Errorf("block %d starts on line %d, not where block %d ends", i, b.start, i-1)
+				}
+				firsts = append(firsts, lines[b.start])
+			}
+			if blocks[0].start != 0 || blocks[len(blocks)-1].end != len(lines) {
+				t.Errorf("blocks %v do not cover the file", blocks)
+			}
+			if !reflect.DeepEqual(firsts, tt.firsts) {
+				t.Errorf("blocks start with %q, want %q", firsts, tt.firsts)
+			}
+		})
+	}
+}
+
+func TestChunkCode(t *testing.T) {
+	body := strings.Repeat("    total = compute(total, 1, 2, 3)\n", 6)
+	src := "import os\n\n" +
+		"# Adds things up.\n@cache\ndef add():\n" + body + "\n" +
+		"class Sum:\n    def run(self):\n" + strings.ReplaceAll(body, "    ", "        ")
+
+	// The import is packed with the function after it, but each definition
+	// is over half the budget, so the two do not share a chunk.
+	chunks := ChunkCode("sum.py", src, ChunkOptions{MaxTokens: 128})
+	var signatures []string
+	for i, c := range chunks {
+		if c.Metadata["language"] != "python" {
+			t.Errorf("chunk %d: language %v", i, c.Metadata["language"])
+		}
+		sig, _ := c.Metadata["signature"].(string)
+		signatures = append(signatures, sig)
+	}
+	if want := []string{"import os", "class Sum:"}; !reflect.DeepEqual(signatures, want) {
+		t.Fatalf("chunk signatures %q, want %q", signatures, want)
+	}
+	if !strings.HasPrefix(chunks[1].Content, "class Sum:") || !strings.HasSuffix(chunks[0].Content, strings.TrimSpace(body)) {
+		t.Errorf("chunks split inside a definition:\n%s\n--\n%s", chunks[0].Content, chunks[1].Content)
+	}
+	if chunks[1].StartLine != 13 || chunks[1].EndLine != 20 {
+		t.Errorf("second chunk spans lines %d-%d, want 13-20", chunks[1].StartLine, chunks[1].EndLine)
+	}
+
+	t.Run("unknown language", func(t *testing.T) {
+		chunks := ChunkCode("Makefile", "build:\n\tgo build ./...\n", ChunkOptions{})
+		if len(chunks) != 1 || chunks[0].Metadata != nil {
+			t.Errorf("got %+v, want one chunk without metadata", chunks)
+		}
+	})
+
+	t.Run("block over budget", func(t *testing.T) {
+		src := "def long():\n" + strings.Repeat(body, 4)
+		chunks := ChunkCode("long.py", src, ChunkOptions{MaxTokens: 64})
+		if len(chunks) < 2 {
+			t.Fatalf("got %d chunks, want the function split", len(chunks))
+		}
+		if chunks[0].Metadata["signature"] != "def long():" || chunks[1].Metadata["signature"] != "total = compute(total, 1, 2, 3)" {
+			t.Errorf("signatures %v and %v", chunks[0].Metadata["signature"], chunks[1].Metadata["signature"])
+		}
+	})
+
+	t.Run("long signature", func(t *testing.T) {
+		src := "def f(" + strings.Repeat("é", 100) + "):\n    pass\n"
+		sig, _ := ChunkCode("f.py", src, ChunkOptions{})[0].Metadata["signature"].(string)
+		if len(sig) > 120 || !utf8.ValidString(sig) || !strings.HasPrefix(src, sig) {
+			t.Errorf("signature %q is not a valid prefix of at most 120 bytes", sig)
+		}
+	})
+}
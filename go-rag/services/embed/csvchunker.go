@@ -0,0 +1,103 @@
+package embed
+
+import (
+	"encoding/csv"
+	"io"
+	"path"
+	"strings"
+)
+
+// ChunkCSV splits a CSV or TSV file into groups of whole rows up to the token
+// budget. The header row is repeated at the top of every chunk so that each
+// one can be understood on its own, while the chunk's offsets cover only its
+// rows. Each chunk records the range of row numbers it covers, counting the
+// header as row 1. Files that do not parse as CSV are split as text.
+func ChunkCSV(name, content string, opts ChunkOptions) []Chunk {
+	rows, ok := csvRows(name, content)
+	if !ok || len(rows) < 2 {
+		return splitPlain(content, opts, "csv")
+	}
+	header := trimSegment(content, rows[0])
+	headerText := content[header.start:header.end]
+
+	// Leave room for the header in every chunk, but never less than half the
+	// budget for the rows themselves.
+	sp := newSplitter(content, opts)
+	sp.maxTokens = max(sp.maxTokens-sp.tokens(header)-1, sp.maxTokens/2)
+	sp.overlap = 0
+	lines := newLineIndex(content)
+
+	var chunks []Chunk
+	emit := func(seg segment, first, last int) {
+		seg = trimSegment(content, seg)
+		if seg.start == seg.end {
+			return
+		}
+		text := headerText + "\n" + content[seg.start:seg.end]
+		c := Chunk{
+			Content:     text,
+			ContentHash: getContentHash(text),
+			Metadata: map[string]interface{}{
+				"language": "csv",
+				"row":      first + 1,
+			},
+		}
+		if last > first {
+			c.Metadata["row_end"] = last + 1
+		}
+		lines.locate(&c, seg.start, seg.end)
+		chunks = append(chunks, c)
+	}
+
+	// Rows end in a line break, so a group's token count is the sum of its
+	// rows' and is kept as the group grows instead of counted again.
+	flush := func(first, last, tokens int) {
+		group := segment{rows[first].start, rows[last].end}
+		if last == first && tokens > sp.maxTokens {
+			// A single row over budget, such as one with a long text field.
+			for _, piece := range sp.split(group) {
+				emit(piece, first, first)
+			}
+			return
+		}
+		emit(group, first, last)
+	}
+	first, pending := 1, 0 // The pending group's first row and its tokens.
+	for i := 1; i < len(rows); i++ {
+		n := sp.tokens(rows[i])
+		if i > first && pending+n > sp.maxTokens {
+			flush(first, i-1, pending)
+			first, pending = i, 0
+		}
+		pending += n
+	}
+	flush(first, len(rows)-1, pending)
+	return chunks
+}
+
+// csvRows returns the byte range of each record of a CSV file, or false if it
+// does not parse. Files named .tsv are tab-separated.
+func csvRows(name, content string) ([]segment, bool) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.ReuseRecord = true
+	if strings.EqualFold(path.Ext(name), ".tsv") {
+		r.Comma = '\t'
+	}
+
+	var rows []segment
+	start := 0
+	for {
+		_, err := r.Read()
+		if err == io.EOF {
+			return rows, true
+		}
+		if err != nil {
+			return nil, false
+		}
+		end := int(r.InputOffset())
+		rows = append(rows, segment{start, end})
+		start = end
+	}
+}
@@ -0,0 +1,116 @@
+package embed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestChunkCSV(t *testing.T) {
+	var rows strings.Builder
+	rows.WriteString("id,name,notes\n")
+	for i := range 40 {
+		fmt.Fprintf(&rows, "%d,item %d,\"plain, quoted\"\n", i, i)
+	}
+	long := strings.Repeat("word ", 200)
+
+	tests := []struct {
+		name   string
+		path   string
+		src    string
+		header string
+		// rows are the row ranges of the chunks, as "row" or "row-row_end".
+		rows []string
+	}{
+		{
+			name:   "groups of rows",
+			path:   "a.csv",
+			src:    rows.String(),
+			header: "id,name,notes",
+			rows:   []string{"2-6", "7-11", "12-16", "17-21", "22-26", "27-31", "32-36", "37-41"},
+		},
+		{
+			name:   "tsv",
+			path:   "a.TSV",
+			src:    "id\tname\n1\tone\n2\ttwo\n",
+			header: "id\tname",
+			rows:   []string{"2-3"},
+		},
+		{
+			// A quoted line break does not end the row.
+			name:   "multi-line field",
+			path:   "a.csv",
+			src:    "id,notes\n1,\"first line\nsecond line\"\n2,x",
+			header: "id,notes",
+			rows:   []string{"2-3"},
+		},
+		{
+			// A row over budget is split on its own, every part keeping its
+			// row number.
+			name:   "long row",
+			path:   "a.csv",
+			src:    "id,notes\n1,short\n2,\"" + long + "\"\n3,short\n",
+			header: "id,notes",
+			rows:   []string{"2", "3", "3", "3", "3", "4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := ChunkCSV(tt.path, tt.src, ChunkOptions{MaxTokens: 64})
+			var got []string
+			for i, c := range chunks {
+				rng := fmt.Sprint(c.Metadata["row"])
+				if end, ok := c.Metadata["row_end"]; ok {
+					rng += fmt.Sprint("-", end)
+				}
+				got = append(got, rng)
+
+				if c.Metadata["language"] != "csv" {
+					t.Errorf("chunk %d: language %v", i, c.Metadata["language"])
+				}
+				// The header leads every chunk but is outside its range.
+				body, ok := strings.CutPrefix(c.Content, tt.header+"\n")
+				if !ok {
+					t.Errorf("chunk %d does not start with the header: %q", i, c.Content)
+				}
+				if src := tt.src[c.StartOffset:c.EndOffset]; body != src {
+					t.Errorf("chunk %d: rows %q, want its source range %q", i, body, src)
+				}
+				if c.StartOffset < len(tt.header) {
+					t.Errorf("chunk %d range starts at %d, in the header", i, c.StartOffset)
+				}
+			}
+			if strings.Join(got, " ") != strings.Join(tt.rows, " ") {
+				t.Errorf("chunk rows %q, want %q", got, tt.rows)
+			}
+		})
+	}
+}
+
+func TestChunkCSVFillsChunks(t *testing.T) {
+	// Rows of varying length, so groups end at different row counts.
+	var src strings.Builder
+	src.WriteString("id,text\n")
+	for i := range 200 {
+		fmt.Fprintf(&src, "%d,%s\n", i, strings.Repeat("w ", i%17))
+	}
+	doc := src.String()
+	rows, _ := csvRows("a.csv", doc)
+
+	opts := ChunkOptions{MaxTokens: 96}
+	chunks := ChunkCSV("a.csv", doc, opts)
+	tok := opts.tokenizer()
+	for i, c := range chunks {
+		if n := tok.CountTokens(c.Content); n > opts.MaxTokens {
+			t.Errorf("chunk %d: %d tokens, over the budget of %d", i, n, opts.MaxTokens)
+		}
+		if i == len(chunks)-1 {
+			break
+		}
+		// The next row would not have fitted.
+		next := rows[chunks[i+1].Metadata["row"].(int)-1]
+		if n := tok.CountTokens(c.Content + "\n" + doc[next.start:next.end]); n <= opts.MaxTokens {
+			t.Errorf("chunk %d: %d tokens with the next row, which would have fitted", i, n)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package embed
+
+import (
+	"sort"
+
+	"go-rag/services/extract"
+)
+
+// annotateLocations copies the metadata of the extracted location, such as the
+// page, each chunk starts in onto the chunk. A chunk running into a later
+// location also records where it ends, e.g. "page_end".
+func annotateLocations(chunks []Chunk, locations []extract.Location) {
+	if len(locations) == 0 {
+		return
+	}
+	locationAt := func(offset int) extract.Location {
+		i := sort.Search(len(locations), func(i int) bool { return locations[i].Offset > offset })
+		if i == 0 {
+			return locations[0]
+		}
+		return locations[i-1]
+	}
+	for i := range chunks {
+		c := &chunks[i]
+		if c.Metadata == nil {
+			c.Metadata = make(map[string]interface{})
+		}
+		start := locationAt(c.StartOffset)
+		end := locationAt(c.EndOffset - 1)
+		for k, v := range start.Metadata {
+			c.Metadata[k] = v
+			if n, ok := v.(int); ok {
+				if m, ok := end.Metadata[k].(int); ok && m != n {
+					c.Metadata[k+"_end"] = m
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package embed
+
+import (
+	"strings"
+)
+
+// parseFrontMatter reads a YAML (---) or TOML (+++) front matter block at the
+// start of a Markdown document. It returns the block's top-level fields and the
+// offset at which the body starts, which is 0 if there is no front matter.
+//
+// Only flat fields are read: scalars, inline lists and YAML block lists.
+// Nested tables and mappings are skipped.
+func parseFrontMatter(content string) (map[string]interface{}, int) {
+	firstEnd := strings.IndexByte(content, '\n')
+	if firstEnd < 0 {
+		return nil, 0
+	}
+	delim := strings.TrimRight(content[:firstEnd], " \t\r")
+	if delim != "---" && delim != "+++" {
+		return nil, 0
+	}
+
+	// Find the closing delimiter line. YAML may also close with "...".
+	bodyStart := firstEnd + 1
+	for off := bodyStart; off < len(content); {
+		end := strings.IndexByte(content[off:], '\n')
+		next := len(content)
+		if end >= 0 {
+			end += off
+			next = end + 1
+		} else {
+			end = len(content)
+		}
+		line := strings.TrimRight(content[off:end], " \t\r")
+		if line == delim || (delim == "---" && line == "...") {
+			block := content[bodyStart:off]
+			if delim == "+++" {
+				return parseTOMLFields(block), next
+			}
+			return parseYAMLFields(block), next
+		}
+		off = next
+	}
+	return nil, 0
+}
+
+// parseYAMLFields reads the top-level "key: value" pairs of a YAML block.
+func parseYAMLFields(block string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	var listKey string // Key whose value is a block list being read.
+	for _, line := range strings.Split(block, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' || line[0] == '-' {
+			if item, ok := strings.CutPrefix(trimmed, "- "); ok && listKey != "" {
+				list, _ := fields[listKey].([]string)
+				fields[listKey] = append(list, unquote(item))
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			listKey = ""
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		listKey = ""
+		switch {
+		case value == "":
+			listKey = key
+		case strings.HasPrefix(value, "["):
+			fields[key] = parseInlineList(value)
+		default:
+			fields[key] = unquote(value)
+		}
+	}
+	return fields
+}
+
+// parseTOMLFields reads the "key = value" pairs of a TOML block that come
+// before its first table.
+func parseTOMLFields(block string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for _, line := range strings.Split(block, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			break
+		}
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			continue
+		}
+		key, value = unquote(strings.TrimSpace(key)), strings.TrimSpace(value)
+		if strings.HasPrefix(value, "[") {
+			fields[key] = parseInlineList(value)
+		} else {
+			fields[key] = unquote(value)
+		}
+	}
+	return fields
+}
+
+// parseInlineList reads a list written as [a, "b", 'c'].
+func parseInlineList(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = unquote(strings.TrimSpace(item)); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// unquote strips matching single or double quotes around a scalar.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
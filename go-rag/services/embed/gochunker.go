@@ -0,0 +1,250 @@
+package embed
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// goSource pairs a parsed Go file with its source for offset lookups.
+type goSource struct {
+	fset    *token.FileSet
+	src     string
+	lines   lineIndex
+	pkgName string
+	sp      *splitter
+}
+
+// offset returns the byte offset of pos in the source.
+func (g *goSource) offset(pos token.Pos) int {
+	return g.fset.Position(pos).Offset
+}
+
+// lineStart returns the offset of the first byte of the line containing off.
+func (g *goSource) lineStart(off int) int {
+	return strings.LastIndexByte(g.src[:off], '\n') + 1
+}
+
+// lineEnd returns the offset just past the end of the line containing off.
+func (g *goSource) lineEnd(off int) int {
+	if i := strings.IndexByte(g.src[off:], '\n'); i >= 0 {
+		return off + i
+	}
+	return len(g.src)
+}
+
+// ChunkGo splits Go source into one chunk per top-level declaration. Each chunk
+// carries the package name, declaration kind and name, receiver, signature, doc
+// comment and line range in its metadata. Functions over the token budget are
+// split on statement boundaries. Files that do not parse fall back
+// to chunkCodeFile.
+func ChunkGo(filename, content string, opts ChunkOptions) []Chunk {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments)
+	if err != nil {
+		return chunkCodeFile(content)
+	}
+
+	g := &goSource{fset: fset, src: content, lines: newLineIndex(content), pkgName: file.Name.Name, sp: newSplitter(content, opts)}
+	var chunks []Chunk
+
+	// The package clause and imports form a header chunk with the package doc.
+	headerStart, headerEnd := file.Package, file.Name.End()
+	if file.Doc != nil {
+		headerStart = file.Doc.Pos()
+	}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			headerEnd = gen.End()
+		}
+	}
+	chunks = appendGoChunk(chunks, g, headerStart, headerEnd, map[string]interface{}{
+		"kind": "package",
+		"name": g.pkgName,
+		"doc":  docText(file.Doc),
+	})
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			chunks = append(chunks, chunkGoFunc(g, d)...)
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+			start := d.Pos()
+			if d.Doc != nil {
+				start = d.Doc.Pos()
+			}
+			chunks = append(chunks, numberParts(appendGoChunk(nil, g, start, d.End(), map[string]interface{}{
+				"kind": d.Tok.String(),
+				"name": strings.Join(genDeclNames(d), ", "),
+				"doc":  docText(d.Doc),
+			}))...)
+		}
+	}
+
+	if len(chunks) == 0 {
+		return chunkCodeFile(content)
+	}
+	return chunks
+}
+
+// chunkGoFunc emits a function or method as a single chunk, or as several
+// chunks split between top-level statements when it is over the token budget.
+// Every part after the first repeats the signature so it can stand on its own.
+func chunkGoFunc(g *goSource, d *ast.FuncDecl) []Chunk {
+	start := d.Pos()
+	if d.Doc != nil {
+		start = d.Doc.Pos()
+	}
+
+	sigEnd := d.End()
+	if d.Body != nil {
+		sigEnd = d.Body.Lbrace
+	}
+	signature := strings.TrimSpace(g.src[g.offset(d.Pos()):g.offset(sigEnd)])
+
+	meta := map[string]interface{}{
+		"kind":      "func",
+		"name":      d.Name.Name,
+		"signature": signature,
+		"doc":       docText(d.Doc),
+	}
+	if d.Recv != nil && len(d.Recv.List) > 0 {
+		meta["kind"] = "method"
+		recv := d.Recv.List[0].Type
+		meta["receiver"] = g.src[g.offset(recv.Pos()):g.offset(recv.End())]
+	}
+
+	startOff, endOff := g.offset(start), g.offset(d.End())
+	if g.sp.tokens(segment{startOff, endOff}) <= g.sp.maxTokens || d.Body == nil || len(d.Body.List) < 2 {
+		return numberParts(appendGoChunk(nil, g, start, d.End(), meta))
+	}
+
+	// Group statements greedily. Each statement's span runs from the line
+	// after the previous statement, so comments between statements travel
+	// with the next, to the end of its own line. Statements sharing a line,
+	// as in generated or minified code, are spanned by their own offsets.
+	var chunks []Chunk
+	partStart, partEnd := g.lineStart(startOff), 0
+	prefix := ""
+	stmts := d.Body.List
+	prevEnd := g.offset(d.Body.Lbrace) + 1
+	for i, stmt := range stmts {
+		stmtStart, stmtEnd := g.offset(stmt.Pos()), g.offset(stmt.End())
+		spanStart := stmtStart
+		if nl := strings.IndexByte(g.src[prevEnd:stmtStart], '\n'); nl >= 0 {
+			spanStart = prevEnd + nl + 1
+		}
+		spanEnd := stmtEnd
+		if i == len(stmts)-1 {
+			spanEnd = g.offset(d.Body.Rbrace) + 1
+		} else if strings.IndexByte(g.src[stmtEnd:g.offset(stmts[i+1].Pos())], '\n') >= 0 {
+			spanEnd = g.lineEnd(stmtEnd)
+		}
+		// Close the current part before this statement would overflow it.
+		if partEnd > partStart && g.sp.tokenizer.CountTokens(prefix)+g.sp.tokens(segment{partStart, spanEnd}) > g.sp.maxTokens {
+			chunks = appendGoPart(chunks, g, prefix, partStart, partEnd, meta)
+			partStart = spanStart
+			prefix = signature + " {\n"
+		}
+		partEnd = spanEnd
+		prevEnd = stmtEnd
+	}
+	chunks = appendGoPart(chunks, g, prefix, partStart, partEnd, meta)
+	return numberParts(chunks)
+}
+
+// numberParts records the position of each part of a declaration that was
+// split into several chunks.
+func numberParts(parts []Chunk) []Chunk {
+	if len(parts) < 2 {
+		return parts
+	}
+	for i := range parts {
+		parts[i].Metadata["part"] = i + 1
+		parts[i].Metadata["parts"] = len(parts)
+	}
+	return parts
+}
+
+// appendGoChunk appends the declaration between start and end as a chunk,
+// widened to whole lines so indentation is preserved.
+func appendGoChunk(chunks []Chunk, g *goSource, start, end token.Pos, meta map[string]interface{}) []Chunk {
+	return appendGoPart(chunks, g, "", g.lineStart(g.offset(start)), g.offset(end), meta)
+}
+
+// appendGoPart appends the source between start and end, after prefix, as a
+// chunk with a copy of meta. Source still over the token budget, such as a
+// single huge statement, is split further at blank lines and line ends.
+func appendGoPart(chunks []Chunk, g *goSource, prefix string, start, end int, meta map[string]interface{}) []Chunk {
+	if start >= end {
+		return chunks
+	}
+	body := strings.TrimRight(g.src[start:end], " \t\n")
+	if strings.TrimSpace(body) == "" {
+		return chunks
+	}
+	end = start + len(body)
+	prefixTokens := g.sp.tokenizer.CountTokens(prefix)
+	if prefixTokens+g.sp.tokens(segment{start, end}) > g.sp.maxTokens {
+		// Leave room for the repeated signature in every piece.
+		sub := *g.sp
+		sub.maxTokens = max(sub.maxTokens-prefixTokens, sub.maxTokens/2)
+		for _, seg := range sub.split(segment{start, end}) {
+			chunks = appendGoSegment(chunks, g, prefix, seg, meta)
+		}
+		return chunks
+	}
+	return appendGoSegment(chunks, g, prefix, segment{start, end}, meta)
+}
+
+// appendGoSegment appends seg, after prefix, as a single chunk.
+func appendGoSegment(chunks []Chunk, g *goSource, prefix string, seg segment, meta map[string]interface{}) []Chunk {
+	content := prefix + g.src[seg.start:seg.end]
+
+	metadata := map[string]interface{}{
+		"language": "go",
+		"package":  g.pkgName,
+	}
+	for k, v := range meta {
+		if s, ok := v.(string); ok && s == "" {
+			continue
+		}
+		metadata[k] = v
+	}
+
+	c := Chunk{
+		Content:     content,
+		ContentHash: getContentHash(content),
+		Metadata:    metadata,
+	}
+	g.lines.locate(&c, seg.start, seg.end)
+	return append(chunks, c)
+}
+
+// genDeclNames returns the names declared by a type, const or var declaration.
+func genDeclNames(d *ast.GenDecl) []string {
+	var names []string
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			names = append(names, s.Name.Name)
+		case *ast.ValueSpec:
+			for _, n := range s.Names {
+				names = append(names, n.Name)
+			}
+		}
+	}
+	return names
+}
+
+// docText returns the text of a doc comment, or "" if there is none.
+func docText(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.TrimSpace(doc.Text())
+}
@@ -0,0 +1,83 @@
+package embed
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChunkGoSplitsLongFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		// stmt occurs once per statement, so every part must account for
+		// all of them between them.
+		stmt  string
+		count int
+	}{
+		{
+			name:  "one statement per line",
+			src:   "package p\n\nfunc F() {\n" + strings.Repeat("\t// step\n\tx := compute(1, 2, 3)\n", 200) + "}\n",
+			stmt:  "x := compute(1, 2, 3)",
+			count: 200,
+		},
+		{
+			// Generated or minified code puts the whole body on one line.
+			name:  "statements sharing a line",
+			src:   "package p\n\nfunc F() { " + strings.Repeat("x := 1; _ = x; ", 300) + "}\n",
+			stmt:  "_ = x",
+			count: 300,
+		},
+		{
+			name:  "last statement on the closing brace line",
+			src:   "package p\n\nfunc F() {\n" + strings.Repeat("\tx := compute(1, 2, 3)\n", 200) + "\treturn }\n",
+			stmt:  "x := compute(1, 2, 3)",
+			count: 200,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ChunkOptions{MaxTokens: 128}
+			chunks := ChunkGo("f.go", tt.src, opts)
+			if len(chunks) < 2 {
+				t.Fatalf("got %d chunks, want the function split", len(chunks))
+			}
+
+			seen := 0
+			for i, c := range chunks {
+				if c.StartOffset >= c.EndOffset {
+					t.Fatalf("chunk %d: empty range [%d, %d)", i, c.StartOffset, c.EndOffset)
+				}
+				if i > 0 && c.StartOffset < chunks[i-1].EndOffset {
+					t.Fatalf("chunk %d starts at %d, before chunk %d ends at %d", i, c.StartOffset, i-1, chunks[i-1].EndOffset)
+				}
+				body := tt.src[c.StartOffset:c.EndOffset]
+				if !strings.HasSuffix(c.Content, body) {
+					t.Fatalf("chunk %d: content does not end with its source range %q", i, body)
+				}
+				if n := opts.tokenizer().CountTokens(c.Content); n > opts.MaxTokens {
+					t.Errorf("chunk %d: %d tokens, over the budget of %d", i, n, opts.MaxTokens)
+				}
+				if i > 0 && c.Metadata["kind"] == "func" && !strings.HasPrefix(c.Content, "func F() {") {
+					t.Errorf("chunk %d does not repeat the signature: %q", i, c.Content[:min(len(c.Content), 40)])
+				}
+				seen += strings.Count(body, tt.stmt)
+			}
+			if seen != tt.count {
+				t.Errorf("chunks cover %d statements, want %d", seen, tt.count)
+			}
+		})
+	}
+}
+
+func TestChunkGoKeepsCommentsWithNextStatement(t *testing.T) {
+	src := "package p\n\nfunc F() {\n" +
+		strings.Repeat("\ta := compute(1, 2, 3)\n", 60) +
+		"\t// marker explains b\n\tb := compute(4, 5, 6)\n" +
+		strings.Repeat("\ta = compute(1, 2, 3)\n", 60) +
+		"\t_ = b\n}\n"
+	for _, c := range ChunkGo("f.go", src, ChunkOptions{MaxTokens: 128}) {
+		if strings.Contains(c.Content, "// marker") && !strings.Contains(c.Content, "b := compute(4, 5, 6)") {
+			t.Fatalf("comment split from its statement:\n%s", c.Content)
+		}
+	}
+}
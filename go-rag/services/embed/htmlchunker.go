@@ -0,0 +1,421 @@
+package embed
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// htmlNode is an element or text node of an HTML document, with the byte range
+// [start, end) of its source.
+type htmlNode struct {
+	tag      string // Lower-case tag name, or "" for text.
+	attrs    map[string]string
+	text     string // Unescaped text of a text node.
+	parent   *htmlNode
+	children []*htmlNode
+	start    int
+	end      int
+}
+
+// Elements that never contain readable content.
+var htmlSkipTags = map[string]bool{
+	"head": true, "script": true, "style": true, "noscript": true, "template": true,
+	"svg": true, "canvas": true, "iframe": true, "object": true, "nav": true,
+	"aside": true, "footer": true, "form": true, "button": true, "select": true,
+}
+
+// Elements that start a new block of text.
+var htmlBlockTags = map[string]bool{
+	"address": true, "article": true, "blockquote": true, "dd": true, "details": true,
+	"div": true, "dl": true, "dt": true, "figcaption": true, "figure": true,
+	"header": true, "hr": true, "li": true, "main": true, "ol": true, "p": true,
+	"section": true, "summary": true, "ul": true,
+}
+
+// Void elements, which have no end tag.
+var htmlVoidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true,
+	"img": true, "input": true, "link": true, "meta": true, "source": true,
+	"track": true, "wbr": true,
+}
+
+// Class and id patterns of page furniture, and of the containers that usually
+// hold the main content, as used by readability-style extractors.
+var (
+	htmlUnlikely = regexp.MustCompile(`(?i)\b(nav|navbar|menu|sidebar|footer|banner|breadcrumbs?|comments?|share|social|sponsor|advert|ads?|cookie|popup|related|toc)\b`)
+	htmlLikely   = regexp.MustCompile(`(?i)\b(article|content|main|body|post|entry|text|story|docs?|documentation)\b`)
+)
+
+// ChunkHTML extracts the main content of an HTML page, dropping scripts,
+// styles, navigation and other page furniture, and chunks it like Markdown:
+// sections start at headings of opts.HeadingLevel and above, tables and <pre>
+// blocks are kept whole, and each chunk records its heading path. Chunk content
+// is the extracted text; its offsets and lines cover the source it came from.
+func ChunkHTML(_, content string, opts ChunkOptions) []Chunk {
+	root := parseHTML(content)
+	title := ""
+	if t := findHTML(root, func(n *htmlNode) bool { return n.tag == "title" }); t != nil {
+		title = collapseSpace(htmlText(t))
+	}
+
+	ex := &htmlExtractor{}
+	ex.walk(mainContent(root))
+	ex.flush()
+	if len(ex.blocks) == 0 {
+		return nil
+	}
+
+	// Lay the blocks out as paragraphs of an extracted text document.
+	var text strings.Builder
+	var blocks []docBlock
+	var headings headingPath
+	for i, b := range ex.blocks {
+		if i > 0 {
+			text.WriteString("\n\n")
+		}
+		db := docBlock{segment: segment{start: text.Len()}, level: b.level, atomic: b.atomic}
+		if b.level > 0 {
+			headings.push(b.level, b.text)
+		}
+		db.path = headings.path()
+		text.WriteString(b.text)
+		db.end = text.Len()
+		blocks = append(blocks, db)
+	}
+	extracted := text.String()
+
+	lines := newLineIndex(content)
+	var chunks []Chunk
+	chunkSections(newSplitter(extracted, opts), blocks, opts.headingLevel(headingLevelToSplit), func(seg segment, path []string) {
+		// Map the chunk back to the source of the blocks it overlaps.
+		first, last := -1, -1
+		for i, b := range blocks {
+			if b.start < seg.end && b.end > seg.start {
+				if first < 0 {
+					first = i
+				}
+				last = i
+			}
+		}
+		c := Chunk{
+			Content:     extracted[seg.start:seg.end],
+			ContentHash: getContentHash(extracted[seg.start:seg.end]),
+			Metadata: map[string]interface{}{
+				"language": "html",
+				"headings": path,
+			},
+		}
+		if title != "" {
+			c.Metadata["title"] = title
+		}
+		lines.locate(&c, ex.blocks[first].start, ex.blocks[last].end)
+		chunks = append(chunks, c)
+	})
+	return chunks
+}
+
+// parseHTML builds a tree of the document's elements, closing unclosed
+// elements leniently. Unlike html.Parse it keeps each node's source offsets.
+func parseHTML(content string) *htmlNode {
+	root := &htmlNode{tag: "#document", end: len(content)}
+	open := []*htmlNode{root}
+	top := func() *htmlNode { return open[len(open)-1] }
+	closeTo := func(i, off int) {
+		for j := len(open) - 1; j >= i; j-- {
+			open[j].end = off
+		}
+		open = open[:i]
+	}
+	// indexOf returns the position of the innermost open element with the tag.
+	indexOf := func(tag string) int {
+		for i := len(open) - 1; i > 0; i-- {
+			if open[i].tag == tag {
+				return i
+			}
+		}
+		return -1
+	}
+
+	z := html.NewTokenizer(strings.NewReader(content))
+	off := 0
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			// io.EOF, or a read error, which strings.Reader never returns.
+			break
+		}
+		start := off
+		off += len(z.Raw())
+
+		switch tt {
+		case html.TextToken:
+			t := &htmlNode{text: string(z.Text()), parent: top(), start: start, end: off}
+			top().children = append(top().children, t)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			n := &htmlNode{tag: string(name), start: start, end: off}
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				if n.attrs == nil {
+					n.attrs = make(map[string]string)
+				}
+				n.attrs[string(k)] = string(v)
+			}
+			// Paragraphs, list items and table cells close implicitly.
+			switch {
+			case n.tag == "li" || n.tag == "dt" || n.tag == "dd" || n.tag == "tr" || n.tag == "td" || n.tag == "th":
+				if i := indexOf(n.tag); i > 0 && i == len(open)-1 {
+					closeTo(i, start)
+				}
+			case n.tag == "p" || htmlBlockTags[n.tag] || isHTMLHeading(n.tag) || n.tag == "pre" || n.tag == "table":
+				if i := indexOf("p"); i > 0 && i == len(open)-1 {
+					closeTo(i, start)
+				}
+			}
+			n.parent = top()
+			top().children = append(top().children, n)
+			if tt == html.StartTagToken && !htmlVoidTags[n.tag] {
+				open = append(open, n)
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if i := indexOf(string(name)); i > 0 {
+				closeTo(i, off)
+			}
+		}
+	}
+	closeTo(1, len(content))
+	return root
+}
+
+// mainContent picks the element holding the page's main content: the largest
+// <main>, <article> or role="main" element if there is one, otherwise the
+// element whose paragraphs score highest, otherwise <body>.
+func mainContent(root *htmlNode) *htmlNode {
+	var best *htmlNode
+	bestLen := 0
+	eachHTML(root, func(n *htmlNode) {
+		if n.tag == "main" || n.tag == "article" || n.attrs["role"] == "main" {
+			if l := len(collapseSpace(htmlText(n))); l > bestLen {
+				best, bestLen = n, l
+			}
+		}
+	})
+	if best != nil {
+		return best
+	}
+
+	// Score containers by the paragraphs they hold, as readability does.
+	scores := make(map[*htmlNode]float64)
+	eachHTML(root, func(n *htmlNode) {
+		if n.tag != "p" && n.tag != "pre" && n.tag != "td" && n.tag != "blockquote" {
+			return
+		}
+		text := collapseSpace(htmlText(n))
+		if len(text) < 25 || n.parent == nil {
+			return
+		}
+		score := 1 + float64(strings.Count(text, ",")) + min(float64(len(text))/100, 3)
+		scores[n.parent] += score
+		if gp := n.parent.parent; gp != nil {
+			scores[gp] += score / 2
+		}
+	})
+	// Visit candidates in document order so ties go to the first.
+	bestScore := 0.0
+	eachHTML(root, func(n *htmlNode) {
+		score, ok := scores[n]
+		if !ok {
+			return
+		}
+		if htmlLikely.MatchString(n.attrs["class"] + " " + n.attrs["id"]) {
+			score *= 1.25
+		}
+		if score > bestScore {
+			best, bestScore = n, score
+		}
+	})
+	if best != nil {
+		return best
+	}
+	if body := findHTML(root, func(n *htmlNode) bool { return n.tag == "body" }); body != nil {
+		return body
+	}
+	return root
+}
+
+// htmlBlock is a block of text extracted from HTML, with its source range.
+type htmlBlock struct {
+	text       string
+	level      int  // Heading level, or 0 if the block is not a heading.
+	atomic     bool // Tables and preformatted text.
+	start, end int
+}
+
+// htmlExtractor walks the main content, collecting text into blocks.
+type htmlExtractor struct {
+	blocks []htmlBlock
+	lines  []string // Lines of the block being collected; <br> starts a new one.
+	start  int
+	end    int
+}
+
+// walk collects the readable text under n.
+func (ex *htmlExtractor) walk(n *htmlNode) {
+	switch {
+	case n.tag == "":
+		if strings.TrimSpace(n.text) == "" && len(ex.lines) == 0 {
+			return
+		}
+		if len(ex.lines) == 0 {
+			ex.lines, ex.start = []string{""}, n.start
+		}
+		ex.lines[len(ex.lines)-1] += n.text
+		ex.end = n.end
+		return
+	case htmlSkipTags[n.tag] || isHiddenHTML(n):
+		return
+	case n.tag != "body" && n.tag != "main" && n.tag != "article" && isUnlikelyHTML(n):
+		return
+	case isHTMLHeading(n.tag):
+		ex.flush()
+		ex.emit(htmlBlock{text: collapseSpace(htmlText(n)), level: int(n.tag[1] - '0'), start: n.start, end: n.end})
+		return
+	case n.tag == "pre":
+		ex.flush()
+		ex.emit(htmlBlock{text: strings.Trim(htmlText(n), "\n"), atomic: true, start: n.start, end: n.end})
+		return
+	case n.tag == "table":
+		ex.flush()
+		ex.emit(htmlBlock{text: htmlTableText(n), atomic: true, start: n.start, end: n.end})
+		return
+	case n.tag == "br":
+		if len(ex.lines) > 0 {
+			ex.lines = append(ex.lines, "")
+		}
+		return
+	}
+
+	block := htmlBlockTags[n.tag]
+	if block {
+		ex.flush()
+	}
+	if n.tag == "li" {
+		ex.lines, ex.start = []string{"- "}, n.start
+	}
+	for _, c := range n.children {
+		ex.walk(c)
+	}
+	if block {
+		ex.flush()
+	}
+}
+
+// flush ends the block being collected.
+func (ex *htmlExtractor) flush() {
+	var lines []string
+	for _, l := range ex.lines {
+		if l = collapseSpace(l); l != "" && l != "-" {
+			lines = append(lines, l)
+		}
+	}
+	if len(lines) > 0 {
+		ex.emit(htmlBlock{text: strings.Join(lines, "\n"), start: ex.start, end: ex.end})
+	}
+	ex.lines = nil
+}
+
+func (ex *htmlExtractor) emit(b htmlBlock) {
+	if strings.TrimSpace(b.text) != "" {
+		ex.blocks = append(ex.blocks, b)
+	}
+}
+
+// htmlTableText renders a table one row per line, with cells separated by " | ".
+func htmlTableText(table *htmlNode) string {
+	var rows []string
+	eachHTML(table, func(n *htmlNode) {
+		if n.tag != "tr" {
+			return
+		}
+		var cells []string
+		for _, c := range n.children {
+			if c.tag == "td" || c.tag == "th" {
+				cells = append(cells, collapseSpace(htmlText(c)))
+			}
+		}
+		if len(cells) > 0 {
+			rows = append(rows, strings.Join(cells, " | "))
+		}
+	})
+	return strings.Join(rows, "\n")
+}
+
+// htmlText returns the text under n, leaving out skipped elements.
+func htmlText(n *htmlNode) string {
+	if n.tag == "" {
+		return n.text
+	}
+	if htmlSkipTags[n.tag] {
+		return ""
+	}
+	var sb strings.Builder
+	for _, c := range n.children {
+		sb.WriteString(htmlText(c))
+		if c.tag == "br" {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// isUnlikelyHTML reports whether an element's class or id marks it as page
+// furniture rather than content.
+func isUnlikelyHTML(n *htmlNode) bool {
+	if n.attrs["role"] == "navigation" || n.attrs["role"] == "complementary" {
+		return true
+	}
+	names := n.attrs["class"] + " " + n.attrs["id"]
+	return htmlUnlikely.MatchString(names) && !htmlLikely.MatchString(names)
+}
+
+// isHiddenHTML reports whether an element is hidden from readers.
+func isHiddenHTML(n *htmlNode) bool {
+	_, hidden := n.attrs["hidden"]
+	return hidden || n.attrs["aria-hidden"] == "true"
+}
+
+func isHTMLHeading(tag string) bool {
+	return len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6'
+}
+
+// eachHTML calls fn for n and every element below it.
+func eachHTML(n *htmlNode, fn func(*htmlNode)) {
+	fn(n)
+	for _, c := range n.children {
+		eachHTML(c, fn)
+	}
+}
+
+// findHTML returns the first node, in document order, for which match is true.
+func findHTML(n *htmlNode, match func(*htmlNode) bool) *htmlNode {
+	if match(n) {
+		return n
+	}
+	for _, c := range n.children {
+		if found := findHTML(c, match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// collapseSpace replaces runs of whitespace with single spaces and trims s.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
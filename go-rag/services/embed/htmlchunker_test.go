@@ -0,0 +1,129 @@
+package embed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChunkHTML(t *testing.T) {
+	page := `<!DOCTYPE html>
+<html>
+<head><title>Webhooks | Docs</title><style>p { color: red }</style></head>
+<body>
+<nav><a href="/">Home</a> <a href="/docs">Docs</a></nav>
+<div class="sidebar"><p>Related pages you may like, with links and more links.</p></div>
+<article>
+<h1>Webhooks</h1>
+<p>Webhooks notify your server of events.</p>
+<h2>Signing</h2>
+<p>Each delivery is signed<br>with an HMAC.</p>
+<pre>
+sig = hmac(secret, body)
+</pre>
+<h3>Rotation</h3>
+<ul><li>Create a new secret<li>Retire the old one</ul>
+<h2>Retries</h2>
+<table><tr><th>Attempt</th><th>Delay</th></tr><tr><td>1</td><td>1m</td></tr></table>
+<div hidden>Hidden text.</div>
+<script>track()</script>
+</article>
+<footer>Copyright</footer>
+</body>
+</html>
+`
+	chunks := ChunkHTML("a.html", page, ChunkOptions{})
+
+	want := []struct {
+		headings []string
+		content  string
+		source   string // Where the chunk's range starts in the page.
+	}{
+		{
+			headings: []string{"Webhooks"},
+			content:  "Webhooks\n\nWebhooks notify your server of events.",
+			source:   "<h1>Webhooks</h1>",
+		},
+		{
+			// A section runs over deeper headings.
+			headings: []string{"Webhooks", "Signing"},
+			content:  "Signing\n\nEach delivery is signed\nwith an HMAC.\n\nsig = hmac(secret, body)\n\nRotation\n\n- Create a new secret\n\n- Retire the old one",
+			source:   "<h2>Signing</h2>",
+		},
+		{
+			headings: []string{"Webhooks", "Retries"},
+			content:  "Retries\n\nAttempt | Delay\n1 | 1m",
+			source:   "<h2>Retries</h2>",
+		},
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d: %+v", len(chunks), len(want), chunks)
+	}
+	for i, w := range want {
+		c := chunks[i]
+		if c.Content != w.content {
+			t.Errorf("chunk %d: content %q, want %q", i, c.Content, w.content)
+		}
+		if !reflect.DeepEqual(c.Metadata["headings"], w.headings) {
+			t.Errorf("chunk %d: headings %v, want %v", i, c.Metadata["headings"], w.headings)
+		}
+		if c.Metadata["title"] != "Webhooks | Docs" || c.Metadata["language"] != "html" {
+			t.Errorf("chunk %d: metadata %v", i, c.Metadata)
+		}
+		if !strings.HasPrefix(page[c.StartOffset:], w.source) {
+			t.Errorf("chunk %d: starts at %q, want %q", i, page[c.StartOffset:min(len(page), c.StartOffset+20)], w.source)
+		}
+	}
+
+	// Splitting at deeper headings gives the subsection its own chunk.
+	chunks = ChunkHTML("a.html", page, ChunkOptions{HeadingLevel: 3})
+	if len(chunks) != 4 || !reflect.DeepEqual(chunks[2].Metadata["headings"], []string{"Webhooks", "Signing", "Rotation"}) {
+		t.Errorf("split at level 3 into %d chunks, the third under %v", len(chunks), chunks[min(2, len(chunks)-1)].Metadata["headings"])
+	}
+}
+
+func TestChunkHTMLMainContent(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want string
+	}{
+		{
+			// Without a <main> or <article>, the paragraphs decide.
+			name: "scored container",
+			page: `<body><div class="menu"><p>Products, pricing, about us, careers and contact.</p></div>` +
+				`<div class="post"><p>The first paragraph, which says something, at length.</p><p>The second, also long enough to count.</p></div></body>`,
+			want: "The first paragraph, which says something, at length.\n\nThe second, also long enough to count.",
+		},
+		{
+			name: "largest article",
+			page: `<body><article><p>Teaser.</p></article><article><p>The full story.</p><p>More of it.</p></article></body>`,
+			want: "The full story.\n\nMore of it.",
+		},
+		{
+			name: "body",
+			page: `<body>Just some text.</body>`,
+			want: "Just some text.",
+		},
+		{
+			name: "entities and whitespace",
+			page: "<body><p>Fish &amp;\n   chips</p></body>",
+			want: "Fish & chips",
+		},
+		{
+			name: "nothing readable",
+			page: `<body><script>run()</script><nav>Home</nav></body>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range ChunkHTML("a.html", tt.page, ChunkOptions{}) {
+				got = append(got, c.Content)
+			}
+			if strings.Join(got, "\n\n") != tt.want {
+				t.Errorf("extracted %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package embed
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ChunkJSON splits a JSON document along its structure. A value that fits the
+// token budget is one chunk; a larger object or array is split into its
+// members or elements, adjacent ones packed together, and members still over
+// budget are split the same way in turn. Each chunk records the JSON path of
+// what it covers, e.g. "$.users[3]", "$.users[3:7]" for a run of elements or
+// "$['name','email']" for a run of members. Invalid JSON is split as text.
+func ChunkJSON(_, content string, opts ChunkOptions) []Chunk {
+	if !json.Valid([]byte(content)) {
+		return splitPlain(content, opts, "json")
+	}
+	sp := newSplitter(content, opts)
+	lines := newLineIndex(content)
+
+	var chunks []Chunk
+	root := trimSegment(content, segment{0, len(content)})
+	chunkJSONValue(sp, root, "$", func(seg segment, path string) {
+		c := Chunk{
+			Content:     content[seg.start:seg.end],
+			ContentHash: getContentHash(content[seg.start:seg.end]),
+			Metadata: map[string]interface{}{
+				"language":  "json",
+				"json_path": path,
+			},
+		}
+		lines.locate(&c, seg.start, seg.end)
+		chunks = append(chunks, c)
+	})
+	return chunks
+}
+
+// jsonMember is a member of an object, from its key to the end of its value,
+// or an element of an array.
+type jsonMember struct {
+	segment
+	value segment
+	key   string // Empty for array elements.
+}
+
+// chunkJSONValue emits the chunks of the JSON value at seg, whose path is path.
+func chunkJSONValue(sp *splitter, seg segment, path string, emit func(seg segment, path string)) {
+	if seg.start == seg.end {
+		return
+	}
+	if sp.tokens(seg) <= sp.maxTokens {
+		emit(seg, path)
+		return
+	}
+	var members []jsonMember
+	switch sp.doc[seg.start] {
+	case '{', '[':
+		members = jsonMembers(sp.doc, seg)
+	}
+	if len(members) == 0 {
+		// A scalar, such as a long string, can only be split as text.
+		for _, piece := range sp.split(seg) {
+			emit(piece, path)
+		}
+		return
+	}
+
+	isArray := sp.doc[seg.start] == '['
+	first := -1 // Index of the first member of the pending run.
+	flush := func(end int) {
+		if first < 0 {
+			return
+		}
+		run := segment{members[first].start, members[end-1].end}
+		emit(run, jsonRunPath(path, members, first, end, isArray))
+		first = -1
+	}
+	for i, m := range members {
+		if sp.tokens(m.segment) > sp.maxTokens {
+			flush(i)
+			chunkJSONValue(sp, m.value, jsonRunPath(path, members, i, i+1, isArray), emit)
+			continue
+		}
+		if first >= 0 && sp.tokens(segment{members[first].start, m.end}) > sp.maxTokens {
+			flush(i)
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	flush(len(members))
+}
+
+// jsonMembers lists the members of the object, or elements of the array, at
+// seg. The document must be valid JSON.
+func jsonMembers(doc string, seg segment) []jsonMember {
+	isObject := doc[seg.start] == '{'
+	var members []jsonMember
+	i := seg.start + 1
+	for {
+		i = skipJSONSpace(doc, i)
+		if i >= seg.end-1 {
+			return members
+		}
+		m := jsonMember{segment: segment{start: i}}
+		if isObject {
+			keyEnd := jsonValueEnd(doc, i)
+			json.Unmarshal([]byte(doc[i:keyEnd]), &m.key)
+			i = skipJSONSpace(doc, keyEnd) + 1 // The colon.
+			i = skipJSONSpace(doc, i)
+		}
+		m.value = segment{i, jsonValueEnd(doc, i)}
+		m.end = m.value.end
+		members = append(members, m)
+		i = skipJSONSpace(doc, m.end)
+		if i < len(doc) && doc[i] == ',' {
+			i++
+		}
+	}
+}
+
+// jsonValueEnd returns the offset just past the JSON value starting at i.
+func jsonValueEnd(doc string, i int) int {
+	switch doc[i] {
+	case '"':
+		for j := i + 1; j < len(doc); j++ {
+			switch doc[j] {
+			case '\\':
+				j++
+			case '"':
+				return j + 1
+			}
+		}
+		return len(doc)
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(doc); j++ {
+			switch doc[j] {
+			case '"':
+				j = jsonValueEnd(doc, j) - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+		return len(doc)
+	default:
+		j := i
+		for j < len(doc) && !strings.ContainsRune(",]} \t\r\n", rune(doc[j])) {
+			j++
+		}
+		return j
+	}
+}
+
+func skipJSONSpace(doc string, i int) int {
+	for i < len(doc) && isSpaceByte(doc[i]) {
+		i++
+	}
+	return i
+}
+
+// jsonIdentifier matches keys that can be written in dot notation.
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// jsonRunPath returns the JSON path of members[first:end] of the value at path.
+func jsonRunPath(path string, members []jsonMember, first, end int, isArray bool) string {
+	if isArray {
+		if end-first == 1 {
+			return fmt.Sprintf("%s[%d]", path, first)
+		}
+		return fmt.Sprintf("%s[%d:%d]", path, first, end)
+	}
+	if end-first == 1 && jsonIdentifier.MatchString(members[first].key) {
+		return path + "." + members[first].key
+	}
+	keys := make([]string, 0, end-first)
+	for _, m := range members[first:end] {
+		keys = append(keys, "'"+strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(m.key)+"'")
+	}
+	return path + "[" + strings.Join(keys, ",") + "]"
+}
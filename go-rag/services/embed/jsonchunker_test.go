@@ -0,0 +1,57 @@
+package embed
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestChunkJSON(t *testing.T) {
+	var users []string
+	for i := range 12 {
+		users = append(users, fmt.Sprintf(`{"id": %d, "name": "user %d", "email": "user%d@example.com"}`, i, i, i))
+	}
+	long := strings.Repeat("word ", 100)
+
+	tests := []struct {
+		name  string
+		src   string
+		paths []string
+	}{
+		{name: "fits", src: `{"a": 1, "b": [1, 2]}`, paths: []string{"$"}},
+		{
+			// Elements are packed into runs up to the budget.
+			name:  "array",
+			src:   "[" + strings.Join(users, ", ") + "]",
+			paths: []string{"$[0:2]", "$[2:4]", "$[4:6]", "$[6:8]", "$[8:10]", "$[10:12]"},
+		},
+		{
+			name:  "nested array",
+			src:   `{"version": 2, "users": [` + strings.Join(users, ", ") + `]}`,
+			paths: []string{"$.version", "$.users[0:2]", "$.users[2:4]", "$.users[4:6]", "$.users[6:8]", "$.users[8:10]", "$.users[10:12]"},
+		},
+		{
+			// Keys that are not identifiers are quoted, a run of members lists
+			// its keys, and a long string is split as text.
+			name:  "members",
+			src:   `{"first name": "Ada", "last-name": "Lovelace", "notes": "` + long + `"}`,
+			paths: []string{"$['first name','last-name']", "$.notes", "$.notes", "$.notes"},
+		},
+		{name: "invalid", src: `{"a": ` + long, paths: []string{"", "", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			for _, c := range ChunkJSON("a.json", tt.src, ChunkOptions{MaxTokens: 64}) {
+				if c.Metadata["language"] != "json" {
+					t.Errorf("chunk language %v", c.Metadata["language"])
+				}
+				path, _ := c.Metadata["json_path"].(string)
+				paths = append(paths, path)
+			}
+			if strings.Join(paths, " ") != strings.Join(tt.paths, " ") {
+				t.Errorf("chunk paths %q, want %q", paths, tt.paths)
+			}
+		})
+	}
+}
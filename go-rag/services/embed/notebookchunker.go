@@ -0,0 +1,168 @@
+package embed
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// notebook is the part of a Jupyter notebook (.ipynb) that is chunked.
+type notebook struct {
+	Cells    []notebookCell `json:"cells"`
+	Metadata struct {
+		KernelSpec struct {
+			Language string `json:"language"`
+		} `json:"kernelspec"`
+		LanguageInfo struct {
+			Name string `json:"name"`
+		} `json:"language_info"`
+	} `json:"metadata"`
+}
+
+type notebookCell struct {
+	CellType string           `json:"cell_type"`
+	Source   notebookText     `json:"source"`
+	Outputs  []notebookOutput `json:"outputs"`
+}
+
+type notebookOutput struct {
+	OutputType string                  `json:"output_type"`
+	Text       notebookText            `json:"text"`
+	Data       map[string]notebookText `json:"data"`
+	EName      string                  `json:"ename"`
+	EValue     string                  `json:"evalue"`
+}
+
+// notebookText is multi-line text, stored either as one string or as a list
+// of lines.
+type notebookText string
+
+func (t *notebookText) UnmarshalJSON(b []byte) error {
+	var lines []string
+	if err := json.Unmarshal(b, &lines); err == nil {
+		*t = notebookText(strings.Join(lines, ""))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		// Rich outputs, such as JSON data, are not text.
+		return nil
+	}
+	*t = notebookText(s)
+	return nil
+}
+
+// Markdown ATX headings, which notebooks use to structure their cells.
+var notebookHeading = regexp.MustCompile(`^(#{1,6})[ \t]+(.+?)[ \t#]*$`)
+
+// pushNotebookHeadings enters the headings of a Markdown cell, skipping
+// comment lines inside fenced code blocks.
+func pushNotebookHeadings(h *headingPath, text string) {
+	inFence := false
+	for _, line := range strings.Split(text, "\n") {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if m := notebookHeading.FindStringSubmatch(line); m != nil && !inFence {
+			h.push(len(m[1]), m[2])
+		}
+	}
+}
+
+// ChunkNotebook splits a Jupyter notebook by cell, so code and Markdown are
+// never mixed in one chunk. Cells over the token budget are split further.
+// Code cells carry the kernel's language and Markdown cells "markdown", and
+// every chunk records its cell number and the heading path of the Markdown
+// cells before it. Cell outputs are appended to code cells when
+// opts.IncludeOutputs is set. A chunk's offsets cover the cell's JSON in the
+// file, since the cell text itself is encoded there.
+func ChunkNotebook(_, content string, opts ChunkOptions) []Chunk {
+	var nb notebook
+	if err := json.Unmarshal([]byte(content), &nb); err != nil || len(nb.Cells) == 0 {
+		return ChunkJSON("", content, opts)
+	}
+	language := nb.Metadata.LanguageInfo.Name
+	if language == "" {
+		language = nb.Metadata.KernelSpec.Language
+	}
+	if language == "" {
+		language = "python"
+	}
+
+	// Locate each cell's JSON so chunks can point back into the file.
+	var cellSegs []segment
+	root := trimSegment(content, segment{0, len(content)})
+	for _, m := range jsonMembers(content, root) {
+		if m.key == "cells" && content[m.value.start] == '[' {
+			for _, cell := range jsonMembers(content, m.value) {
+				cellSegs = append(cellSegs, cell.segment)
+			}
+		}
+	}
+	if len(cellSegs) != len(nb.Cells) {
+		return ChunkJSON("", content, opts)
+	}
+
+	lines := newLineIndex(content)
+	var headings headingPath
+	var chunks []Chunk
+	for i, cell := range nb.Cells {
+		text := strings.TrimSpace(string(cell.Source))
+		if cell.CellType == "markdown" {
+			pushNotebookHeadings(&headings, text)
+		}
+		if cell.CellType == "code" && opts.IncludeOutputs {
+			if out := notebookOutputs(cell.Outputs); out != "" {
+				text += "\n\nOutput:\n" + out
+			}
+		}
+		if text == "" {
+			continue
+		}
+
+		cellLanguage := language
+		switch cell.CellType {
+		case "markdown":
+			cellLanguage = "markdown"
+		case "raw":
+			cellLanguage = "text"
+		}
+		for _, piece := range splitPlain(text, opts, "") {
+			c := Chunk{
+				Content:     piece.Content,
+				ContentHash: piece.ContentHash,
+				Metadata: map[string]interface{}{
+					"language":  cellLanguage,
+					"cell":      i + 1,
+					"cell_type": cell.CellType,
+					"headings":  headings.path(),
+				},
+			}
+			lines.locate(&c, cellSegs[i].start, cellSegs[i].end)
+			chunks = append(chunks, c)
+		}
+	}
+	return chunks
+}
+
+// notebookOutputs renders the text of a code cell's outputs: streams, plain
+// text results and errors. Images and other rich outputs are skipped.
+func notebookOutputs(outputs []notebookOutput) string {
+	var parts []string
+	for _, o := range outputs {
+		var text string
+		switch o.OutputType {
+		case "stream":
+			text = string(o.Text)
+		case "execute_result", "display_data":
+			text = string(o.Data["text/plain"])
+		case "error":
+			text = o.EName + ": " + o.EValue
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
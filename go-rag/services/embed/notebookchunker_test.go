@@ -0,0 +1,91 @@
+package embed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "source": ["# Analysis\n", "\n", "Load the data."]},
+  {"cell_type": "code", "source": "import pandas as pd\ndf = pd.read_csv('a.csv')", "outputs": []},
+  {"cell_type": "markdown", "source": ["## Plot\n", "` + "```" + `python\n", "# not a heading\n", "` + "```" + `"]},
+  {"cell_type": "code", "source": ["df.plot()\n", "print(len(df))"], "outputs": [
+   {"output_type": "stream", "name": "stdout", "text": ["42\n"]},
+   {"output_type": "display_data", "data": {"image/png": "iVBORw0KGgo=", "text/plain": ["<Figure>"]}},
+   {"output_type": "error", "ename": "ValueError", "evalue": "bad value", "traceback": []}
+  ]},
+  {"cell_type": "code", "source": [], "outputs": []},
+  {"cell_type": "raw", "source": "Raw text."}
+ ],
+ "metadata": {"kernelspec": {"language": "python"}, "language_info": {"name": "julia"}}
+}`
+
+func TestChunkNotebook(t *testing.T) {
+	want := []struct {
+		cell     int
+		cellType string
+		language string
+		headings []string
+		content  string
+	}{
+		{1, "markdown", "markdown", []string{"Analysis"}, "# Analysis\n\nLoad the data."},
+		// The kernel's language, preferring language_info.
+		{2, "code", "julia", []string{"Analysis"}, "import pandas as pd\ndf = pd.read_csv('a.csv')"},
+		// Comments in fenced code are not headings.
+		{3, "markdown", "markdown", []string{"Analysis", "Plot"}, "## Plot\n```python\n# not a heading\n```"},
+		{4, "code", "julia", []string{"Analysis", "Plot"}, "df.plot()\nprint(len(df))"},
+		// Empty cells are skipped.
+		{6, "raw", "text", []string{"Analysis", "Plot"}, "Raw text."},
+	}
+
+	chunks := ChunkNotebook("a.ipynb", testNotebook, ChunkOptions{})
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d", len(chunks), len(want))
+	}
+	for i, w := range want {
+		c := chunks[i]
+		if c.Metadata["cell"] != w.cell || c.Metadata["cell_type"] != w.cellType || c.Metadata["language"] != w.language {
+			t.Errorf("chunk %d: metadata %v, want cell %d of type %s in %s", i, c.Metadata, w.cell, w.cellType, w.language)
+		}
+		if !reflect.DeepEqual(c.Metadata["headings"], w.headings) {
+			t.Errorf("chunk %d: headings %v, want %v", i, c.Metadata["headings"], w.headings)
+		}
+		if c.Content != w.content {
+			t.Errorf("chunk %d: content %q, want %q", i, c.Content, w.content)
+		}
+		// The range is the cell's JSON in the file.
+		if cell := testNotebook[c.StartOffset:c.EndOffset]; !strings.HasPrefix(cell, `{"cell_type": "`+w.cellType) || !strings.HasSuffix(cell, "}") {
+			t.Errorf("chunk %d: range %q is not its cell", i, cell)
+		}
+	}
+
+	t.Run("outputs", func(t *testing.T) {
+		chunks := ChunkNotebook("a.ipynb", testNotebook, ChunkOptions{IncludeOutputs: true})
+		want := "df.plot()\nprint(len(df))\n\nOutput:\n42\n<Figure>\nValueError: bad value"
+		if chunks[3].Content != want {
+			t.Errorf("code cell with outputs %q, want %q", chunks[3].Content, want)
+		}
+	})
+
+	t.Run("long cell", func(t *testing.T) {
+		nb := `{"cells": [{"cell_type": "code", "source": "` + strings.Repeat(`x = compute(1, 2, 3)\n`, 60) + `"}]}`
+		chunks := ChunkNotebook("a.ipynb", nb, ChunkOptions{MaxTokens: 64})
+		if len(chunks) < 2 {
+			t.Fatalf("got %d chunks, want the cell split", len(chunks))
+		}
+		for i, c := range chunks {
+			if c.Metadata["cell"] != 1 || c.Metadata["language"] != "python" {
+				t.Errorf("chunk %d: metadata %v", i, c.Metadata)
+			}
+		}
+	})
+
+	t.Run("not a notebook", func(t *testing.T) {
+		chunks := ChunkNotebook("a.ipynb", `{"cells": []}`, ChunkOptions{})
+		if len(chunks) != 1 || chunks[0].Metadata["json_path"] != "$" {
+			t.Errorf("got %+v, want the file chunked as JSON", chunks)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package embed
+
+import (
+	"go-rag/ent/ent"
+
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// PayloadMetadataKeys are the chunk metadata keys mirrored into the Qdrant
+// payload so that searches can filter on them.
+var PayloadMetadataKeys = []string{"language", "headings", "kind", "name", "page", "sheet", "slide", "cell_type"}
+
+// chunkPayload is the Qdrant payload stored with a chunk's vector. It is the
+// whole payload, so that it can overwrite the stored one when the chunk moves.
+func chunkPayload(doc *ent.Document, ownerID uuid.UUID, chunkID int, c Chunk) map[string]*qdrant.Value {
+	payload := locationPayload(c)
+	payload["user_id"] = qdrant.NewValueString(ownerID.String())
+	payload["project_id"] = qdrant.NewValueInt(int64(doc.Edges.Project.ID))
+	payload["document_id"] = qdrant.NewValueInt(int64(doc.ID))
+	payload["path"] = qdrant.NewValueString(doc.Path)
+	payload["chunk_id"] = qdrant.NewValueInt(int64(chunkID))
+	return payload
+}
+
+// locationPayload holds the part of a chunk's payload that can change when
+// the chunk moves within its document: its line range and filterable
+// metadata.
+func locationPayload(c Chunk) map[string]*qdrant.Value {
+	payload := map[string]*qdrant.Value{
+		"start_line": qdrant.NewValueInt(int64(c.StartLine)),
+		"end_line":   qdrant.NewValueInt(int64(c.EndLine)),
+	}
+	for _, key := range PayloadMetadataKeys {
+		switch v := c.Metadata[key].(type) {
+		case string:
+			if v != "" {
+				payload[key] = qdrant.NewValueString(v)
+			}
+		case int:
+			payload[key] = qdrant.NewValueInt(int64(v))
+		case []string:
+			values := make([]*qdrant.Value, 0, len(v))
+			for _, s := range v {
+				values = append(values, qdrant.NewValueString(s))
+			}
+			payload[key] = qdrant.NewValueList(&qdrant.ListValue{Values: values})
+		}
+	}
+	return payload
+}
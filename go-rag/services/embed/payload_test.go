@@ -0,0 +1,40 @@
+package embed
+
+import (
+	"go-rag/ent/ent"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestChunkPayloadOnlyHoldsCurrentMetadata(t *testing.T) {
+	doc := &ent.Document{ID: 3, Path: "docs/a.go", Edges: ent.DocumentEdges{Project: &ent.Project{ID: 2}}}
+	owner := uuid.New()
+
+	before := chunkPayload(doc, owner, 9, Chunk{
+		StartLine: 1, EndLine: 4,
+		Metadata: map[string]interface{}{"language": "go", "headings": []string{"Intro"}, "kind": "func"},
+	})
+	after := chunkPayload(doc, owner, 9, Chunk{
+		StartLine: 7, EndLine: 10,
+		Metadata: map[string]interface{}{"language": "go"},
+	})
+
+	for _, key := range []string{"headings", "kind"} {
+		if _, ok := before[key]; !ok {
+			t.Fatalf("payload lacks %q before the move", key)
+		}
+		if _, ok := after[key]; ok {
+			t.Errorf("payload keeps %q that the moved chunk no longer has", key)
+		}
+	}
+	// Overwriting must not lose the fields searches are scoped by.
+	for _, key := range []string{"user_id", "project_id", "document_id", "path", "chunk_id", "language", "start_line"} {
+		if _, ok := after[key]; !ok {
+			t.Errorf("payload lacks %q after the move", key)
+		}
+	}
+	if got := after["start_line"].GetIntegerValue(); got != 7 {
+		t.Errorf("start_line %d, want 7", got)
+	}
+}
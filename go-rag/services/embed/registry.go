@@ -0,0 +1,220 @@
+package embed
+
+import (
+	"go-rag/ent/ent"
+	"mime"
+	"path"
+	"strings"
+	"sync"
+)
+
+// ChunkOptions tunes a chunker. Zero values (and a nil Overlap) select the
+// chunker's defaults. MaxTokens is the target maximum chunk size, capped at
+// MaxModelTokens, and Overlap the number of tokens repeated from the end of a
+// chunk at the start of the next when a block has to be split. HeadingLevel is
+// the Markdown heading level at which sections are split. IncludeOutputs adds
+// the outputs of notebook cells to their chunks. Token counts come from
+// Tokenizer, or DefaultTokenizer when it is nil.
+type ChunkOptions struct {
+	MaxTokens      int
+	Overlap        *int
+	HeadingLevel   int
+	IncludeOutputs bool
+	Tokenizer      Tokenizer
+}
+
+func (o ChunkOptions) maxTokens() int {
+	if o.MaxTokens <= 0 {
+		return defaultMaxTokens
+	}
+	return min(o.MaxTokens, MaxModelTokens)
+}
+
+func (o ChunkOptions) tokenizer() Tokenizer {
+	if o.Tokenizer != nil {
+		return o.Tokenizer
+	}
+	return DefaultTokenizer
+}
+
+func (o ChunkOptions) overlap(def int) int {
+	if o.Overlap != nil && *o.Overlap >= 0 {
+		return *o.Overlap
+	}
+	return def
+}
+
+func (o ChunkOptions) headingLevel(def int) int {
+	if o.HeadingLevel > 0 {
+		return o.HeadingLevel
+	}
+	return def
+}
+
+// Chunker splits a document into chunks.
+type Chunker interface {
+	Chunk(name, content string, opts ChunkOptions) []Chunk
+}
+
+// ChunkerFunc adapts a function to the Chunker interface.
+type ChunkerFunc func(name, content string, opts ChunkOptions) []Chunk
+
+// Chunk calls f(name, content, opts).
+func (f ChunkerFunc) Chunk(name, content string, opts ChunkOptions) []Chunk {
+	return f(name, content, opts)
+}
+
+// Built-in chunkers.
+var (
+	MarkdownChunker = ChunkerFunc(func(_, content string, opts ChunkOptions) []Chunk {
+		return chunkMarkdown(content, opts)
+	})
+	GoChunker   = ChunkerFunc(ChunkGo)
+	TextChunker = ChunkerFunc(ChunkText)
+	HTMLChunker = ChunkerFunc(ChunkHTML)
+	RSTChunker  = ChunkerFunc(ChunkRST)
+	JSONChunker = ChunkerFunc(ChunkJSON)
+	CSVChunker  = ChunkerFunc(ChunkCSV)
+	// NotebookChunker chunks Jupyter notebooks by cell.
+	NotebookChunker = ChunkerFunc(ChunkNotebook)
+	// CodeChunker chunks Go with ChunkGo and every other language with ChunkCode.
+	CodeChunker = ChunkerFunc(func(name, content string, opts ChunkOptions) []Chunk {
+		if strings.EqualFold(path.Ext(name), ".go") {
+			return ChunkGo(name, content, opts)
+		}
+		return ChunkCode(name, content, opts)
+	})
+	// WholeChunker keeps the entire document as a single chunk.
+	WholeChunker = ChunkerFunc(func(_, content string, _ ChunkOptions) []Chunk {
+		return chunkCodeFile(content)
+	})
+)
+
+// Registry picks a chunker for a document by its file extension or MIME type.
+type Registry struct {
+	mu       sync.RWMutex
+	byExt    map[string]Chunker
+	byMIME   map[string]Chunker
+	fallback Chunker
+}
+
+// NewRegistry returns an empty registry that falls back to the given chunker.
+func NewRegistry(fallback Chunker) *Registry {
+	return &Registry{
+		byExt:    make(map[string]Chunker),
+		byMIME:   make(map[string]Chunker),
+		fallback: fallback,
+	}
+}
+
+// RegisterExt registers c for files with any of the given extensions, e.g. ".md".
+func (r *Registry) RegisterExt(c Chunker, exts ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, ext := range exts {
+		r.byExt[strings.ToLower(ext)] = c
+	}
+}
+
+// RegisterMIME registers c for documents of any of the given MIME types.
+func (r *Registry) RegisterMIME(c Chunker, mimeTypes ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range mimeTypes {
+		r.byMIME[strings.ToLower(t)] = c
+	}
+}
+
+// Lookup returns the chunker for a document. The file extension takes
+// precedence over the MIME type, which may be empty.
+func (r *Registry) Lookup(name, mimeType string) Chunker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if c, ok := r.byExt[strings.ToLower(path.Ext(name))]; ok {
+		return c
+	}
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		if c, ok := r.byMIME[mediaType]; ok {
+			return c
+		}
+	}
+	return r.fallback
+}
+
+// Chunkers is the registry used by ProcessDocument for the "auto" strategy.
+var Chunkers = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry(CodeChunker)
+	r.RegisterExt(MarkdownChunker, ".md", ".markdown")
+	r.RegisterMIME(MarkdownChunker, "text/markdown")
+	r.RegisterExt(GoChunker, ".go")
+	r.RegisterMIME(GoChunker, "text/x-go")
+	r.RegisterExt(TextChunker, ".txt", ".text")
+	r.RegisterMIME(TextChunker, "text/plain")
+	r.RegisterExt(HTMLChunker, ".html", ".htm", ".xhtml")
+	r.RegisterMIME(HTMLChunker, "text/html", "application/xhtml+xml")
+	r.RegisterExt(RSTChunker, ".rst", ".rest")
+	r.RegisterMIME(RSTChunker, "text/x-rst")
+	r.RegisterExt(JSONChunker, ".json")
+	r.RegisterMIME(JSONChunker, "application/json")
+	r.RegisterExt(CSVChunker, ".csv", ".tsv")
+	r.RegisterMIME(CSVChunker, "text/csv", "text/tab-separated-values")
+	r.RegisterExt(NotebookChunker, ".ipynb")
+	r.RegisterMIME(NotebookChunker, "application/x-ipynb+json")
+	return r
+}
+
+// Chunking strategies that can be configured on a project.
+const (
+	StrategyAuto     = "auto"
+	StrategyMarkdown = "markdown"
+	StrategyCode     = "code"
+	StrategyWhole    = "whole"
+	StrategyText     = "text"
+	StrategyHTML     = "html"
+	StrategyRST      = "rst"
+	StrategyJSON     = "json"
+	StrategyCSV      = "csv"
+	StrategyNotebook = "notebook"
+)
+
+// strategies maps the fixed strategies to their chunker. "auto" is resolved
+// through the Chunkers registry instead.
+var strategies = map[string]Chunker{
+	StrategyMarkdown: MarkdownChunker,
+	StrategyCode:     CodeChunker,
+	StrategyWhole:    WholeChunker,
+	StrategyText:     TextChunker,
+	StrategyHTML:     HTMLChunker,
+	StrategyRST:      RSTChunker,
+	StrategyJSON:     JSONChunker,
+	StrategyCSV:      CSVChunker,
+	StrategyNotebook: NotebookChunker,
+}
+
+// ValidStrategy reports whether s is a known chunking strategy.
+func ValidStrategy(s string) bool {
+	_, ok := strategies[s]
+	return ok || s == StrategyAuto
+}
+
+// ChunkerFor returns the chunker a project's strategy selects for a document.
+func ChunkerFor(strategy, name, mimeType string) Chunker {
+	if c, ok := strategies[strategy]; ok {
+		return c
+	}
+	return Chunkers.Lookup(name, mimeType)
+}
+
+// ChunkOptionsFor returns the chunking settings configured on a project.
+func ChunkOptionsFor(p *ent.Project) ChunkOptions {
+	opts := ChunkOptions{Overlap: p.ChunkOverlap, IncludeOutputs: p.ChunkIncludeOutputs}
+	if p.ChunkMaxSize != nil {
+		opts.MaxTokens = *p.ChunkMaxSize
+	}
+	if p.ChunkHeadingLevel != nil {
+		opts.HeadingLevel = *p.ChunkHeadingLevel
+	}
+	return opts
+}
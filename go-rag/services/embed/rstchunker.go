@@ -0,0 +1,146 @@
+package embed
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Characters reStructuredText allows in section title adornments.
+const rstAdornmentChars = "=-`:'\"~^_*+#<>.!$%&(),/;?@[]\\{|}"
+
+// Directives whose content is code and must not be split.
+var rstCodeDirective = regexp.MustCompile(`^\.\.\s+(code-block|code|sourcecode|highlight|literalinclude|math)::`)
+
+// Border lines of grid tables (+---+) and simple tables (=== ===).
+var rstTableBorder = regexp.MustCompile(`^(\+[-=+]+\+|=+( +=+)+)$`)
+
+// ChunkRST splits reStructuredText into sections at titles of opts.HeadingLevel
+// and above, as Sphinx documentation is structured. Title levels follow the
+// order in which adornment styles first appear. Literal blocks, code directives
+// and tables are kept whole, and each chunk records its heading path.
+func ChunkRST(_, content string, opts ChunkOptions) []Chunk {
+	lines := newLineIndex(content)
+	sp := newSplitter(content, opts)
+
+	var chunks []Chunk
+	chunkSections(sp, rstBlocks(content, lines), opts.headingLevel(headingLevelToSplit), func(seg segment, path []string) {
+		c := Chunk{
+			Content:     content[seg.start:seg.end],
+			ContentHash: getContentHash(content[seg.start:seg.end]),
+			Metadata: map[string]interface{}{
+				"language": "rst",
+				"headings": path,
+			},
+		}
+		lines.locate(&c, seg.start, seg.end)
+		chunks = append(chunks, c)
+	})
+	return chunks
+}
+
+// rstBlocks finds the section titles, paragraphs, literal blocks, directives
+// and tables of a document. Each block runs to the start of the next.
+func rstBlocks(content string, offsets lineIndex) []docBlock {
+	lines := strings.Split(content, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+
+	var blocks []docBlock
+	var headings headingPath
+	var styles []string // Adornment styles in order of first appearance.
+	literalNext := false
+
+	for i := 0; i < len(lines); {
+		if lines[i] == "" {
+			i++
+			continue
+		}
+		b := docBlock{segment: segment{start: offsets[i]}}
+
+		title, style, n := rstTitle(lines, i)
+		switch {
+		case n > 0:
+			level := 0
+			for j, s := range styles {
+				if s == style {
+					level = j + 1
+				}
+			}
+			if level == 0 {
+				styles = append(styles, style)
+				level = len(styles)
+			}
+			headings.push(level, title)
+			b.level = level
+			i += n
+			literalNext = false
+
+		case literalNext && isIndented(lines[i]), rstCodeDirective.MatchString(lines[i]):
+			// The indented body, blank lines included, belongs to the block.
+			b.atomic = true
+			i = indentedEnd(lines, i+1)
+			literalNext = false
+
+		case strings.HasPrefix(lines[i], ".. "):
+			i = indentedEnd(lines, i+1)
+			literalNext = false
+
+		default:
+			b.atomic = rstTableBorder.MatchString(lines[i])
+			for i < len(lines) && lines[i] != "" {
+				i++
+			}
+			literalNext = strings.HasSuffix(lines[i-1], "::")
+		}
+
+		b.path = headings.path()
+		blocks = append(blocks, b)
+	}
+
+	for i := range blocks {
+		blocks[i].end = len(content)
+		if i+1 < len(blocks) {
+			blocks[i].end = blocks[i+1].start
+		}
+	}
+	return blocks
+}
+
+// rstTitle reports whether a section title starts at line i, returning its
+// text, its adornment style and the number of lines it takes up.
+func rstTitle(lines []string, i int) (string, string, int) {
+	// Overlined: adornment, title, matching adornment.
+	if isAdornment(lines[i]) && i+2 < len(lines) && lines[i+1] != "" && lines[i+2] == lines[i] {
+		return strings.TrimSpace(lines[i+1]), "over" + lines[i][:1], 3
+	}
+	// Underlined: title, adornment at least as long as the title.
+	if !isIndented(lines[i]) && !isAdornment(lines[i]) && i+1 < len(lines) && isAdornment(lines[i+1]) &&
+		utf8.RuneCountInString(lines[i+1]) >= utf8.RuneCountInString(lines[i]) {
+		return strings.TrimSpace(lines[i]), lines[i+1][:1], 2
+	}
+	return "", "", 0
+}
+
+// isAdornment reports whether a line repeats a single punctuation character,
+// as section title adornments do.
+func isAdornment(line string) bool {
+	if len(line) < 2 || !strings.ContainsRune(rstAdornmentChars, rune(line[0])) {
+		return false
+	}
+	return strings.Count(line, line[:1]) == len(line)
+}
+
+// indentedEnd returns the index of the first line from i on that ends an
+// indented body: the first non-blank line that is not indented.
+func indentedEnd(lines []string, i int) int {
+	for i < len(lines) && (lines[i] == "" || isIndented(lines[i])) {
+		i++
+	}
+	return i
+}
+
+func isIndented(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
@@ -0,0 +1,119 @@
+package embed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const rstGuide = `=======
+ Guide
+=======
+
+Intro text.
+
+Install
+-------
+
+Run this::
+
+    pip install x
+
+    pip install y
+
+Usage
+-----
+
+.. code-block:: python
+
+   import x
+
+   x.run()
+
+Details
+~~~~~~~
+
++---+---+
+| a | b |
++---+---+
+
+.. note:: A note.
+
+Reference
+---------
+
+See also.
+`
+
+func TestRSTBlocks(t *testing.T) {
+	want := []struct {
+		first  string // The block's first line.
+		level  int
+		atomic bool
+		path   []string
+	}{
+		{"=======", 1, false, []string{"Guide"}},
+		{"Intro text.", 0, false, []string{"Guide"}},
+		{"Install", 2, false, []string{"Guide", "Install"}},
+		{"Run this::", 0, false, []string{"Guide", "Install"}},
+		// A literal block runs over blank lines to the next unindented one.
+		{"    pip install x", 0, true, []string{"Guide", "Install"}},
+		{"Usage", 2, false, []string{"Guide", "Usage"}},
+		{".. code-block:: python", 0, true, []string{"Guide", "Usage"}},
+		// Levels follow the order adornment styles first appear in.
+		{"Details", 3, false, []string{"Guide", "Usage", "Details"}},
+		{"+---+---+", 0, true, []string{"Guide", "Usage", "Details"}},
+		{".. note:: A note.", 0, false, []string{"Guide", "Usage", "Details"}},
+		{"Reference", 2, false, []string{"Guide", "Reference"}},
+		{"See also.", 0, false, []string{"Guide", "Reference"}},
+	}
+
+	blocks := rstBlocks(rstGuide, newLineIndex(rstGuide))
+	if len(blocks) != len(want) {
+		t.Fatalf("got %d blocks, want %d", len(blocks), len(want))
+	}
+	for i, w := range want {
+		b := blocks[i]
+		first, _, _ := strings.Cut(rstGuide[b.start:], "\n")
+		if first != w.first || b.level != w.level || b.atomic != w.atomic || !reflect.DeepEqual(b.path, w.path) {
+			t.Errorf("block %d: %q level %d atomic %v under %v, want %q level %d atomic %v under %v",
+				i, first, b.level, b.atomic, b.path, w.first, w.level, w.atomic, w.path)
+		}
+		if i > 0 && b.start != blocks[i-1].end {
+			t.Errorf("block %d starts at %d, not where block %d ends", i, b.start, i-1)
+		}
+	}
+}
+
+func TestChunkRST(t *testing.T) {
+	chunks := ChunkRST("guide.rst", rstGuide, ChunkOptions{})
+	var starts []string
+	for i, c := range chunks {
+		first, _, _ := strings.Cut(c.Content, "\n")
+		starts = append(starts, first)
+		if c.Metadata["language"] != "rst" {
+			t.Errorf("chunk %d: language %v", i, c.Metadata["language"])
+		}
+	}
+	// Sections start at the second level, running over deeper titles.
+	if want := []string{"=======", "Install", "Usage", "Reference"}; !reflect.DeepEqual(starts, want) {
+		t.Errorf("chunks start with %q, want %q", starts, want)
+	}
+	if !reflect.DeepEqual(chunks[2].Metadata["headings"], []string{"Guide", "Usage"}) {
+		t.Errorf("third chunk under %v", chunks[2].Metadata["headings"])
+	}
+
+	// A literal block over budget is kept whole rather than split.
+	literal := "Title\n=====\n\nExample::\n\n" + strings.Repeat("    x = compute(1, 2, 3)\n", 30)
+	whole := false
+	for _, c := range ChunkRST("a.rst", literal, ChunkOptions{MaxTokens: 48}) {
+		n := strings.Count(c.Content, "x = compute")
+		whole = whole || n == 30
+		if n != 0 && n != 30 {
+			t.Errorf("literal block split, %d of its lines in one chunk", n)
+		}
+	}
+	if !whole {
+		t.Error("no chunk holds the literal block")
+	}
+}
@@ -1,13 +1,16 @@
 package embed
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"go-rag/ent/ent"
 	"go-rag/ent/ent/chunk"
 	"go-rag/ent/ent/document"
+	"go-rag/services/events"
+	"go-rag/services/extract"
 	"go-rag/services/proto"
-	"strings"
 	"sync"
 
 	"github.com/google/uuid"
@@ -17,11 +20,18 @@ import (
 
 const CollectionName = "go-rag-chunks"
 
+// TextSearchConfig is the Postgres text search configuration used for the chunk
+// lexical index. "simple" keeps identifiers and error strings unstemmed. The
+// generated "content_tsv" column of the migrations is built with it too.
+const TextSearchConfig = "simple"
+
 // Service handles the document processing pipeline.
 type Service struct {
 	Client             *ent.Client
 	InferenceClient    proto.InferencerClient
 	QdrantPointsClient qdrant.PointsClient
+	// Events, when set, receives the progress of each document.
+	Events *events.Bus
 }
 
 type embeddingJob struct {
@@ -35,8 +45,30 @@ type embeddingResult struct {
 	Err    error
 }
 
+// movedChunk is an unchanged chunk whose position or metadata has changed.
+type movedChunk struct {
+	ID    int
+	Chunk Chunk
+}
+
+// locationChanged reports whether a stored chunk's position or metadata differs
+// from the freshly chunked one with the same content.
+func locationChanged(existing *ent.Chunk, c Chunk) bool {
+	if existing.Index != c.Index ||
+		existing.StartOffset != c.StartOffset || existing.EndOffset != c.EndOffset ||
+		existing.StartLine != c.StartLine || existing.EndLine != c.EndLine {
+		return true
+	}
+	// Stored metadata comes back from JSON, so compare the encoded forms.
+	stored, _ := json.Marshal(existing.Metadata)
+	fresh, _ := json.Marshal(c.Metadata)
+	return !bytes.Equal(stored, fresh)
+}
+
 // ProcessDocument handles the intelligent chunking and embedding of a document.
-func (s *Service) ProcessDocument(ctx context.Context, documentID int) {
+// It returns why processing failed, leaving the document's status for the
+// caller to settle, since a failed attempt may yet be retried.
+func (s *Service) ProcessDocument(ctx context.Context, documentID int) error {
 	log := logrus.WithField("document_id", documentID)
 	log.Info("starting smart processing for document")
 
@@ -50,12 +82,21 @@ func (s *Service) ProcessDocument(ctx context.Context, documentID int) {
 		Only(ctx)
 	if err != nil {
 		log.WithError(err).Error("failed to fetch document with relations")
-		s.Client.Document.UpdateOneID(documentID).SetStatus("failed").Exec(context.Background())
-		return
+		return err
 	}
 
+	if doc.Edges.Project == nil || doc.Edges.Project.Edges.Owner == nil {
+		err := fmt.Errorf("document %d does not belong to an owned project", doc.ID)
+		log.WithError(err).Error("cannot process document")
+		return err
+	}
 	ownerID := doc.Edges.Project.Edges.Owner.ID
 
+	if err := s.SetStatus(ctx, doc.ID, document.StatusChunking, nil); err != nil {
+		log.WithError(err).Warn("could not start processing")
+		return err
+	}
+
 	// Create a map of existing chunk hashes for quick lookups.
 	existingChunks := make(map[string]*ent.Chunk)
 	for _, c := range doc.Edges.Chunks {
@@ -63,28 +104,52 @@ func (s *Service) ProcessDocument(ctx context.Context, documentID int) {
 	}
 
 	// 2. Generate new chunks from the document's content.
-	var newChunks []Chunk
-	if strings.HasSuffix(doc.Name, ".md") {
-		newChunks = ChunkMarkdown(doc.Content)
-	} else {
-		newChunks = chunkCodeFile(doc.Content)
+	// Binary uploads such as PDF and Office files are chunked by their extracted text.
+	content, mimeType := doc.Content, doc.MimeType
+	var locations []extract.Location
+	if len(doc.Source) > 0 {
+		res, err := extractText(doc)
+		if err != nil {
+			log.WithError(err).Error("failed to extract document text")
+			return err
+		}
+		content, mimeType, locations = res.Text, res.MIMEType, res.Locations
+		if content != doc.Content {
+			if err := s.Client.Document.UpdateOneID(doc.ID).SetContent(content).Exec(ctx); err != nil {
+				log.WithError(err).Error("failed to save extracted text")
+				return err
+			}
+		}
 	}
+
+	// The project's strategy picks the chunker; "auto" goes by file extension.
+	p := doc.Edges.Project
+	newChunks := ChunkerFor(p.ChunkStrategy, doc.Name, mimeType).Chunk(doc.Name, content, ChunkOptionsFor(p))
+	annotateLocations(newChunks, locations)
 	log.WithFields(logrus.Fields{
+		"strategy":             p.ChunkStrategy,
 		"new_chunk_count":      len(newChunks),
 		"existing_chunk_count": len(existingChunks),
 	}).Info("document chunked and existing chunks loaded")
 
-	// 3. Determine which chunks are new, modified, or deleted.
+	// 3. Determine which chunks are new, modified, moved or deleted.
 	var chunksToEmbed []Chunk
+	var chunksToMove []movedChunk
 	chunksToDelete := make(map[string]*ent.Chunk)
 	for k, v := range existingChunks {
 		chunksToDelete[k] = v // Assume all old chunks will be deleted initially
 	}
 
-	for _, newChunk := range newChunks {
-		if _, exists := existingChunks[newChunk.ContentHash]; exists {
+	for i := range newChunks {
+		newChunk := newChunks[i]
+		newChunk.Index = i
+		if existing, exists := existingChunks[newChunk.ContentHash]; exists {
 			// This chunk is unchanged. Remove it from the deletion list.
 			delete(chunksToDelete, newChunk.ContentHash)
+			// Edits elsewhere in the document may still have moved it.
+			if locationChanged(existing, newChunk) {
+				chunksToMove = append(chunksToMove, movedChunk{ID: existing.ID, Chunk: newChunk})
+			}
 		} else {
 			// This is a new or modified chunk that needs embedding.
 			chunksToEmbed = append(chunksToEmbed, newChunk)
@@ -93,36 +158,62 @@ func (s *Service) ProcessDocument(ctx context.Context, documentID int) {
 
 	log.WithFields(logrus.Fields{
 		"to_embed":  len(chunksToEmbed),
+		"to_move":   len(chunksToMove),
 		"to_delete": len(chunksToDelete),
 	}).Info("calculated chunk diff")
+	s.Events.Publish(ctx, events.Event{
+		Type:          events.DocumentChunked,
+		ProjectID:     p.ID,
+		DocumentID:    doc.ID,
+		Chunks:        len(newChunks),
+		NewChunks:     len(chunksToEmbed),
+		RemovedChunks: len(chunksToDelete),
+	})
 
 	// 4. Process the diff.
-	if len(chunksToEmbed) > 0 || len(chunksToDelete) > 0 {
+	if len(chunksToEmbed) > 0 || len(chunksToDelete) > 0 || len(chunksToMove) > 0 {
+		if err := s.SetStatus(ctx, doc.ID, document.StatusEmbedding, nil); err != nil {
+			return err
+		}
 		var vectors [][]float32
 		if len(chunksToEmbed) > 0 {
 			var err error
 			vectors, err = s.embedChunks(ctx, chunksToEmbed)
 			if err != nil {
 				log.WithError(err).Error("failed to embed new/modified chunks")
-				s.Client.Document.UpdateOneID(doc.ID).SetStatus("failed").Exec(ctx)
-				return
+				return err
 			}
 			log.Info("new chunks embedded successfully")
 		}
 
 		// 5. Save everything to the databases (Postgres + Qdrant).
-		if err := s.syncDatabase(ctx, doc, ownerID, chunksToEmbed, vectors, chunksToDelete); err != nil {
+		if err := s.SetStatus(ctx, doc.ID, document.StatusIndexing, nil); err != nil {
+			return err
+		}
+		if err := s.syncDatabase(ctx, doc, ownerID, chunksToEmbed, vectors, chunksToMove, chunksToDelete); err != nil {
 			log.WithError(err).Error("failed to sync databases")
-			s.Client.Document.UpdateOneID(doc.ID).SetStatus("failed").Exec(ctx)
-			return
+			return err
 		}
 	} else {
 		log.Info("no changes detected in document content")
 	}
 
 	// 6. Finalize document status.
-	s.Client.Document.UpdateOneID(doc.ID).SetStatus("completed").SaveX(ctx)
+	if err := s.SetStatus(ctx, doc.ID, document.StatusCompleted, nil); err != nil {
+		log.WithError(err).Error("failed to finalize document status")
+		return err
+	}
 	log.Info("document smart processing completed successfully")
+	return nil
+}
+
+// extractText pulls the text out of a document uploaded in a binary format.
+func extractText(doc *ent.Document) (*extract.Result, error) {
+	e, ok := extract.For(doc.Name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported document format")
+	}
+	return e.Extract(doc.Source)
 }
 
 func (s *Service) DeleteDocumentVectors(ctx context.Context, documentID int) error {
@@ -176,8 +267,28 @@ func (s *Service) DeleteDocumentVectors(ctx context.Context, documentID int) err
 	return nil
 }
 
+// SetDocumentPath updates the path stored with each of a document's vectors,
+// for documents moved without being processed again.
+func (s *Service) SetDocumentPath(ctx context.Context, documentID int, path string) error {
+	wait := true
+	_, err := s.QdrantPointsClient.SetPayload(ctx, &qdrant.SetPayloadPoints{
+		CollectionName: CollectionName,
+		Payload:        map[string]*qdrant.Value{"path": qdrant.NewValueString(path)},
+		PointsSelector: &qdrant.PointsSelector{
+			PointsSelectorOneOf: &qdrant.PointsSelector_Filter{
+				Filter: &qdrant.Filter{Must: []*qdrant.Condition{qdrant.NewMatchInt("document_id", int64(documentID))}},
+			},
+		},
+		Wait: &wait,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update path of document vectors: %w", err)
+	}
+	return nil
+}
+
 // syncDatabase handles the transactional update to Postgres and the corresponding upsert/delete in Qdrant.
-func (s *Service) syncDatabase(ctx context.Context, doc *ent.Document, ownerID uuid.UUID, newChunks []Chunk, newVectors [][]float32, chunksToDelete map[string]*ent.Chunk) error {
+func (s *Service) syncDatabase(ctx context.Context, doc *ent.Document, ownerID uuid.UUID, newChunks []Chunk, newVectors [][]float32, movedChunks []movedChunk, chunksToDelete map[string]*ent.Chunk) error {
 	// --- Delete old points from Qdrant ---
 	if len(chunksToDelete) > 0 {
 		var pointsToDelete []*qdrant.PointId
@@ -226,13 +337,34 @@ func (s *Service) syncDatabase(ctx context.Context, doc *ent.Document, ownerID u
 		logrus.WithField("count", len(idsToDelete)).Info("deleted old chunks from postgres")
 	}
 
+	// Record the new position of chunks that moved within the document
+	for _, m := range movedChunks {
+		err := tx.Chunk.UpdateOneID(m.ID).
+			SetIndex(m.Chunk.Index).
+			SetMetadata(m.Chunk.Metadata).
+			SetStartOffset(m.Chunk.StartOffset).
+			SetEndOffset(m.Chunk.EndOffset).
+			SetStartLine(m.Chunk.StartLine).
+			SetEndLine(m.Chunk.EndLine).
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update moved chunk: %w", err)
+		}
+	}
+
 	// Create new chunks in Postgres and prepare points for Qdrant
 	var pointsToUpsert []*qdrant.PointStruct
 	for i, chunkData := range newChunks {
 		c, err := tx.Chunk.Create().
-			SetIndex(i).
+			SetIndex(chunkData.Index).
 			SetContent(chunkData.Content).
 			SetContentHash(chunkData.ContentHash).
+			SetMetadata(chunkData.Metadata).
+			SetStartOffset(chunkData.StartOffset).
+			SetEndOffset(chunkData.EndOffset).
+			SetStartLine(chunkData.StartLine).
+			SetEndLine(chunkData.EndLine).
 			SetDocument(doc).
 			Save(ctx)
 		if err != nil {
@@ -244,12 +376,7 @@ func (s *Service) syncDatabase(ctx context.Context, doc *ent.Document, ownerID u
 		pointsToUpsert = append(pointsToUpsert, &qdrant.PointStruct{
 			Id:      &qdrant.PointId{PointIdOptions: &qdrant.PointId_Num{Num: uint64(c.ID)}},
 			Vectors: &qdrant.Vectors{VectorsOptions: &qdrant.Vectors_Vector{Vector: &qdrant.Vector{Data: newVectors[i]}}},
-			Payload: map[string]*qdrant.Value{
-				"user_id":     {Kind: &qdrant.Value_StringValue{StringValue: ownerID.String()}},
-				"project_id":  {Kind: &qdrant.Value_IntegerValue{IntegerValue: int64(doc.Edges.Project.ID)}},
-				"document_id": {Kind: &qdrant.Value_IntegerValue{IntegerValue: int64(doc.ID)}},
-				"chunk_id":    {Kind: &qdrant.Value_IntegerValue{IntegerValue: int64(c.ID)}},
-			},
+			Payload: chunkPayload(doc, ownerID, c.ID, chunkData),
 		})
 	}
 
@@ -268,6 +395,31 @@ func (s *Service) syncDatabase(ctx context.Context, doc *ent.Document, ownerID u
 		logrus.WithField("count", len(pointsToUpsert)).Info("upserted new points to qdrant")
 	}
 
+	// Replace the payload of moved points, so that metadata keys the chunk
+	// no longer has are dropped rather than merged with the new ones.
+	for _, m := range movedChunks {
+		wait := true
+		_, err := s.QdrantPointsClient.OverwritePayload(ctx, &qdrant.SetPayloadPoints{
+			CollectionName: CollectionName,
+			Payload:        chunkPayload(doc, ownerID, m.ID, m.Chunk),
+			PointsSelector: &qdrant.PointsSelector{
+				PointsSelectorOneOf: &qdrant.PointsSelector_Points{
+					Points: &qdrant.PointsIdsList{
+						Ids: []*qdrant.PointId{{PointIdOptions: &qdrant.PointId_Num{Num: uint64(m.ID)}}},
+					},
+				},
+			},
+			Wait: &wait,
+		})
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update payload of moved point: %w", err)
+		}
+	}
+	if len(movedChunks) > 0 {
+		logrus.WithField("count", len(movedChunks)).Info("updated locations of moved chunks")
+	}
+
 	return tx.Commit()
 }
 
@@ -0,0 +1,117 @@
+package embed
+
+import (
+	"context"
+	"fmt"
+	"go-rag/ent/ent"
+	"go-rag/ent/ent/document"
+	"go-rag/services/events"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// transitions lists, for each document state, the states it may be entered
+// from. A document is queued whenever its content changes, so queued may
+// follow any state. Chunking may also follow a stage of a run that was
+// interrupted, when the run is started over.
+var transitions = map[document.Status][]document.Status{
+	document.StatusQueued: {
+		document.StatusQueued, document.StatusChunking, document.StatusEmbedding, document.StatusIndexing,
+		document.StatusCompleted, document.StatusFailed, document.StatusCancelled,
+	},
+	document.StatusChunking:  {document.StatusQueued, document.StatusChunking, document.StatusEmbedding, document.StatusIndexing},
+	document.StatusEmbedding: {document.StatusChunking},
+	document.StatusIndexing:  {document.StatusEmbedding},
+	// A document whose chunks are all unchanged is completed right after
+	// chunking.
+	document.StatusCompleted: {document.StatusChunking, document.StatusIndexing},
+	document.StatusFailed:    {document.StatusQueued, document.StatusChunking, document.StatusEmbedding, document.StatusIndexing},
+	document.StatusCancelled: {document.StatusQueued, document.StatusChunking, document.StatusEmbedding, document.StatusIndexing},
+}
+
+// Transition makes a document update move the document to state to. The
+// update then only applies to documents in a state to may follow, and it
+// stamps the time the state was entered. Entering queued starts a new run,
+// so the stamps of the later stages are cleared.
+func Transition(m *ent.DocumentMutation, to document.Status) {
+	now := time.Now()
+	m.Where(document.StatusIn(transitions[to]...))
+	m.SetStatus(to)
+	switch to {
+	case document.StatusQueued:
+		m.SetQueuedAt(now)
+		m.ClearChunkingAt()
+		m.ClearEmbeddingAt()
+		m.ClearIndexingAt()
+		m.ClearCompletedAt()
+		m.ClearFailedAt()
+		m.ClearCancelledAt()
+	case document.StatusChunking:
+		m.SetChunkingAt(now)
+	case document.StatusEmbedding:
+		m.SetEmbeddingAt(now)
+	case document.StatusIndexing:
+		m.SetIndexingAt(now)
+	case document.StatusCompleted:
+		m.SetCompletedAt(now)
+		m.ClearLastError()
+	case document.StatusFailed:
+		m.SetFailedAt(now)
+	case document.StatusCancelled:
+		m.SetCancelledAt(now)
+	}
+}
+
+// SetStatus moves a document to a new state, recording cause as its last
+// error when given. It fails if the document is not in a state to may
+// follow, such as when it was cancelled.
+func (s *Service) SetStatus(ctx context.Context, documentID int, to document.Status, cause error) error {
+	update := s.Client.Document.Update().Where(document.ID(documentID))
+	Transition(update.Mutation(), to)
+	if cause != nil {
+		update.SetLastError(cause.Error())
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		doc, err := s.Client.Document.Query().
+			Where(document.ID(documentID)).
+			Select(document.FieldStatus).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("invalid status transition from %s to %s", doc.Status, to)
+	}
+	s.PublishStatus(ctx, documentID, to, cause)
+	return nil
+}
+
+// PublishStatus tells the document's project that the document entered state
+// to, for updates that move it with Transition rather than SetStatus.
+func (s *Service) PublishStatus(ctx context.Context, documentID int, to document.Status, cause error) {
+	if s.Events == nil {
+		return
+	}
+	projectID, err := s.Client.Document.Query().
+		Where(document.ID(documentID)).
+		QueryProject().
+		OnlyID(ctx)
+	if err != nil {
+		logrus.WithError(err).WithField("document_id", documentID).Warn("could not find project of document for status event")
+		return
+	}
+	ev := events.Event{
+		Type:       events.DocumentStatus,
+		ProjectID:  projectID,
+		DocumentID: documentID,
+		Status:     string(to),
+	}
+	if cause != nil {
+		ev.Error = cause.Error()
+	}
+	s.Events.Publish(ctx, ev)
+}
@@ -0,0 +1,30 @@
+package embed
+
+// ChunkText splits plain text into chunks of whole paragraphs up to the token
+// budget, with opts.Overlap tokens repeated between adjacent chunks. Paragraphs
+// over budget are split at line and sentence boundaries.
+func ChunkText(_, content string, opts ChunkOptions) []Chunk {
+	return splitPlain(content, opts, "text")
+}
+
+// splitPlain splits content without any knowledge of its structure, preferring
+// paragraph, then line and sentence boundaries. The language is recorded in
+// each chunk's metadata unless it is empty.
+func splitPlain(content string, opts ChunkOptions, language string) []Chunk {
+	sp := newSplitter(content, opts)
+	lines := newLineIndex(content)
+
+	var chunks []Chunk
+	for _, seg := range sp.split(segment{0, len(content)}) {
+		c := Chunk{
+			Content:     content[seg.start:seg.end],
+			ContentHash: getContentHash(content[seg.start:seg.end]),
+		}
+		if language != "" {
+			c.Metadata = map[string]interface{}{"language": language}
+		}
+		lines.locate(&c, seg.start, seg.end)
+		chunks = append(chunks, c)
+	}
+	return chunks
+}
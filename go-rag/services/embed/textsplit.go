@@ -0,0 +1,221 @@
+package embed
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxModelTokens is the context length of the embedding model. Chunks are never
+// allowed to exceed it, whatever a project configures.
+const MaxModelTokens = 512
+
+// Default chunk budget and overlap between adjacent chunks, in tokens.
+const (
+	defaultMaxTokens     = 256
+	defaultOverlapTokens = 32
+)
+
+// Tokenizer counts the tokens a text takes up in the embedding model.
+type Tokenizer interface {
+	CountTokens(text string) int
+}
+
+// DefaultTokenizer approximates the embedding model's WordPiece tokenizer.
+var DefaultTokenizer Tokenizer = wordPieceEstimator{}
+
+// wordPieceEstimator estimates WordPiece token counts without the model's
+// vocabulary: every punctuation mark and every CJK character is a token, and a
+// run of letters or digits is one token plus one per further five characters,
+// which is how rare words and identifiers break into sub-word pieces.
+type wordPieceEstimator struct{}
+
+func (wordPieceEstimator) CountTokens(text string) int {
+	tokens, run := 0, 0
+	flush := func() {
+		if run > 0 {
+			tokens += 1 + (run-1)/5
+			run = 0
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case r >= 0x2E80 && unicode.IsLetter(r):
+			// CJK scripts are not space-separated; each character is a token.
+			flush()
+			tokens++
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			run++
+		default:
+			flush()
+			tokens++
+		}
+	}
+	flush()
+	return tokens
+}
+
+// splitter cuts a document into chunks of at most maxTokens, repeating up to
+// overlap tokens of each chunk at the start of the next one.
+type splitter struct {
+	doc       string
+	tokenizer Tokenizer
+	maxTokens int
+	overlap   int
+}
+
+// newSplitter applies the options' budget, overlap and tokenizer to doc.
+func newSplitter(doc string, opts ChunkOptions) *splitter {
+	sp := &splitter{
+		doc:       doc,
+		tokenizer: opts.tokenizer(),
+		maxTokens: opts.maxTokens(),
+		overlap:   opts.overlap(defaultOverlapTokens),
+	}
+	// Overlap must leave room for new content in every chunk.
+	if sp.overlap > sp.maxTokens/2 {
+		sp.overlap = sp.maxTokens / 2
+	}
+	return sp
+}
+
+func (sp *splitter) tokens(seg segment) int {
+	return sp.tokenizer.CountTokens(sp.doc[seg.start:seg.end])
+}
+
+// Boundaries to split at, from most to least preferred: paragraphs, lines,
+// sentences and words.
+var splitSeparators = []string{"\n\n", "\n", ". ", "? ", "! ", "; ", " "}
+
+// split returns the byte ranges of the chunks of seg. Each range is a verbatim
+// slice of the document, so formatting inside a chunk is preserved.
+func (sp *splitter) split(seg segment) []segment {
+	seg = trimSegment(sp.doc, seg)
+	if seg.start == seg.end {
+		return nil
+	}
+	if sp.tokens(seg) <= sp.maxTokens {
+		return []segment{seg}
+	}
+	return sp.merge(sp.pieces(seg, 0))
+}
+
+// pieces breaks seg at the most preferred separator that occurs in it, then
+// recursively breaks any piece that is still over budget at the next one.
+func (sp *splitter) pieces(seg segment, level int) []segment {
+	if sp.tokens(seg) <= sp.maxTokens {
+		return []segment{seg}
+	}
+	if level == len(splitSeparators) {
+		return sp.hardSplit(seg)
+	}
+
+	sep := splitSeparators[level]
+	text := sp.doc[seg.start:seg.end]
+	if !strings.Contains(text, sep) {
+		return sp.pieces(seg, level+1)
+	}
+
+	var out []segment
+	start := seg.start
+	for start < seg.end {
+		i := strings.Index(sp.doc[start:seg.end], sep)
+		end := seg.end
+		if i >= 0 {
+			// The separator stays with the piece before it.
+			end = start + i + len(sep)
+		}
+		out = append(out, sp.pieces(segment{start, end}, level+1)...)
+		start = end
+	}
+	return out
+}
+
+// hardSplit cuts a run without any separator, such as a long URL or base64
+// blob, into pieces that fit the budget.
+func (sp *splitter) hardSplit(seg segment) []segment {
+	var out []segment
+	start := seg.start
+	for start < seg.end {
+		end := start
+		for end < seg.end {
+			_, size := utf8.DecodeRuneInString(sp.doc[end:seg.end])
+			if end > start && sp.tokens(segment{start, end + size}) > sp.maxTokens {
+				break
+			}
+			end += size
+		}
+		out = append(out, segment{start, end})
+		start = end
+	}
+	return out
+}
+
+// pack merges adjacent blocks, such as top-level declarations, into chunks up
+// to the budget without overlap. Blocks over budget are kept whole for split.
+func (sp *splitter) pack(blocks []segment) []segment {
+	var packed []segment
+	for _, b := range blocks {
+		if n := len(packed); n > 0 && sp.tokens(segment{packed[n-1].start, b.end}) <= sp.maxTokens {
+			packed[n-1].end = b.end
+			continue
+		}
+		packed = append(packed, b)
+	}
+	return packed
+}
+
+// merge packs consecutive pieces into chunks up to the budget. A new chunk
+// starts with the trailing pieces of the previous one, up to sp.overlap tokens.
+func (sp *splitter) merge(pieces []segment) []segment {
+	var chunks []segment
+	first := 0 // Index of the first piece of the current chunk.
+	for i := 1; i <= len(pieces); i++ {
+		if i < len(pieces) && sp.tokens(segment{pieces[first].start, pieces[i].end}) <= sp.maxTokens {
+			continue
+		}
+		chunks = append(chunks, trimSegment(sp.doc, segment{pieces[first].start, pieces[i-1].end}))
+		if i == len(pieces) {
+			break
+		}
+
+		// Walk back from the end of the finished chunk to pick the overlap,
+		// keeping room for at least the next piece.
+		next := i
+		for next > first+1 {
+			candidate := segment{pieces[next-1].start, pieces[i].end}
+			if sp.tokens(segment{pieces[next-1].start, pieces[i-1].end}) > sp.overlap || sp.tokens(candidate) > sp.maxTokens {
+				break
+			}
+			next--
+		}
+		first = next
+	}
+	return chunks
+}
+
+// trimSegment narrows seg to exclude blank lines around it and trailing
+// whitespace. The indentation of its first line is kept.
+func trimSegment(doc string, seg segment) segment {
+	atLineStart := seg.start == 0 || doc[seg.start-1] == '\n'
+	indent := seg.start
+	for seg.start < seg.end && isSpaceByte(doc[seg.start]) {
+		if doc[seg.start] == '\n' {
+			atLineStart, indent = true, seg.start+1
+		}
+		seg.start++
+	}
+	for seg.end > seg.start && isSpaceByte(doc[seg.end-1]) {
+		seg.end--
+	}
+	if atLineStart && seg.start < seg.end {
+		seg.start = indent
+	}
+	return seg
+}
+
+func isSpaceByte(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
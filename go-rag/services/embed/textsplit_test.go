@@ -0,0 +1,156 @@
+package embed
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestWordPieceEstimator(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"   \n\t", 0},
+		{"the quick fox", 3},
+		// A run of letters is one token per started five characters.
+		{"abcde", 1},
+		{"abcdef", 2},
+		{"internationalization", 4},
+		{"x := f(1, 2)", 9},
+		{"日本語", 3},
+		{"日本語 text", 4},
+	}
+	for _, tt := range tests {
+		if got := DefaultTokenizer.CountTokens(tt.text); got != tt.want {
+			t.Errorf("CountTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNewSplitterCapsOverlap(t *testing.T) {
+	intp := func(n int) *int { return &n }
+	tests := []struct {
+		name    string
+		opts    ChunkOptions
+		max     int
+		overlap int
+	}{
+		{name: "defaults", opts: ChunkOptions{}, max: defaultMaxTokens, overlap: defaultOverlapTokens},
+		{name: "budget over the model", opts: ChunkOptions{MaxTokens: 4096}, max: MaxModelTokens, overlap: defaultOverlapTokens},
+		{name: "no overlap", opts: ChunkOptions{MaxTokens: 64, Overlap: intp(0)}, max: 64, overlap: 0},
+		{name: "overlap within half", opts: ChunkOptions{MaxTokens: 64, Overlap: intp(20)}, max: 64, overlap: 20},
+		{name: "overlap over half", opts: ChunkOptions{MaxTokens: 64, Overlap: intp(50)}, max: 64, overlap: 32},
+		{name: "default overlap over half", opts: ChunkOptions{MaxTokens: 40}, max: 40, overlap: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := newSplitter("", tt.opts)
+			if sp.maxTokens != tt.max || sp.overlap != tt.overlap {
+				t.Errorf("budget %d, overlap %d; want %d, %d", sp.maxTokens, sp.overlap, tt.max, tt.overlap)
+			}
+		})
+	}
+}
+
+func TestSplitterSplit(t *testing.T) {
+	sentence := "The quick brown fox jumps over the lazy dog near the river bank. "
+	tests := []struct {
+		name    string
+		doc     string
+		max     int
+		overlap int
+	}{
+		{name: "fits", doc: "A short paragraph.\n", max: 64, overlap: 16},
+		{name: "paragraphs", doc: strings.Repeat(strings.Repeat(sentence, 3)+"\n\n", 8), max: 64, overlap: 16},
+		{name: "one long paragraph", doc: strings.Repeat(sentence, 30), max: 64, overlap: 16},
+		{name: "without overlap", doc: strings.Repeat(sentence, 30), max: 64, overlap: 0},
+		{name: "lines", doc: strings.Repeat("x := compute(1, 2, 3)\n", 100), max: 48, overlap: 8},
+		{name: "words", doc: strings.Repeat("word ", 400), max: 32, overlap: 8},
+		{name: "unbroken run", doc: "see " + strings.Repeat("aGVsbG8gd29ybGQ", 200) + " end", max: 32, overlap: 8},
+		{name: "cjk", doc: strings.Repeat("日本語のテキストです。", 100), max: 50, overlap: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlap := tt.overlap
+			sp := newSplitter(tt.doc, ChunkOptions{MaxTokens: tt.max, Overlap: &overlap})
+			segs := sp.split(segment{0, len(tt.doc)})
+			if len(segs) == 0 {
+				t.Fatal("no chunks")
+			}
+
+			covered := make([]bool, len(tt.doc))
+			for i, seg := range segs {
+				if seg.start < 0 || seg.start >= seg.end || seg.end > len(tt.doc) {
+					t.Fatalf("chunk %d: range [%d, %d) of %d bytes", i, seg.start, seg.end, len(tt.doc))
+				}
+				if n := sp.tokens(seg); n > tt.max {
+					t.Errorf("chunk %d: %d tokens, over the budget of %d", i, n, tt.max)
+				}
+				if text := tt.doc[seg.start:seg.end]; strings.TrimSpace(text) != text {
+					t.Errorf("chunk %d is not trimmed: %q", i, text)
+				}
+				if i > 0 {
+					prev := segs[i-1]
+					if seg.start <= prev.start || seg.end <= prev.end {
+						t.Fatalf("chunk %d [%d, %d) does not follow chunk %d [%d, %d)", i, seg.start, seg.end, i-1, prev.start, prev.end)
+					}
+					if seg.start < prev.end {
+						if tt.overlap == 0 {
+							t.Errorf("chunk %d overlaps the one before it without overlap configured", i)
+						} else if n := sp.tokens(segment{seg.start, prev.end}); n > tt.overlap {
+							t.Errorf("chunk %d repeats %d tokens, over the overlap of %d", i, n, tt.overlap)
+						}
+					}
+				}
+				for j := seg.start; j < seg.end; j++ {
+					covered[j] = true
+				}
+			}
+			for j, ok := range covered {
+				if !ok && !unicode.IsSpace(rune(tt.doc[j])) {
+					t.Fatalf("byte %d (%q) is in no chunk", j, tt.doc[j:min(len(tt.doc), j+20)])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitterMergeRepeatsOverlap(t *testing.T) {
+	doc := strings.Repeat("The quick brown fox jumps over the lazy dog near the river bank. ", 30)
+	overlap := 16
+	sp := newSplitter(doc, ChunkOptions{MaxTokens: 64, Overlap: &overlap})
+	segs := sp.split(segment{0, len(doc)})
+	if len(segs) < 2 {
+		t.Fatalf("got %d chunks, want the paragraph split", len(segs))
+	}
+	// Every sentence is 14 tokens, so each chunk after the first starts with
+	// the last sentence of the one before it.
+	for i := 1; i < len(segs); i++ {
+		if segs[i].start >= segs[i-1].end {
+			t.Errorf("chunk %d does not repeat the end of chunk %d", i, i-1)
+		}
+	}
+}
+
+func TestTrimSegment(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{name: "blank lines around", doc: "\n\n  indented\n\n", want: "  indented"},
+		{name: "mid-line start", doc: "a   b  ", want: "a   b"},
+		{name: "trailing whitespace", doc: "text \t\r\n", want: "text"},
+		{name: "only whitespace", doc: " \n\t ", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seg := trimSegment(tt.doc, segment{0, len(tt.doc)})
+			if got := tt.doc[seg.start:seg.end]; got != tt.want {
+				t.Errorf("trimmed to %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
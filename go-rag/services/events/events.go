@@ -0,0 +1,228 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"go-rag/ent/ent"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// channel is the Postgres notification channel events are published on.
+const channel = "project_events"
+
+// Event types.
+const (
+	// DocumentCreated, DocumentUpdated and DocumentDeleted report changes
+	// made to a project's documents, by a user or by a sync or import.
+	DocumentCreated = "document.created"
+	DocumentUpdated = "document.updated"
+	DocumentDeleted = "document.deleted"
+	// DocumentStatus reports that a document entered a new processing state.
+	DocumentStatus = "document.status"
+	// DocumentChunked reports the chunks a document was split into.
+	DocumentChunked = "document.chunked"
+	// ProjectDeleted reports that a project is being deleted.
+	ProjectDeleted = "project.deleted"
+	// Resync tells subscribers that events may have been missed, as while
+	// the connection to the database was lost, so they should refetch state.
+	Resync = "resync"
+)
+
+// maxErrorLength bounds the error message carried by an event, since a
+// notification payload must stay under 8000 bytes.
+const maxErrorLength = 2000
+
+// subscriberBuffer is how many events a slow subscriber may fall behind
+// before further events to it are dropped.
+const subscriberBuffer = 64
+
+// Event is a change in a project, as sent to its subscribers.
+type Event struct {
+	Type       string `json:"type"`
+	ProjectID  int    `json:"project_id"`
+	DocumentID int    `json:"document_id,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Chunk counts of a document.chunked event: all of the document's
+	// chunks, and those that are new and must be embedded or were removed.
+	Chunks        int       `json:"chunks,omitempty"`
+	NewChunks     int       `json:"new_chunks,omitempty"`
+	RemovedChunks int       `json:"removed_chunks,omitempty"`
+	Time          time.Time `json:"time"`
+}
+
+// Bus publishes project events through Postgres NOTIFY and delivers the
+// events of every server instance to this instance's subscribers, which it
+// receives through LISTEN. A nil *Bus publishes nothing.
+type Bus struct {
+	Client      *ent.Client
+	DatabaseURL string
+
+	mu    sync.Mutex
+	subs  map[int]map[chan Event]struct{}
+	hooks []func(context.Context, Event)
+}
+
+// OnPublish registers fn to be called with each event this instance
+// publishes, before the event is sent. Unlike subscribers, which receive the
+// events of every instance, a hook sees each event exactly once, which suits
+// work that must not be repeated per instance.
+func (b *Bus) OnPublish(fn func(context.Context, Event)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.hooks = append(b.hooks, fn)
+}
+
+// Publish sends an event to the subscribers of its project on every
+// instance. Failures are logged, as events are informational. When ctx
+// carries a transaction, the hooks are given it and the event is only sent
+// once it commits.
+func (b *Bus) Publish(ctx context.Context, ev Event) {
+	if b == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	b.mu.Lock()
+	hooks := b.hooks
+	b.mu.Unlock()
+	for _, hook := range hooks {
+		hook(ctx, ev)
+	}
+
+	payload, err := notification(ev)
+	if err != nil {
+		logrus.WithError(err).Error("events: failed to encode event")
+		return
+	}
+	// A notification sent in a transaction is delivered when it commits.
+	client := b.Client
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
+	if _, err := client.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, payload); err != nil {
+		logrus.WithError(err).WithField("type", ev.Type).Warn("events: failed to publish event")
+	}
+}
+
+// notification encodes an event as a notification payload, shortening its
+// error message to fit.
+func notification(ev Event) (string, error) {
+	if len(ev.Error) > maxErrorLength {
+		cut := maxErrorLength
+		for cut > 0 && !utf8.RuneStart(ev.Error[cut]) {
+			cut--
+		}
+		ev.Error = ev.Error[:cut] + "…"
+	}
+	payload, err := json.Marshal(ev)
+	return string(payload), err
+}
+
+// Start listens for the events of all instances until ctx is cancelled,
+// reconnecting to the database as needed.
+func (b *Bus) Start(ctx context.Context) error {
+	listener := pq.NewListener(b.DatabaseURL, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logrus.WithError(err).Warn("events: listener connection problem")
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		defer listener.Close()
+		ping := time.NewTicker(90 * time.Second)
+		defer ping.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.NotificationChannel():
+				if n == nil {
+					// The connection was re-established; notifications sent
+					// in between were lost.
+					b.broadcast(Event{Type: Resync, Time: time.Now()})
+					continue
+				}
+				var ev Event
+				if err := json.Unmarshal([]byte(n.Extra), &ev); err != nil {
+					logrus.WithError(err).Warn("events: ignoring malformed notification")
+					continue
+				}
+				b.dispatch(ev)
+			case <-ping.C:
+				// Detects a dead connection that would otherwise go unnoticed.
+				go listener.Ping()
+			}
+		}
+	}()
+	logrus.Info("events: listening for project events")
+	return nil
+}
+
+// Subscribe returns the events of a project as they arrive, and a function
+// that ends the subscription.
+func (b *Bus) Subscribe(projectID int) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+	b.mu.Lock()
+	if b.subs == nil {
+		b.subs = make(map[int]map[chan Event]struct{})
+	}
+	if b.subs[projectID] == nil {
+		b.subs[projectID] = make(map[chan Event]struct{})
+	}
+	b.subs[projectID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs[projectID], ch)
+			if len(b.subs[projectID]) == 0 {
+				delete(b.subs, projectID)
+			}
+			b.mu.Unlock()
+		})
+	}
+}
+
+// dispatch hands an event to the subscribers of its project.
+func (b *Bus) dispatch(ev Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[ev.ProjectID] {
+		send(ch, ev)
+	}
+}
+
+// broadcast hands an event to every subscriber, each with its own project.
+func (b *Bus) broadcast(ev Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for projectID, subs := range b.subs {
+		ev.ProjectID = projectID
+		for ch := range subs {
+			send(ch, ev)
+		}
+	}
+}
+
+// send delivers an event without waiting on a subscriber that fell behind.
+func send(ch chan Event, ev Event) {
+	select {
+	case ch <- ev:
+	default:
+		logrus.WithField("project_id", ev.ProjectID).Warn("events: subscriber is falling behind, dropping event")
+	}
+}
@@ -0,0 +1,203 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"go-rag/ent/ent"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/mattn/go-sqlite3"
+)
+
+// notified receives the payloads sent with pg_notify to the test databases.
+var notified = make(chan string, 16)
+
+// The test database stands in for Postgres' pg_notify by recording the
+// payloads it is given.
+func init() {
+	sql.Register("sqlite3_pg_notify", &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("pg_notify", func(ch, payload string) interface{} {
+				if ch == channel {
+					notified <- payload
+				}
+				return nil
+			}, false)
+		},
+	})
+}
+
+// newTestBus returns a bus over a fresh in-memory database.
+func newTestBus(t *testing.T) *Bus {
+	t.Helper()
+	db, err := sql.Open("sqlite3_pg_notify", "file:"+strings.ReplaceAll(t.Name(), "/", "_")+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	return &Bus{Client: client}
+}
+
+// receive returns the next event on ch, failing the test if none arrives.
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return Event{}
+	}
+}
+
+// quiet fails the test if an event is waiting on ch.
+func quiet(t *testing.T, ch <-chan Event) {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		t.Errorf("unexpected event %+v", ev)
+	default:
+	}
+}
+
+func TestPublish(t *testing.T) {
+	b := newTestBus(t)
+	var (
+		mu     sync.Mutex
+		hooked []Event
+	)
+	b.OnPublish(func(_ context.Context, ev Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		hooked = append(hooked, ev)
+	})
+
+	b.Publish(context.Background(), Event{Type: DocumentUpdated, ProjectID: 3, DocumentID: 7, Path: "docs/a.md"})
+
+	var payload string
+	select {
+	case payload = <-notified:
+	case <-time.After(time.Second):
+		t.Fatal("event was not notified")
+	}
+	var ev Event
+	if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+		t.Fatalf("payload %q: %v", payload, err)
+	}
+	if ev.Type != DocumentUpdated || ev.ProjectID != 3 || ev.DocumentID != 7 || ev.Path != "docs/a.md" {
+		t.Errorf("notified %+v", ev)
+	}
+	if ev.Time.IsZero() {
+		t.Error("event was published without a time")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(hooked) != 1 || hooked[0].DocumentID != 7 || !hooked[0].Time.Equal(ev.Time) {
+		t.Errorf("hook saw %+v, want the published event once", hooked)
+	}
+}
+
+func TestPublishOnNilBus(t *testing.T) {
+	var b *Bus
+	b.Publish(context.Background(), Event{Type: DocumentCreated, ProjectID: 1})
+}
+
+func TestNotificationTruncatesErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  string
+	}{
+		{name: "ascii", err: strings.Repeat("e", maxErrorLength+100)},
+		// The limit falls inside a three-byte character.
+		{name: "multi-byte", err: "e" + strings.Repeat("€", maxErrorLength)},
+		{name: "short", err: "file not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := notification(Event{Type: DocumentStatus, ProjectID: 1, Error: tt.err})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !utf8.ValidString(payload) {
+				t.Fatalf("payload is not valid UTF-8")
+			}
+			if len(payload) >= 8000 {
+				t.Errorf("payload of %d bytes is over the notification limit", len(payload))
+			}
+			var ev Event
+			if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+				t.Fatal(err)
+			}
+			if len(tt.err) <= maxErrorLength {
+				if ev.Error != tt.err {
+					t.Errorf("error %q, want it unchanged", ev.Error)
+				}
+				return
+			}
+			want := strings.TrimSuffix(ev.Error, "…")
+			if want == ev.Error || !strings.HasPrefix(tt.err, want) || len(want) > maxErrorLength {
+				t.Errorf("error of %d bytes is not a marked prefix of at most %d bytes", len(ev.Error), maxErrorLength)
+			}
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	b := &Bus{}
+	first, cancelFirst := b.Subscribe(1)
+	second, cancelSecond := b.Subscribe(1)
+	other, cancelOther := b.Subscribe(2)
+	defer cancelSecond()
+	defer cancelOther()
+
+	b.dispatch(Event{Type: DocumentCreated, ProjectID: 1, DocumentID: 10})
+	for _, ch := range []<-chan Event{first, second} {
+		if ev := receive(t, ch); ev.DocumentID != 10 {
+			t.Errorf("received %+v, want document 10", ev)
+		}
+	}
+	quiet(t, other)
+
+	// A resync reaches every subscriber, addressed to its own project.
+	b.broadcast(Event{Type: Resync})
+	for ch, project := range map[<-chan Event]int{first: 1, second: 1, other: 2} {
+		if ev := receive(t, ch); ev.Type != Resync || ev.ProjectID != project {
+			t.Errorf("received %+v, want a resync of project %d", ev, project)
+		}
+	}
+
+	// Ending a subscription, even twice, leaves the others in place.
+	cancelFirst()
+	cancelFirst()
+	b.dispatch(Event{Type: DocumentDeleted, ProjectID: 1, DocumentID: 11})
+	quiet(t, first)
+	if ev := receive(t, second); ev.DocumentID != 11 {
+		t.Errorf("received %+v, want document 11", ev)
+	}
+}
+
+func TestSubscriberFallingBehind(t *testing.T) {
+	b := &Bus{}
+	ch, cancel := b.Subscribe(1)
+	defer cancel()
+
+	// Events past the buffer are dropped rather than blocking the bus.
+	for i := range subscriberBuffer + 10 {
+		b.dispatch(Event{Type: DocumentStatus, ProjectID: 1, DocumentID: i})
+	}
+	if n := len(ch); n != subscriberBuffer {
+		t.Errorf("%d events buffered, want %d", n, subscriberBuffer)
+	}
+	if ev := receive(t, ch); ev.DocumentID != 0 {
+		t.Errorf("first event is for document %d, want the earliest", ev.DocumentID)
+	}
+}
@@ -0,0 +1,298 @@
+package extract
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DOCX extracts the text of a Word document as Markdown: paragraphs styled as
+// headings become Markdown headings, so chunks carry their heading path, list
+// items become bullets and tables become Markdown tables.
+func DOCX(data []byte) (*Result, error) {
+	pkg, err := openOOXML(data, "docx")
+	if err != nil {
+		return nil, err
+	}
+	body, err := pkg.read("word/document.xml")
+	if err != nil {
+		return nil, fmt.Errorf("docx: %w", err)
+	}
+	if body == nil {
+		return nil, errors.New("docx: document part is missing")
+	}
+	styles, err := pkg.read("word/styles.xml")
+	if err != nil {
+		return nil, fmt.Errorf("docx: %w", err)
+	}
+
+	w := &docxWriter{headings: docxHeadingLevels(styles)}
+	if err := w.parse(body); err != nil {
+		return nil, fmt.Errorf("docx: malformed document: %w", err)
+	}
+	text := strings.Join(w.blocks, "\n\n")
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("docx: no extractable text")
+	}
+	return &Result{Text: text, MIMEType: "text/markdown"}, nil
+}
+
+// docxHeadingLevels maps paragraph style IDs to heading levels. A style is a
+// heading if it is named "heading N" or "Title", or sets an outline level,
+// directly or through the style it is based on.
+func docxHeadingLevels(data []byte) map[string]int {
+	var doc struct {
+		Styles []struct {
+			Type    string   `xml:"type,attr"`
+			ID      string   `xml:"styleId,attr"`
+			Name    ooxmlVal `xml:"name"`
+			BasedOn ooxmlVal `xml:"basedOn"`
+			Outline ooxmlVal `xml:"pPr>outlineLvl"`
+		} `xml:"style"`
+	}
+	levels := make(map[string]int)
+	if data == nil || xml.Unmarshal(data, &doc) != nil {
+		return levels
+	}
+
+	direct := make(map[string]int)
+	basedOn := make(map[string]string)
+	for _, s := range doc.Styles {
+		if s.Type != "" && s.Type != "paragraph" {
+			continue
+		}
+		basedOn[s.ID] = s.BasedOn.Val
+		name := strings.ToLower(s.Name.Val)
+		switch {
+		case strings.HasPrefix(name, "heading "):
+			if n, err := strconv.Atoi(strings.TrimPrefix(name, "heading ")); err == nil && n >= 1 && n <= 6 {
+				direct[s.ID] = n
+			}
+		case name == "title":
+			direct[s.ID] = 1
+		case s.Outline.Val != "":
+			if n, err := strconv.Atoi(s.Outline.Val); err == nil && n >= 0 && n < 6 {
+				direct[s.ID] = n + 1
+			}
+		}
+	}
+	for id := range basedOn {
+		// Follow the basedOn chain, guarding against cycles.
+		for s, hops := id, 0; s != "" && hops < 16; s, hops = basedOn[s], hops+1 {
+			if n, ok := direct[s]; ok {
+				levels[id] = n
+				break
+			}
+		}
+	}
+	return levels
+}
+
+// ooxmlVal is an element whose value is in its w:val attribute.
+type ooxmlVal struct {
+	Val string `xml:"val,attr"`
+}
+
+// docxParagraph is a paragraph being read.
+type docxParagraph struct {
+	text    strings.Builder
+	style   string
+	outline int // outline level + 1 set on the paragraph itself, or 0
+	list    bool
+}
+
+// docxTable collects the rows of a table being read, and the paragraphs of its
+// current cell.
+type docxTable struct {
+	rows [][]string
+	cell []string
+}
+
+type docxWriter struct {
+	headings map[string]int
+	blocks   []string
+	paras    []*docxParagraph // nested through text boxes
+	tables   []*docxTable     // nested tables
+}
+
+func (w *docxWriter) parse(data []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	inText := false
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			p := w.paragraph()
+			switch t.Name.Local {
+			case "Fallback":
+				// Alternate content repeats the preferred Choice for older readers.
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			case "p":
+				w.paras = append(w.paras, &docxParagraph{})
+			case "pStyle":
+				if p != nil {
+					p.style = attr(t, "val")
+				}
+			case "outlineLvl":
+				if n, err := strconv.Atoi(attr(t, "val")); p != nil && err == nil && n >= 0 && n < 6 {
+					p.outline = n + 1
+				}
+			case "numPr":
+				if p != nil {
+					p.list = true
+				}
+			case "t":
+				inText = true
+			case "tab":
+				if p != nil {
+					p.text.WriteByte('\t')
+				}
+			case "br", "cr":
+				if p != nil {
+					p.text.WriteByte('\n')
+				}
+			case "noBreakHyphen":
+				if p != nil {
+					p.text.WriteByte('-')
+				}
+			case "tbl":
+				w.tables = append(w.tables, &docxTable{})
+			case "tr":
+				if tbl := w.table(); tbl != nil {
+					tbl.rows = append(tbl.rows, nil)
+				}
+			case "tc":
+				if tbl := w.table(); tbl != nil {
+					tbl.cell = nil
+				}
+			}
+		case xml.CharData:
+			if p := w.paragraph(); inText && p != nil {
+				p.text.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				w.endParagraph()
+			case "tc":
+				if tbl := w.table(); tbl != nil && len(tbl.rows) > 0 {
+					last := len(tbl.rows) - 1
+					tbl.rows[last] = append(tbl.rows[last], strings.Join(tbl.cell, " "))
+				}
+			case "tbl":
+				w.endTable()
+			}
+		}
+	}
+}
+
+func (w *docxWriter) paragraph() *docxParagraph {
+	if len(w.paras) == 0 {
+		return nil
+	}
+	return w.paras[len(w.paras)-1]
+}
+
+func (w *docxWriter) table() *docxTable {
+	if len(w.tables) == 0 {
+		return nil
+	}
+	return w.tables[len(w.tables)-1]
+}
+
+func (w *docxWriter) endParagraph() {
+	p := w.paragraph()
+	if p == nil {
+		return
+	}
+	w.paras = w.paras[:len(w.paras)-1]
+	text := strings.TrimSpace(p.text.String())
+	if text == "" {
+		return
+	}
+	if tbl := w.table(); tbl != nil {
+		tbl.cell = append(tbl.cell, collapseLines(text))
+		return
+	}
+
+	level := p.outline
+	if level == 0 {
+		level = w.headings[p.style]
+	}
+	switch {
+	case level > 0:
+		w.blocks = append(w.blocks, strings.Repeat("#", level)+" "+collapseLines(text))
+	case p.list:
+		w.blocks = append(w.blocks, "- "+text)
+	default:
+		w.blocks = append(w.blocks, text)
+	}
+}
+
+// endTable renders a finished table as a Markdown table, its first row as the
+// header. A nested table is flattened into the enclosing cell.
+func (w *docxWriter) endTable() {
+	tbl := w.table()
+	if tbl == nil {
+		return
+	}
+	w.tables = w.tables[:len(w.tables)-1]
+	var rows [][]string
+	width := 0
+	for _, r := range tbl.rows {
+		if len(r) == 0 {
+			continue
+		}
+		rows = append(rows, r)
+		if len(r) > width {
+			width = len(r)
+		}
+	}
+	if len(rows) == 0 {
+		return
+	}
+	if outer := w.table(); outer != nil {
+		for _, r := range rows {
+			outer.cell = append(outer.cell, strings.Join(r, " "))
+		}
+		return
+	}
+
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for i := 0; i < width; i++ {
+			cell := ""
+			if i < len(cells) {
+				cell = strings.ReplaceAll(cells[i], "|", `\|`)
+			}
+			b.WriteString(" " + cell + " |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(rows[0])
+	b.WriteString("|" + strings.Repeat(" --- |", width) + "\n")
+	for _, r := range rows[1:] {
+		writeRow(r)
+	}
+	w.blocks = append(w.blocks, strings.TrimSuffix(b.String(), "\n"))
+}
+
+// collapseLines joins the lines of text that must stay on one line.
+func collapseLines(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,53 @@
+// Package extract pulls the text out of binary document formats, such as PDF
+// and Office files, so that they can be chunked and embedded like text files.
+package extract
+
+import (
+	"path"
+	"strings"
+)
+
+// Result is the text extracted from a document.
+type Result struct {
+	// Text is the document's text, laid out for chunking.
+	Text string
+	// MIMEType is the format of Text, "text/plain" or "text/markdown", and
+	// selects the chunker for it.
+	MIMEType string
+	// Locations mark where each page, slide or sheet starts in Text, in order.
+	Locations []Location
+}
+
+// Location attaches metadata, such as a page number, to the text from Offset
+// up to the next location.
+type Location struct {
+	Offset   int
+	Metadata map[string]interface{}
+}
+
+// Extractor extracts the text of one document format.
+type Extractor interface {
+	Extract(data []byte) (*Result, error)
+}
+
+// ExtractorFunc adapts a function to the Extractor interface.
+type ExtractorFunc func(data []byte) (*Result, error)
+
+// Extract calls f(data).
+func (f ExtractorFunc) Extract(data []byte) (*Result, error) {
+	return f(data)
+}
+
+// extractors maps lower-case file extensions to the extractor for the format.
+var extractors = map[string]Extractor{
+	".pdf":  ExtractorFunc(PDF),
+	".docx": ExtractorFunc(DOCX),
+	".xlsx": ExtractorFunc(XLSX),
+	".pptx": ExtractorFunc(PPTX),
+}
+
+// For returns the extractor for a file, by its extension.
+func For(name string) (Extractor, bool) {
+	e, ok := extractors[strings.ToLower(path.Ext(name))]
+	return e, ok
+}
@@ -0,0 +1,297 @@
+package extract_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go-rag/services/embed"
+	"go-rag/services/extract"
+)
+
+const (
+	wordNS  = `xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`
+	sheetNS = `xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"`
+	slideNS = `xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"`
+)
+
+// zipOf builds a package holding the given parts.
+func zipOf(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// rels returns a relationships part with targets by ID.
+func rels(targets ...string) string {
+	var b strings.Builder
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 0; i+1 < len(targets); i += 2 {
+		b.WriteString(`<Relationship Id="` + targets[i] + `" Target="` + targets[i+1] + `"/>`)
+	}
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// para returns a Word paragraph with the given style, or none.
+func para(style, text string) string {
+	p := `<w:p>`
+	if style != "" {
+		p += `<w:pPr><w:pStyle w:val="` + style + `"/></w:pPr>`
+	}
+	return p + `<w:r><w:t>` + text + `</w:t></w:r></w:p>`
+}
+
+func docxParts() map[string]string {
+	styles := `<w:styles ` + wordNS + `>
+		<w:style w:type="paragraph" w:styleId="Normal"><w:name w:val="Normal"/></w:style>
+		<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/></w:style>
+		<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/></w:style>
+		<w:style w:type="paragraph" w:styleId="Chapter"><w:name w:val="Chapter Part"/><w:basedOn w:val="Heading2"/></w:style>
+		<w:style w:type="character" w:styleId="Heading1Char"><w:name w:val="heading 1 char"/></w:style>
+	</w:styles>`
+	body := para("Heading1", "Installation") +
+		para("", "Download the installer.") +
+		para("Heading2", "Linux") +
+		para("Normal", "Run the script.") +
+		para("Chapter", "Packages") +
+		para("", "Use apt.") +
+		`<w:p><w:pPr><w:outlineLvl w:val="2"/></w:pPr><w:r><w:t>Details</w:t></w:r></w:p>` +
+		`<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/></w:numPr></w:pPr><w:r><w:t>First step</w:t></w:r></w:p>` +
+		`<w:tbl><w:tr><w:tc>` + para("", "Name") + `</w:tc><w:tc>` + para("", "Value") + `</w:tc></w:tr>` +
+		`<w:tr><w:tc>` + para("", "a|b") + `</w:tc><w:tc>` + para("", "1") + `</w:tc></w:tr></w:tbl>`
+	return map[string]string{
+		"word/document.xml": `<w:document ` + wordNS + `><w:body>` + body + `</w:body></w:document>`,
+		"word/styles.xml":   styles,
+	}
+}
+
+func TestDOCX(t *testing.T) {
+	res, err := extract.DOCX(zipOf(t, docxParts()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"# Installation",
+		"Download the installer.",
+		"## Linux",
+		"Run the script.",
+		"## Packages",
+		"Use apt.",
+		"### Details",
+		"- First step",
+		"| Name | Value |\n| --- | --- |\n| a\\|b | 1 |",
+	}, "\n\n")
+	if res.Text != want || res.MIMEType != "text/markdown" {
+		t.Fatalf("extracted %s:\n%s\nwant:\n%s", res.MIMEType, res.Text, want)
+	}
+}
+
+func TestDOCXHeadingsBecomeHeadingPath(t *testing.T) {
+	res, err := extract.DOCX(zipOf(t, docxParts()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks := embed.ChunkerFor("auto", "guide.docx", res.MIMEType).Chunk("guide.docx", res.Text, embed.ChunkOptions{})
+
+	want := map[string][]string{
+		"Download the installer.": {"Installation"},
+		"Run the script.":         {"Installation", "Linux"},
+		"Use apt.":                {"Installation", "Packages"},
+	}
+	for text, path := range want {
+		found := false
+		for _, c := range chunks {
+			if !strings.Contains(c.Content, text) {
+				continue
+			}
+			found = true
+			if got := c.Metadata["headings"]; !reflect.DeepEqual(got, path) {
+				t.Errorf("chunk with %q has heading path %v, want %v", text, got, path)
+			}
+		}
+		if !found {
+			t.Errorf("no chunk contains %q", text)
+		}
+	}
+}
+
+func xlsxParts() map[string]string {
+	return map[string]string{
+		"xl/workbook.xml": `<workbook ` + sheetNS + `><sheets>
+			<sheet name="People" sheetId="1" r:id="rId1"/>
+			<sheet name="Archive" sheetId="2" state="hidden" r:id="rId2"/>
+			<sheet name="Notes" sheetId="3" r:id="rId3"/>
+		</sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": rels("rId1", "worksheets/sheet1.xml", "rId2", "worksheets/sheet2.xml", "rId3", "worksheets/sheet3.xml"),
+		"xl/sharedStrings.xml": `<sst ` + sheetNS + `>
+			<si><t>Name</t></si>
+			<si><t>Role</t></si>
+			<si><t>Ada</t></si>
+			<si><t>Engineer</t></si>
+			<si><r><t>Grace </t></r><r><t>Hopper</t></r><rPh><t>グレース</t></rPh></si>
+		</sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet ` + sheetNS + `><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+			<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2" t="s"><v>3</v></c></row>
+			<row r="3"><c r="A3"/></row>
+			<row r="4"><c r="A4" t="s"><v>4</v></c><c r="B4" t="inlineStr"><is><t>Admiral</t></is></c><c r="C4"><v>42</v></c><c r="D4" t="b"><v>1</v></c></row>
+		</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet ` + sheetNS + `><sheetData>
+			<row r="1"><c r="A1" t="inlineStr"><is><t>Hidden</t></is></c></row>
+		</sheetData></worksheet>`,
+		"xl/worksheets/sheet3.xml": `<worksheet ` + sheetNS + `><sheetData>
+			<row r="7"><c r="B7" t="inlineStr"><is><t>Remember</t></is></c><c r="C7" t="inlineStr"><is><t>the milk</t></is></c></row>
+		</sheetData></worksheet>`,
+	}
+}
+
+func TestXLSX(t *testing.T) {
+	res, err := extract.XLSX(zipOf(t, xlsxParts()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		text  string
+		sheet string
+		row   int
+	}{
+		// Cells are labelled with the header row, read through shared strings.
+		{"Name: Ada | Role: Engineer", "People", 2},
+		// Columns without a header keep their bare value.
+		{"Name: Grace Hopper | Role: Admiral | 42 | TRUE", "People", 4},
+		// A sheet with a single row is all header.
+		{"Remember | the milk", "Notes", 7},
+	}
+	if len(res.Locations) != len(want) {
+		t.Fatalf("got %d rows:\n%s", len(res.Locations), res.Text)
+	}
+	for i, w := range want {
+		loc := res.Locations[i]
+		end := len(res.Text)
+		if i+1 < len(res.Locations) {
+			end = res.Locations[i+1].Offset
+		}
+		if got := strings.TrimSpace(res.Text[loc.Offset:end]); got != w.text {
+			t.Errorf("row %d: %q, want %q", i, got, w.text)
+		}
+		if loc.Metadata["sheet"] != w.sheet || loc.Metadata["row"] != w.row {
+			t.Errorf("row %d: location %v, want sheet %q row %d", i, loc.Metadata, w.sheet, w.row)
+		}
+	}
+}
+
+// slide returns a slide part with a title and body shapes.
+func slide(attrs, title string, body ...string) string {
+	s := `<p:sld ` + slideNS + ` ` + attrs + `><p:cSld><p:spTree>`
+	if title != "" {
+		s += `<p:sp><p:nvSpPr><p:nvPr><p:ph type="title"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:r><a:t>` + title + `</a:t></a:r></a:p></p:txBody></p:sp>`
+	}
+	for _, b := range body {
+		s += `<p:sp><p:txBody><a:p><a:r><a:t>` + b + `</a:t></a:r></a:p></p:txBody></p:sp>`
+	}
+	s += `<p:sp><p:nvSpPr><p:nvPr><p:ph type="sldNum"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:r><a:t>7</a:t></a:r></a:p></p:txBody></p:sp>`
+	return s + `</p:spTree></p:cSld></p:sld>`
+}
+
+func pptxParts() map[string]string {
+	return map[string]string{
+		"ppt/presentation.xml": `<p:presentation ` + slideNS + `><p:sldIdLst>
+			<p:sldId id="256" r:id="rId2"/>
+			<p:sldId id="257" r:id="rId3"/>
+			<p:sldId id="258" r:id="rId4"/>
+		</p:sldIdLst></p:presentation>`,
+		"ppt/_rels/presentation.xml.rels":  rels("rId2", "slides/slide1.xml", "rId3", "slides/slide2.xml", "rId4", "slides/slide3.xml"),
+		"ppt/slides/slide1.xml":            slide("", "Welcome", "First point"),
+		"ppt/slides/_rels/slide1.xml.rels": rels("rId1", "../slideLayouts/slideLayout1.xml", "rId2", "../notesSlides/notesSlide1.xml"),
+		"ppt/notesSlides/notesSlide1.xml":  slide("", "", "Say hello first"),
+		"ppt/slides/slide2.xml":            slide(`show="0"`, "Hidden", "Not shown"),
+		"ppt/slides/slide3.xml":            slide("", "", "Closing words"),
+	}
+}
+
+func TestPPTX(t *testing.T) {
+	res, err := extract.PPTX(zipOf(t, pptxParts()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Slide 1: Welcome\n\nFirst point\n\nNotes:\nSay hello first\n\nSlide 3\n\nClosing words"
+	if res.Text != want {
+		t.Fatalf("extracted:\n%s\nwant:\n%s", res.Text, want)
+	}
+	wantLocations := []map[string]interface{}{
+		{"slide": 1, "slide_title": "Welcome"},
+		{"slide": 3},
+	}
+	if len(res.Locations) != len(wantLocations) {
+		t.Fatalf("got %d locations, want %d", len(res.Locations), len(wantLocations))
+	}
+	for i, loc := range res.Locations {
+		if !reflect.DeepEqual(loc.Metadata, wantLocations[i]) {
+			t.Errorf("location %d: %v, want %v", i, loc.Metadata, wantLocations[i])
+		}
+	}
+	if !strings.HasPrefix(res.Text[res.Locations[1].Offset:], "Slide 3") {
+		t.Errorf("second location does not start at slide 3")
+	}
+}
+
+// without returns parts with some removed.
+func without(parts map[string]string, names ...string) map[string]string {
+	out := make(map[string]string, len(parts))
+	for k, v := range parts {
+		out[k] = v
+	}
+	for _, n := range names {
+		delete(out, n)
+	}
+	return out
+}
+
+func TestOOXMLErrors(t *testing.T) {
+	valid := zipOf(t, docxParts())
+	tests := []struct {
+		name    string
+		extract func([]byte) (*extract.Result, error)
+		data    []byte
+		want    string
+	}{
+		{name: "docx not a zip", extract: extract.DOCX, data: []byte("plain text"), want: "not a valid document package"},
+		{name: "docx truncated zip", extract: extract.DOCX, data: valid[:len(valid)/2], want: "not a valid document package"},
+		{name: "docx document missing", extract: extract.DOCX, data: zipOf(t, without(docxParts(), "word/document.xml")), want: "document part is missing"},
+		{name: "docx malformed", extract: extract.DOCX, data: zipOf(t, map[string]string{"word/document.xml": "<w:document " + wordNS + "><w:body>"}), want: "malformed"},
+		{name: "xlsx not a zip", extract: extract.XLSX, data: []byte("a,b\n1,2\n"), want: "not a valid document package"},
+		{name: "xlsx workbook missing", extract: extract.XLSX, data: zipOf(t, without(xlsxParts(), "xl/workbook.xml")), want: "workbook part is missing"},
+		{name: "xlsx sheet missing", extract: extract.XLSX, data: zipOf(t, without(xlsxParts(), "xl/worksheets/sheet1.xml")), want: `sheet "People" is missing`},
+		{name: "xlsx sheet relationships missing", extract: extract.XLSX, data: zipOf(t, without(xlsxParts(), "xl/_rels/workbook.xml.rels")), want: "has no part"},
+		{name: "pptx not a zip", extract: extract.PPTX, data: []byte{0x50, 0x4b, 0x03, 0x04, 0xff}, want: "not a valid document package"},
+		{name: "pptx presentation missing", extract: extract.PPTX, data: zipOf(t, without(pptxParts(), "ppt/presentation.xml")), want: "presentation part is missing"},
+		{name: "pptx slide missing", extract: extract.PPTX, data: zipOf(t, without(pptxParts(), "ppt/slides/slide3.xml")), want: "slide 3 is missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.extract(tt.data)
+			if err == nil {
+				t.Fatalf("extracted %q, want an error", res.Text)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
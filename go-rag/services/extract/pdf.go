@@ -0,0 +1,675 @@
+package extract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PDF object model. Numbers are int or float64, strings are pdfString and
+// null is nil.
+type (
+	pdfName    string
+	pdfString  string
+	pdfKeyword string
+	pdfArray   []interface{}
+	pdfDict    map[pdfName]interface{}
+	pdfRef     struct{ num, gen int }
+	pdfStream  struct {
+		dict pdfDict
+		raw  []byte // Still encoded with the stream's filters.
+	}
+)
+
+// ErrEncrypted is returned for password-protected or encrypted PDFs.
+var ErrEncrypted = errors.New("pdf: encrypted documents are not supported")
+
+// pdfFile is a parsed PDF: its objects by number and its trailer.
+type pdfFile struct {
+	objects map[int]interface{}
+	trailer pdfDict
+	fonts   map[pdfRef]*pdfFont // Fonts loaded so far, shared between pages.
+	// xrefValid is set when the file's last startxref offset points at a
+	// cross-reference table or stream.
+	xrefValid bool
+}
+
+// objHeader matches the "12 0 obj" that starts every indirect object, and
+// trailerKeyword the start of a classic trailer.
+var (
+	objHeader      = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	trailerKeyword = regexp.MustCompile(`trailer\s*<<`)
+)
+
+// parsePDF reads every object in the file. Instead of trusting the
+// cross-reference table, which is often damaged, it scans the file for object
+// definitions; later definitions win, as incremental updates intend.
+func parsePDF(data []byte) (*pdfFile, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF-")) {
+		return nil, errors.New("pdf: not a PDF file")
+	}
+
+	// A file cut short, such as by an interrupted upload, has lost its end
+	// of file marker, which readers look for in the last 1024 bytes.
+	if !bytes.Contains(data[max(0, len(data)-1024):], []byte("%%EOF")) {
+		return nil, errors.New("pdf: document is truncated")
+	}
+
+	f := &pdfFile{objects: make(map[int]interface{}), trailer: pdfDict{}, fonts: make(map[pdfRef]*pdfFont)}
+	var objStreams []*pdfStream
+	for pos := 0; pos < len(data); {
+		m := objHeader.FindSubmatchIndex(data[pos:])
+		if m == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+m[2] : pos+m[3]]))
+		lx := &pdfLexer{data: data, pos: pos + m[1]}
+		obj, err := lx.object()
+		if err != nil {
+			pos += m[1]
+			continue
+		}
+		f.objects[num] = obj
+		pos = lx.pos
+
+		if s, ok := obj.(*pdfStream); ok {
+			switch s.dict["Type"] {
+			case pdfName("ObjStm"):
+				objStreams = append(objStreams, s)
+			case pdfName("XRef"):
+				// Cross-reference streams carry the trailer entries.
+				for k, v := range s.dict {
+					f.trailer[k] = v
+				}
+			}
+		}
+	}
+	if len(f.objects) == 0 {
+		return nil, errors.New("pdf: no objects found")
+	}
+
+	// Classic trailers follow the "trailer" keyword; the last one is newest.
+	for _, idx := range trailerKeyword.FindAllIndex(data, -1) {
+		lx := &pdfLexer{data: data, pos: idx[0] + len("trailer")}
+		if d, err := lx.object(); err == nil {
+			if dict, ok := d.(pdfDict); ok {
+				for k, v := range dict {
+					f.trailer[k] = v
+				}
+			}
+		}
+	}
+	if _, ok := f.trailer["Encrypt"]; ok {
+		return nil, ErrEncrypted
+	}
+	f.xrefValid = validXref(data)
+
+	// Objects packed in object streams (PDF 1.5+) don't override objects
+	// defined directly in the file.
+	for _, s := range objStreams {
+		f.unpackObjectStream(s)
+	}
+	return f, nil
+}
+
+// validXref reports whether the last startxref offset in data points at a
+// cross-reference table or stream.
+func validXref(data []byte) bool {
+	i := bytes.LastIndex(data, []byte("startxref"))
+	if i < 0 {
+		return false
+	}
+	lx := &pdfLexer{data: data, pos: i + len("startxref")}
+	tok, err := lx.token()
+	off, ok := tok.(int)
+	if err != nil || !ok || off < 0 || off >= len(data) {
+		return false
+	}
+	if bytes.HasPrefix(data[off:], []byte("xref")) {
+		return true
+	}
+	m := objHeader.FindIndex(data[off:])
+	return m != nil && m[0] == 0
+}
+
+// unpackObjectStream adds the objects compressed into an object stream.
+func (f *pdfFile) unpackObjectStream(s *pdfStream) {
+	data, err := f.decode(s)
+	if err != nil {
+		return
+	}
+	n, _ := f.resolve(s.dict["N"]).(int)
+	first, _ := f.resolve(s.dict["First"]).(int)
+	if first > len(data) {
+		return
+	}
+	header := &pdfLexer{data: data[:first]}
+	for i := 0; i < n; i++ {
+		num, err1 := header.object()
+		off, err2 := header.object()
+		objNum, ok1 := num.(int)
+		objOff, ok2 := off.(int)
+		if err1 != nil || err2 != nil || !ok1 || !ok2 {
+			return
+		}
+		if _, exists := f.objects[objNum]; exists || first+objOff >= len(data) {
+			continue
+		}
+		lx := &pdfLexer{data: data, pos: first + objOff}
+		if obj, err := lx.object(); err == nil {
+			f.objects[objNum] = obj
+		}
+	}
+}
+
+// resolve follows indirect references to the object they point to.
+func (f *pdfFile) resolve(obj interface{}) interface{} {
+	for i := 0; i < 32; i++ {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj
+		}
+		obj = f.objects[ref.num]
+	}
+	return nil
+}
+
+// dict resolves obj and returns it as a dictionary, or the dictionary of a
+// stream, or nil.
+func (f *pdfFile) dict(obj interface{}) pdfDict {
+	switch v := f.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.dict
+	}
+	return nil
+}
+
+// array resolves obj and returns it as an array, or nil.
+func (f *pdfFile) array(obj interface{}) pdfArray {
+	a, _ := f.resolve(obj).(pdfArray)
+	return a
+}
+
+// number resolves obj and returns it as a float, or def if it is not a number.
+func (f *pdfFile) number(obj interface{}, def float64) float64 {
+	switch v := f.resolve(obj).(type) {
+	case int:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return def
+}
+
+// decode returns the stream's data with its filters undone.
+func (f *pdfFile) decode(s *pdfStream) ([]byte, error) {
+	var filters []interface{}
+	switch v := f.resolve(s.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []interface{}{v}
+	case pdfArray:
+		filters = v
+	}
+
+	data := s.raw
+	for _, filter := range filters {
+		name, _ := f.resolve(filter).(pdfName)
+		var err error
+		switch name {
+		case "FlateDecode", "Fl":
+			data, err = inflate(data)
+		case "ASCIIHexDecode", "AHx":
+			data, err = asciiHexDecode(data)
+		case "ASCII85Decode", "A85":
+			data, err = ascii85Decode(data)
+		default:
+			return nil, fmt.Errorf("pdf: unsupported stream filter %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// inflate undoes FlateDecode, keeping whatever decoded before any corruption.
+func inflate(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("pdf: bad compressed stream: %w", err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("pdf: bad compressed stream: %w", err)
+	}
+	return out, nil
+}
+
+func asciiHexDecode(data []byte) ([]byte, error) {
+	var digits []byte
+	for _, b := range data {
+		if b == '>' {
+			break
+		}
+		if !isPDFSpace(b) {
+			digits = append(digits, b)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	_, err := hex.Decode(out, digits)
+	return out, err
+}
+
+func ascii85Decode(data []byte) ([]byte, error) {
+	if i := bytes.Index(data, []byte("~>")); i >= 0 {
+		data = data[:i]
+	}
+	data = bytes.TrimPrefix(bytes.TrimSpace(data), []byte("<~"))
+	out := make([]byte, len(data)*4/5+4)
+	n, _, err := ascii85.Decode(out, data, true)
+	return out[:n], err
+}
+
+// pdfLexer reads PDF objects from data, starting at pos.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+var errEOF = errors.New("pdf: unexpected end of data")
+
+func isPDFSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f' || b == 0
+}
+
+func isPDFDelimiter(b byte) bool {
+	return strings.IndexByte("()<>[]{}/%", b) >= 0
+}
+
+// skipSpace skips whitespace and comments.
+func (lx *pdfLexer) skipSpace() {
+	for lx.pos < len(lx.data) {
+		switch b := lx.data[lx.pos]; {
+		case isPDFSpace(b):
+			lx.pos++
+		case b == '%':
+			for lx.pos < len(lx.data) && lx.data[lx.pos] != '\n' && lx.data[lx.pos] != '\r' {
+				lx.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// token reads the next token: an object, or a keyword or delimiter such as
+// "]" or ">>" that ends a container.
+func (lx *pdfLexer) token() (interface{}, error) {
+	lx.skipSpace()
+	if lx.pos >= len(lx.data) {
+		return nil, errEOF
+	}
+	b := lx.data[lx.pos]
+	switch {
+	case b == '/':
+		lx.pos++
+		return pdfName(lx.name()), nil
+	case b == '(':
+		lx.pos++
+		return lx.literalString(), nil
+	case b == '<' && lx.peek(1) == '<':
+		lx.pos += 2
+		return pdfKeyword("<<"), nil
+	case b == '>' && lx.peek(1) == '>':
+		lx.pos += 2
+		return pdfKeyword(">>"), nil
+	case b == '<':
+		lx.pos++
+		return lx.hexString(), nil
+	case b == '[' || b == ']' || b == '{' || b == '}':
+		lx.pos++
+		return pdfKeyword(string(b)), nil
+	case b == '+' || b == '-' || b == '.' || (b >= '0' && b <= '9'):
+		return lx.number(), nil
+	}
+
+	start := lx.pos
+	for lx.pos < len(lx.data) && !isPDFSpace(lx.data[lx.pos]) && !isPDFDelimiter(lx.data[lx.pos]) {
+		lx.pos++
+	}
+	if lx.pos == start {
+		// A stray delimiter such as ")"; skip it.
+		lx.pos++
+	}
+	switch word := string(lx.data[start:lx.pos]); word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	default:
+		return pdfKeyword(word), nil
+	}
+}
+
+func (lx *pdfLexer) peek(n int) byte {
+	if lx.pos+n < len(lx.data) {
+		return lx.data[lx.pos+n]
+	}
+	return 0
+}
+
+// object reads a complete object: a container, an indirect reference or a
+// stream are assembled from their tokens.
+func (lx *pdfLexer) object() (interface{}, error) {
+	tok, err := lx.token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case pdfKeyword("["):
+		var arr pdfArray
+		for {
+			save := lx.pos
+			t, err := lx.token()
+			if err != nil {
+				return nil, err
+			}
+			if t == pdfKeyword("]") {
+				return arr, nil
+			}
+			lx.pos = save
+			v, err := lx.object()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+
+	case pdfKeyword("<<"):
+		dict := pdfDict{}
+		for {
+			t, err := lx.token()
+			if err != nil {
+				return nil, err
+			}
+			if t == pdfKeyword(">>") {
+				break
+			}
+			key, ok := t.(pdfName)
+			if !ok {
+				return nil, fmt.Errorf("pdf: dictionary key is %T, not a name", t)
+			}
+			v, err := lx.object()
+			if err != nil {
+				return nil, err
+			}
+			dict[key] = v
+		}
+		return lx.streamAfter(dict)
+
+	case pdfKeyword("endobj"):
+		return nil, nil
+	}
+
+	// "1 0 R" is a reference; peek ahead for the generation and "R".
+	if num, ok := tok.(int); ok {
+		save := lx.pos
+		if gen, err := lx.token(); err == nil {
+			if g, ok := gen.(int); ok {
+				if r, err := lx.token(); err == nil && r == pdfKeyword("R") {
+					return pdfRef{num, g}, nil
+				}
+			}
+		}
+		lx.pos = save
+		return num, nil
+	}
+	return tok, nil
+}
+
+// streamAfter reads the stream data that follows a stream's dictionary, if
+// any, and returns the stream; otherwise it returns the dictionary.
+func (lx *pdfLexer) streamAfter(dict pdfDict) (interface{}, error) {
+	save := lx.pos
+	lx.skipSpace()
+	if !bytes.HasPrefix(lx.data[lx.pos:], []byte("stream")) {
+		lx.pos = save
+		return dict, nil
+	}
+	lx.pos += len("stream")
+	if lx.peek(0) == '\r' {
+		lx.pos++
+	}
+	if lx.peek(0) == '\n' {
+		lx.pos++
+	}
+
+	start := lx.pos
+	// Trust a direct /Length only if "endstream" follows it; otherwise, and for
+	// indirect lengths, look for the keyword.
+	if n, ok := dict["Length"].(int); ok && n >= 0 && start+n <= len(lx.data) {
+		rest := bytes.TrimLeft(lx.data[start+n:], " \t\r\n")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			lx.pos = start + n
+			return &pdfStream{dict: dict, raw: lx.data[start : start+n]}, nil
+		}
+	}
+	end := bytes.Index(lx.data[start:], []byte("endstream"))
+	if end < 0 {
+		return nil, errors.New("pdf: unterminated stream")
+	}
+	raw := bytes.TrimRight(lx.data[start:start+end], "\r\n")
+	lx.pos = start + end + len("endstream")
+	return &pdfStream{dict: dict, raw: raw}, nil
+}
+
+// name reads a name after its "/", decoding #xx escapes.
+func (lx *pdfLexer) name() string {
+	var sb strings.Builder
+	for lx.pos < len(lx.data) {
+		b := lx.data[lx.pos]
+		if isPDFSpace(b) || isPDFDelimiter(b) {
+			break
+		}
+		if b == '#' && lx.pos+2 < len(lx.data) {
+			if v, err := strconv.ParseUint(string(lx.data[lx.pos+1:lx.pos+3]), 16, 8); err == nil {
+				sb.WriteByte(byte(v))
+				lx.pos += 3
+				continue
+			}
+		}
+		sb.WriteByte(b)
+		lx.pos++
+	}
+	return sb.String()
+}
+
+// literalString reads a (string) after its "(", with nested parentheses and
+// backslash escapes.
+func (lx *pdfLexer) literalString() pdfString {
+	var out []byte
+	depth := 1
+	for lx.pos < len(lx.data) {
+		b := lx.data[lx.pos]
+		lx.pos++
+		switch b {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return pdfString(out)
+			}
+		case '\\':
+			if lx.pos >= len(lx.data) {
+				break
+			}
+			e := lx.data[lx.pos]
+			lx.pos++
+			switch e {
+			case 'n':
+				b = '\n'
+			case 'r':
+				b = '\r'
+			case 't':
+				b = '\t'
+			case 'b':
+				b = '\b'
+			case 'f':
+				b = '\f'
+			case '\r', '\n':
+				// A line continuation.
+				if e == '\r' && lx.peek(0) == '\n' {
+					lx.pos++
+				}
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && lx.pos < len(lx.data) && lx.data[lx.pos] >= '0' && lx.data[lx.pos] <= '7'; i++ {
+						v = v*8 + int(lx.data[lx.pos]-'0')
+						lx.pos++
+					}
+					b = byte(v)
+				} else {
+					b = e
+				}
+			}
+		}
+		out = append(out, b)
+	}
+	return pdfString(out)
+}
+
+// hexString reads a <hex string> after its "<".
+func (lx *pdfLexer) hexString() pdfString {
+	end := bytes.IndexByte(lx.data[lx.pos:], '>')
+	if end < 0 {
+		end = len(lx.data) - lx.pos
+	}
+	out, _ := asciiHexDecode(lx.data[lx.pos : lx.pos+end])
+	lx.pos += end + 1
+	return pdfString(out)
+}
+
+// number reads an integer or real.
+func (lx *pdfLexer) number() interface{} {
+	start := lx.pos
+	lx.pos++
+	for lx.pos < len(lx.data) {
+		b := lx.data[lx.pos]
+		if (b < '0' || b > '9') && b != '.' {
+			break
+		}
+		lx.pos++
+	}
+	s := string(lx.data[start:lx.pos])
+	if i, err := strconv.Atoi(s); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return 0
+}
+
+// inheritedPageAttrs are the page attributes that pages take from the page
+// tree when they do not set them.
+var inheritedPageAttrs = []pdfName{"Resources", "Rotate"}
+
+// pages returns the page dictionaries in order, with inherited attributes
+// resolved into each.
+func (f *pdfFile) pages() ([]pdfDict, error) {
+	root := f.dict(f.trailer["Root"])
+	if root == nil {
+		// Without a usable trailer, find the catalog itself.
+		for _, obj := range f.objects {
+			if d, ok := obj.(pdfDict); ok && d["Type"] == pdfName("Catalog") {
+				root = d
+				break
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New("pdf: document catalog not found")
+	}
+
+	var pages []pdfDict
+	visited := make(map[interface{}]bool)
+	var walk func(node interface{}, inherited pdfDict, depth int)
+	walk = func(node interface{}, inherited pdfDict, depth int) {
+		if ref, ok := node.(pdfRef); ok {
+			if visited[ref] {
+				return
+			}
+			visited[ref] = true
+		}
+		d := f.dict(node)
+		if d == nil || depth > 64 {
+			return
+		}
+		attrs := pdfDict{}
+		for _, key := range inheritedPageAttrs {
+			if v, ok := d[key]; ok {
+				attrs[key] = v
+			} else if v, ok := inherited[key]; ok {
+				attrs[key] = v
+			}
+		}
+		kids, isTree := d["Kids"]
+		if !isTree || d["Type"] == pdfName("Page") {
+			page := pdfDict{}
+			for k, v := range d {
+				page[k] = v
+			}
+			for k, v := range attrs {
+				page[k] = v
+			}
+			pages = append(pages, page)
+			return
+		}
+		for _, kid := range f.array(kids) {
+			walk(kid, attrs, depth+1)
+		}
+	}
+	walk(root["Pages"], nil, 0)
+	if len(pages) == 0 {
+		return nil, errors.New("pdf: document has no pages")
+	}
+	return pages, nil
+}
+
+// contents returns the page's content stream, concatenating the parts of a
+// content array.
+func (f *pdfFile) contents(page pdfDict) []byte {
+	var parts []interface{}
+	switch v := f.resolve(page["Contents"]).(type) {
+	case *pdfStream:
+		parts = []interface{}{v}
+	case pdfArray:
+		parts = v
+	}
+	var buf bytes.Buffer
+	for _, p := range parts {
+		if s, ok := f.resolve(p).(*pdfStream); ok {
+			if data, err := f.decode(s); err == nil {
+				buf.Write(data)
+				buf.WriteByte('\n')
+			}
+		}
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,178 @@
+package extract
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readFixture returns a file from testdata.
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// lines returns the non-empty lines of text.
+func lines(text string) []string {
+	var out []string
+	for _, l := range strings.Split(text, "\n") {
+		if l != "" {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// assertOrder checks that each want line occurs in got, after the line before it.
+func assertOrder(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	i := 0
+	for _, l := range got {
+		if i < len(want) && l == want[i] {
+			i++
+		}
+	}
+	if i < len(want) {
+		t.Errorf("lines %q: %q missing or out of order", got, want[i])
+	}
+}
+
+func TestPDFPages(t *testing.T) {
+	for _, name := range []string{"pages.pdf", "damaged-xref.pdf"} {
+		t.Run(name, func(t *testing.T) {
+			res, err := PDF(readFixture(t, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.MIMEType != "text/plain" {
+				t.Errorf("MIME type %q", res.MIMEType)
+			}
+			want := []string{"Page one text.", "Page two text.\nSecond line of page two.", "Page three text."}
+			if len(res.Locations) != len(want) {
+				t.Fatalf("got %d locations, want %d", len(res.Locations), len(want))
+			}
+			for i, loc := range res.Locations {
+				if loc.Metadata["page"] != i+1 {
+					t.Errorf("location %d is page %v, want %d", i, loc.Metadata["page"], i+1)
+				}
+				end := len(res.Text)
+				if i+1 < len(res.Locations) {
+					end = res.Locations[i+1].Offset
+				}
+				if got := strings.TrimSpace(res.Text[loc.Offset:end]); got != want[i] {
+					t.Errorf("page %d text %q, want %q", i+1, got, want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPDFReadingOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want []string
+	}{
+		{
+			name: "two columns between a title and a footer",
+			file: "columns.pdf",
+			want: []string{
+				"A Study of Two Column Layout Extraction",
+				"Left column line one", "Left column line two", "Left column line three",
+				"Left column line four", "Left column line five",
+				"Right column line one", "Right column line two", "Right column line three",
+				"Right column line four", "Right column line five",
+				"Footer text that spans both of the columns at the foot of the page",
+			},
+		},
+		{
+			name: "rotated page and a label up the margin",
+			file: "rotated.pdf",
+			want: []string{
+				"Landscape line one", "Landscape line two", "Landscape line three",
+				"Upright line one", "Upright line two", "Sidebar label",
+			},
+		},
+		{
+			name: "object and cross-reference streams",
+			file: "objstream.pdf",
+			want: []string{"Compressed object streams."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := PDF(readFixture(t, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := lines(res.Text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got lines %q, want %q", got, tt.want)
+			}
+			assertOrder(t, got, tt.want...)
+		})
+	}
+}
+
+func TestPDFFontEncodings(t *testing.T) {
+	res, err := PDF(readFixture(t, "encodings.pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrder(t, lines(res.Text),
+		// /Differences names glyphs for codes, including accented letters
+		// and ligatures.
+		"Hello World", "café", "fine",
+		// A ToUnicode CMap maps two-byte codes with bfchar and bfrange,
+		// including a surrogate pair.
+		"日本ABCé", "😀",
+	)
+}
+
+func TestPDFErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "not a PDF", data: []byte("hello"), want: "not a PDF"},
+		{name: "encrypted", data: readFixture(t, "encrypted.pdf"), want: "encrypted"},
+		{name: "truncated", data: readFixture(t, "truncated.pdf"), want: "truncated"},
+		{name: "broken cross-reference table", data: readFixture(t, "broken-xref.pdf"), want: "cross-reference table is damaged"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := PDF(tt.data)
+			if err == nil {
+				t.Fatalf("extracted %q, want an error", res.Text)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := PDF(readFixture(t, "encrypted.pdf")); !errors.Is(err, ErrEncrypted) {
+		t.Errorf("encrypted document: error %v, want ErrEncrypted", err)
+	}
+}
+
+func TestPDFTruncatedAnywhere(t *testing.T) {
+	for _, name := range []string{"pages.pdf", "objstream.pdf", "encodings.pdf"} {
+		data := readFixture(t, name)
+		end := bytes.LastIndex(data, []byte("%%EOF")) + len("%%EOF")
+		for n := 0; n < end; n++ {
+			res, err := PDF(data[:n])
+			if err == nil {
+				t.Fatalf("%s cut to %d bytes: extracted %q, want an error", name, n, res.Text)
+			}
+		}
+	}
+}
@@ -0,0 +1,390 @@
+package extract
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/unicode/norm"
+)
+
+// pdfFont decodes the strings shown with a font into text and glyph widths.
+type pdfFont struct {
+	// Code lengths in bytes, from the CMap's codespace ranges.
+	codespace []codeRange
+	toUnicode map[uint32]string
+	// encoding maps the codes of simple fonts without a ToUnicode CMap.
+	encoding *[256]string
+	// ucs2 marks composite fonts whose codes are already Unicode.
+	ucs2 bool
+	// Glyph widths in thousandths of text space units.
+	widths       map[uint32]float64
+	defaultWidth float64
+	widthScale   float64
+}
+
+type codeRange struct {
+	lo, hi []byte
+}
+
+// pdfGlyph is one character code of a shown string.
+type pdfGlyph struct {
+	code  uint32
+	text  string
+	width float64 // In text space units, at a font size of 1.
+	space bool    // Single-byte code 32, which word spacing applies to.
+}
+
+// font loads the font described by a font dictionary.
+func (f *pdfFile) font(obj interface{}) *pdfFont {
+	d := f.dict(obj)
+	ft := &pdfFont{defaultWidth: 500, widthScale: 1.0 / 1000}
+	if d == nil {
+		ft.encoding = baseEncoding("StandardEncoding")
+		return ft
+	}
+
+	if s, ok := f.resolve(d["ToUnicode"]).(*pdfStream); ok {
+		if data, err := f.decode(s); err == nil {
+			ft.parseCMap(data)
+		}
+	}
+
+	if d["Subtype"] == pdfName("Type0") {
+		enc, _ := f.resolve(d["Encoding"]).(pdfName)
+		ft.ucs2 = strings.Contains(string(enc), "UCS2") || strings.Contains(string(enc), "UTF16")
+		if len(ft.codespace) == 0 {
+			ft.codespace = []codeRange{{lo: []byte{0, 0}, hi: []byte{0xff, 0xff}}}
+		}
+		ft.defaultWidth = 1000
+		if desc := f.array(d["DescendantFonts"]); len(desc) > 0 {
+			dd := f.dict(desc[0])
+			ft.defaultWidth = f.number(dd["DW"], 1000)
+			ft.widths = f.cidWidths(f.array(dd["W"]))
+		}
+		return ft
+	}
+
+	// Simple fonts use single-byte codes.
+	ft.codespace = []codeRange{{lo: []byte{0}, hi: []byte{0xff}}}
+	ft.encoding = f.simpleEncoding(d)
+	ft.widths = make(map[uint32]float64)
+	first := int(f.number(d["FirstChar"], 0))
+	for i, w := range f.array(d["Widths"]) {
+		ft.widths[uint32(first+i)] = f.number(w, 0)
+	}
+	if d["Subtype"] == pdfName("Type3") {
+		// Type 3 widths are in glyph space, mapped by the font matrix.
+		if m := f.array(d["FontMatrix"]); len(m) > 0 {
+			ft.widthScale = f.number(m[0], 0.001)
+		}
+	}
+	if fd := f.dict(d["FontDescriptor"]); fd != nil {
+		if w := f.number(fd["MissingWidth"], 0); w > 0 {
+			ft.defaultWidth = w
+		}
+	}
+	return ft
+}
+
+// cidWidths reads a composite font's W array, whose entries are either
+// "c [w1 w2 ...]" or "cfirst clast w".
+func (f *pdfFile) cidWidths(w pdfArray) map[uint32]float64 {
+	widths := make(map[uint32]float64)
+	for i := 0; i+1 < len(w); {
+		first := int(f.number(w[i], 0))
+		if list, ok := f.resolve(w[i+1]).(pdfArray); ok {
+			for j, v := range list {
+				widths[uint32(first+j)] = f.number(v, 0)
+			}
+			i += 2
+			continue
+		}
+		if i+2 >= len(w) {
+			break
+		}
+		last, width := int(f.number(w[i+1], 0)), f.number(w[i+2], 0)
+		for c := first; c <= last && c-first < 65536; c++ {
+			widths[uint32(c)] = width
+		}
+		i += 3
+	}
+	return widths
+}
+
+// simpleEncoding builds the code-to-text table of a simple font from its base
+// encoding and /Differences.
+func (f *pdfFile) simpleEncoding(d pdfDict) *[256]string {
+	base := "StandardEncoding"
+	if d["Subtype"] == pdfName("TrueType") {
+		base = "WinAnsiEncoding"
+	}
+	var differences pdfArray
+	switch enc := f.resolve(d["Encoding"]).(type) {
+	case pdfName:
+		base = string(enc)
+	case pdfDict:
+		if b, ok := f.resolve(enc["BaseEncoding"]).(pdfName); ok {
+			base = string(b)
+		}
+		differences = f.array(enc["Differences"])
+	}
+
+	table := baseEncoding(base)
+	code := 0
+	for _, v := range differences {
+		switch v := f.resolve(v).(type) {
+		case int:
+			code = v
+		case pdfName:
+			if code >= 0 && code < 256 {
+				table[code] = glyphText(string(v))
+			}
+			code++
+		}
+	}
+	return table
+}
+
+// baseEncoding returns a copy of one of the standard simple font encodings.
+func baseEncoding(name string) *[256]string {
+	var table [256]string
+	cm := charmap.Windows1252
+	if name == "MacRomanEncoding" {
+		cm = charmap.Macintosh
+	}
+	for i := 32; i < len(table); i++ {
+		if r := cm.DecodeByte(byte(i)); !unicode.IsControl(r) && r != utf8.RuneError {
+			table[i] = string(r)
+		}
+	}
+	if name == "StandardEncoding" {
+		// Where StandardEncoding differs from WinAnsiEncoding in common text.
+		table['\''] = "’"
+		table['`'] = "‘"
+		for code, text := range standardEncodingHigh {
+			table[code] = text
+		}
+	}
+	return &table
+}
+
+var standardEncodingHigh = map[int]string{
+	0xa9: "'", 0xaa: "“", 0xab: "«", 0xae: "fi", 0xaf: "fl",
+	0xb1: "–", 0xb7: "•", 0xba: "”", 0xbc: "…", 0xd0: "—",
+	0xe1: "Æ", 0xe8: "Ł", 0xe9: "Ø", 0xea: "Œ", 0xf1: "æ",
+	0xf5: "ı", 0xf8: "ł", 0xf9: "ø", 0xfa: "œ", 0xfb: "ß",
+}
+
+// glyphs splits a shown string into character codes and decodes each.
+func (ft *pdfFont) glyphs(s pdfString) []pdfGlyph {
+	var out []pdfGlyph
+	for i := 0; i < len(s); {
+		n := ft.codeLength(s[i:])
+		var code uint32
+		for _, b := range []byte(s[i : i+n]) {
+			code = code<<8 | uint32(b)
+		}
+		i += n
+
+		g := pdfGlyph{code: code, space: n == 1 && code == 32}
+		switch text, ok := ft.toUnicode[code]; {
+		case ok:
+			g.text = text
+		case ft.encoding != nil && code < 256:
+			g.text = ft.encoding[code]
+		case ft.ucs2:
+			g.text = string(rune(code))
+		}
+		w, ok := ft.widths[code]
+		if !ok || w == 0 {
+			w = ft.defaultWidth
+		}
+		g.width = w * ft.widthScale
+		out = append(out, g)
+	}
+	return out
+}
+
+// codeLength returns the byte length of the code at the start of s.
+func (ft *pdfFont) codeLength(s pdfString) int {
+	for n := 1; n <= 4 && n <= len(s); n++ {
+		for _, r := range ft.codespace {
+			if len(r.lo) == n && inCodeRange([]byte(s[:n]), r) {
+				return n
+			}
+		}
+	}
+	if len(ft.codespace) > 0 && len(ft.codespace[0].lo) <= len(s) {
+		return len(ft.codespace[0].lo)
+	}
+	return 1
+}
+
+func inCodeRange(code []byte, r codeRange) bool {
+	for i, b := range code {
+		if b < r.lo[i] || b > r.hi[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCMap reads the codespace ranges and bfchar and bfrange mappings of a
+// ToUnicode CMap.
+func (ft *pdfFont) parseCMap(data []byte) {
+	ft.toUnicode = make(map[uint32]string)
+	lx := &pdfLexer{data: data}
+	var operands []interface{}
+	for {
+		obj, err := lx.object()
+		if err != nil {
+			return
+		}
+		kw, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		switch kw {
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 && len(lo) == len(hi) && len(lo) > 0 {
+					ft.codespace = append(ft.codespace, codeRange{lo: []byte(lo), hi: []byte(hi)})
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					ft.toUnicode[codeOf(src)] = utf16Text(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 {
+					continue
+				}
+				start, end := codeOf(lo), codeOf(hi)
+				if end < start || end-start > 65535 {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case pdfString:
+					// Consecutive codes map to consecutive values of the last unit.
+					units := utf16Units(dst)
+					for c := start; c <= end && len(units) > 0; c++ {
+						ft.toUnicode[c] = string(utf16.Decode(units))
+						units[len(units)-1]++
+					}
+				case pdfArray:
+					for j, v := range dst {
+						if s, ok := v.(pdfString); ok && start+uint32(j) <= end {
+							ft.toUnicode[start+uint32(j)] = utf16Text(s)
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+func codeOf(s pdfString) uint32 {
+	var code uint32
+	for _, b := range []byte(s) {
+		code = code<<8 | uint32(b)
+	}
+	return code
+}
+
+func utf16Units(s pdfString) []uint16 {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	if len(s) == 1 {
+		units = append(units, uint16(s[0]))
+	}
+	return units
+}
+
+func utf16Text(s pdfString) string {
+	return string(utf16.Decode(utf16Units(s)))
+}
+
+// Glyph names that are not a letter, an accented letter or a uniXXXX name.
+var glyphNames = map[string]string{
+	"space": " ", "exclam": "!", "quotedbl": "\"", "numbersign": "#", "dollar": "$",
+	"percent": "%", "ampersand": "&", "quotesingle": "'", "parenleft": "(", "parenright": ")",
+	"asterisk": "*", "plus": "+", "comma": ",", "hyphen": "-", "period": ".", "slash": "/",
+	"zero": "0", "one": "1", "two": "2", "three": "3", "four": "4", "five": "5",
+	"six": "6", "seven": "7", "eight": "8", "nine": "9", "colon": ":", "semicolon": ";",
+	"less": "<", "equal": "=", "greater": ">", "question": "?", "at": "@",
+	"bracketleft": "[", "backslash": "\\", "bracketright": "]", "asciicircum": "^",
+	"underscore": "_", "grave": "`", "braceleft": "{", "bar": "|", "braceright": "}",
+	"asciitilde": "~", "quoteleft": "‘", "quoteright": "’", "quotedblleft": "“",
+	"quotedblright": "”", "quotesinglbase": "‚", "quotedblbase": "„",
+	"guillemotleft": "«", "guillemotright": "»", "endash": "–", "emdash": "—",
+	"bullet": "•", "ellipsis": "…", "fi": "fi", "fl": "fl", "ff": "ff", "ffi": "ffi",
+	"ffl": "ffl", "dagger": "†", "daggerdbl": "‡", "periodcentered": "·",
+	"minus": "−", "multiply": "×", "divide": "÷", "degree": "°",
+	"copyright": "©", "registered": "®", "trademark": "™", "section": "§",
+	"paragraph": "¶", "nbspace": " ", "nonbreakingspace": " ", "plusminus": "±",
+	"Euro": "€", "germandbls": "ß", "dotlessi": "ı", "ae": "æ", "AE": "Æ",
+	"oe": "œ", "OE": "Œ", "oslash": "ø", "Oslash": "Ø", "lslash": "ł",
+	"Lslash": "Ł", "eth": "ð", "Eth": "Ð", "thorn": "þ", "Thorn": "Þ",
+	"exclamdown": "¡", "questiondown": "¿", "cent": "¢", "sterling": "£",
+	"yen": "¥", "mu": "µ", "logicalnot": "¬", "florin": "ƒ",
+	"perthousand": "‰", "fraction": "⁄", "onehalf": "½", "onequarter": "¼",
+	"threequarters": "¾", "arrowright": "→", "arrowleft": "←",
+}
+
+// Accent suffixes of glyph names such as "eacute", as combining marks.
+var glyphAccents = map[string]rune{
+	"acute": '\u0301', "grave": '\u0300', "circumflex": '\u0302', "dieresis": '\u0308',
+	"tilde": '\u0303', "ring": '\u030a', "cedilla": '\u0327', "caron": '\u030c',
+	"macron": '\u0304', "breve": '\u0306', "ogonek": '\u0328', "dotaccent": '\u0307',
+	"hungarumlaut": '\u030b',
+}
+
+// glyphText returns the text of a glyph name from a font's /Differences.
+func glyphText(name string) string {
+	if i := strings.IndexByte(name, '.'); i > 0 {
+		name = name[:i] // Variants such as "a.sc".
+	}
+	if text, ok := glyphNames[name]; ok {
+		return text
+	}
+	if len(name) == 1 {
+		return name
+	}
+	if hexDigits, ok := strings.CutPrefix(name, "uni"); ok && len(hexDigits)%4 == 0 {
+		var units []uint16
+		for i := 0; i < len(hexDigits); i += 4 {
+			v, err := strconv.ParseUint(hexDigits[i:i+4], 16, 16)
+			if err != nil {
+				return ""
+			}
+			units = append(units, uint16(v))
+		}
+		return string(utf16.Decode(units))
+	}
+	if hexDigits, ok := strings.CutPrefix(name, "u"); ok && len(hexDigits) >= 4 && len(hexDigits) <= 6 {
+		if v, err := strconv.ParseUint(hexDigits, 16, 32); err == nil {
+			return string(rune(v))
+		}
+	}
+	if accent, ok := glyphAccents[name[1:]]; ok {
+		return norm.NFC.String(name[:1] + string(accent))
+	}
+	return ""
+}
@@ -0,0 +1,534 @@
+package extract
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// PDF extracts the text of a PDF page by page, in reading order: lines are
+// read top to bottom, and multi-column pages column by column. Each page is a
+// Location with its 1-based "page" number.
+func PDF(data []byte) (result *Result, err error) {
+	// A malformed file must fail its document, not the server.
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("pdf: malformed document: %v", r)
+		}
+	}()
+
+	f, err := parsePDF(data)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := f.pages()
+	if err != nil {
+		if !f.xrefValid {
+			return nil, fmt.Errorf("%w (the cross-reference table is damaged)", err)
+		}
+		return nil, err
+	}
+
+	var text strings.Builder
+	var locations []Location
+	for i, page := range pages {
+		pageText := f.pageText(page)
+		if text.Len() > 0 && pageText != "" {
+			text.WriteString("\n\n")
+		}
+		locations = append(locations, Location{Offset: text.Len(), Metadata: map[string]interface{}{"page": i + 1}})
+		text.WriteString(pageText)
+	}
+	if strings.TrimSpace(text.String()) == "" {
+		return nil, errors.New("pdf: no extractable text; scanned documents are not supported")
+	}
+	return &Result{Text: text.String(), MIMEType: "text/plain", Locations: locations}, nil
+}
+
+// pageText returns the laid-out text of a page, as the page is displayed.
+// Text running in other directions, such as a label up the margin, follows
+// the upright text.
+func (f *pdfFile) pageText(page pdfDict) string {
+	in := &textInterpreter{f: f}
+	rotate, _ := f.resolve(page["Rotate"]).(int)
+	in.run(f.contents(page), f.dict(page["Resources"]), pageRotation(rotate), 0)
+
+	var byDir [4][]textRun
+	for _, r := range in.runs {
+		byDir[r.dir] = append(byDir[r.dir], r)
+	}
+	var lines []string
+	for _, runs := range byDir {
+		if dirLines := layoutRuns(runs, 0); len(dirLines) > 0 {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, dirLines...)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pageRotation returns the matrix that turns a page's user space the way its
+// /Rotate entry, in degrees clockwise, turns it for display.
+func pageRotation(degrees int) matrix {
+	switch (degrees%360 + 360) % 360 {
+	case 90:
+		return matrix{0, -1, 1, 0, 0, 0}
+	case 180:
+		return matrix{-1, 0, 0, -1, 0, 0}
+	case 270:
+		return matrix{0, 1, -1, 0, 0, 0}
+	}
+	return identity
+}
+
+// direction returns the number of quarter turns, counterclockwise, closest to
+// the direction that text drawn with the text rendering matrix m runs in.
+func direction(m matrix) int {
+	turns := int(math.Round(math.Atan2(m[1], m[0]) / (math.Pi / 2)))
+	return (turns + 4) % 4
+}
+
+// readingFrame turns a point on the page so that text running in direction
+// dir reads left to right along a horizontal baseline.
+func readingFrame(dir int, x, y float64) (float64, float64) {
+	switch dir {
+	case 1:
+		return y, -x
+	case 2:
+		return -x, -y
+	case 3:
+		return -y, x
+	}
+	return x, y
+}
+
+// matrix is a PDF transformation matrix [a b c d e f].
+type matrix [6]float64
+
+var identity = matrix{1, 0, 0, 1, 0, 0}
+
+// mul returns m × n.
+func (m matrix) mul(n matrix) matrix {
+	return matrix{
+		m[0]*n[0] + m[1]*n[2], m[0]*n[1] + m[1]*n[3],
+		m[2]*n[0] + m[3]*n[2], m[2]*n[1] + m[3]*n[3],
+		m[4]*n[0] + m[5]*n[2] + n[4], m[4]*n[1] + m[5]*n[3] + n[5],
+	}
+}
+
+func translate(tx, ty float64) matrix {
+	return matrix{1, 0, 0, 1, tx, ty}
+}
+
+// graphicsState is the part of the PDF graphics state that affects text.
+type graphicsState struct {
+	ctm       matrix
+	font      *pdfFont
+	fontSize  float64
+	charSpace float64
+	wordSpace float64
+	scale     float64
+	leading   float64
+	rise      float64
+}
+
+// textRun is text shown along one baseline without a large gap, with its
+// extent in the reading frame of its direction.
+type textRun struct {
+	text   string
+	dir    int
+	x0, x1 float64
+	y      float64
+	size   float64
+}
+
+// textInterpreter runs content streams, recording the text they show.
+type textInterpreter struct {
+	f    *pdfFile
+	runs []textRun
+}
+
+// run interprets a content stream with its resources.
+func (in *textInterpreter) run(content []byte, resources pdfDict, ctm matrix, depth int) {
+	gs := graphicsState{ctm: ctm, scale: 1}
+	var stack []graphicsState
+	var tm, tlm matrix
+	var operands []interface{}
+
+	lx := &pdfLexer{data: content}
+	for {
+		obj, err := lx.object()
+		if err != nil {
+			return
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		num := func(i int) float64 {
+			if i < len(operands) {
+				return in.f.number(operands[i], 0)
+			}
+			return 0
+		}
+
+		switch op {
+		case "q":
+			stack = append(stack, gs)
+		case "Q":
+			if n := len(stack); n > 0 {
+				gs, stack = stack[n-1], stack[:n-1]
+			}
+		case "cm":
+			if len(operands) == 6 {
+				gs.ctm = matrix{num(0), num(1), num(2), num(3), num(4), num(5)}.mul(gs.ctm)
+			}
+		case "BT":
+			tm, tlm = identity, identity
+		case "Tf":
+			if len(operands) == 2 {
+				name, _ := operands[0].(pdfName)
+				gs.font = in.font(in.f.dict(resources["Font"])[name])
+				gs.fontSize = num(1)
+			}
+		case "Td", "TD":
+			if op == "TD" {
+				gs.leading = -num(1)
+			}
+			tlm = translate(num(0), num(1)).mul(tlm)
+			tm = tlm
+		case "Tm":
+			if len(operands) == 6 {
+				tlm = matrix{num(0), num(1), num(2), num(3), num(4), num(5)}
+				tm = tlm
+			}
+		case "T*":
+			tlm = translate(0, -gs.leading).mul(tlm)
+			tm = tlm
+		case "Tc":
+			gs.charSpace = num(0)
+		case "Tw":
+			gs.wordSpace = num(0)
+		case "Tz":
+			gs.scale = num(0) / 100
+		case "TL":
+			gs.leading = num(0)
+		case "Ts":
+			gs.rise = num(0)
+		case "Tj", "'", "\"":
+			if op != "Tj" {
+				tlm = translate(0, -gs.leading).mul(tlm)
+				tm = tlm
+			}
+			if op == "\"" && len(operands) == 3 {
+				gs.wordSpace, gs.charSpace = num(0), num(1)
+			}
+			if len(operands) > 0 {
+				if s, ok := operands[len(operands)-1].(pdfString); ok {
+					tm = in.show(s, &gs, tm)
+				}
+			}
+		case "TJ":
+			if len(operands) == 1 {
+				arr, _ := operands[0].(pdfArray)
+				for _, v := range arr {
+					if s, ok := v.(pdfString); ok {
+						tm = in.show(s, &gs, tm)
+					} else {
+						tx := -in.f.number(v, 0) / 1000 * gs.fontSize * gs.scale
+						tm = translate(tx, 0).mul(tm)
+					}
+				}
+			}
+		case "Do":
+			if len(operands) == 1 && depth < 8 {
+				name, _ := operands[0].(pdfName)
+				in.form(in.f.dict(resources["XObject"])[name], resources, gs.ctm, depth)
+			}
+		case "BI":
+			// Skip inline image data, which is not tokenizable.
+			if i := bytes.Index(content[lx.pos:], []byte("ID")); i >= 0 {
+				lx.pos += i + 2
+				end := bytes.Index(content[lx.pos:], []byte("EI"))
+				for end >= 0 && lx.pos+end+2 < len(content) && !isPDFSpace(content[lx.pos+end+2]) {
+					next := bytes.Index(content[lx.pos+end+2:], []byte("EI"))
+					if next < 0 {
+						end = -1
+						break
+					}
+					end += 2 + next
+				}
+				if end < 0 {
+					return
+				}
+				lx.pos += end + 2
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+// form runs a form XObject's content stream.
+func (in *textInterpreter) form(obj interface{}, resources pdfDict, ctm matrix, depth int) {
+	s, ok := in.f.resolve(obj).(*pdfStream)
+	if !ok || s.dict["Subtype"] != pdfName("Form") {
+		return
+	}
+	data, err := in.f.decode(s)
+	if err != nil {
+		return
+	}
+	if r := in.f.dict(s.dict["Resources"]); r != nil {
+		resources = r
+	}
+	if m := in.f.array(s.dict["Matrix"]); len(m) == 6 {
+		ctm = matrix{in.f.number(m[0], 1), in.f.number(m[1], 0), in.f.number(m[2], 0),
+			in.f.number(m[3], 1), in.f.number(m[4], 0), in.f.number(m[5], 0)}.mul(ctm)
+	}
+	in.run(data, resources, ctm, depth+1)
+}
+
+// font loads a font, caching fonts shared between pages by reference.
+func (in *textInterpreter) font(obj interface{}) *pdfFont {
+	ref, isRef := obj.(pdfRef)
+	if isRef {
+		if ft, ok := in.f.fonts[ref]; ok {
+			return ft
+		}
+	}
+	ft := in.f.font(obj)
+	if isRef {
+		in.f.fonts[ref] = ft
+	}
+	return ft
+}
+
+// show records the glyphs of a shown string and returns the text matrix
+// advanced past them.
+func (in *textInterpreter) show(s pdfString, gs *graphicsState, tm matrix) matrix {
+	if gs.font == nil {
+		gs.font = in.f.font(nil)
+	}
+	params := matrix{gs.fontSize * gs.scale, 0, 0, gs.fontSize, 0, gs.rise}
+	for _, g := range gs.font.glyphs(s) {
+		trm := params.mul(tm).mul(gs.ctm)
+		tx := g.width*gs.fontSize + gs.charSpace
+		if g.space {
+			tx += gs.wordSpace
+		}
+		tm = translate(tx*gs.scale, 0).mul(tm)
+		end := params.mul(tm).mul(gs.ctm)
+
+		dir := direction(trm)
+		x, y := readingFrame(dir, trm[4], trm[5])
+		x1, _ := readingFrame(dir, end[4], end[5])
+		in.emit(g.text, dir, x, y, x1, math.Hypot(trm[2], trm[3]))
+	}
+	return tm
+}
+
+// emit adds a glyph's text to the current run, or starts a new run when the
+// glyph runs in another direction, is on another line or is far from the end
+// of the current one.
+func (in *textInterpreter) emit(text string, dir int, x, y, x1, size float64) {
+	if text == "" {
+		return
+	}
+	if size <= 0 {
+		size = 1
+	}
+	if n := len(in.runs); n > 0 {
+		r := &in.runs[n-1]
+		gap := x - r.x1
+		if r.dir == dir && math.Abs(r.y-y) < size/2 && gap > -size && gap < size {
+			if gap > size*0.15 && !strings.HasSuffix(r.text, " ") && text != " " {
+				r.text += " "
+			}
+			r.text += text
+			r.x1 = math.Max(r.x1, x1)
+			return
+		}
+	}
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	in.runs = append(in.runs, textRun{text: text, dir: dir, x0: x, x1: x1, y: y, size: size})
+}
+
+// layoutRuns orders a page's runs for reading and returns its lines, with an
+// empty line between paragraphs. Pages split by a vertical gutter are read
+// column by column, with text spanning the columns, such as titles and
+// footers, kept in place between them.
+func layoutRuns(runs []textRun, depth int) []string {
+	if len(runs) == 0 {
+		return nil
+	}
+	gutterStart, gutterEnd, ok := findGutter(runs)
+	if !ok || depth >= 3 {
+		return layoutLines(runs)
+	}
+
+	var left, right, spanning []textRun
+	for _, r := range runs {
+		switch {
+		case r.x1 <= gutterStart:
+			left = append(left, r)
+		case r.x0 >= gutterEnd:
+			right = append(right, r)
+		default:
+			spanning = append(spanning, r)
+		}
+	}
+	sort.SliceStable(spanning, func(i, j int) bool { return spanning[i].y > spanning[j].y })
+
+	// Spanning text divides the page into bands, each read column by column.
+	var lines []string
+	band := func(top, bottom float64) {
+		inBand := func(rs []textRun) []textRun {
+			var out []textRun
+			for _, r := range rs {
+				if r.y < top && r.y >= bottom {
+					out = append(out, r)
+				}
+			}
+			return out
+		}
+		for _, col := range [][]textRun{inBand(left), inBand(right)} {
+			if colLines := layoutRuns(col, depth+1); len(colLines) > 0 {
+				if len(lines) > 0 {
+					lines = append(lines, "")
+				}
+				lines = append(lines, colLines...)
+			}
+		}
+	}
+	top := math.Inf(1)
+	for i := 0; i < len(spanning); {
+		j := i + 1
+		for j < len(spanning) && spanning[i].y-spanning[j].y < spanning[i].size/2 {
+			j++
+		}
+		y := spanning[i].y + spanning[i].size/2
+		band(top, y)
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, layoutLines(spanning[i:j])...)
+		top = spanning[j-1].y - spanning[j-1].size/2
+		i = j
+	}
+	band(top, math.Inf(-1))
+	return lines
+}
+
+// findGutter looks for a vertical strip free of text that divides the runs
+// into two columns of comparable height, returning its x range.
+func findGutter(runs []textRun) (float64, float64, bool) {
+	if len(runs) < 6 {
+		return 0, 0, false
+	}
+	minX, maxX := math.Inf(1), math.Inf(-1)
+	for _, r := range runs {
+		minX, maxX = math.Min(minX, r.x0), math.Max(maxX, r.x1)
+	}
+	width := maxX - minX
+	if width < 100 || width > 10000 {
+		return 0, 0, false
+	}
+
+	// Count the runs covering each point across the page. A few runs, such
+	// as a title and a footer, may cross the gutter.
+	cover := make([]int, int(width)+1)
+	for _, r := range runs {
+		for x := int(r.x0 - minX); x <= int(r.x1-minX) && x < len(cover); x++ {
+			cover[max(x, 0)]++
+		}
+	}
+	allowed := max(2, len(runs)/20)
+
+	bestStart, bestEnd := -1, -1
+	for x := int(width * 0.2); x < int(width*0.8); x++ {
+		if cover[x] > allowed {
+			continue
+		}
+		end := x
+		for end+1 < len(cover) && cover[end+1] <= allowed {
+			end++
+		}
+		if end-x > bestEnd-bestStart {
+			bestStart, bestEnd = x, end
+		}
+		x = end
+	}
+	if bestStart < 0 || bestEnd-bestStart < 6 {
+		return 0, 0, false
+	}
+	start, end := minX+float64(bestStart), minX+float64(bestEnd)
+
+	// Both sides must hold a real share of the text, over overlapping heights.
+	var nLeft, nRight int
+	leftTop, leftBottom := math.Inf(-1), math.Inf(1)
+	rightTop, rightBottom := math.Inf(-1), math.Inf(1)
+	for _, r := range runs {
+		switch {
+		case r.x1 <= start:
+			nLeft++
+			leftTop, leftBottom = math.Max(leftTop, r.y), math.Min(leftBottom, r.y)
+		case r.x0 >= end:
+			nRight++
+			rightTop, rightBottom = math.Max(rightTop, r.y), math.Min(rightBottom, r.y)
+		}
+	}
+	minShare := max(3, len(runs)/5)
+	if nLeft < minShare || nRight < minShare {
+		return 0, 0, false
+	}
+	overlap := math.Min(leftTop, rightTop) - math.Max(leftBottom, rightBottom)
+	shorter := math.Min(leftTop-leftBottom, rightTop-rightBottom)
+	if overlap < shorter/2 {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// layoutLines groups runs into lines by baseline, top to bottom, and inserts
+// an empty line where the gap between lines marks a new paragraph.
+func layoutLines(runs []textRun) []string {
+	runs = append([]textRun(nil), runs...)
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].y > runs[j].y })
+
+	var lines []string
+	for i := 0; i < len(runs); {
+		// Runs within half a line of the first are on the same line.
+		j := i + 1
+		for j < len(runs) && math.Abs(runs[j].y-runs[i].y) < runs[i].size/2 {
+			j++
+		}
+		line := runs[i:j]
+		sort.SliceStable(line, func(a, b int) bool { return line[a].x0 < line[b].x0 })
+
+		var sb strings.Builder
+		for k, r := range line {
+			if k > 0 && r.x0-line[k-1].x1 > r.size*0.15 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(r.text)
+		}
+
+		if i > 0 {
+			prev := runs[i-1]
+			if prev.y-runs[i].y > 1.6*math.Max(prev.size, runs[i].size) {
+				lines = append(lines, "")
+			}
+		}
+		lines = append(lines, strings.TrimSpace(sb.String()))
+		i = j
+	}
+	return lines
+}
@@ -0,0 +1,204 @@
+package extract
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PPTX extracts the text of a PowerPoint presentation, slide by slide in show
+// order, followed by each slide's speaker notes. Each slide is a location
+// carrying its number and title.
+func PPTX(data []byte) (*Result, error) {
+	pkg, err := openOOXML(data, "pptx")
+	if err != nil {
+		return nil, err
+	}
+	presentation, err := pkg.read("ppt/presentation.xml")
+	if err != nil {
+		return nil, fmt.Errorf("pptx: %w", err)
+	}
+	if presentation == nil {
+		return nil, errors.New("pptx: presentation part is missing")
+	}
+	var pres struct {
+		Slides []struct {
+			Attrs []xml.Attr `xml:",any,attr"`
+		} `xml:"sldIdLst>sldId"`
+	}
+	if err := xml.Unmarshal(presentation, &pres); err != nil {
+		return nil, fmt.Errorf("pptx: malformed presentation: %w", err)
+	}
+	rels, err := pkg.relationships("ppt/presentation.xml")
+	if err != nil {
+		return nil, fmt.Errorf("pptx: %w", err)
+	}
+
+	res := &Result{MIMEType: "text/plain"}
+	var b strings.Builder
+	for i, s := range pres.Slides {
+		number := i + 1
+		part, ok := rels[relID(xml.StartElement{Attr: s.Attrs})]
+		if !ok {
+			return nil, fmt.Errorf("pptx: slide %d has no part", number)
+		}
+		data, err := pkg.read(part)
+		if err != nil {
+			return nil, fmt.Errorf("pptx: %w", err)
+		}
+		if data == nil {
+			return nil, fmt.Errorf("pptx: part %s of slide %d is missing", part, number)
+		}
+		slide, err := pptxSlideText(data)
+		if err != nil {
+			return nil, fmt.Errorf("pptx: malformed slide %d: %w", number, err)
+		}
+		if slide.hidden {
+			continue
+		}
+		notes, err := pptxNotes(pkg, part)
+		if err != nil {
+			return nil, fmt.Errorf("pptx: malformed notes of slide %d: %w", number, err)
+		}
+		if slide.title == "" && len(slide.body) == 0 && len(notes) == 0 {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		metadata := map[string]interface{}{"slide": number}
+		if slide.title != "" {
+			metadata["slide_title"] = slide.title
+		}
+		res.Locations = append(res.Locations, Location{Offset: b.Len(), Metadata: metadata})
+		b.WriteString("Slide " + strconv.Itoa(number))
+		if slide.title != "" {
+			b.WriteString(": " + slide.title)
+		}
+		if len(slide.body) > 0 {
+			b.WriteString("\n\n" + strings.Join(slide.body, "\n"))
+		}
+		if len(notes) > 0 {
+			b.WriteString("\n\nNotes:\n" + strings.Join(notes, "\n"))
+		}
+	}
+	res.Text = b.String()
+	if strings.TrimSpace(res.Text) == "" {
+		return nil, errors.New("pptx: no extractable text")
+	}
+	return res, nil
+}
+
+// pptxSlide is the text of one slide.
+type pptxSlide struct {
+	title  string
+	body   []string
+	hidden bool
+}
+
+// pptxSlideText reads the text of a slide's shapes. The first title
+// placeholder is the slide's title; the lines of other shapes, including table
+// rows, form its body.
+func pptxSlideText(data []byte) (*pptxSlide, error) {
+	slide := &pptxSlide{}
+	var shape []string // paragraphs of the current shape
+	var para strings.Builder
+	var row []string
+	isTitle, isChrome, inText := false, false, false
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return slide, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "sld":
+				slide.hidden = attr(t, "show") == "0"
+			case "Fallback":
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+			case "sp", "graphicFrame":
+				shape, isTitle, isChrome = nil, false, false
+			case "ph":
+				switch attr(t, "type") {
+				case "title", "ctrTitle":
+					isTitle = true
+				case "sldNum", "dt", "ftr", "hdr":
+					// Slide numbers, dates and footers repeat on every slide.
+					isChrome = true
+				}
+			case "p":
+				para.Reset()
+			case "t":
+				inText = true
+			case "br":
+				para.WriteByte('\n')
+			case "tr":
+				row = nil
+			}
+		case xml.CharData:
+			if inText {
+				para.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if text := strings.TrimSpace(para.String()); text != "" {
+					shape = append(shape, text)
+				}
+			case "tc":
+				row = append(row, collapseLines(strings.Join(shape, " ")))
+				shape = nil
+			case "tr":
+				if line := strings.Join(nonEmpty(row), " | "); line != "" {
+					slide.body = append(slide.body, line)
+				}
+			case "sp", "graphicFrame":
+				switch {
+				case isChrome:
+				case isTitle && slide.title == "":
+					slide.title = collapseLines(strings.Join(shape, " "))
+				default:
+					slide.body = append(slide.body, shape...)
+				}
+				shape = nil
+			}
+		}
+	}
+}
+
+// pptxNotes returns the speaker notes of a slide, if it has any.
+func pptxNotes(pkg *ooxmlPackage, slidePart string) ([]string, error) {
+	rels, err := pkg.relationships(slidePart)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range rels {
+		if !strings.Contains(target, "notesSlides/") {
+			continue
+		}
+		data, err := pkg.read(target)
+		if err != nil || data == nil {
+			return nil, err
+		}
+		notes, err := pptxSlideText(data)
+		if err != nil {
+			return nil, err
+		}
+		return notes.body, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,277 @@
+package extract
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// XLSX extracts the rows of an Excel workbook. The first non-empty row of each
+// sheet is taken as its header, and every later row is written as
+// "Header: value" pairs so that a chunk of rows keeps the meaning of its
+// columns. Each row is a location carrying its sheet name and row number.
+func XLSX(data []byte) (*Result, error) {
+	pkg, err := openOOXML(data, "xlsx")
+	if err != nil {
+		return nil, err
+	}
+	workbook, err := pkg.read("xl/workbook.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: %w", err)
+	}
+	if workbook == nil {
+		return nil, errors.New("xlsx: workbook part is missing")
+	}
+	var wb struct {
+		Sheets []struct {
+			Name  string     `xml:"name,attr"`
+			State string     `xml:"state,attr"`
+			Attrs []xml.Attr `xml:",any,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := xml.Unmarshal(workbook, &wb); err != nil {
+		return nil, fmt.Errorf("xlsx: malformed workbook: %w", err)
+	}
+	rels, err := pkg.relationships("xl/workbook.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: %w", err)
+	}
+	shared, err := xlsxSharedStrings(pkg)
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: malformed shared strings: %w", err)
+	}
+
+	res := &Result{MIMEType: "text/plain"}
+	var b strings.Builder
+	for _, sheet := range wb.Sheets {
+		if sheet.State == "hidden" || sheet.State == "veryHidden" {
+			continue
+		}
+		part, ok := rels[relID(xml.StartElement{Attr: sheet.Attrs})]
+		if !ok {
+			return nil, fmt.Errorf("xlsx: sheet %q has no part", sheet.Name)
+		}
+		data, err := pkg.read(part)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: %w", err)
+		}
+		if data == nil {
+			return nil, fmt.Errorf("xlsx: part %s of sheet %q is missing", part, sheet.Name)
+		}
+		rows, err := xlsxRows(data, shared)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: malformed sheet %q: %w", sheet.Name, err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		header := rows[0]
+		for _, r := range rows[1:] {
+			var pairs []string
+			for i, v := range r.cells {
+				if v == "" {
+					continue
+				}
+				if i < len(header.cells) && header.cells[i] != "" {
+					v = header.cells[i] + ": " + v
+				}
+				pairs = append(pairs, v)
+			}
+			if len(pairs) == 0 {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteString("\n\n")
+			}
+			res.Locations = append(res.Locations, Location{
+				Offset:   b.Len(),
+				Metadata: map[string]interface{}{"sheet": sheet.Name, "row": r.number},
+			})
+			b.WriteString(strings.Join(pairs, " | "))
+		}
+		if len(rows) == 1 {
+			// A sheet with a single row, e.g. a list of names, is all header.
+			if b.Len() > 0 {
+				b.WriteString("\n\n")
+			}
+			res.Locations = append(res.Locations, Location{
+				Offset:   b.Len(),
+				Metadata: map[string]interface{}{"sheet": sheet.Name, "row": header.number},
+			})
+			b.WriteString(strings.Join(nonEmpty(header.cells), " | "))
+		}
+	}
+	res.Text = b.String()
+	if strings.TrimSpace(res.Text) == "" {
+		return nil, errors.New("xlsx: no extractable text")
+	}
+	return res, nil
+}
+
+// xlsxSharedStrings reads the workbook's shared string table, which cells of
+// type "s" index into.
+func xlsxSharedStrings(pkg *ooxmlPackage) ([]string, error) {
+	data, err := pkg.read("xl/sharedStrings.xml")
+	if err != nil || data == nil {
+		return nil, err
+	}
+	var sst []string
+	var cur strings.Builder
+	inItem, inText, inPhonetic := false, false, false
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return sst, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				inItem = true
+				cur.Reset()
+			case "rPh":
+				inPhonetic = true
+			case "t":
+				inText = true
+			}
+		case xml.CharData:
+			if inItem && inText && !inPhonetic {
+				cur.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				inItem = false
+				sst = append(sst, cur.String())
+			case "rPh":
+				inPhonetic = false
+			case "t":
+				inText = false
+			}
+		}
+	}
+}
+
+// xlsxRow is a non-empty row of a sheet, its cells placed by column.
+type xlsxRow struct {
+	number int
+	cells  []string
+}
+
+// xlsxRows reads the non-empty rows of a sheet.
+func xlsxRows(data []byte, shared []string) ([]xlsxRow, error) {
+	var rows []xlsxRow
+	var row *xlsxRow
+	var cellType, cellRef string
+	var value strings.Builder
+	inValue := false
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				number, err := strconv.Atoi(attr(t, "r"))
+				if err != nil {
+					number = len(rows) + 1
+				}
+				row = &xlsxRow{number: number}
+			case "c":
+				cellType, cellRef = attr(t, "t"), attr(t, "r")
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			case "rPh":
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				if row == nil {
+					continue
+				}
+				col := xlsxColumn(cellRef)
+				if col < 0 {
+					col = len(row.cells)
+				}
+				if v := xlsxValue(cellType, strings.TrimSpace(value.String()), shared); v != "" && col < 1<<14 {
+					for len(row.cells) <= col {
+						row.cells = append(row.cells, "")
+					}
+					row.cells[col] = collapseLines(v)
+				}
+			case "row":
+				if row != nil && len(nonEmpty(row.cells)) > 0 {
+					rows = append(rows, *row)
+				}
+				row = nil
+			}
+		}
+	}
+}
+
+// xlsxValue renders a cell's raw value according to its type.
+func xlsxValue(cellType, raw string, shared []string) string {
+	switch cellType {
+	case "s":
+		if i, err := strconv.Atoi(raw); err == nil && i >= 0 && i < len(shared) {
+			return shared[i]
+		}
+		return ""
+	case "b":
+		if raw == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	default:
+		// Numbers, formula results and inline strings are used as stored.
+		return raw
+	}
+}
+
+// xlsxColumn returns the zero-based column of a cell reference such as "AB12",
+// or -1 if the reference has no column.
+func xlsxColumn(ref string) int {
+	col := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+	}
+	return col - 1
+}
+
+func nonEmpty(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+// Package fakeinferencer provides a deterministic, dependency-free implementation
+// of the Inferencer gRPC service so the pipeline can run and be tested offline.
+package fakeinferencer
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"net"
+	"strings"
+	"unicode"
+
+	"go-rag/services/proto"
+	"go-rag/services/qdrant"
+
+	"google.golang.org/grpc"
+)
+
+// Server is a fake Inferencer. Embeddings are hashed bags of words, so texts
+// sharing terms are close in cosine space, rerank scores are the fraction of
+// query terms found in each passage, generated answers quote the first
+// sentence of source [1] in the prompt, and follow-up questions are made
+// standalone by appending the previous question.
+type Server struct {
+	proto.UnimplementedInferencerServer
+}
+
+// GetEmbedding returns a normalized feature-hashed vector of the text's terms.
+func (s *Server) GetEmbedding(ctx context.Context, req *proto.EmbeddingRequest) (*proto.EmbeddingResponse, error) {
+	return &proto.EmbeddingResponse{Embedding: Embed(req.GetText())}, nil
+}
+
+// Rerank scores each passage by its overlap with the query terms.
+func (s *Server) Rerank(ctx context.Context, req *proto.RerankRequest) (*proto.RerankResponse, error) {
+	queryTerms := terms(req.GetQuery())
+	scores := make([]float32, len(req.GetPassages()))
+	if len(queryTerms) == 0 {
+		return &proto.RerankResponse{Scores: scores}, nil
+	}
+
+	for i, passage := range req.GetPassages() {
+		passageTerms := make(map[string]bool)
+		for _, t := range terms(passage) {
+			passageTerms[t] = true
+		}
+		matched := 0
+		for _, t := range queryTerms {
+			if passageTerms[t] {
+				matched++
+			}
+		}
+		scores[i] = float32(matched) / float32(len(queryTerms))
+	}
+	return &proto.RerankResponse{Scores: scores}, nil
+}
+
+// Generate streams an answer built from the prompt's first source, one word per message.
+func (s *Server) Generate(req *proto.GenerateRequest, stream grpc.ServerStreamingServer[proto.GenerateResponse]) error {
+	for i, word := range strings.Fields(Answer(req.GetPrompt())) {
+		token := word
+		if i > 0 {
+			token = " " + word
+		}
+		if err := stream.Send(&proto.GenerateResponse{Token: token}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Answer computes the fake answer for a prompt: the first sentence of the
+// source introduced by a "[1]" line, cited as [1]. For question rewriting
+// prompts it is the follow-up question followed by the last user question.
+func Answer(prompt string) string {
+	lines := strings.Split(prompt, "\n")
+
+	if strings.HasSuffix(strings.TrimSpace(prompt), "Standalone question:") {
+		var previous, followUp string
+		for _, line := range lines {
+			if q, ok := strings.CutPrefix(line, "User: "); ok {
+				previous = q
+			}
+			if q, ok := strings.CutPrefix(line, "Follow-up question: "); ok {
+				followUp = q
+			}
+		}
+		return strings.TrimSpace(followUp + " " + previous)
+	}
+
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "[1]") {
+			continue
+		}
+		for _, next := range lines[i+1:] {
+			next = strings.TrimSpace(next)
+			if next == "" {
+				continue
+			}
+			if end := strings.IndexAny(next, ".!?"); end >= 0 {
+				next = next[:end+1]
+			}
+			return next + " [1]"
+		}
+	}
+	return "I don't know based on the provided sources."
+}
+
+// Embed computes the fake embedding for a text.
+func Embed(text string) []float32 {
+	vector := make([]float32, qdrant.VectorSize)
+	for _, t := range terms(text) {
+		h := fnv.New32a()
+		h.Write([]byte(t))
+		sum := h.Sum32()
+		// The low bit picks the sign so unrelated terms tend to cancel out.
+		if sum&1 == 0 {
+			vector[sum%qdrant.VectorSize]++
+		} else {
+			vector[sum%qdrant.VectorSize]--
+		}
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm == 0 {
+		return vector
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] = float32(float64(vector[i]) / norm)
+	}
+	return vector
+}
+
+// terms splits text into lowercase alphanumeric words.
+func terms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// Serve registers a fake Inferencer on a new gRPC server and serves on lis
+// until the listener fails or the server is stopped.
+func Serve(lis net.Listener) (*grpc.Server, <-chan error) {
+	srv := grpc.NewServer()
+	proto.RegisterInferencerServer(srv, &Server{})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+	return srv, errCh
+}
@@ -111,6 +111,210 @@ func (x *EmbeddingResponse) GetEmbedding() []float32 {
 	return nil
 }
 
+// Request message for reranking
+type RerankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Passages      []string               `protobuf:"bytes,2,rep,name=passages,proto3" json:"passages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerankRequest) Reset() {
+	*x = RerankRequest{}
+	mi := &file_proto_embeddings_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerankRequest) ProtoMessage() {}
+
+func (x *RerankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_embeddings_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerankRequest.ProtoReflect.Descriptor instead.
+func (*RerankRequest) Descriptor() ([]byte, []int) {
+	return file_proto_embeddings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RerankRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RerankRequest) GetPassages() []string {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+// Response message for reranking, one score per passage in request order
+type RerankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []float32              `protobuf:"fixed32,1,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerankResponse) Reset() {
+	*x = RerankResponse{}
+	mi := &file_proto_embeddings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerankResponse) ProtoMessage() {}
+
+func (x *RerankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_embeddings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerankResponse.ProtoReflect.Descriptor instead.
+func (*RerankResponse) Descriptor() ([]byte, []int) {
+	return file_proto_embeddings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RerankResponse) GetScores() []float32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// Request message for answer generation
+type GenerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        string                 `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	MaxTokens     int32                  `protobuf:"varint,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Temperature   float32                `protobuf:"fixed32,3,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_proto_embeddings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_embeddings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_embeddings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerateRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *GenerateRequest) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *GenerateRequest) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+// Streamed response message for answer generation, one or more tokens per message
+type GenerateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateResponse) Reset() {
+	*x = GenerateResponse{}
+	mi := &file_proto_embeddings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateResponse) ProtoMessage() {}
+
+func (x *GenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_embeddings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_embeddings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenerateResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 var File_proto_embeddings_proto protoreflect.FileDescriptor
 
 const file_proto_embeddings_proto_rawDesc = "" +
@@ -119,10 +323,24 @@ const file_proto_embeddings_proto_rawDesc = "" +
 	"\x10EmbeddingRequest\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\"1\n" +
 	"\x11EmbeddingResponse\x12\x1c\n" +
-	"\tembedding\x18\x01 \x03(\x02R\tembedding2W\n" +
+	"\tembedding\x18\x01 \x03(\x02R\tembedding\"A\n" +
+	"\rRerankRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bpassages\x18\x02 \x03(\tR\bpassages\"(\n" +
+	"\x0eRerankResponse\x12\x16\n" +
+	"\x06scores\x18\x01 \x03(\x02R\x06scores\"j\n" +
+	"\x0fGenerateRequest\x12\x16\n" +
+	"\x06prompt\x18\x01 \x01(\tR\x06prompt\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x02 \x01(\x05R\tmaxTokens\x12 \n" +
+	"\vtemperature\x18\x03 \x01(\x02R\vtemperature\"(\n" +
+	"\x10GenerateResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token2\xdd\x01\n" +
 	"\n" +
 	"Inferencer\x12I\n" +
-	"\fGetEmbedding\x12\x1b.inference.EmbeddingRequest\x1a\x1c.inference.EmbeddingResponseB-Z+github.com/garv/go-rag/services/proto;protob\x06proto3"
+	"\fGetEmbedding\x12\x1b.inference.EmbeddingRequest\x1a\x1c.inference.EmbeddingResponse\x12=\n" +
+	"\x06Rerank\x12\x18.inference.RerankRequest\x1a\x19.inference.RerankResponse\x12E\n" +
+	"\bGenerate\x12\x1a.inference.GenerateRequest\x1a\x1b.inference.GenerateResponse0\x01B-Z+github.com/garv/go-rag/services/proto;protob\x06proto3"
 
 var (
 	file_proto_embeddings_proto_rawDescOnce sync.Once
@@ -136,16 +354,24 @@ func file_proto_embeddings_proto_rawDescGZIP() []byte {
 	return file_proto_embeddings_proto_rawDescData
 }
 
-var file_proto_embeddings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_embeddings_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_embeddings_proto_goTypes = []any{
 	(*EmbeddingRequest)(nil),  // 0: inference.EmbeddingRequest
 	(*EmbeddingResponse)(nil), // 1: inference.EmbeddingResponse
+	(*RerankRequest)(nil),     // 2: inference.RerankRequest
+	(*RerankResponse)(nil),    // 3: inference.RerankResponse
+	(*GenerateRequest)(nil),   // 4: inference.GenerateRequest
+	(*GenerateResponse)(nil),  // 5: inference.GenerateResponse
 }
 var file_proto_embeddings_proto_depIdxs = []int32{
 	0, // 0: inference.Inferencer.GetEmbedding:input_type -> inference.EmbeddingRequest
-	1, // 1: inference.Inferencer.GetEmbedding:output_type -> inference.EmbeddingResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: inference.Inferencer.Rerank:input_type -> inference.RerankRequest
+	4, // 2: inference.Inferencer.Generate:input_type -> inference.GenerateRequest
+	1, // 3: inference.Inferencer.GetEmbedding:output_type -> inference.EmbeddingResponse
+	3, // 4: inference.Inferencer.Rerank:output_type -> inference.RerankResponse
+	5, // 5: inference.Inferencer.Generate:output_type -> inference.GenerateResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -162,7 +388,7 @@ func file_proto_embeddings_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_embeddings_proto_rawDesc), len(file_proto_embeddings_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
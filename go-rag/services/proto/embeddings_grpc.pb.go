@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Inferencer_GetEmbedding_FullMethodName = "/inference.Inferencer/GetEmbedding"
+	Inferencer_Rerank_FullMethodName       = "/inference.Inferencer/Rerank"
+	Inferencer_Generate_FullMethodName     = "/inference.Inferencer/Generate"
 )
 
 // InferencerClient is the client API for Inferencer service.
@@ -29,6 +31,8 @@ const (
 // gRPC service
 type InferencerClient interface {
 	GetEmbedding(ctx context.Context, in *EmbeddingRequest, opts ...grpc.CallOption) (*EmbeddingResponse, error)
+	Rerank(ctx context.Context, in *RerankRequest, opts ...grpc.CallOption) (*RerankResponse, error)
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateResponse], error)
 }
 
 type inferencerClient struct {
@@ -49,6 +53,35 @@ func (c *inferencerClient) GetEmbedding(ctx context.Context, in *EmbeddingReques
 	return out, nil
 }
 
+func (c *inferencerClient) Rerank(ctx context.Context, in *RerankRequest, opts ...grpc.CallOption) (*RerankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RerankResponse)
+	err := c.cc.Invoke(ctx, Inferencer_Rerank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inferencerClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Inferencer_ServiceDesc.Streams[0], Inferencer_Generate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateRequest, GenerateResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Inferencer_GenerateClient = grpc.ServerStreamingClient[GenerateResponse]
+
 // InferencerServer is the server API for Inferencer service.
 // All implementations must embed UnimplementedInferencerServer
 // for forward compatibility.
@@ -56,6 +89,8 @@ func (c *inferencerClient) GetEmbedding(ctx context.Context, in *EmbeddingReques
 // gRPC service
 type InferencerServer interface {
 	GetEmbedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error)
+	Rerank(context.Context, *RerankRequest) (*RerankResponse, error)
+	Generate(*GenerateRequest, grpc.ServerStreamingServer[GenerateResponse]) error
 	mustEmbedUnimplementedInferencerServer()
 }
 
@@ -69,6 +104,12 @@ type UnimplementedInferencerServer struct{}
 func (UnimplementedInferencerServer) GetEmbedding(context.Context, *EmbeddingRequest) (*EmbeddingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEmbedding not implemented")
 }
+func (UnimplementedInferencerServer) Rerank(context.Context, *RerankRequest) (*RerankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rerank not implemented")
+}
+func (UnimplementedInferencerServer) Generate(*GenerateRequest, grpc.ServerStreamingServer[GenerateResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
 func (UnimplementedInferencerServer) mustEmbedUnimplementedInferencerServer() {}
 func (UnimplementedInferencerServer) testEmbeddedByValue()                    {}
 
@@ -108,6 +149,35 @@ func _Inferencer_GetEmbedding_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inferencer_Rerank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InferencerServer).Rerank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inferencer_Rerank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InferencerServer).Rerank(ctx, req.(*RerankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inferencer_Generate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InferencerServer).Generate(m, &grpc.GenericServerStream[GenerateRequest, GenerateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Inferencer_GenerateServer = grpc.ServerStreamingServer[GenerateResponse]
+
 // Inferencer_ServiceDesc is the grpc.ServiceDesc for Inferencer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,7 +189,17 @@ var Inferencer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEmbedding",
 			Handler:    _Inferencer_GetEmbedding_Handler,
 		},
+		{
+			MethodName: "Rerank",
+			Handler:    _Inferencer_Rerank_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Generate",
+			Handler:       _Inferencer_Generate_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/embeddings.proto",
 }
@@ -121,12 +121,34 @@ func EnsureCollectionExists(ctx context.Context, collectionsClient qdrant.Collec
 			}
 
 			log.Info("all payload indexes created successfully")
-			return nil
+			return EnsureMetadataIndexes(ctx, pointsClient, collectionName)
 		}
 		return fmt.Errorf("could not get collection info: %w", err)
 	}
 
 	log.Info("collection already exists")
+	return EnsureMetadataIndexes(ctx, pointsClient, collectionName)
+}
+
+// metadataIndexFields are the chunk metadata payload keys that searches filter on.
+var metadataIndexFields = []string{"language", "headings", "kind", "name"}
+
+// EnsureMetadataIndexes creates keyword payload indexes for the chunk metadata
+// keys. Creating an index that already exists is a no-op, so this also
+// upgrades collections created before the keys were added.
+func EnsureMetadataIndexes(ctx context.Context, pointsClient qdrant.PointsClient, collectionName string) error {
+	wait := true
+	for _, field := range metadataIndexFields {
+		_, err := pointsClient.CreateFieldIndex(ctx, &qdrant.CreateFieldIndexCollection{
+			CollectionName: collectionName,
+			FieldName:      field,
+			FieldType:      qdrant.FieldType_FieldTypeKeyword.Enum(),
+			Wait:           &wait,
+		})
+		if err != nil {
+			return fmt.Errorf("could not create '%s' payload index: %w", field, err)
+		}
+	}
 	return nil
 }
 